@@ -74,6 +74,7 @@ func (ab *AppBundle) WriteBundle(out io.Writer, opts ...WriteOption) error {
 			return fmt.Errorf("loading applet for bundling: %w", err)
 		}
 		bundleFiles = app.PathsForBundle()
+		app.Close()
 	}
 
 	// Setup writers.
@@ -5,6 +5,7 @@ import (
 	"io/fs"
 	"os"
 	"path/filepath"
+	"slices"
 	"strings"
 	"time"
 
@@ -12,6 +13,7 @@ import (
 	"github.com/spf13/cobra"
 	"tidbyt.dev/pixlet/cmd/community"
 	"tidbyt.dev/pixlet/manifest"
+	"tidbyt.dev/pixlet/runtime"
 	"tidbyt.dev/pixlet/tools"
 )
 
@@ -35,7 +37,10 @@ containing multiple Starlark files and resources.
 The check command runs a series of checks to ensure your app is ready
 to publish in the community repo. Every failed check will have a solution
 provided. If your app fails a check, try the provided solution and reach out on
-Discord if you get stuck.`,
+Discord if you get stuck.
+
+Apps that use privileged modules, which reach the network, secrets or
+archives, are listed with the modules they use.`,
 	Args: cobra.MinimumNArgs(1),
 	RunE: checkCmd,
 }
@@ -157,8 +162,18 @@ func checkCmd(cmd *cobra.Command, args []string) error {
 			return nil
 		})
 
+		// Find out which privileged modules the app uses, so that they
+		// can be reviewed.
+		privileged, err := privilegedModules(path, fsys)
+		if err != nil {
+			return fmt.Errorf("could not load app: %w", err)
+		}
+
 		// If we're here, the app and manifest are good to go!
 		success(path)
+		if len(privileged) > 0 {
+			fmt.Printf("  ▪️ Privileged modules: %s\n", strings.Join(privileged, ", "))
+		}
 	}
 
 	if foundIssue {
@@ -168,6 +183,24 @@ func checkCmd(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+// privilegedModules returns the privileged modules loaded by the app.
+func privilegedModules(path string, fsys fs.FS) ([]string, error) {
+	applet, err := runtime.NewAppletFromFS(filepath.Base(path), fsys, runtime.WithPrintDisabled())
+	if err != nil {
+		return nil, err
+	}
+	defer applet.Close()
+
+	var privileged []string
+	for _, m := range applet.Modules() {
+		if slices.Contains(runtime.PrivilegedModules, m) {
+			privileged = append(privileged, m)
+		}
+	}
+
+	return privileged, nil
+}
+
 func doesManifestExist(dir string) bool {
 	file := filepath.Join(dir, manifest.ManifestFileName)
 	_, err := os.Stat(file)
@@ -45,9 +45,11 @@ func LoadApp(cmd *cobra.Command, args []string) error {
 	runtime.InitHTTP(cache)
 	runtime.InitCache(cache)
 
-	if _, err := runtime.NewAppletFromFS(filepath.Base(path), fs, runtime.WithPrintDisabled()); err != nil {
+	applet, err := runtime.NewAppletFromFS(filepath.Base(path), fs, runtime.WithPrintDisabled())
+	if err != nil {
 		return fmt.Errorf("failed to load applet: %w", err)
 	}
+	applet.Close()
 
 	return nil
 }
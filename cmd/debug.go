@@ -0,0 +1,101 @@
+package cmd
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"tidbyt.dev/pixlet/runtime"
+	"tidbyt.dev/pixlet/server/dap"
+)
+
+var (
+	debugAddr      string
+	debugStdio     bool
+	debugHandler   string
+	debugParameter string
+)
+
+func init() {
+	DebugCmd.Flags().StringVarP(&debugAddr, "addr", "", "127.0.0.1:4711", "Address to listen on for the debugger client")
+	DebugCmd.Flags().BoolVarP(&debugStdio, "stdio", "", false, "Talk to the debugger client over stdin and stdout instead of listening")
+	DebugCmd.Flags().StringVarP(&debugHandler, "handler", "", "", "Debug the named schema handler instead of main()")
+	DebugCmd.Flags().StringVarP(&debugParameter, "parameter", "", "", "Parameter to pass to the schema handler")
+}
+
+var DebugCmd = &cobra.Command{
+	Use:   "debug [path] [<key>=value>]...",
+	Short: "Debug a Pixlet app with a Debug Adapter Protocol client",
+	Args:  cobra.MinimumNArgs(1),
+	RunE:  debug,
+	Long: `Debug a Pixlet app with a Debug Adapter Protocol client.
+
+The path argument should be the path to the Pixlet app to debug. The
+app can be a single file with the .star extension, or a directory
+containing multiple Starlark files and resources.
+
+The app is run with the provided config parameters once the client
+has connected and set its breakpoints. Clients such as VS Code can
+set breakpoints, step through the app and inspect variables.
+
+By default, a single client connection is accepted on --addr. With
+--stdio, the protocol is spoken over stdin and stdout, which is how
+editors usually launch debug adapters.`,
+}
+
+func debug(cmd *cobra.Command, args []string) error {
+	path := args[0]
+
+	info, err := os.Stat(path)
+	if err != nil {
+		return fmt.Errorf("failed to stat %s: %w", path, err)
+	}
+	if !info.IsDir() && !strings.HasSuffix(path, ".star") {
+		return fmt.Errorf("script file must have suffix .star: %s", path)
+	}
+
+	config := map[string]string{}
+	for _, param := range args[1:] {
+		split := strings.Split(param, "=")
+		if len(split) < 2 {
+			return fmt.Errorf("parameters must be on form <key>=<value>, found %s", param)
+		}
+		config[split[0]] = strings.Join(split[1:], "=")
+	}
+
+	cache := runtime.NewInMemoryCache()
+	runtime.InitHTTP(cache)
+	runtime.InitCache(cache)
+	runtime.InitStore(runtime.NewInMemoryStore(runtime.DefaultStoreQuota))
+
+	newSession := func(s *dap.Session) *dap.Session {
+		s.Program = path
+		s.Config = config
+		s.Handler = debugHandler
+		s.Parameter = debugParameter
+		return s
+	}
+
+	if debugStdio {
+		return newSession(dap.NewSession(os.Stdin, os.Stdout)).Serve()
+	}
+
+	listener, err := net.Listen("tcp", debugAddr)
+	if err != nil {
+		return fmt.Errorf("listening on %s: %w", debugAddr, err)
+	}
+	defer listener.Close()
+
+	fmt.Fprintf(os.Stderr, "waiting for debugger client on %s\n", listener.Addr())
+
+	conn, err := listener.Accept()
+	if err != nil {
+		return fmt.Errorf("accepting connection: %w", err)
+	}
+	defer conn.Close()
+
+	return newSession(dap.NewSession(conn, conn)).Serve()
+}
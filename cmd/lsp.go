@@ -0,0 +1,29 @@
+package cmd
+
+import (
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"tidbyt.dev/pixlet/server/lsp"
+)
+
+var LSPCmd = &cobra.Command{
+	Use:   "lsp",
+	Short: "Run a language server for Pixlet apps",
+	Args:  cobra.NoArgs,
+	RunE:  runLSP,
+	Long: `Run a Language Server Protocol server for Pixlet apps.
+
+The server speaks the protocol over stdin and stdout, and is meant to
+be launched by an editor. It completes module names, widget
+constructors and their arguments, shows widget docs on hover, and
+reports the same warnings as pixlet lint as you type, along with
+unknown or missing widget arguments.`,
+}
+
+func runLSP(cmd *cobra.Command, args []string) error {
+	s := lsp.NewServer(os.Stdin, os.Stdout)
+	s.Warnings = defaultWarnings()
+	return s.Serve()
+}
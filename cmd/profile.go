@@ -119,6 +119,7 @@ func ProfileApp(path string, config map[string]string) (*pprof_profile.Profile,
 	if err != nil {
 		return nil, fmt.Errorf("failed to load applet: %w", err)
 	}
+	defer applet.Close()
 
 	buf := new(bytes.Buffer)
 	if err = starlark.StartProfile(buf); err != nil {
@@ -27,6 +27,7 @@ var (
 	width         int
 	height        int
 	timeout       int
+	installation  string
 )
 
 func init() {
@@ -68,6 +69,13 @@ func init() {
 		30000,
 		"Timeout for execution (ms)",
 	)
+	RenderCmd.Flags().StringVarP(
+		&installation,
+		"installation-id",
+		"",
+		"",
+		"Installation ID to scope cache and store keys to",
+	)
 }
 
 var RenderCmd = &cobra.Command{
@@ -133,6 +141,9 @@ func render(cmd *cobra.Command, args []string) error {
 	if silenceOutput {
 		opts = append(opts, runtime.WithPrintDisabled())
 	}
+	if installation != "" {
+		opts = append(opts, runtime.WithInstallationID(installation))
+	}
 
 	ctx := context.Background()
 	if timeout > 0 {
@@ -146,11 +157,13 @@ func render(cmd *cobra.Command, args []string) error {
 	cache := runtime.NewInMemoryCache()
 	runtime.InitHTTP(cache)
 	runtime.InitCache(cache)
+	runtime.InitStore(runtime.NewInMemoryStore(runtime.DefaultStoreQuota))
 
 	applet, err := runtime.NewAppletFromFS(filepath.Base(path), fs, opts...)
 	if err != nil {
 		return fmt.Errorf("failed to load applet: %w", err)
 	}
+	defer applet.Close()
 
 	roots, err := applet.RunWithConfig(ctx, config)
 	if err != nil {
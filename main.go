@@ -29,6 +29,7 @@ func init() {
 	rootCmd.AddCommand(cmd.DeleteCmd)
 	rootCmd.AddCommand(cmd.FormatCmd)
 	rootCmd.AddCommand(cmd.LintCmd)
+	rootCmd.AddCommand(cmd.LSPCmd)
 	rootCmd.AddCommand(cmd.CheckCmd)
 	rootCmd.AddCommand(cmd.SetAuthCmd)
 	rootCmd.AddCommand(community.CommunityCmd)
@@ -11,4 +11,5 @@ func init() {
 	rootCmd.AddCommand(private.PrivateCmd)
 	rootCmd.AddCommand(cmd.CreateCmd)
 	rootCmd.AddCommand(cmd.ServeCmd)
+	rootCmd.AddCommand(cmd.DebugCmd)
 }
@@ -0,0 +1,225 @@
+package render
+
+import (
+	"image"
+	"image/color"
+	"math"
+
+	"github.com/tidbyt/gg"
+)
+
+// BarChart draws one or more series of values as bars.
+//
+// `data` is either a list of numbers, for a single series, or a list
+// of series. Bars for the same position in each series are drawn next
+// to each other, or on top of each other if `stacked` is set. Bars
+// grow up from zero, or down for negative values, and a value of
+// `None` leaves a gap. With `horizontal` set, bars grow right from
+// zero instead, and the first bar is at the top.
+//
+// With a single series, `colors` are used for each bar in turn.
+// Otherwise, they are used for each series. Negative values are drawn
+// in `color_inverted`, if set.
+//
+// The value axis spans zero and every value, unless limited by
+// `value_lim`.
+//
+// DOC(Data): A list of numbers, or a list of lists of numbers
+// DOC(Width): Limits BarChart width
+// DOC(Height): Limits BarChart height
+// DOC(Colors): Colors of bars or series, default is '#fff'
+// DOC(ColorInverted): Color for values below 0
+// DOC(Horizontal): Draw bars from left to right instead of bottom to top
+// DOC(Stacked): Stack series on top of each other instead of side by side
+// DOC(Gap): Space between bars or groups of bars
+// DOC(ValueLim): Limit value axis to a range
+//
+// EXAMPLE BEGIN
+// render.BarChart(
+//      data = [
+//           [3, 5, 2, -2, 4],
+//           [2, 3, 4, -1, 1],
+//      ],
+//      width = 64,
+//      height = 32,
+//      colors = ["#0a0", "#00a"],
+//      color_inverted = "#a00",
+//      gap = 2,
+// )
+// EXAMPLE END
+type BarChart struct {
+	Widget
+
+	Data [][]float64 `starlark:"data,required"`
+
+	Width  int `starlark:"width,required"`
+	Height int `starlark:"height,required"`
+
+	Colors        []color.Color
+	ColorInverted color.Color `starlark:"color_inverted"`
+
+	Horizontal bool
+	Stacked    bool
+	Gap        int
+
+	ValueLim [2]float64 `starlark:"value_lim"`
+}
+
+// bar is a span of a single bar along the value axis.
+type bar struct {
+	series     int
+	index      int
+	start, end float64
+}
+
+// bars returns the bars of each group, from their start to their end
+// value.
+func (c BarChart) bars() [][]bar {
+	count := 0
+	for _, series := range c.Data {
+		count = max(count, len(series))
+	}
+
+	groups := make([][]bar, count)
+	for i := range groups {
+		positive, negative := 0.0, 0.0
+		for s, series := range c.Data {
+			if i >= len(series) || math.IsNaN(series[i]) {
+				groups[i] = append(groups[i], bar{series: s, index: i})
+				continue
+			}
+
+			v := series[i]
+			b := bar{series: s, index: i, end: v}
+			if c.Stacked {
+				if v >= 0 {
+					b.start, b.end = positive, positive+v
+					positive += v
+				} else {
+					b.start, b.end = negative, negative+v
+					negative += v
+				}
+			}
+			groups[i] = append(groups[i], b)
+		}
+	}
+	return groups
+}
+
+// limits returns the range of the value axis.
+func (c BarChart) limits(groups [][]bar) (float64, float64) {
+	lo, hi := 0.0, 0.0
+	for _, group := range groups {
+		for _, b := range group {
+			lo = math.Min(lo, math.Min(b.start, b.end))
+			hi = math.Max(hi, math.Max(b.start, b.end))
+		}
+	}
+
+	limLo, limHi := lo, hi
+	if !math.IsNaN(c.ValueLim[0]) {
+		limLo = c.ValueLim[0]
+	}
+	if !math.IsNaN(c.ValueLim[1]) {
+		limHi = c.ValueLim[1]
+	}
+
+	// Limits that leave no room, like the zero value, fall back to
+	// the range of the data
+	if limLo < limHi {
+		return limLo, limHi
+	}
+	if lo == hi {
+		hi = lo + 1
+	}
+	return lo, hi
+}
+
+// spans splits size pixels into n spans with gap pixels between them,
+// and returns the start and end of each span.
+func spans(size, n, gap int) [][2]int {
+	free := max(size-gap*(n-1), 0)
+
+	result := make([][2]int, n)
+	pos := 0
+	for i := range result {
+		result[i][0] = pos
+		pos += free / n
+		if i < free%n {
+			pos++
+		}
+		result[i][1] = pos
+		pos += gap
+	}
+	return result
+}
+
+func (c BarChart) PaintBounds(bounds image.Rectangle, frameIdx int) image.Rectangle {
+	return image.Rect(0, 0, c.Width, c.Height)
+}
+
+func (c BarChart) Paint(dc *gg.Context, bounds image.Rectangle, frameIdx int) {
+	groups := c.bars()
+	if len(groups) == 0 {
+		return
+	}
+	lo, hi := c.limits(groups)
+
+	// lengths are along the value axis, and widths across it
+	length, width := c.Height, c.Width
+	if c.Horizontal {
+		length, width = c.Width, c.Height
+	}
+
+	pos := func(v float64) float64 {
+		p := math.Round((v - lo) / (hi - lo) * float64(length))
+		return math.Max(0, math.Min(float64(length), p))
+	}
+
+	perGroup := len(c.Data)
+	if c.Stacked {
+		perGroup = 1
+	}
+
+	for i, group := range spans(width, len(groups), c.Gap) {
+		slots := spans(group[1]-group[0], perGroup, 0)
+
+		for j, b := range groups[i] {
+			slot := slots[0]
+			if !c.Stacked {
+				slot = slots[j]
+			}
+			from, to := float64(group[0]+slot[0]), float64(group[0]+slot[1])
+
+			a, z := pos(math.Min(b.start, b.end)), pos(math.Max(b.start, b.end))
+			if to <= from || a == z {
+				continue
+			}
+
+			dc.SetColor(c.color(b))
+			if c.Horizontal {
+				dc.DrawRectangle(a, from, z-a, to-from)
+			} else {
+				dc.DrawRectangle(from, float64(length)-z, to-from, z-a)
+			}
+			dc.Fill()
+		}
+	}
+}
+
+func (c BarChart) color(b bar) color.Color {
+	if b.end < b.start && c.ColorInverted != nil {
+		return c.ColorInverted
+	}
+	if len(c.Colors) == 0 {
+		return DefaultPlotColor
+	}
+	if len(c.Data) == 1 {
+		return c.Colors[b.index%len(c.Colors)]
+	}
+	return c.Colors[b.series%len(c.Colors)]
+}
+
+func (c BarChart) FrameCount() int {
+	return 1
+}
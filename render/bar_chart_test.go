@@ -0,0 +1,83 @@
+package render
+
+import (
+	"image"
+	"image/color"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	barRed   = color.RGBA{0xff, 0, 0, 0xff}
+	barGreen = color.RGBA{0, 0xff, 0, 0xff}
+	barBlue  = color.RGBA{0, 0, 0xff, 0xff}
+)
+
+func TestBarChartGrouped(t *testing.T) {
+	c := BarChart{
+		Data:          [][]float64{{1, 2}, {2, -1}},
+		Width:         9,
+		Height:        4,
+		Colors:        []color.Color{barRed, barGreen},
+		ColorInverted: barBlue,
+		Gap:           1,
+		ValueLim:      Empty,
+	}
+
+	// the value axis spans -1 to 2, so zero is a pixel up from the
+	// bottom
+	assert.Equal(t, nil, checkImage([]string{
+		"..gg.rr..",
+		"rrgg.rr..",
+		"rrgg.rr..",
+		".......bb",
+	}, PaintWidget(c, image.Rect(0, 0, 100, 100), 0)))
+
+	// limits cut bars off
+	c.ValueLim = [2]float64{0, 1}
+	assert.Equal(t, nil, checkImage([]string{
+		"rrgg.rr..",
+		"rrgg.rr..",
+		"rrgg.rr..",
+		"rrgg.rr..",
+	}, PaintWidget(c, image.Rect(0, 0, 100, 100), 0)))
+}
+
+func TestBarChartStackedHorizontal(t *testing.T) {
+	c := BarChart{
+		Data:       [][]float64{{1, 2}, {2, math.NaN()}},
+		Width:      6,
+		Height:     2,
+		Colors:     []color.Color{barRed, barGreen},
+		Horizontal: true,
+		Stacked:    true,
+	}
+
+	assert.Equal(t, nil, checkImage([]string{
+		"rrgggg",
+		"rrrr..",
+	}, PaintWidget(c, image.Rect(0, 0, 100, 100), 0)))
+}
+
+func TestBarChartSingleSeries(t *testing.T) {
+	// with a single series, colors are used for each bar
+	c := BarChart{
+		Data:   [][]float64{{1, 2, math.NaN(), 2}},
+		Width:  4,
+		Height: 2,
+		Colors: []color.Color{barRed, barGreen, barBlue},
+	}
+
+	assert.Equal(t, nil, checkImage([]string{
+		".g.r",
+		"rg.r",
+	}, PaintWidget(c, image.Rect(0, 0, 100, 100), 0)))
+
+	c.Data = [][]float64{}
+	assert.Equal(t, nil, checkImage([]string{
+		"....",
+		"....",
+	}, PaintWidget(c, image.Rect(0, 0, 100, 100), 0)))
+}
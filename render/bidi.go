@@ -0,0 +1,405 @@
+package render
+
+import (
+	"unicode"
+
+	"golang.org/x/image/font"
+	"golang.org/x/text/unicode/bidi"
+)
+
+// Text is stored in logical order, the order it's read in, but fonts
+// draw it left to right. Before drawing, Arabic letters are replaced
+// with their contextual forms, and each line is reordered with the
+// Unicode Bidirectional Algorithm (https://unicode.org/reports/tr9/).
+//
+// The algorithm here leaves out explicit embeddings, isolates and
+// bracket pairs, which are rare in the short strings drawn by apps.
+
+func bidiClass(r rune) bidi.Class {
+	p, _ := bidi.LookupRune(r)
+	return p.Class()
+}
+
+// hasRightToLeft tells if s has any right-to-left letters. Text without
+// them is drawn as is.
+func hasRightToLeft(s string) bool {
+	for _, r := range s {
+		if c := bidiClass(r); c == bidi.R || c == bidi.AL {
+			return true
+		}
+	}
+	return false
+}
+
+// isRightToLeft tells if the paragraph direction of s is right to
+// left, which is the direction of its first strong character.
+func isRightToLeft(s string) bool {
+	for _, r := range s {
+		switch bidiClass(r) {
+		case bidi.L:
+			return false
+		case bidi.R, bidi.AL:
+			return true
+		}
+	}
+	return false
+}
+
+// visualText shapes a line of text for face and puts it in the order
+// it's drawn in, for a paragraph that's right to left if rtl is set.
+func visualText(s string, face font.Face, rtl bool) string {
+	if !hasRightToLeft(s) {
+		return s
+	}
+	return string(visualOrder(shapeArabic([]rune(s), face), rtl))
+}
+
+// bidiLevels resolves the embedding level of each character of a line,
+// with rules W1 to I2 of the algorithm.
+func bidiLevels(runes []rune, rtl bool) []int {
+	n := len(runes)
+	base, dir := 0, bidi.L
+	if rtl {
+		base, dir = 1, bidi.R
+	}
+
+	types := make([]bidi.Class, n)
+	for i, r := range runes {
+		types[i] = bidiClass(r)
+		if types[i] > bidi.AL {
+			types[i] = bidi.BN
+		}
+	}
+
+	// prevStrong returns the type of the closest strong character
+	// before i, or the paragraph direction
+	prevStrong := func(i int) bidi.Class {
+		for j := i - 1; j >= 0; j-- {
+			switch types[j] {
+			case bidi.L, bidi.R, bidi.AL:
+				return types[j]
+			}
+		}
+		return dir
+	}
+
+	// W1: marks take the type of the character before them
+	for i := range types {
+		if types[i] == bidi.NSM {
+			if i == 0 {
+				types[i] = dir
+			} else {
+				types[i] = types[i-1]
+			}
+		}
+	}
+
+	// W2, W3: numbers after Arabic letters are Arabic numbers, and
+	// Arabic letters are then right to left
+	for i := range types {
+		if types[i] == bidi.EN && prevStrong(i) == bidi.AL {
+			types[i] = bidi.AN
+		}
+	}
+	for i := range types {
+		if types[i] == bidi.AL {
+			types[i] = bidi.R
+		}
+	}
+
+	// W4: a single separator between two numbers of a kind joins them
+	for i := 1; i < n-1; i++ {
+		before, after := types[i-1], types[i+1]
+		switch {
+		case types[i] == bidi.ES && before == bidi.EN && after == bidi.EN:
+			types[i] = bidi.EN
+		case types[i] == bidi.CS && before == bidi.EN && after == bidi.EN:
+			types[i] = bidi.EN
+		case types[i] == bidi.CS && before == bidi.AN && after == bidi.AN:
+			types[i] = bidi.AN
+		}
+	}
+
+	// W5: terminators next to European numbers, like currency signs,
+	// are part of them
+	for i := 0; i < n; i++ {
+		if types[i] != bidi.ET {
+			continue
+		}
+		end := i
+		for end < n && types[end] == bidi.ET {
+			end++
+		}
+		if (i > 0 && types[i-1] == bidi.EN) || (end < n && types[end] == bidi.EN) {
+			for j := i; j < end; j++ {
+				types[j] = bidi.EN
+			}
+		}
+		i = end - 1
+	}
+
+	// W6: other separators and terminators are neutral
+	for i, t := range types {
+		if t == bidi.ES || t == bidi.ET || t == bidi.CS {
+			types[i] = bidi.ON
+		}
+	}
+
+	// W7: European numbers in left-to-right text are left to right
+	for i := range types {
+		if types[i] == bidi.EN && prevStrong(i) == bidi.L {
+			types[i] = bidi.L
+		}
+	}
+
+	// N1, N2: neutrals between characters of the same direction take
+	// that direction, and the paragraph direction otherwise
+	strong := func(t bidi.Class) bidi.Class {
+		if t == bidi.L {
+			return bidi.L
+		}
+		return bidi.R
+	}
+	isNeutral := func(t bidi.Class) bool {
+		return t == bidi.B || t == bidi.S || t == bidi.WS || t == bidi.ON || t == bidi.BN
+	}
+	for i := 0; i < n; i++ {
+		if !isNeutral(types[i]) {
+			continue
+		}
+		end := i
+		for end < n && isNeutral(types[end]) {
+			end++
+		}
+		before, after := dir, dir
+		if i > 0 {
+			before = strong(types[i-1])
+		}
+		if end < n {
+			after = strong(types[end])
+		}
+		resolved := dir
+		if before == after {
+			resolved = before
+		}
+		for j := i; j < end; j++ {
+			types[j] = resolved
+		}
+		i = end - 1
+	}
+
+	// I1, I2
+	levels := make([]int, n)
+	for i, t := range types {
+		levels[i] = base
+		switch {
+		case base == 0 && t == bidi.R:
+			levels[i] = 1
+		case base == 0 && (t == bidi.AN || t == bidi.EN):
+			levels[i] = 2
+		case base == 1 && t != bidi.R:
+			levels[i] = 2
+		}
+	}
+
+	// L1: whitespace at the end of the line goes at the paragraph level
+	for i := n - 1; i >= 0; i-- {
+		c := bidiClass(runes[i])
+		if c != bidi.WS && c != bidi.S && c != bidi.BN {
+			break
+		}
+		levels[i] = base
+	}
+
+	return levels
+}
+
+// visualOrder reorders a line of text from logical to visual order,
+// with rule L2 of the algorithm. Marks stay after the character they're
+// on, and brackets in right-to-left text are mirrored.
+func visualOrder(runes []rune, rtl bool) []rune {
+	levels := bidiLevels(runes, rtl)
+
+	type cluster struct {
+		runes []rune
+		level int
+	}
+
+	var clusters []cluster
+	for i, r := range runes {
+		if len(clusters) > 0 && unicode.Is(unicode.Mn, r) {
+			last := &clusters[len(clusters)-1]
+			last.runes = append(last.runes, r)
+			continue
+		}
+		clusters = append(clusters, cluster{runes: []rune{r}, level: levels[i]})
+	}
+
+	highest, lowestOdd := 0, 0
+	for _, c := range clusters {
+		if c.level > highest {
+			highest = c.level
+		}
+		if c.level%2 == 1 && (lowestOdd == 0 || c.level < lowestOdd) {
+			lowestOdd = c.level
+		}
+	}
+
+	// from the highest level to the lowest odd one, reverse every
+	// sequence of characters at that level or higher
+	for level := highest; lowestOdd > 0 && level >= lowestOdd; level-- {
+		for i := 0; i < len(clusters); i++ {
+			if clusters[i].level < level {
+				continue
+			}
+			end := i
+			for end < len(clusters) && clusters[end].level >= level {
+				end++
+			}
+			for a, b := i, end-1; a < b; a, b = a+1, b-1 {
+				clusters[a], clusters[b] = clusters[b], clusters[a]
+			}
+			i = end
+		}
+	}
+
+	out := make([]rune, 0, len(runes))
+	for _, c := range clusters {
+		if c.level%2 == 1 {
+			c.runes[0] = []rune(bidi.ReverseString(string(c.runes[0])))[0]
+		}
+		out = append(out, c.runes...)
+	}
+	return out
+}
+
+// arabicForms maps Arabic letters to their isolated form in the Arabic
+// Presentation Forms-B block, and how many forms they have. Letters
+// with two forms only join the letter before them. Letters with four
+// forms join on both sides, and their final, initial and medial forms
+// follow the isolated one.
+var arabicForms = map[rune]struct {
+	isolated rune
+	forms    int
+}{
+	0x0621: {0xfe80, 1}, // hamza
+	0x0622: {0xfe81, 2}, // alef with madda above
+	0x0623: {0xfe83, 2}, // alef with hamza above
+	0x0624: {0xfe85, 2}, // waw with hamza above
+	0x0625: {0xfe87, 2}, // alef with hamza below
+	0x0626: {0xfe89, 4}, // yeh with hamza above
+	0x0627: {0xfe8d, 2}, // alef
+	0x0628: {0xfe8f, 4}, // beh
+	0x0629: {0xfe93, 2}, // teh marbuta
+	0x062a: {0xfe95, 4}, // teh
+	0x062b: {0xfe99, 4}, // theh
+	0x062c: {0xfe9d, 4}, // jeem
+	0x062d: {0xfea1, 4}, // hah
+	0x062e: {0xfea5, 4}, // khah
+	0x062f: {0xfea9, 2}, // dal
+	0x0630: {0xfeab, 2}, // thal
+	0x0631: {0xfead, 2}, // reh
+	0x0632: {0xfeaf, 2}, // zain
+	0x0633: {0xfeb1, 4}, // seen
+	0x0634: {0xfeb5, 4}, // sheen
+	0x0635: {0xfeb9, 4}, // sad
+	0x0636: {0xfebd, 4}, // dad
+	0x0637: {0xfec1, 4}, // tah
+	0x0638: {0xfec5, 4}, // zah
+	0x0639: {0xfec9, 4}, // ain
+	0x063a: {0xfecd, 4}, // ghain
+	0x0641: {0xfed1, 4}, // feh
+	0x0642: {0xfed5, 4}, // qaf
+	0x0643: {0xfed9, 4}, // kaf
+	0x0644: {0xfedd, 4}, // lam
+	0x0645: {0xfee1, 4}, // meem
+	0x0646: {0xfee5, 4}, // noon
+	0x0647: {0xfee9, 4}, // heh
+	0x0648: {0xfeed, 2}, // waw
+	0x0649: {0xfeef, 2}, // alef maksura
+	0x064a: {0xfef1, 4}, // yeh
+}
+
+// lamAlef maps the alefs that form a ligature after lam to the isolated
+// form of the ligature. Its final form follows it.
+var lamAlef = map[rune]rune{
+	0x0622: 0xfef5,
+	0x0623: 0xfef7,
+	0x0625: 0xfef9,
+	0x0627: 0xfefb,
+}
+
+const (
+	arabicLam     = 0x0644
+	arabicTatweel = 0x0640
+	zeroWidthJoin = 0x200d
+)
+
+// shapeArabic replaces Arabic letters with the form they take next to
+// their neighbours. Forms that face doesn't have are left out, so fonts
+// without them still draw the letters.
+func shapeArabic(runes []rune, face font.Face) []rune {
+	// joinsNext tells if r connects to the letter after it, and
+	// joinsPrev if it connects to the letter before it
+	joinsNext := func(r rune) bool {
+		return arabicForms[r].forms == 4 || r == arabicTatweel || r == zeroWidthJoin
+	}
+	joinsPrev := func(r rune) bool {
+		return arabicForms[r].forms >= 2 || r == arabicTatweel || r == zeroWidthJoin
+	}
+
+	// neighbour returns the closest letter to i in the direction step,
+	// skipping over marks, or 0 if there's none
+	neighbour := func(i, step int) rune {
+		for j := i + step; j >= 0 && j < len(runes); j += step {
+			if !unicode.Is(unicode.Mn, runes[j]) {
+				return runes[j]
+			}
+		}
+		return 0
+	}
+
+	out := make([]rune, 0, len(runes))
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+		letter, ok := arabicForms[r]
+		if !ok || letter.forms == 1 {
+			out = append(out, r)
+			continue
+		}
+
+		prev := joinsNext(neighbour(i, -1))
+
+		if r == arabicLam && i+1 < len(runes) {
+			if ligature, ok := lamAlef[runes[i+1]]; ok {
+				if prev {
+					ligature++
+				}
+				if hasGlyph(face, ligature) {
+					out = append(out, ligature)
+					i++
+					continue
+				}
+			}
+		}
+
+		next := letter.forms == 4 && joinsPrev(neighbour(i, 1))
+		form := letter.isolated
+		switch {
+		case prev && next:
+			form += 3
+		case next:
+			form += 2
+		case prev:
+			form++
+		}
+
+		if hasGlyph(face, form) {
+			out = append(out, form)
+		} else {
+			out = append(out, r)
+		}
+	}
+
+	return out
+}
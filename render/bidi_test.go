@@ -0,0 +1,135 @@
+package render
+
+import (
+	"image"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tidbyt/gg"
+	"golang.org/x/image/font"
+)
+
+func TestVisualOrder(t *testing.T) {
+	for _, tc := range []struct {
+		logical string
+		rtl     bool
+		visual  string
+	}{
+		{"Hello, world!", false, "Hello, world!"},
+		{"שלום", true, "םולש"},
+
+		// numbers keep their order inside right-to-left text
+		{"שלום 123 עולם", true, "םלוע 123 םולש"},
+		{"abc שלום 123 עולם def", false, "abc םלוע 123 םולש def"},
+		{"שלום abc def!", true, "!abc def םולש"},
+
+		// brackets are mirrored, and marks stay after their letter
+		{"(שלום)", true, "(םולש)"},
+		{"שָׁלוֹם", true, "םוֹלשָׁ"},
+	} {
+		assert.Equal(t, tc.visual, string(visualOrder([]rune(tc.logical), tc.rtl)), tc.logical)
+	}
+
+	assert.True(t, isRightToLeft("123 שלום abc"))
+	assert.False(t, isRightToLeft("abc שלום"))
+	assert.False(t, isRightToLeft("123"))
+}
+
+func TestShapeArabic(t *testing.T) {
+	face, err := GetFont("10x20")
+	require.NoError(t, err)
+
+	// seen is initial, lam and alef form a ligature joined to seen, and
+	// meem stands alone as alef doesn't join the letter after it
+	assert.Equal(t, []rune{0xfeb3, 0xfefc, 0xfee1}, shapeArabic([]rune("سلام"), face))
+
+	// initial, medial and final forms
+	assert.Equal(t, []rune{0xfe91, 0xfef4, 0xfe96}, shapeArabic([]rune("بيت"), face))
+
+	// fonts without the forms draw the letters as they are
+	face, err = GetFont("tb-8")
+	require.NoError(t, err)
+	assert.Equal(t, []rune("سلام"), shapeArabic([]rune("سلام"), face))
+}
+
+func TestTextRightToLeft(t *testing.T) {
+	text := &Text{Content: "שלום 42"}
+	require.NoError(t, text.Init())
+	assert.Equal(t, paintLeftToRight(t, "42 םולש"), PaintWidget(text, image.Rect(0, 0, 64, 32), 0))
+
+	// right-to-left paragraphs are aligned to the right
+	wrapped := &WrappedText{Content: "שלום\nabc", Width: 30}
+	require.NoError(t, wrapped.Init())
+	im := PaintWidget(wrapped, image.Rect(0, 0, 64, 32), 0)
+	reversedIm := paintLeftToRight(t, "םולש")
+	w, h := reversedIm.Bounds().Dx(), reversedIm.Bounds().Dy()
+	for y := 0; y < h; y++ {
+		for x := 0; x < 30; x++ {
+			expected := uint32(0)
+			if x >= 30-w {
+				expected = alphaAt(reversedIm, x-30+w, y)
+			}
+			assert.Equal(t, expected, alphaAt(im, x, y), "%d,%d", x, y)
+		}
+	}
+
+	// lines are broken in reading order
+	wrapped = &WrappedText{Content: "אחת שתיים שלוש", Width: 48}
+	require.NoError(t, wrapped.Init())
+	lines, truncated := wrapped.layout(48, 32)
+	assert.False(t, truncated)
+	require.Len(t, lines, 2)
+	assert.Equal(t, "םייתש תחא", lines[0].text)
+	assert.Equal(t, "שולש", lines[1].text)
+	assert.True(t, lines[0].rtl)
+	assert.True(t, lines[1].rtl)
+}
+
+func TestMarqueeRightToLeft(t *testing.T) {
+	text := &Text{Content: "שלום עולם"}
+	require.NoError(t, text.Init())
+	textIm := PaintWidget(text, image.Rect(0, 0, 100, 32), 0)
+	w, h := text.Size()
+
+	m := Marquee{Width: 20, Child: text}
+	assert.Equal(t, w+20, m.FrameCount())
+
+	// the start of the text, on its right, is shown first and then
+	// scrolls to the right
+	for _, frame := range []int{0, 5} {
+		im := PaintWidget(m, image.Rect(0, 0, 100, 32), frame)
+		for y := 0; y < h; y++ {
+			for x := 0; x < 20; x++ {
+				tx := w - 20 + x - frame
+				if tx < 0 {
+					assert.Equal(t, uint32(0), alphaAt(im, x, y))
+				} else {
+					assert.Equal(t, alphaAt(textIm, tx, y), alphaAt(im, x, y), "frame %d at %d,%d", frame, x, y)
+				}
+			}
+		}
+	}
+}
+
+func alphaAt(im image.Image, x, y int) uint32 {
+	_, _, _, a := im.At(x, y).RGBA()
+	return a
+}
+
+// paintLeftToRight draws a string in the default font as is, without
+// reordering it.
+func paintLeftToRight(t *testing.T, s string) image.Image {
+	face, err := GetFont(DefaultFontFace)
+	require.NoError(t, err)
+
+	metrics := face.Metrics()
+	height := metrics.Ascent.Floor() + metrics.Descent.Floor()
+	width := font.MeasureString(face, s).Floor()
+
+	dc := gg.NewContext(width, height)
+	dc.SetFontFace(face)
+	dc.SetColor(DefaultFontColor)
+	dc.DrawString(s, 0, float64(height-metrics.Descent.Floor()))
+	return dc.Image()
+}
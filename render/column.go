@@ -28,10 +28,17 @@ import (
 // - `"end"`: place children at the right
 // - `"center"`: place children in the center
 //
+// `gap` leaves space between children. Children wrapped in `Flex`
+// share the space that's left over by the others, and children that
+// don't fit are cut off, unless `shrink` is set, which makes them
+// smaller to fit in the Column.
+//
 // DOC(Children): Child widgets to lay out
 // DOC(Expanded): Column should expand to fill all available vertical space
 // DOC(MainAlign): Alignment along vertical main axis
 // DOC(CrossAlign): Alignment along horizontal cross axis
+// DOC(Gap): Space between children
+// DOC(Shrink): Shrink children that don't fit, instead of cutting them off
 //
 // EXAMPLE BEGIN
 // render.Column(
@@ -62,6 +69,8 @@ type Column struct {
 	MainAlign  string   `starlark:"main_align"`
 	CrossAlign string   `starlark:"cross_align"`
 	Expanded   bool
+	Gap        int
+	Shrink     bool
 }
 
 func (c Column) PaintBounds(bounds image.Rectangle, frameIdx int) image.Rectangle {
@@ -71,6 +80,8 @@ func (c Column) PaintBounds(bounds image.Rectangle, frameIdx int) image.Rectangl
 		MainAlign:  c.MainAlign,
 		CrossAlign: c.CrossAlign,
 		Expanded:   c.Expanded,
+		Gap:        c.Gap,
+		Shrink:     c.Shrink,
 	}
 	return v.PaintBounds(bounds, frameIdx)
 }
@@ -82,6 +93,8 @@ func (c Column) Paint(dc *gg.Context, bounds image.Rectangle, frameIdx int) {
 		MainAlign:  c.MainAlign,
 		CrossAlign: c.CrossAlign,
 		Expanded:   c.Expanded,
+		Gap:        c.Gap,
+		Shrink:     c.Shrink,
 	}
 	v.Paint(dc, bounds, frameIdx)
 }
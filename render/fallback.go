@@ -0,0 +1,144 @@
+package render
+
+import (
+	"image"
+	"strings"
+
+	"github.com/zachomedia/go-bdf"
+	"golang.org/x/image/font"
+	"golang.org/x/image/math/fixed"
+)
+
+// DefaultFallbackFonts are the built-in fonts that draw characters that
+// the font of a text doesn't have, in order of preference.
+var DefaultFallbackFonts = []string{"emoji", "6x13", "10x20"}
+
+// GetFontFor returns the face to draw content with in the named font.
+// Characters of content that the font doesn't have are drawn with the
+// first of DefaultFallbackFonts that has them. Only the fallback fonts
+// that are needed are used, since they can make the text taller.
+func GetFontFor(name, content string) (font.Face, error) {
+	fontMutex.Lock()
+	defer fontMutex.Unlock()
+
+	face, err := getFont(name)
+	if err != nil {
+		return nil, err
+	}
+
+	needed := make([]bool, len(DefaultFallbackFonts))
+	for _, r := range content {
+		if hasGlyph(face, r) {
+			continue
+		}
+		for i, fallback := range DefaultFallbackFonts {
+			f, err := getFont(fallback)
+			if err != nil {
+				return nil, err
+			}
+			if hasGlyph(f, r) {
+				needed[i] = true
+				break
+			}
+		}
+	}
+
+	chain := []string{name}
+	for i, fallback := range DefaultFallbackFonts {
+		if needed[i] {
+			chain = append(chain, fallback)
+		}
+	}
+	if len(chain) == 1 {
+		return face, nil
+	}
+	return getFont(strings.Join(chain, ","))
+}
+
+// fallbackFace draws every character with the first of its faces that
+// has a glyph for it. Its ascent and descent are the largest of its
+// faces, and all glyphs share a baseline, so text that mixes faces
+// lines up.
+type fallbackFace struct {
+	faces []font.Face
+}
+
+func newFallbackFace(faces []font.Face) font.Face {
+	if len(faces) == 1 {
+		return faces[0]
+	}
+	return &fallbackFace{faces: faces}
+}
+
+// hasGlyph tells if a face has a glyph for r. BDF faces draw their
+// default character for runes they don't have, so they're checked
+// directly.
+func hasGlyph(face font.Face, r rune) bool {
+	switch f := face.(type) {
+	case *bdf.Face:
+		_, ok := f.Font.CharMap[r]
+		return ok
+	case *spacedFace:
+		return hasGlyph(f.Face, r)
+	case *fallbackFace:
+		for _, face := range f.faces {
+			if hasGlyph(face, r) {
+				return true
+			}
+		}
+		return false
+	default:
+		_, ok := face.GlyphAdvance(r)
+		return ok
+	}
+}
+
+// face returns the face to draw r with. If none of the faces have it,
+// the first face draws it, usually as its default character.
+func (f *fallbackFace) face(r rune) font.Face {
+	for _, face := range f.faces {
+		if hasGlyph(face, r) {
+			return face
+		}
+	}
+	return f.faces[0]
+}
+
+func (f *fallbackFace) Close() error {
+	return nil
+}
+
+func (f *fallbackFace) Glyph(dot fixed.Point26_6, r rune) (image.Rectangle, image.Image, image.Point, fixed.Int26_6, bool) {
+	return f.face(r).Glyph(dot, r)
+}
+
+func (f *fallbackFace) GlyphBounds(r rune) (fixed.Rectangle26_6, fixed.Int26_6, bool) {
+	return f.face(r).GlyphBounds(r)
+}
+
+func (f *fallbackFace) GlyphAdvance(r rune) (fixed.Int26_6, bool) {
+	return f.face(r).GlyphAdvance(r)
+}
+
+func (f *fallbackFace) Kern(r0, r1 rune) fixed.Int26_6 {
+	face := f.face(r0)
+	if face != f.face(r1) {
+		return 0
+	}
+	return face.Kern(r0, r1)
+}
+
+func (f *fallbackFace) Metrics() font.Metrics {
+	m := f.faces[0].Metrics()
+	for _, face := range f.faces[1:] {
+		fm := face.Metrics()
+		if fm.Ascent > m.Ascent {
+			m.Ascent = fm.Ascent
+		}
+		if fm.Descent > m.Descent {
+			m.Descent = fm.Descent
+		}
+	}
+	m.Height = m.Ascent + m.Descent
+	return m
+}
@@ -0,0 +1,110 @@
+package render
+
+import (
+	"image"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/image/font/gofont/goregular"
+)
+
+func TestFallbackFont(t *testing.T) {
+	// tb-8 has no heart, so it's drawn from the emoji font, and the
+	// variation selector after it takes no space
+	text := &Text{Content: "A❤️B", Font: "tb-8,emoji"}
+	require.NoError(t, text.Init())
+	im := PaintWidget(text, image.Rect(0, 0, 0, 0), 0)
+	assert.Equal(t, nil, checkImage([]string{
+		"......ww.ww.......",
+		".ww..wwwwwww.www..",
+		"w..w.wwwwwww.w..w.",
+		"w..w.wwwwwww.www..",
+		"wwww..wwwww..w..w.",
+		"w..w...www...w..w.",
+		"w..w....w....www..",
+		"..................",
+	}, im))
+
+	// fonts of different sizes share a baseline, and the text is as
+	// tall as the largest of them
+	text = &Text{Content: "aᚠ", Font: "tom-thumb, 6x13"}
+	require.NoError(t, text.Init())
+	im = PaintWidget(text, image.Rect(0, 0, 0, 0), 0)
+	assert.Equal(t, nil, checkImage([]string{
+		"..........",
+		"..........",
+		".....w..w.",
+		".....w.w..",
+		".....ww...",
+		".....w..w.",
+		".....w.w..",
+		"ww...ww...",
+		".ww..w....",
+		"w.w..w....",
+		"www..w....",
+		"..........",
+		"..........",
+	}, im))
+
+	// fonts are cached by the whole chain
+	face, err := GetFont("tb-8,emoji")
+	require.NoError(t, err)
+	again, err := GetFont("tb-8,emoji")
+	require.NoError(t, err)
+	assert.Same(t, face, again)
+
+	_, err = GetFont("tb-8,nope")
+	assert.ErrorContains(t, err, "unknown font 'nope'")
+}
+
+func TestDefaultFallbackFonts(t *testing.T) {
+	// characters that a font doesn't have are drawn with the built-in
+	// fonts that do
+	text := &Text{Content: "A❤️B", Font: "tb-8"}
+	require.NoError(t, text.Init())
+	chained := &Text{Content: "A❤️B", Font: "tb-8,emoji"}
+	require.NoError(t, chained.Init())
+	assert.Equal(t, PaintWidget(chained, image.Rect(0, 0, 0, 0), 0), PaintWidget(text, image.Rect(0, 0, 0, 0), 0))
+
+	// only the fonts that are needed are used
+	face, err := GetFontFor("tb-8", "A⌘")
+	require.NoError(t, err)
+	expected, err := GetFont("tb-8,6x13")
+	require.NoError(t, err)
+	assert.Same(t, expected, face)
+
+	// so text that doesn't need them keeps its size
+	face, err = GetFontFor("tb-8", "AB")
+	require.NoError(t, err)
+	expected, err = GetFont("tb-8")
+	require.NoError(t, err)
+	assert.Same(t, expected, face)
+
+	// as does text that none of them can help with
+	face, err = GetFontFor("tb-8", "A品")
+	require.NoError(t, err)
+	assert.Same(t, expected, face)
+
+	m, err := MeasureText("A⌘", "tb-8")
+	require.NoError(t, err)
+	assert.Equal(t, 13, m.Height)
+}
+
+func TestFallbackFontScalable(t *testing.T) {
+	name, err := AddFont("go.ttf", goregular.TTF)
+	require.NoError(t, err)
+
+	// the scalable font only draws what tb-8 doesn't have
+	face, err := GetFont("tb-8," + name + "@8")
+	require.NoError(t, err)
+	assert.True(t, hasGlyph(face, 'A'))
+	assert.True(t, hasGlyph(face, 'ǎ'))
+	assert.False(t, hasGlyph(face, '日'))
+
+	text := &Text{Content: "Aǎ", Font: "tb-8," + name + "@8"}
+	require.NoError(t, text.Init())
+	w, h := text.Size()
+	assert.Equal(t, 5+4, w)
+	assert.Equal(t, 10, h, "as tall as the scalable font")
+}
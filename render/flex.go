@@ -0,0 +1,86 @@
+package render
+
+import (
+	"fmt"
+	"image"
+
+	"github.com/tidbyt/gg"
+)
+
+// Flex lets a child of a Row or Column share the space that the other
+// children leave over.
+//
+// Flex children start at `min_size` along the main axis of their Row
+// or Column, which is 0 by default, and grow to fill the space that's
+// left, shared by their `weight`. A child with a weight of 2 gets
+// twice as much as one with a weight of 1, up to its `max_size`, if
+// set. Their child is laid out in the size they get, and cut off if
+// it doesn't fit.
+//
+// Outside of a Row or Column, Flex draws its child as is.
+//
+// DOC(Child): Widget to lay out
+// DOC(Weight): Share of the space left over, default is 1
+// DOC(MinSize): Smallest size along the main axis
+// DOC(MaxSize): Largest size along the main axis, or 0 for no limit
+//
+// EXAMPLE BEGIN
+// render.Row(
+//      gap=2,
+//      children=[
+//           render.Flex(render.Box(height=8, color="#a00")),
+//           render.Box(width=10, height=8, color="#0a0"),
+//           render.Flex(render.Box(height=8, color="#00a"), weight=2),
+//      ],
+// )
+// EXAMPLE END
+type Flex struct {
+	Widget
+	Child   Widget `starlark:"child,required"`
+	Weight  float64
+	MinSize int `starlark:"min_size"`
+	MaxSize int `starlark:"max_size"`
+}
+
+func (f *Flex) Init() error {
+	if f.Weight < 0 {
+		return fmt.Errorf("invalid weight %v, must not be negative", f.Weight)
+	}
+	if f.MinSize < 0 || f.MaxSize < 0 {
+		return fmt.Errorf("min_size and max_size must not be negative")
+	}
+	if f.MaxSize > 0 && f.MaxSize < f.MinSize {
+		return fmt.Errorf("max_size %d is less than min_size %d", f.MaxSize, f.MinSize)
+	}
+	return nil
+}
+
+func (f Flex) weight() float64 {
+	if f.Weight <= 0 {
+		return 1
+	}
+	return f.Weight
+}
+
+// asFlex returns w as a Flex, if it is one.
+func asFlex(w Widget) (Flex, bool) {
+	switch f := w.(type) {
+	case Flex:
+		return f, true
+	case *Flex:
+		return *f, true
+	}
+	return Flex{}, false
+}
+
+func (f Flex) PaintBounds(bounds image.Rectangle, frameIdx int) image.Rectangle {
+	return f.Child.PaintBounds(bounds, frameIdx)
+}
+
+func (f Flex) Paint(dc *gg.Context, bounds image.Rectangle, frameIdx int) {
+	f.Child.Paint(dc, bounds, frameIdx)
+}
+
+func (f Flex) FrameCount() int {
+	return f.Child.FrameCount()
+}
@@ -0,0 +1,176 @@
+package render
+
+import (
+	"image"
+	"image/color"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	flexRed   = color.RGBA{0xff, 0, 0, 0xff}
+	flexGreen = color.RGBA{0, 0xff, 0, 0xff}
+	flexBlue  = color.RGBA{0, 0, 0xff, 0xff}
+)
+
+func TestFlexWeights(t *testing.T) {
+	r := Row{
+		Gap: 1,
+		Children: []Widget{
+			Flex{Child: Box{Height: 1, Color: flexRed}},
+			Box{Width: 2, Height: 2, Color: flexGreen},
+			&Flex{Child: Box{Height: 1, Color: flexBlue}, Weight: 2},
+		},
+	}
+
+	// flex children share what's left by weight, so the row fills
+	// its bounds even if it's not expanded
+	im := PaintWidget(r, image.Rect(0, 0, 12, 10), 0)
+	assert.Equal(t, nil, checkImage([]string{
+		"rrr.gg.bbbbb",
+		"....gg......",
+	}, im))
+
+	im = PaintWidget(r, image.Rect(0, 0, 7, 10), 0)
+	assert.Equal(t, nil, checkImage([]string{
+		"r.gg.bb",
+		"..gg...",
+	}, im))
+}
+
+func TestFlexMinMax(t *testing.T) {
+	c := Column{
+		Children: []Widget{
+			Flex{Child: Box{Width: 1, Color: flexRed}, MaxSize: 2},
+			Flex{Child: Box{Width: 1, Color: flexGreen}, MinSize: 3, Weight: 0.5},
+			Box{Width: 2, Height: 1, Color: flexBlue},
+		},
+	}
+
+	// the first child stops at its max size, leaving the rest to the
+	// second, which starts at its min size
+	im := PaintWidget(c, image.Rect(0, 0, 5, 9), 0)
+	assert.Equal(t, nil, checkImage([]string{
+		"r.",
+		"r.",
+		"g.",
+		"g.",
+		"g.",
+		"g.",
+		"g.",
+		"g.",
+		"bb",
+	}, im))
+
+	// and doesn't go below its min size when there's no space
+	im = PaintWidget(c, image.Rect(0, 0, 5, 4), 0)
+	assert.Equal(t, nil, checkImage([]string{
+		"g.",
+		"g.",
+		"g.",
+		"bb",
+	}, im))
+
+	// if they're all at their max size, the column doesn't fill
+	c.Children[1] = Flex{Child: Box{Width: 1, Color: flexGreen}, MaxSize: 1}
+	assert.Equal(t, image.Rect(0, 0, 2, 4), c.PaintBounds(image.Rect(0, 0, 5, 9), 0))
+}
+
+func TestFlexOutsideVector(t *testing.T) {
+	f := Flex{Child: Box{Width: 2, Height: 1, Color: flexRed}, Weight: 3}
+	im := PaintWidget(f, image.Rect(0, 0, 10, 10), 0)
+	assert.Equal(t, nil, checkImage([]string{
+		"rr",
+	}, im))
+}
+
+func TestFlexInvalid(t *testing.T) {
+	assert.Error(t, (&Flex{Weight: -1}).Init())
+	assert.Error(t, (&Flex{MinSize: -1}).Init())
+	assert.Error(t, (&Flex{MinSize: 4, MaxSize: 2}).Init())
+	assert.NoError(t, (&Flex{MinSize: 4}).Init())
+}
+
+func TestVectorGap(t *testing.T) {
+	c := Column{
+		Gap:        2,
+		CrossAlign: "end",
+		Children: []Widget{
+			Box{Width: 2, Height: 1, Color: flexRed},
+			Box{Width: 1, Height: 2, Color: flexGreen},
+			Box{Width: 2, Height: 1, Color: flexBlue},
+		},
+	}
+	im := PaintWidget(c, image.Rect(0, 0, 10, 10), 0)
+	assert.Equal(t, nil, checkImage([]string{
+		"rr",
+		"..",
+		"..",
+		".g",
+		".g",
+		"..",
+		"..",
+		"bb",
+	}, im))
+
+	// gaps come on top of the space from main_align
+	r := Row{
+		Gap:       1,
+		Expanded:  true,
+		MainAlign: "space_between",
+		Children: []Widget{
+			Box{Width: 1, Height: 1, Color: flexRed},
+			Box{Width: 1, Height: 1, Color: flexGreen},
+			Box{Width: 1, Height: 1, Color: flexBlue},
+		},
+	}
+	im = PaintWidget(r, image.Rect(0, 0, 9, 10), 0)
+	assert.Equal(t, nil, checkImage([]string{
+		"r...g...b",
+	}, im))
+}
+
+func TestVectorShrink(t *testing.T) {
+	r := Row{
+		Children: []Widget{
+			Box{Width: 6, Height: 1, Color: flexRed},
+			Box{Width: 3, Height: 1, Color: flexGreen},
+			Box{Width: 3, Height: 1, Color: flexBlue},
+		},
+	}
+
+	// without shrink, children that don't fit are cut off
+	im := PaintWidget(r, image.Rect(0, 0, 8, 10), 0)
+	assert.Equal(t, nil, checkImage([]string{
+		"rrrrrrgg",
+	}, im))
+
+	// with it, they all shrink by their size
+	r.Shrink = true
+	im = PaintWidget(r, image.Rect(0, 0, 8, 10), 0)
+	assert.Equal(t, nil, checkImage([]string{
+		"rrrrggbb",
+	}, im))
+
+	// children that fit don't change
+	im = PaintWidget(r, image.Rect(0, 0, 13, 10), 0)
+	assert.Equal(t, nil, checkImage([]string{
+		"rrrrrrgggbbb",
+	}, im))
+
+	// shrunk children are laid out at their new size
+	text := &WrappedText{Content: "AB CD", Font: "CG-pixel-3x5-mono"}
+	assert.NoError(t, text.Init())
+	c := Row{
+		Shrink: true,
+		Children: []Widget{
+			text,
+			Box{Width: 10, Height: 1, Color: flexRed},
+		},
+	}
+	w, h := WidgetSize(text, 100, 100)
+	assert.Equal(t, 5, h)
+	assert.Equal(t, 20, w)
+	assert.Equal(t, image.Rect(0, 0, 20, 10), c.PaintBounds(image.Rect(0, 0, 20, 100), 0))
+}
@@ -3,8 +3,13 @@ package render
 //go:generate go run gen/embedfonts.go
 
 import (
+	"bytes"
+	"container/list"
+	"crypto/sha256"
 	"encoding/base64"
 	"fmt"
+	"path"
+	"strings"
 	"sync"
 
 	"github.com/zachomedia/go-bdf"
@@ -14,6 +19,20 @@ import (
 var fontCache = map[string]font.Face{}
 var fontMutex = &sync.Mutex{}
 
+// MaxCachedFaces is how many faces of scalable fonts at a size, and of
+// lists of fonts, are kept around. The least recently used are dropped
+// first, and made again when they're needed.
+const MaxCachedFaces = 64
+
+// faceCache holds the faces that are made from other fonts. There's no
+// end to the sizes and lists that can be asked for, so unlike fontCache,
+// it's bounded.
+var faceCache = newFaceLRU(MaxCachedFaces)
+
+// addedFonts counts how many times each font was added with AddFont and
+// not yet released with ReleaseFont.
+var addedFonts = map[string]int{}
+
 func GetFontList() []string {
 	fontNames := []string{}
 	for key := range fontDataRaw {
@@ -22,13 +41,53 @@ func GetFontList() []string {
 	return fontNames
 }
 
+// GetFont returns the face of a font by name. The name can be a
+// comma-separated list of fonts, such as "tb-8,emoji", in which case
+// every character is drawn with the first of the fonts that has it.
 func GetFont(name string) (font.Face, error) {
 	fontMutex.Lock()
 	defer fontMutex.Unlock()
 
+	return getFont(name)
+}
+
+func getFont(name string) (font.Face, error) {
 	if font, ok := fontCache[name]; ok {
 		return font, nil
 	}
+	if face, ok := faceCache.get(name); ok {
+		return face, nil
+	}
+
+	if strings.Contains(name, ",") {
+		var faces []font.Face
+		for _, n := range strings.Split(name, ",") {
+			face, err := getFont(strings.TrimSpace(n))
+			if err != nil {
+				return nil, err
+			}
+			faces = append(faces, face)
+		}
+
+		face := newFallbackFace(faces)
+		faceCache.add(name, face)
+		return face, nil
+	}
+
+	// scalable fonts are cached for each size they're drawn at
+	if i := strings.LastIndex(name, "@"); i >= 0 && scalableFonts[name[:i]] != nil {
+		face, err := newScalableFace(name[:i], name[i+1:])
+		if err != nil {
+			return nil, err
+		}
+
+		faceCache.add(name, face)
+		return face, nil
+	}
+
+	if _, ok := scalableFonts[name]; ok {
+		return nil, fmt.Errorf("font '%s' needs a pixel size, such as '%s@12'", name, name)
+	}
 
 	dataB64, ok := fontDataRaw[name]
 	if !ok {
@@ -48,3 +107,163 @@ func GetFont(name string) (font.Face, error) {
 	fontCache[name] = f.NewFace()
 	return fontCache[name], nil
 }
+
+// ParseFont parses a font in the BDF or PCF format.
+func ParseFont(data []byte) (face font.Face, err error) {
+	if bytes.HasPrefix(data, []byte(pcfMagic)) {
+		f, err := parsePCF(data)
+		if err != nil {
+			return nil, err
+		}
+		return f.NewFace(), nil
+	}
+
+	if !bytes.HasPrefix(bytes.TrimSpace(data), []byte("STARTFONT")) {
+		return nil, fmt.Errorf("not a BDF or PCF font")
+	}
+
+	// the BDF parser doesn't check its input
+	defer func() {
+		if r := recover(); r != nil {
+			face, err = nil, fmt.Errorf("malformed BDF font: %v", r)
+		}
+	}()
+
+	f, err := bdf.Parse(data)
+	if err != nil {
+		return nil, err
+	}
+	return f.NewFace(), nil
+}
+
+// AddFont parses a BDF, PCF, TrueType or OpenType font, such as one
+// bundled with an app, and returns the name that widgets can use for it.
+// The name is derived from the file name and the font data, so fonts of
+// different apps don't clash, and adding the same font again doesn't
+// parse it again.
+//
+// TrueType and OpenType fonts are drawn at the pixel size that follows
+// an "@" in the name, such as "noto.ttf#0123456789ab@12".
+func AddFont(filename string, data []byte) (string, error) {
+	sum := sha256.Sum256(data)
+	name := fmt.Sprintf("%s#%x", path.Base(filename), sum[:6])
+
+	fontMutex.Lock()
+	defer fontMutex.Unlock()
+
+	if addedFonts[name] > 0 {
+		addedFonts[name]++
+		return name, nil
+	}
+
+	if isScalableFont(data) {
+		f, err := parseScalableFont(data)
+		if err != nil {
+			return "", fmt.Errorf("parsing font '%s': %w", filename, err)
+		}
+
+		scalableFonts[name] = f
+		addedFonts[name] = 1
+		return name, nil
+	}
+
+	face, err := ParseFont(data)
+	if err != nil {
+		return "", fmt.Errorf("parsing font '%s': %w", filename, err)
+	}
+
+	fontCache[name] = face
+	addedFonts[name] = 1
+	return name, nil
+}
+
+// ReleaseFont undoes an AddFont. Once a font is released as many times as
+// it was added, it's removed along with every face drawn from it, and
+// widgets can no longer use it. Names that weren't added are ignored.
+func ReleaseFont(name string) {
+	fontMutex.Lock()
+	defer fontMutex.Unlock()
+
+	if addedFonts[name] == 0 {
+		return
+	}
+
+	addedFonts[name]--
+	if addedFonts[name] > 0 {
+		return
+	}
+
+	delete(addedFonts, name)
+	delete(scalableFonts, name)
+	delete(fontCache, name)
+	faceCache.removeFunc(func(key string) bool {
+		return usesFont(key, name)
+	})
+}
+
+// usesFont tells if a font cache key, such as "noto.ttf#0123456789ab@12"
+// or "tb-8,emoji", draws with the named font.
+func usesFont(key, name string) bool {
+	for _, n := range strings.Split(key, ",") {
+		n = strings.TrimSpace(n)
+		if n == name || strings.HasPrefix(n, name+"@") {
+			return true
+		}
+	}
+	return false
+}
+
+// faceLRU is a cache of faces that keeps the most recently used ones.
+// The caller must hold fontMutex.
+type faceLRU struct {
+	size  int
+	order *list.List // of *faceEntry, most recently used first
+	items map[string]*list.Element
+}
+
+type faceEntry struct {
+	key  string
+	face font.Face
+}
+
+func newFaceLRU(size int) *faceLRU {
+	return &faceLRU{
+		size:  size,
+		order: list.New(),
+		items: map[string]*list.Element{},
+	}
+}
+
+func (c *faceLRU) get(key string) (font.Face, bool) {
+	e, ok := c.items[key]
+	if !ok {
+		return nil, false
+	}
+	c.order.MoveToFront(e)
+	return e.Value.(*faceEntry).face, true
+}
+
+func (c *faceLRU) add(key string, face font.Face) {
+	if e, ok := c.items[key]; ok {
+		e.Value.(*faceEntry).face = face
+		c.order.MoveToFront(e)
+		return
+	}
+
+	c.items[key] = c.order.PushFront(&faceEntry{key, face})
+	for c.order.Len() > c.size {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.items, oldest.Value.(*faceEntry).key)
+	}
+}
+
+// removeFunc removes the faces whose keys match.
+func (c *faceLRU) removeFunc(match func(key string) bool) {
+	for key, e := range c.items {
+		if match(key) {
+			c.order.Remove(e)
+			delete(c.items, key)
+		}
+	}
+}
@@ -11,6 +11,7 @@ var fontDataRaw = map[string]string{
  "CG-pixel-3x5-mono": "U1RBUlRGT05UIDIuMQpGT05UIC1Gb250Rm9yZ2UtQ0cgcGl4ZWwgM3g1IG1vbm8tQm9vay1SLU5vcm1hbC0tNS01MC03NS03NS1QLTM5LUlTTzEwNjQ2LTEKU0laRSA1IDc1IDc1CkZPTlRCT1VORElOR0JPWCA1IDUgMCAwCkNPTU1FTlQgIkdlbmVyYXRlZCBieSBmb250Zm9yZ2UsIGh0dHA6Ly9mb250Zm9yZ2Uuc291cmNlZm9yZ2UubmV0IgpDT01NRU5UICJDb3B5cmlnaHQgdnl6bmV2IDIwMTciCkNPTU1FTlQgIiJDRyBwaXhlbCAzeDUgbW9ubyIgaXMgYmFzZWQgb24gIkNHIHBpeGVsIDN4NSIgYnkgInZ5em5ldiIgKGh0dHA6Ly9mb250c3RydWN0LmNvbS9mb250c3RydWN0b3JzL3Nob3cvMTQ0MTk3NC92eXpuZXYpLCB3aGljaCBpcyBiYXNlZCBvbiAiQ0cgcGl4ZWwgNHg1IiBieSAidnl6bmV2IiAoaHR0cDovL2ZvbnRzdHJ1Y3QuY29tL2ZvbnRzdHJ1Y3RvcnMvc2hvdy8xNDQxOTc0L3Z5em5ldikiClNUQVJUUFJPUEVSVElFUyA0MApGT1VORFJZICJGb250Rm9yZ2UiCkZBTUlMWV9OQU1FICJDRyBwaXhlbCAzeDUgbW9ubyIKV0VJR0hUX05BTUUgIkJvb2siClNMQU5UICJSIgpTRVRXSURUSF9OQU1FICJOb3JtYWwiCkFERF9TVFlMRV9OQU1FICIiClBJWEVMX1NJWkUgNQpQT0lOVF9TSVpFIDUwClJFU09MVVRJT05fWCA3NQpSRVNPTFVUSU9OX1kgNzUKU1BBQ0lORyAiUCIKQVZFUkFHRV9XSURUSCAzOQpDSEFSU0VUX1JFR0lTVFJZICJJU08xMDY0NiIKQ0hBUlNFVF9FTkNPRElORyAiMSIKRk9OVE5BTUVfUkVHSVNUUlkgIiIKQ0hBUlNFVF9DT0xMRUNUSU9OUyAiSVNPMTA2NDYtMSIKRk9OVF9OQU1FICJDRy1waXhlbC0zeDUtbW9ubyIKRkFDRV9OQU1FICJDRyBwaXhlbCAzeDUgbW9ubyBSZWd1bGFyIgpDT1BZUklHSFQgIkNvcHlyaWdodCB2eXpuZXYgMjAxNyIKRk9OVF9WRVJTSU9OICIxLjAiCkZPTlRfQVNDRU5UIDUKRk9OVF9ERVNDRU5UIDAKVU5ERVJMSU5FX1BPU0lUSU9OIDAKVU5ERVJMSU5FX1RISUNLTkVTUyAxClhfSEVJR0hUIDQKQ0FQX0hFSUdIVCA0ClJBV19BU0NFTlQgMTAwMApSQVdfREVTQ0VOVCAwCk5PUk1fU1BBQ0UgNApSRUxBVElWRV9XRUlHSFQgNDAKUkVMQVRJVkVfU0VUV0lEVEggNTAKU1VQRVJTQ1JJUFRfWCAwClNVUEVSU0NSSVBUX1kgMgpTVVBFUlNDUklQVF9TSVpFIDIKU1VCU0NSSVBUX1ggMApTVUJTQ1JJUFRfWSAwClNVQlNDUklQVF9TSVpFIDIKRklHVVJFX1dJRFRIIDQKQVZHX0xPV0VSQ0FTRV9XSURUSCA0MApBVkdfVVBQRVJDQVNFX1dJRFRIIDQwCkVORFBST1BFUlRJRVMKQ0hBUlMgOTgKU1RBUlRDSEFSIHNwYWNlCkVOQ09ESU5HIDMyClNXSURUSCA3OTkgMApEV0lEVEggNCAwCkJCWCAxIDEgMCAwCkJJVE1BUAowMApFTkRDSEFSClNUQVJUQ0hBUiBleGNsYW0KRU5DT0RJTkcgMzMKU1dJRFRIIDc5OSAwCkRXSURUSCA0IDAKQkJYIDEgNSAxIDAKQklUTUFQCjgwCjgwCjgwCjAwCjgwCkVORENIQVIKU1RBUlRDSEFSIHF1b3RlZGJsCkVOQ09ESU5HIDM0ClNXSURUSCA3OTkgMApEV0lEVEggNCAwCkJCWCAzIDIgMCAzCkJJVE1BUApBMApBMApFTkRDSEFSClNUQVJUQ0hBUiBudW1iZXJzaWduCkVOQ09ESU5HIDM1ClNXSURUSCA3OTkgMApEV0lEVEggNCAwCkJCWCAzIDUgMCAwCkJJVE1BUApBMApFMApBMApFMApBMApFTkRDSEFSClNUQVJUQ0hBUiBkb2xsYXIKRU5DT0RJTkcgMzYKU1dJRFRIIDc5OSAwCkRXSURUSCA0IDAKQkJYIDMgNSAwIDAKQklUTUFQCjYwCkMwCjQwCjYwCkMwCkVORENIQVIKU1RBUlRDSEFSIHBlcmNlbnQKRU5DT0RJTkcgMzcKU1dJRFRIIDc5OSAwCkRXSURUSCA0IDAKQkJYIDMgNSAwIDAKQklUTUFQCjgwCjIwCjQwCjgwCjIwCkVORENIQVIKU1RBUlRDSEFSIGFtcGVyc2FuZApFTkNPRElORyAzOApTV0lEVEggNzk5IDAKRFdJRFRIIDQgMApCQlggMyA1IDAgMApCSVRNQVAKNjAKODAKNjAKQTAKNjAKRU5EQ0hBUgpTVEFSVENIQVIgcXVvdGVzaW5nbGUKRU5DT0RJTkcgMzkKU1dJRFRIIDc5OSAwCkRXSURUSCA0IDAKQkJYIDEgMiAxIDMKQklUTUFQCjgwCjgwCkVORENIQVIKU1RBUlRDSEFSIHBhcmVubGVmdApFTkNPRElORyA0MApTV0lEVEggNzk5IDAKRFdJRFRIIDQgMApCQlggMiA1IDEgMApCSVRNQVAKNDAKODAKODAKODAKNDAKRU5EQ0hBUgpTVEFSVENIQVIgcGFyZW5yaWdodApFTkNPRElORyA0MQpTV0lEVEggNzk5IDAKRFdJRFRIIDQgMApCQlggMiA1IDAgMApCSVRNQVAKODAKNDAKNDAKNDAKODAKRU5EQ0hBUgpTVEFSVENIQVIgYXN0ZXJpc2sKRU5DT0RJTkcgNDIKU1dJRFRIIDc5OSAwCkRXSURUSCA0IDAKQkJYIDMgNSAwIDAKQklUTUFQCkEwCjQwCkUwCjQwCkEwCkVORENIQVIKU1RBUlRDSEFSIHBsdXMKRU5DT0RJTkcgNDMKU1dJRFRIIDc5OSAwCkRXSURUSCA0IDAKQkJYIDMgMyAwIDEKQklUTUFQCjQwCkUwCjQwCkVORENIQVIKU1RBUlRDSEFSIGNvbW1hCkVOQ09ESU5HIDQ0ClNXSURUSCA3OTkgMApEV0lEVEggNCAwCkJCWCAyIDIgMCAwCkJJVE1BUAo0MAo4MApFTkRDSEFSClNUQVJUQ0hBUiBoeXBoZW4KRU5DT0RJTkcgNDUKU1dJRFRIIDc5OSAwCkRXSURUSCA0IDAKQkJYIDMgMSAwIDIKQklUTUFQCkUwCkVORENIQVIKU1RBUlRDSEFSIHBlcmlvZApFTkNPRElORyA0NgpTV0lEVEggNzk5IDAKRFdJRFRIIDQgMApCQlggMSAxIDEgMApCSVRNQVAKODAKRU5EQ0hBUgpTVEFSVENIQVIgc2xhc2gKRU5DT0RJTkcgNDcKU1dJRFRIIDc5OSAwCkRXSURUSCA0IDAKQkJYIDMgNSAwIDAKQklUTUFQCjIwCjIwCjQwCjgwCjgwCkVORENIQVIKU1RBUlRDSEFSIHplcm8KRU5DT0RJTkcgNDgKU1dJRFRIIDc5OSAwCkRXSURUSCA0IDAKQkJYIDMgNSAwIDAKQklUTUFQCkUwCkEwCkEwCkEwCkUwCkVORENIQVIKU1RBUlRDSEFSIG9uZQpFTkNPRElORyA0OQpTV0lEVEggNzk5IDAKRFdJRFRIIDQgMApCQlggMiA1IDAgMApCSVRNQVAKNDAKQzAKNDAKNDAKNDAKRU5EQ0hBUgpTVEFSVENIQVIgdHdvCkVOQ09ESU5HIDUwClNXSURUSCA3OTkgMApEV0lEVEggNCAwCkJCWCAzIDUgMCAwCkJJVE1BUApDMAoyMAo0MAo4MApFMApFTkRDSEFSClNUQVJUQ0hBUiB0aHJlZQpFTkNPRElORyA1MQpTV0lEVEggNzk5IDAKRFdJRFRIIDQgMApCQlggMyA1IDAgMApCSVRNQVAKQzAKMjAKNDAKMjAKQzAKRU5EQ0hBUgpTVEFSVENIQVIgZm91cgpFTkNPRElORyA1MgpTV0lEVEggNzk5IDAKRFdJRFRIIDQgMApCQlggMyA1IDAgMApCSVRNQVAKQTAKQTAKRTAKMjAKMjAKRU5EQ0hBUgpTVEFSVENIQVIgZml2ZQpFTkNPRElORyA1MwpTV0lEVEggNzk5IDAKRFdJRFRIIDQgMApCQlggMyA1IDAgMApCSVRNQVAKRTAKODAKQzAKMjAKQzAKRU5EQ0hBUgpTVEFSVENIQVIgc2l4CkVOQ09ESU5HIDU0ClNXSURUSCA3OTkgMApEV0lEVEggNCAwCkJCWCAzIDUgMCAwCkJJVE1BUAo2MAo4MApDMApBMAo0MApFTkRDSEFSClNUQVJUQ0hBUiBzZXZlbgpFTkNPRElORyA1NQpTV0lEVEggNzk5IDAKRFdJRFRIIDQgMApCQlggMyA1IDAgMApCSVRNQVAKRTAKMjAKNDAKNDAKNDAKRU5EQ0hBUgpTVEFSVENIQVIgZWlnaHQKRU5DT0RJTkcgNTYKU1dJRFRIIDc5OSAwCkRXSURUSCA0IDAKQkJYIDMgNSAwIDAKQklUTUFQCjQwCkEwCjQwCkEwCjQwCkVORENIQVIKU1RBUlRDSEFSIG5pbmUKRU5DT0RJTkcgNTcKU1dJRFRIIDc5OSAwCkRXSURUSCA0IDAKQkJYIDMgNSAwIDAKQklUTUFQCjQwCkEwCjYwCjIwCkMwCkVORENIQVIKU1RBUlRDSEFSIGNvbG9uCkVOQ09ESU5HIDU4ClNXSURUSCA3OTkgMApEV0lEVEggNCAwCkJCWCAxIDMgMSAxCkJJVE1BUAo4MAowMAo4MApFTkRDSEFSClNUQVJUQ0hBUiBzZW1pY29sb24KRU5DT0RJTkcgNTkKU1dJRFRIIDc5OSAwCkRXSURUSCA0IDAKQkJYIDIgNCAwIDAKQklUTUFQCjQwCjAwCjQwCjgwCkVORENIQVIKU1RBUlRDSEFSIGxlc3MKRU5DT0RJTkcgNjAKU1dJRFRIIDc5OSAwCkRXSURUSCA0IDAKQkJYIDMgNSAwIDAKQklUTUFQCjIwCjQwCjgwCjQwCjIwCkVORENIQVIKU1RBUlRDSEFSIGVxdWFsCkVOQ09ESU5HIDYxClNXSURUSCA3OTkgMApEV0lEVEggNCAwCkJCWCAzIDMgMCAxCkJJVE1BUApFMAowMApFMApFTkRDSEFSClNUQVJUQ0hBUiBncmVhdGVyCkVOQ09ESU5HIDYyClNXSURUSCA3OTkgMApEV0lEVEggNCAwCkJCWCAzIDUgMCAwCkJJVE1BUAo4MAo0MAoyMAo0MAo4MApFTkRDSEFSClNUQVJUQ0hBUiBxdWVzdGlvbgpFTkNPRElORyA2MwpTV0lEVEggNzk5IDAKRFdJRFRIIDQgMApCQlggMyA1IDAgMApCSVRNQVAKQzAKMjAKNDAKMDAKNDAKRU5EQ0hBUgpTVEFSVENIQVIgYXQKRU5DT0RJTkcgNjQKU1dJRFRIIDc5OSAwCkRXSURUSCA0IDAKQkJYIDMgNSAwIDAKQklUTUFQCjQwCkEwCkUwCjgwCjYwCkVORENIQVIKU1RBUlRDSEFSIEEKRU5DT0RJTkcgNjUKU1dJRFRIIDc5OSAwCkRXSURUSCA0IDAKQkJYIDMgNSAwIDAKQklUTUFQCjQwCkEwCkUwCkEwCkEwCkVORENIQVIKU1RBUlRDSEFSIEIKRU5DT0RJTkcgNjYKU1dJRFRIIDc5OSAwCkRXSURUSCA0IDAKQkJYIDMgNSAwIDAKQklUTUFQCkMwCkEwCkMwCkEwCkMwCkVORENIQVIKU1RBUlRDSEFSIEMKRU5DT0RJTkcgNjcKU1dJRFRIIDc5OSAwCkRXSURUSCA0IDAKQkJYIDMgNSAwIDAKQklUTUFQCjQwCkEwCjgwCkEwCjQwCkVORENIQVIKU1RBUlRDSEFSIEQKRU5DT0RJTkcgNjgKU1dJRFRIIDc5OSAwCkRXSURUSCA0IDAKQkJYIDMgNSAwIDAKQklUTUFQCkMwCkEwCkEwCkEwCkMwCkVORENIQVIKU1RBUlRDSEFSIEUKRU5DT0RJTkcgNjkKU1dJRFRIIDc5OSAwCkRXSURUSCA0IDAKQkJYIDMgNSAwIDAKQklUTUFQCkUwCjgwCkUwCjgwCkUwCkVORENIQVIKU1RBUlRDSEFSIEYKRU5DT0RJTkcgNzAKU1dJRFRIIDc5OSAwCkRXSURUSCA0IDAKQkJYIDMgNSAwIDAKQklUTUFQCkUwCjgwCkUwCjgwCjgwCkVORENIQVIKU1RBUlRDSEFSIEcKRU5DT0RJTkcgNzEKU1dJRFRIIDc5OSAwCkRXSURUSCA0IDAKQkJYIDMgNSAwIDAKQklUTUFQCjYwCjgwCjgwCkEwCjYwCkVORENIQVIKU1RBUlRDSEFSIEgKRU5DT0RJTkcgNzIKU1dJRFRIIDc5OSAwCkRXSURUSCA0IDAKQkJYIDMgNSAwIDAKQklUTUFQCkEwCkEwCkUwCkEwCkEwCkVORENIQVIKU1RBUlRDSEFSIEkKRU5DT0RJTkcgNzMKU1dJRFRIIDc5OSAwCkRXSURUSCA0IDAKQkJYIDEgNSAxIDAKQklUTUFQCjgwCjgwCjgwCjgwCjgwCkVORENIQVIKU1RBUlRDSEFSIEoKRU5DT0RJTkcgNzQKU1dJRFRIIDc5OSAwCkRXSURUSCA0IDAKQkJYIDMgNSAwIDAKQklUTUFQCjIwCjIwCjIwCkEwCjQwCkVORENIQVIKU1RBUlRDSEFSIEsKRU5DT0RJTkcgNzUKU1dJRFRIIDc5OSAwCkRXSURUSCA0IDAKQkJYIDMgNSAwIDAKQklUTUFQCkEwCkEwCkMwCkEwCkEwCkVORENIQVIKU1RBUlRDSEFSIEwKRU5DT0RJTkcgNzYKU1dJRFRIIDc5OSAwCkRXSURUSCA0IDAKQkJYIDMgNSAwIDAKQklUTUFQCjgwCjgwCjgwCjgwCkUwCkVORENIQVIKU1RBUlRDSEFSIE0KRU5DT0RJTkcgNzcKU1dJRFRIIDc5OSAwCkRXSURUSCA0IDAKQkJYIDMgNSAwIDAKQklUTUFQCkEwCkUwCkEwCkEwCkEwCkVORENIQVIKU1RBUlRDSEFSIE4KRU5DT0RJTkcgNzgKU1dJRFRIIDc5OSAwCkRXSURUSCA0IDAKQkJYIDMgNSAwIDAKQklUTUFQCkMwCkEwCkEwCkEwCkEwCkVORENIQVIKU1RBUlRDSEFSIE8KRU5DT0RJTkcgNzkKU1dJRFRIIDc5OSAwCkRXSURUSCA0IDAKQkJYIDMgNSAwIDAKQklUTUFQCjQwCkEwCkEwCkEwCjQwCkVORENIQVIKU1RBUlRDSEFSIFAKRU5DT0RJTkcgODAKU1dJRFRIIDc5OSAwCkRXSURUSCA0IDAKQkJYIDMgNSAwIDAKQklUTUFQCkMwCkEwCkMwCjgwCjgwCkVORENIQVIKU1RBUlRDSEFSIFEKRU5DT0RJTkcgODEKU1dJRFRIIDc5OSAwCkRXSURUSCA0IDAKQkJYIDMgNSAwIDAKQklUTUFQCjQwCkEwCkEwCkUwCjYwCkVORENIQVIKU1RBUlRDSEFSIFIKRU5DT0RJTkcgODIKU1dJRFRIIDc5OSAwCkRXSURUSCA0IDAKQkJYIDMgNSAwIDAKQklUTUFQCkMwCkEwCkMwCkEwCkEwCkVORENIQVIKU1RBUlRDSEFSIFMKRU5DT0RJTkcgODMKU1dJRFRIIDc5OSAwCkRXSURUSCA0IDAKQkJYIDMgNSAwIDAKQklUTUFQCjYwCjgwCjQwCjIwCkMwCkVORENIQVIKU1RBUlRDSEFSIFQKRU5DT0RJTkcgODQKU1dJRFRIIDc5OSAwCkRXSURUSCA0IDAKQkJYIDMgNSAwIDAKQklUTUFQCkUwCjQwCjQwCjQwCjQwCkVORENIQVIKU1RBUlRDSEFSIFUKRU5DT0RJTkcgODUKU1dJRFRIIDc5OSAwCkRXSURUSCA0IDAKQkJYIDMgNSAwIDAKQklUTUFQCkEwCkEwCkEwCkEwCkUwCkVORENIQVIKU1RBUlRDSEFSIFYKRU5DT0RJTkcgODYKU1dJRFRIIDc5OSAwCkRXSURUSCA0IDAKQkJYIDMgNSAwIDAKQklUTUFQCkEwCkEwCkEwCkUwCjQwCkVORENIQVIKU1RBUlRDSEFSIFcKRU5DT0RJTkcgODcKU1dJRFRIIDc5OSAwCkRXSURUSCA0IDAKQkJYIDMgNSAwIDAKQklUTUFQCkEwCkEwCkEwCkUwCkEwCkVORENIQVIKU1RBUlRDSEFSIFgKRU5DT0RJTkcgODgKU1dJRFRIIDc5OSAwCkRXSURUSCA0IDAKQkJYIDMgNSAwIDAKQklUTUFQCkEwCkEwCjQwCkEwCkEwCkVORENIQVIKU1RBUlRDSEFSIFkKRU5DT0RJTkcgODkKU1dJRFRIIDc5OSAwCkRXSURUSCA0IDAKQkJYIDMgNSAwIDAKQklUTUFQCkEwCkEwCkUwCjQwCjQwCkVORENIQVIKU1RBUlRDSEFSIFoKRU5DT0RJTkcgOTAKU1dJRFRIIDc5OSAwCkRXSURUSCA0IDAKQkJYIDMgNSAwIDAKQklUTUFQCkUwCjIwCjQwCjgwCkUwCkVORENIQVIKU1RBUlRDSEFSIGJyYWNrZXRsZWZ0CkVOQ09ESU5HIDkxClNXSURUSCA3OTkgMApEV0lEVEggNCAwCkJCWCAyIDUgMSAwCkJJVE1BUApDMAo4MAo4MAo4MApDMApFTkRDSEFSClNUQVJUQ0hBUiBiYWNrc2xhc2gKRU5DT0RJTkcgOTIKU1dJRFRIIDc5OSAwCkRXSURUSCA0IDAKQkJYIDMgNSAwIDAKQklUTUFQCjgwCjgwCjQwCjIwCjIwCkVORENIQVIKU1RBUlRDSEFSIGJyYWNrZXRyaWdodApFTkNPRElORyA5MwpTV0lEVEggNzk5IDAKRFdJRFRIIDQgMApCQlggMiA1IDAgMApCSVRNQVAKQzAKNDAKNDAKNDAKQzAKRU5EQ0hBUgpTVEFSVENIQVIgYXNjaWljaXJjdW0KRU5DT0RJTkcgOTQKU1dJRFRIIDc5OSAwCkRXSURUSCA0IDAKQkJYIDMgMiAwIDMKQklUTUFQCjQwCkEwCkVORENIQVIKU1RBUlRDSEFSIHVuZGVyc2NvcmUKRU5DT0RJTkcgOTUKU1dJRFRIIDc5OSAwCkRXSURUSCA0IDAKQkJYIDMgMSAwIDAKQklUTUFQCkUwCkVORENIQVIKU1RBUlRDSEFSIGdyYXZlCkVOQ09ESU5HIDk2ClNXSURUSCA3OTkgMApEV0lEVEggNCAwCkJCWCAyIDIgMCAzCkJJVE1BUAo4MAo0MApFTkRDSEFSClNUQVJUQ0hBUiBhCkVOQ09ESU5HIDk3ClNXSURUSCA3OTkgMApEV0lEVEggNCAwCkJCWCAzIDUgMCAwCkJJVE1BUAo0MApBMApFMApBMApBMApFTkRDSEFSClNUQVJUQ0hBUiBiCkVOQ09ESU5HIDk4ClNXSURUSCA3OTkgMApEV0lEVEggNCAwCkJCWCAzIDUgMCAwCkJJVE1BUApDMApBMApDMApBMApDMApFTkRDSEFSClNUQVJUQ0hBUiBjCkVOQ09ESU5HIDk5ClNXSURUSCA3OTkgMApEV0lEVEggNCAwCkJCWCAzIDUgMCAwCkJJVE1BUAo0MApBMAo4MApBMAo0MApFTkRDSEFSClNUQVJUQ0hBUiBkCkVOQ09ESU5HIDEwMApTV0lEVEggNzk5IDAKRFdJRFRIIDQgMApCQlggMyA1IDAgMApCSVRNQVAKQzAKQTAKQTAKQTAKQzAKRU5EQ0hBUgpTVEFSVENIQVIgZQpFTkNPRElORyAxMDEKU1dJRFRIIDc5OSAwCkRXSURUSCA0IDAKQkJYIDMgNSAwIDAKQklUTUFQCkUwCjgwCkUwCjgwCkUwCkVORENIQVIKU1RBUlRDSEFSIGYKRU5DT0RJTkcgMTAyClNXSURUSCA3OTkgMApEV0lEVEggNCAwCkJCWCAzIDUgMCAwCkJJVE1BUApFMAo4MApFMAo4MAo4MApFTkRDSEFSClNUQVJUQ0hBUiBnCkVOQ09ESU5HIDEwMwpTV0lEVEggNzk5IDAKRFdJRFRIIDQgMApCQlggMyA1IDAgMApCSVRNQVAKNjAKODAKODAKQTAKNjAKRU5EQ0hBUgpTVEFSVENIQVIgaApFTkNPRElORyAxMDQKU1dJRFRIIDc5OSAwCkRXSURUSCA0IDAKQkJYIDMgNSAwIDAKQklUTUFQCkEwCkEwCkUwCkEwCkEwCkVORENIQVIKU1RBUlRDSEFSIGkKRU5DT0RJTkcgMTA1ClNXSURUSCA3OTkgMApEV0lEVEggNCAwCkJCWCAxIDUgMSAwCkJJVE1BUAo4MAo4MAo4MAo4MAo4MApFTkRDSEFSClNUQVJUQ0hBUiBqCkVOQ09ESU5HIDEwNgpTV0lEVEggNzk5IDAKRFdJRFRIIDQgMApCQlggMyA1IDAgMApCSVRNQVAKMjAKMjAKMjAKQTAKNDAKRU5EQ0hBUgpTVEFSVENIQVIgawpFTkNPRElORyAxMDcKU1dJRFRIIDc5OSAwCkRXSURUSCA0IDAKQkJYIDMgNSAwIDAKQklUTUFQCkEwCkEwCkMwCkEwCkEwCkVORENIQVIKU1RBUlRDSEFSIGwKRU5DT0RJTkcgMTA4ClNXSURUSCA3OTkgMApEV0lEVEggNCAwCkJCWCAzIDUgMCAwCkJJVE1BUAo4MAo4MAo4MAo4MApFMApFTkRDSEFSClNUQVJUQ0hBUiBtCkVOQ09ESU5HIDEwOQpTV0lEVEggNzk5IDAKRFdJRFRIIDQgMApCQlggMyA1IDAgMApCSVRNQVAKQTAKRTAKQTAKQTAKQTAKRU5EQ0hBUgpTVEFSVENIQVIgbgpFTkNPRElORyAxMTAKU1dJRFRIIDc5OSAwCkRXSURUSCA0IDAKQkJYIDMgNSAwIDAKQklUTUFQCkMwCkEwCkEwCkEwCkEwCkVORENIQVIKU1RBUlRDSEFSIG8KRU5DT0RJTkcgMTExClNXSURUSCA3OTkgMApEV0lEVEggNCAwCkJCWCAzIDUgMCAwCkJJVE1BUAo0MApBMApBMApBMAo0MApFTkRDSEFSClNUQVJUQ0hBUiBwCkVOQ09ESU5HIDExMgpTV0lEVEggNzk5IDAKRFdJRFRIIDQgMApCQlggMyA1IDAgMApCSVRNQVAKQzAKQTAKQzAKODAKODAKRU5EQ0hBUgpTVEFSVENIQVIgcQpFTkNPRElORyAxMTMKU1dJRFRIIDc5OSAwCkRXSURUSCA0IDAKQkJYIDMgNSAwIDAKQklUTUFQCjQwCkEwCkEwCkUwCjYwCkVORENIQVIKU1RBUlRDSEFSIHIKRU5DT0RJTkcgMTE0ClNXSURUSCA3OTkgMApEV0lEVEggNCAwCkJCWCAzIDUgMCAwCkJJVE1BUApDMApBMApDMApBMApBMApFTkRDSEFSClNUQVJUQ0hBUiBzCkVOQ09ESU5HIDExNQpTV0lEVEggNzk5IDAKRFdJRFRIIDQgMApCQlggMyA1IDAgMApCSVRNQVAKNjAKODAKNDAKMjAKQzAKRU5EQ0hBUgpTVEFSVENIQVIgdApFTkNPRElORyAxMTYKU1dJRFRIIDc5OSAwCkRXSURUSCA0IDAKQkJYIDMgNSAwIDAKQklUTUFQCkUwCjQwCjQwCjQwCjQwCkVORENIQVIKU1RBUlRDSEFSIHUKRU5DT0RJTkcgMTE3ClNXSURUSCA3OTkgMApEV0lEVEggNCAwCkJCWCAzIDUgMCAwCkJJVE1BUApBMApBMApBMApBMApFMApFTkRDSEFSClNUQVJUQ0hBUiB2CkVOQ09ESU5HIDExOApTV0lEVEggNzk5IDAKRFdJRFRIIDQgMApCQlggMyA1IDAgMApCSVRNQVAKQTAKQTAKQTAKRTAKNDAKRU5EQ0hBUgpTVEFSVENIQVIgdwpFTkNPRElORyAxMTkKU1dJRFRIIDc5OSAwCkRXSURUSCA0IDAKQkJYIDMgNSAwIDAKQklUTUFQCkEwCkEwCkEwCkUwCkEwCkVORENIQVIKU1RBUlRDSEFSIHgKRU5DT0RJTkcgMTIwClNXSURUSCA3OTkgMApEV0lEVEggNCAwCkJCWCAzIDUgMCAwCkJJVE1BUApBMApBMAo0MApBMApBMApFTkRDSEFSClNUQVJUQ0hBUiB5CkVOQ09ESU5HIDEyMQpTV0lEVEggNzk5IDAKRFdJRFRIIDQgMApCQlggMyA1IDAgMApCSVRNQVAKQTAKQTAKRTAKNDAKNDAKRU5EQ0hBUgpTVEFSVENIQVIgegpFTkNPRElORyAxMjIKU1dJRFRIIDc5OSAwCkRXSURUSCA0IDAKQkJYIDMgNSAwIDAKQklUTUFQCkUwCjIwCjQwCjgwCkUwCkVORENIQVIKU1RBUlRDSEFSIGJyYWNlbGVmdApFTkNPRElORyAxMjMKU1dJRFRIIDc5OSAwCkRXSURUSCA0IDAKQkJYIDMgNSAwIDAKQklUTUFQCjYwCjQwCkMwCjQwCjYwCkVORENIQVIKU1RBUlRDSEFSIGJhcgpFTkNPRElORyAxMjQKU1dJRFRIIDc5OSAwCkRXSURUSCA0IDAKQkJYIDEgNSAxIDAKQklUTUFQCjgwCjgwCjAwCjgwCjgwCkVORENIQVIKU1RBUlRDSEFSIGJyYWNlcmlnaHQKRU5DT0RJTkcgMTI1ClNXSURUSCA3OTkgMApEV0lEVEggNCAwCkJCWCAzIDUgMCAwCkJJVE1BUApDMAo0MAo2MAo0MApDMApFTkRDSEFSClNUQVJUQ0hBUiBhc2NpaXRpbGRlCkVOQ09ESU5HIDEyNgpTV0lEVEggNzk5IDAKRFdJRFRIIDQgMApCQlggMyAyIDAgMgpCSVRNQVAKNjAKQzAKRU5EQ0hBUgpTVEFSVENIQVIgLm5vdGRlZgpFTkNPRElORyAtMQpTV0lEVEggNzk5IDAKRFdJRFRIIDQgMApCQlggNSA1IDAgMApCSVRNQVAKRjgKRDgKRjgKRjgKRjgKRU5EQ0hBUgpTVEFSVENIQVIgZ2x5cGgxCkVOQ09ESU5HIC0xClNXSURUSCA3OTkgMApEV0lEVEggNCAwCkJCWCAxIDEgMCAwCkJJVE1BUAowMApFTkRDSEFSClNUQVJUQ0hBUiBnbHlwaDIKRU5DT0RJTkcgLTEKU1dJRFRIIDE5OSAwCkRXSURUSCAxIDAKQkJYIDEgMSAwIDAKQklUTUFQCjAwCkVORENIQVIKRU5ERk9OVAo=",
  "CG-pixel-4x5-mono": "U1RBUlRGT05UIDIuMQpGT05UIC1Gb250Rm9yZ2UtQ0cgcGl4ZWwgNHg1IG1vbm8tQm9vay1SLU5vcm1hbC0tNS01MC03NS03NS1QLTQ5LUlTTzEwNjQ2LTEKU0laRSA1IDc1IDc1CkZPTlRCT1VORElOR0JPWCA1IDUgMCAwCkNPTU1FTlQgIkdlbmVyYXRlZCBieSBmb250Zm9yZ2UsIGh0dHA6Ly9mb250Zm9yZ2Uuc291cmNlZm9yZ2UubmV0IgpDT01NRU5UICJDb3B5cmlnaHQgdnl6bmV2IDIwMTciCkNPTU1FTlQgIiJDRyBwaXhlbCA0eDUgbW9ubyIgaXMgYmFzZWQgb24gIkNHIHBpeGVsIDR4NSIgYnkgInZ5em5ldiIgKGh0dHA6Ly9mb250c3RydWN0LmNvbS9mb250c3RydWN0b3JzL3Nob3cvMTQ0MTk3NC92eXpuZXYpIgpTVEFSVFBST1BFUlRJRVMgNDAKRk9VTkRSWSAiRm9udEZvcmdlIgpGQU1JTFlfTkFNRSAiQ0cgcGl4ZWwgNHg1IG1vbm8iCldFSUdIVF9OQU1FICJCb29rIgpTTEFOVCAiUiIKU0VUV0lEVEhfTkFNRSAiTm9ybWFsIgpBRERfU1RZTEVfTkFNRSAiIgpQSVhFTF9TSVpFIDUKUE9JTlRfU0laRSA1MApSRVNPTFVUSU9OX1ggNzUKUkVTT0xVVElPTl9ZIDc1ClNQQUNJTkcgIlAiCkFWRVJBR0VfV0lEVEggNDkKQ0hBUlNFVF9SRUdJU1RSWSAiSVNPMTA2NDYiCkNIQVJTRVRfRU5DT0RJTkcgIjEiCkZPTlROQU1FX1JFR0lTVFJZICIiCkNIQVJTRVRfQ09MTEVDVElPTlMgIklTTzEwNjQ2LTEiCkZPTlRfTkFNRSAiQ0ctcGl4ZWwtNHg1LW1vbm8iCkZBQ0VfTkFNRSAiQ0cgcGl4ZWwgNHg1IG1vbm8gUmVndWxhciIKQ09QWVJJR0hUICJDb3B5cmlnaHQgdnl6bmV2IDIwMTciCkZPTlRfVkVSU0lPTiAiMS4wIgpGT05UX0FTQ0VOVCA1CkZPTlRfREVTQ0VOVCAwClVOREVSTElORV9QT1NJVElPTiAwClVOREVSTElORV9USElDS05FU1MgMQpYX0hFSUdIVCA0CkNBUF9IRUlHSFQgNApSQVdfQVNDRU5UIDEwMDAKUkFXX0RFU0NFTlQgMApOT1JNX1NQQUNFIDUKUkVMQVRJVkVfV0VJR0hUIDQwClJFTEFUSVZFX1NFVFdJRFRIIDUwClNVUEVSU0NSSVBUX1ggMApTVVBFUlNDUklQVF9ZIDIKU1VQRVJTQ1JJUFRfU0laRSAyClNVQlNDUklQVF9YIDAKU1VCU0NSSVBUX1kgMApTVUJTQ1JJUFRfU0laRSAyCkZJR1VSRV9XSURUSCA1CkFWR19MT1dFUkNBU0VfV0lEVEggNTAKQVZHX1VQUEVSQ0FTRV9XSURUSCA1MApFTkRQUk9QRVJUSUVTCkNIQVJTIDk4ClNUQVJUQ0hBUiBzcGFjZQpFTkNPRElORyAzMgpTV0lEVEggMTAwMCAwCkRXSURUSCA1IDAKQkJYIDEgMSAwIDAKQklUTUFQCjAwCkVORENIQVIKU1RBUlRDSEFSIGV4Y2xhbQpFTkNPRElORyAzMwpTV0lEVEggMTAwMCAwCkRXSURUSCA1IDAKQkJYIDEgNSAxIDAKQklUTUFQCjgwCjgwCjgwCjAwCjgwCkVORENIQVIKU1RBUlRDSEFSIHF1b3RlZGJsCkVOQ09ESU5HIDM0ClNXSURUSCA5OTkgMApEV0lEVEggNSAwCkJCWCAzIDIgMCAzCkJJVE1BUApBMApBMApFTkRDSEFSClNUQVJUQ0hBUiBudW1iZXJzaWduCkVOQ09ESU5HIDM1ClNXSURUSCAxMDAwIDAKRFdJRFRIIDUgMApCQlggNCA1IDAgMApCSVRNQVAKQTAKRjAKQTAKRjAKQTAKRU5EQ0hBUgpTVEFSVENIQVIgZG9sbGFyCkVOQ09ESU5HIDM2ClNXSURUSCAxMDAwIDAKRFdJRFRIIDUgMApCQlggNCA1IDAgMApCSVRNQVAKNzAKQTAKNjAKNTAKRTAKRU5EQ0hBUgpTVEFSVENIQVIgcGVyY2VudApFTkNPRElORyAzNwpTV0lEVEggMTAwMCAwCkRXSURUSCA1IDAKQkJYIDQgNSAwIDAKQklUTUFQCkMwCjEwCjYwCjgwCjMwCkVORENIQVIKU1RBUlRDSEFSIGFtcGVyc2FuZApFTkNPRElORyAzOApTV0lEVEggMTAwMCAwCkRXSURUSCA1IDAKQkJYIDQgNSAwIDAKQklUTUFQCjQwCkEwCjQwCkEwCjUwCkVORENIQVIKU1RBUlRDSEFSIHF1b3Rlc2luZ2xlCkVOQ09ESU5HIDM5ClNXSURUSCAxMDAwIDAKRFdJRFRIIDUgMApCQlggMSAyIDEgMwpCSVRNQVAKODAKODAKRU5EQ0hBUgpTVEFSVENIQVIgcGFyZW5sZWZ0CkVOQ09ESU5HIDQwClNXSURUSCA5OTkgMApEV0lEVEggNSAwCkJCWCAyIDUgMSAwCkJJVE1BUAo0MAo4MAo4MAo4MAo0MApFTkRDSEFSClNUQVJUQ0hBUiBwYXJlbnJpZ2h0CkVOQ09ESU5HIDQxClNXSURUSCA5OTkgMApEV0lEVEggNSAwCkJCWCAyIDUgMSAwCkJJVE1BUAo4MAo0MAo0MAo0MAo4MApFTkRDSEFSClNUQVJUQ0hBUiBhc3RlcmlzawpFTkNPRElORyA0MgpTV0lEVEggMTAwMCAwCkRXSURUSCA1IDAKQkJYIDQgNSAwIDAKQklUTUFQCjkwCjYwCkYwCjYwCjkwCkVORENIQVIKU1RBUlRDSEFSIHBsdXMKRU5DT0RJTkcgNDMKU1dJRFRIIDk5OSAwCkRXSURUSCA1IDAKQkJYIDMgMyAwIDEKQklUTUFQCjQwCkUwCjQwCkVORENIQVIKU1RBUlRDSEFSIGNvbW1hCkVOQ09ESU5HIDQ0ClNXSURUSCAxMDAwIDAKRFdJRFRIIDUgMApCQlggMiAyIDAgMApCSVRNQVAKNDAKODAKRU5EQ0hBUgpTVEFSVENIQVIgaHlwaGVuCkVOQ09ESU5HIDQ1ClNXSURUSCA5OTkgMApEV0lEVEggNSAwCkJCWCAzIDEgMCAyCkJJVE1BUApFMApFTkRDSEFSClNUQVJUQ0hBUiBwZXJpb2QKRU5DT0RJTkcgNDYKU1dJRFRIIDEwMDAgMApEV0lEVEggNSAwCkJCWCAxIDEgMSAwCkJJVE1BUAo4MApFTkRDSEFSClNUQVJUQ0hBUiBzbGFzaApFTkNPRElORyA0NwpTV0lEVEggOTk5IDAKRFdJRFRIIDUgMApCQlggMyA1IDAgMApCSVRNQVAKMjAKMjAKNDAKODAKODAKRU5EQ0hBUgpTVEFSVENIQVIgemVybwpFTkNPRElORyA0OApTV0lEVEggMTAwMCAwCkRXSURUSCA1IDAKQkJYIDQgNSAwIDAKQklUTUFQCjYwCkIwCkQwCjkwCjYwCkVORENIQVIKU1RBUlRDSEFSIG9uZQpFTkNPRElORyA0OQpTV0lEVEggOTk5IDAKRFdJRFRIIDUgMApCQlggMiA1IDEgMApCSVRNQVAKNDAKQzAKNDAKNDAKNDAKRU5EQ0hBUgpTVEFSVENIQVIgdHdvCkVOQ09ESU5HIDUwClNXSURUSCAxMDAwIDAKRFdJRFRIIDUgMApCQlggNCA1IDAgMApCSVRNQVAKNjAKOTAKMjAKNDAKRjAKRU5EQ0hBUgpTVEFSVENIQVIgdGhyZWUKRU5DT0RJTkcgNTEKU1dJRFRIIDEwMDAgMApEV0lEVEggNSAwCkJCWCA0IDUgMCAwCkJJVE1BUApFMAoxMAo2MAoxMApFMApFTkRDSEFSClNUQVJUQ0hBUiBmb3VyCkVOQ09ESU5HIDUyClNXSURUSCAxMDAwIDAKRFdJRFRIIDUgMApCQlggNCA1IDAgMApCSVRNQVAKOTAKOTAKRjAKMTAKMTAKRU5EQ0hBUgpTVEFSVENIQVIgZml2ZQpFTkNPRElORyA1MwpTV0lEVEggMTAwMCAwCkRXSURUSCA1IDAKQkJYIDQgNSAwIDAKQklUTUFQCkYwCjgwCkUwCjEwCkUwCkVORENIQVIKU1RBUlRDSEFSIHNpeApFTkNPRElORyA1NApTV0lEVEggMTAwMCAwCkRXSURUSCA1IDAKQkJYIDQgNSAwIDAKQklUTUFQCjYwCjgwCkUwCjkwCjYwCkVORENIQVIKU1RBUlRDSEFSIHNldmVuCkVOQ09ESU5HIDU1ClNXSURUSCAxMDAwIDAKRFdJRFRIIDUgMApCQlggNCA1IDAgMApCSVRNQVAKRjAKMTAKMjAKMjAKMjAKRU5EQ0hBUgpTVEFSVENIQVIgZWlnaHQKRU5DT0RJTkcgNTYKU1dJRFRIIDEwMDAgMApEV0lEVEggNSAwCkJCWCA0IDUgMCAwCkJJVE1BUAo2MAo5MAo2MAo5MAo2MApFTkRDSEFSClNUQVJUQ0hBUiBuaW5lCkVOQ09ESU5HIDU3ClNXSURUSCAxMDAwIDAKRFdJRFRIIDUgMApCQlggNCA1IDAgMApCSVRNQVAKNjAKOTAKNzAKMTAKNjAKRU5EQ0hBUgpTVEFSVENIQVIgY29sb24KRU5DT0RJTkcgNTgKU1dJRFRIIDEwMDAgMApEV0lEVEggNSAwCkJCWCAxIDMgMSAxCkJJVE1BUAo4MAowMAo4MApFTkRDSEFSClNUQVJUQ0hBUiBzZW1pY29sb24KRU5DT0RJTkcgNTkKU1dJRFRIIDEwMDAgMApEV0lEVEggNSAwCkJCWCAxIDQgMSAwCkJJVE1BUAo4MAowMAo4MAo4MApFTkRDSEFSClNUQVJUQ0hBUiBsZXNzCkVOQ09ESU5HIDYwClNXSURUSCA5OTkgMApEV0lEVEggNSAwCkJCWCAzIDUgMCAwCkJJVE1BUAoyMAo0MAo4MAo0MAoyMApFTkRDSEFSClNUQVJUQ0hBUiBlcXVhbApFTkNPRElORyA2MQpTV0lEVEggOTk5IDAKRFdJRFRIIDUgMApCQlggMyAzIDAgMQpCSVRNQVAKRTAKMDAKRTAKRU5EQ0hBUgpTVEFSVENIQVIgZ3JlYXRlcgpFTkNPRElORyA2MgpTV0lEVEggOTk5IDAKRFdJRFRIIDUgMApCQlggMyA1IDAgMApCSVRNQVAKODAKNDAKMjAKNDAKODAKRU5EQ0hBUgpTVEFSVENIQVIgcXVlc3Rpb24KRU5DT0RJTkcgNjMKU1dJRFRIIDk5OSAwCkRXSURUSCA1IDAKQkJYIDMgNSAwIDAKQklUTUFQCkMwCjIwCjQwCjAwCjQwCkVORENIQVIKU1RBUlRDSEFSIGF0CkVOQ09ESU5HIDY0ClNXSURUSCAxMDAwIDAKRFdJRFRIIDUgMApCQlggNCA1IDAgMApCSVRNQVAKNjAKOTAKQjAKODAKNzAKRU5EQ0hBUgpTVEFSVENIQVIgQQpFTkNPRElORyA2NQpTV0lEVEggMTAwMCAwCkRXSURUSCA1IDAKQkJYIDQgNSAwIDAKQklUTUFQCjYwCjkwCkYwCjkwCjkwCkVORENIQVIKU1RBUlRDSEFSIEIKRU5DT0RJTkcgNjYKU1dJRFRIIDEwMDAgMApEV0lEVEggNSAwCkJCWCA0IDUgMCAwCkJJVE1BUApFMAo5MApFMAo5MApFMApFTkRDSEFSClNUQVJUQ0hBUiBDCkVOQ09ESU5HIDY3ClNXSURUSCAxMDAwIDAKRFdJRFRIIDUgMApCQlggNCA1IDAgMApCSVRNQVAKNjAKOTAKODAKOTAKNjAKRU5EQ0hBUgpTVEFSVENIQVIgRApFTkNPRElORyA2OApTV0lEVEggMTAwMCAwCkRXSURUSCA1IDAKQkJYIDQgNSAwIDAKQklUTUFQCkUwCjkwCjkwCjkwCkUwCkVORENIQVIKU1RBUlRDSEFSIEUKRU5DT0RJTkcgNjkKU1dJRFRIIDEwMDAgMApEV0lEVEggNSAwCkJCWCA0IDUgMCAwCkJJVE1BUApGMAo4MApFMAo4MApGMApFTkRDSEFSClNUQVJUQ0hBUiBGCkVOQ09ESU5HIDcwClNXSURUSCAxMDAwIDAKRFdJRFRIIDUgMApCQlggNCA1IDAgMApCSVRNQVAKRjAKODAKRTAKODAKODAKRU5EQ0hBUgpTVEFSVENIQVIgRwpFTkNPRElORyA3MQpTV0lEVEggMTAwMCAwCkRXSURUSCA1IDAKQkJYIDQgNSAwIDAKQklUTUFQCjYwCjgwCkIwCjkwCjYwCkVORENIQVIKU1RBUlRDSEFSIEgKRU5DT0RJTkcgNzIKU1dJRFRIIDEwMDAgMApEV0lEVEggNSAwCkJCWCA0IDUgMCAwCkJJVE1BUAo5MAo5MApGMAo5MAo5MApFTkRDSEFSClNUQVJUQ0hBUiBJCkVOQ09ESU5HIDczClNXSURUSCAxMDAwIDAKRFdJRFRIIDUgMApCQlggMSA1IDEgMApCSVRNQVAKODAKODAKODAKODAKODAKRU5EQ0hBUgpTVEFSVENIQVIgSgpFTkNPRElORyA3NApTV0lEVEggMTAwMCAwCkRXSURUSCA1IDAKQkJYIDQgNSAwIDAKQklUTUFQCjEwCjEwCjEwCjkwCjYwCkVORENIQVIKU1RBUlRDSEFSIEsKRU5DT0RJTkcgNzUKU1dJRFRIIDEwMDAgMApEV0lEVEggNSAwCkJCWCA0IDUgMCAwCkJJVE1BUAo5MApBMApDMApBMAo5MApFTkRDSEFSClNUQVJUQ0hBUiBMCkVOQ09ESU5HIDc2ClNXSURUSCAxMDAwIDAKRFdJRFRIIDUgMApCQlggNCA1IDAgMApCSVRNQVAKODAKODAKODAKODAKRjAKRU5EQ0hBUgpTVEFSVENIQVIgTQpFTkNPRElORyA3NwpTV0lEVEggMTAwMCAwCkRXSURUSCA1IDAKQkJYIDQgNSAwIDAKQklUTUFQCjkwCkYwCjkwCjkwCjkwCkVORENIQVIKU1RBUlRDSEFSIE4KRU5DT0RJTkcgNzgKU1dJRFRIIDEwMDAgMApEV0lEVEggNSAwCkJCWCA0IDUgMCAwCkJJVE1BUAo5MApEMApCMAo5MAo5MApFTkRDSEFSClNUQVJUQ0hBUiBPCkVOQ09ESU5HIDc5ClNXSURUSCAxMDAwIDAKRFdJRFRIIDUgMApCQlggNCA1IDAgMApCSVRNQVAKNjAKOTAKOTAKOTAKNjAKRU5EQ0hBUgpTVEFSVENIQVIgUApFTkNPRElORyA4MApTV0lEVEggMTAwMCAwCkRXSURUSCA1IDAKQkJYIDQgNSAwIDAKQklUTUFQCkUwCjkwCkUwCjgwCjgwCkVORENIQVIKU1RBUlRDSEFSIFEKRU5DT0RJTkcgODEKU1dJRFRIIDEwMDAgMApEV0lEVEggNSAwCkJCWCA0IDUgMCAwCkJJVE1BUAo2MAo5MAo5MApCMAo3MApFTkRDSEFSClNUQVJUQ0hBUiBSCkVOQ09ESU5HIDgyClNXSURUSCAxMDAwIDAKRFdJRFRIIDUgMApCQlggNCA1IDAgMApCSVRNQVAKRTAKOTAKRTAKQTAKOTAKRU5EQ0hBUgpTVEFSVENIQVIgUwpFTkNPRElORyA4MwpTV0lEVEggMTAwMCAwCkRXSURUSCA1IDAKQkJYIDQgNSAwIDAKQklUTUFQCjcwCjgwCjYwCjEwCkUwCkVORENIQVIKU1RBUlRDSEFSIFQKRU5DT0RJTkcgODQKU1dJRFRIIDk5OSAwCkRXSURUSCA1IDAKQkJYIDMgNSAwIDAKQklUTUFQCkUwCjQwCjQwCjQwCjQwCkVORENIQVIKU1RBUlRDSEFSIFUKRU5DT0RJTkcgODUKU1dJRFRIIDEwMDAgMApEV0lEVEggNSAwCkJCWCA0IDUgMCAwCkJJVE1BUAo5MAo5MAo5MAo5MAo2MApFTkRDSEFSClNUQVJUQ0hBUiBWCkVOQ09ESU5HIDg2ClNXSURUSCA5OTkgMApEV0lEVEggNSAwCkJCWCAzIDUgMCAwCkJJVE1BUApBMApBMApBMApBMAo0MApFTkRDSEFSClNUQVJUQ0hBUiBXCkVOQ09ESU5HIDg3ClNXSURUSCAxMDAwIDAKRFdJRFRIIDUgMApCQlggNCA1IDAgMApCSVRNQVAKOTAKOTAKOTAKRjAKOTAKRU5EQ0hBUgpTVEFSVENIQVIgWApFTkNPRElORyA4OApTV0lEVEggMTAwMCAwCkRXSURUSCA1IDAKQkJYIDQgNSAwIDAKQklUTUFQCjkwCjkwCjYwCjkwCjkwCkVORENIQVIKU1RBUlRDSEFSIFkKRU5DT0RJTkcgODkKU1dJRFRIIDk5OSAwCkRXSURUSCA1IDAKQkJYIDMgNSAwIDAKQklUTUFQCkEwCkEwCjQwCjQwCjQwCkVORENIQVIKU1RBUlRDSEFSIFoKRU5DT0RJTkcgOTAKU1dJRFRIIDEwMDAgMApEV0lEVEggNSAwCkJCWCA0IDUgMCAwCkJJVE1BUApGMAoxMAo2MAo4MApGMApFTkRDSEFSClNUQVJUQ0hBUiBicmFja2V0bGVmdApFTkNPRElORyA5MQpTV0lEVEggOTk5IDAKRFdJRFRIIDUgMApCQlggMiA1IDEgMApCSVRNQVAKQzAKODAKODAKODAKQzAKRU5EQ0hBUgpTVEFSVENIQVIgYmFja3NsYXNoCkVOQ09ESU5HIDkyClNXSURUSCA5OTkgMApEV0lEVEggNSAwCkJCWCAzIDUgMCAwCkJJVE1BUAo4MAo4MAo0MAoyMAoyMApFTkRDSEFSClNUQVJUQ0hBUiBicmFja2V0cmlnaHQKRU5DT0RJTkcgOTMKU1dJRFRIIDk5OSAwCkRXSURUSCA1IDAKQkJYIDIgNSAxIDAKQklUTUFQCkMwCjQwCjQwCjQwCkMwCkVORENIQVIKU1RBUlRDSEFSIGFzY2lpY2lyY3VtCkVOQ09ESU5HIDk0ClNXSURUSCA5OTkgMApEV0lEVEggNSAwCkJCWCAzIDIgMCAzCkJJVE1BUAo0MApBMApFTkRDSEFSClNUQVJUQ0hBUiB1bmRlcnNjb3JlCkVOQ09ESU5HIDk1ClNXSURUSCAxMDAwIDAKRFdJRFRIIDUgMApCQlggNCAxIDAgMApCSVRNQVAKRjAKRU5EQ0hBUgpTVEFSVENIQVIgZ3JhdmUKRU5DT0RJTkcgOTYKU1dJRFRIIDk5OSAwCkRXSURUSCA1IDAKQkJYIDIgMiAxIDMKQklUTUFQCjgwCjQwCkVORENIQVIKU1RBUlRDSEFSIGEKRU5DT0RJTkcgOTcKU1dJRFRIIDEwMDAgMApEV0lEVEggNSAwCkJCWCA0IDUgMCAwCkJJVE1BUAo2MAo5MApGMAo5MAo5MApFTkRDSEFSClNUQVJUQ0hBUiBiCkVOQ09ESU5HIDk4ClNXSURUSCAxMDAwIDAKRFdJRFRIIDUgMApCQlggNCA1IDAgMApCSVRNQVAKRTAKOTAKRTAKOTAKRTAKRU5EQ0hBUgpTVEFSVENIQVIgYwpFTkNPRElORyA5OQpTV0lEVEggMTAwMCAwCkRXSURUSCA1IDAKQkJYIDQgNSAwIDAKQklUTUFQCjYwCjkwCjgwCjkwCjYwCkVORENIQVIKU1RBUlRDSEFSIGQKRU5DT0RJTkcgMTAwClNXSURUSCAxMDAwIDAKRFdJRFRIIDUgMApCQlggNCA1IDAgMApCSVRNQVAKRTAKOTAKOTAKOTAKRTAKRU5EQ0hBUgpTVEFSVENIQVIgZQpFTkNPRElORyAxMDEKU1dJRFRIIDEwMDAgMApEV0lEVEggNSAwCkJCWCA0IDUgMCAwCkJJVE1BUApGMAo4MApFMAo4MApGMApFTkRDSEFSClNUQVJUQ0hBUiBmCkVOQ09ESU5HIDEwMgpTV0lEVEggMTAwMCAwCkRXSURUSCA1IDAKQkJYIDQgNSAwIDAKQklUTUFQCkYwCjgwCkUwCjgwCjgwCkVORENIQVIKU1RBUlRDSEFSIGcKRU5DT0RJTkcgMTAzClNXSURUSCAxMDAwIDAKRFdJRFRIIDUgMApCQlggNCA1IDAgMApCSVRNQVAKNjAKODAKQjAKOTAKNjAKRU5EQ0hBUgpTVEFSVENIQVIgaApFTkNPRElORyAxMDQKU1dJRFRIIDEwMDAgMApEV0lEVEggNSAwCkJCWCA0IDUgMCAwCkJJVE1BUAo5MAo5MApGMAo5MAo5MApFTkRDSEFSClNUQVJUQ0hBUiBpCkVOQ09ESU5HIDEwNQpTV0lEVEggMTAwMCAwCkRXSURUSCA1IDAKQkJYIDEgNSAxIDAKQklUTUFQCjgwCjgwCjgwCjgwCjgwCkVORENIQVIKU1RBUlRDSEFSIGoKRU5DT0RJTkcgMTA2ClNXSURUSCAxMDAwIDAKRFdJRFRIIDUgMApCQlggNCA1IDAgMApCSVRNQVAKMTAKMTAKMTAKOTAKNjAKRU5EQ0hBUgpTVEFSVENIQVIgawpFTkNPRElORyAxMDcKU1dJRFRIIDEwMDAgMApEV0lEVEggNSAwCkJCWCA0IDUgMCAwCkJJVE1BUAo5MApBMApDMApBMAo5MApFTkRDSEFSClNUQVJUQ0hBUiBsCkVOQ09ESU5HIDEwOApTV0lEVEggMTAwMCAwCkRXSURUSCA1IDAKQkJYIDQgNSAwIDAKQklUTUFQCjgwCjgwCjgwCjgwCkYwCkVORENIQVIKU1RBUlRDSEFSIG0KRU5DT0RJTkcgMTA5ClNXSURUSCAxMDAwIDAKRFdJRFRIIDUgMApCQlggNCA1IDAgMApCSVRNQVAKOTAKRjAKOTAKOTAKOTAKRU5EQ0hBUgpTVEFSVENIQVIgbgpFTkNPRElORyAxMTAKU1dJRFRIIDEwMDAgMApEV0lEVEggNSAwCkJCWCA0IDUgMCAwCkJJVE1BUAo5MApEMApCMAo5MAo5MApFTkRDSEFSClNUQVJUQ0hBUiBvCkVOQ09ESU5HIDExMQpTV0lEVEggMTAwMCAwCkRXSURUSCA1IDAKQkJYIDQgNSAwIDAKQklUTUFQCjYwCjkwCjkwCjkwCjYwCkVORENIQVIKU1RBUlRDSEFSIHAKRU5DT0RJTkcgMTEyClNXSURUSCAxMDAwIDAKRFdJRFRIIDUgMApCQlggNCA1IDAgMApCSVRNQVAKRTAKOTAKRTAKODAKODAKRU5EQ0hBUgpTVEFSVENIQVIgcQpFTkNPRElORyAxMTMKU1dJRFRIIDEwMDAgMApEV0lEVEggNSAwCkJCWCA0IDUgMCAwCkJJVE1BUAo2MAo5MAo5MApCMAo3MApFTkRDSEFSClNUQVJUQ0hBUiByCkVOQ09ESU5HIDExNApTV0lEVEggMTAwMCAwCkRXSURUSCA1IDAKQkJYIDQgNSAwIDAKQklUTUFQCkUwCjkwCkUwCkEwCjkwCkVORENIQVIKU1RBUlRDSEFSIHMKRU5DT0RJTkcgMTE1ClNXSURUSCAxMDAwIDAKRFdJRFRIIDUgMApCQlggNCA1IDAgMApCSVRNQVAKNzAKODAKNjAKMTAKRTAKRU5EQ0hBUgpTVEFSVENIQVIgdApFTkNPRElORyAxMTYKU1dJRFRIIDk5OSAwCkRXSURUSCA1IDAKQkJYIDMgNSAwIDAKQklUTUFQCkUwCjQwCjQwCjQwCjQwCkVORENIQVIKU1RBUlRDSEFSIHUKRU5DT0RJTkcgMTE3ClNXSURUSCAxMDAwIDAKRFdJRFRIIDUgMApCQlggNCA1IDAgMApCSVRNQVAKOTAKOTAKOTAKOTAKNjAKRU5EQ0hBUgpTVEFSVENIQVIgdgpFTkNPRElORyAxMTgKU1dJRFRIIDk5OSAwCkRXSURUSCA1IDAKQkJYIDMgNSAwIDAKQklUTUFQCkEwCkEwCkEwCkEwCjQwCkVORENIQVIKU1RBUlRDSEFSIHcKRU5DT0RJTkcgMTE5ClNXSURUSCAxMDAwIDAKRFdJRFRIIDUgMApCQlggNCA1IDAgMApCSVRNQVAKOTAKOTAKOTAKRjAKOTAKRU5EQ0hBUgpTVEFSVENIQVIgeApFTkNPRElORyAxMjAKU1dJRFRIIDEwMDAgMApEV0lEVEggNSAwCkJCWCA0IDUgMCAwCkJJVE1BUAo5MAo5MAo2MAo5MAo5MApFTkRDSEFSClNUQVJUQ0hBUiB5CkVOQ09ESU5HIDEyMQpTV0lEVEggOTk5IDAKRFdJRFRIIDUgMApCQlggMyA1IDAgMApCSVRNQVAKQTAKQTAKNDAKNDAKNDAKRU5EQ0hBUgpTVEFSVENIQVIgegpFTkNPRElORyAxMjIKU1dJRFRIIDEwMDAgMApEV0lEVEggNSAwCkJCWCA0IDUgMCAwCkJJVE1BUApGMAoxMAo2MAo4MApGMApFTkRDSEFSClNUQVJUQ0hBUiBicmFjZWxlZnQKRU5DT0RJTkcgMTIzClNXSURUSCA5OTkgMApEV0lEVEggNSAwCkJCWCAzIDUgMCAwCkJJVE1BUAo2MAo0MApDMAo0MAo2MApFTkRDSEFSClNUQVJUQ0hBUiBiYXIKRU5DT0RJTkcgMTI0ClNXSURUSCAxMDAwIDAKRFdJRFRIIDUgMApCQlggMSA1IDEgMApCSVRNQVAKODAKODAKMDAKODAKODAKRU5EQ0hBUgpTVEFSVENIQVIgYnJhY2VyaWdodApFTkNPRElORyAxMjUKU1dJRFRIIDk5OSAwCkRXSURUSCA1IDAKQkJYIDMgNSAwIDAKQklUTUFQCkMwCjQwCjYwCjQwCkMwCkVORENIQVIKU1RBUlRDSEFSIGFzY2lpdGlsZGUKRU5DT0RJTkcgMTI2ClNXSURUSCAxMDAwIDAKRFdJRFRIIDUgMApCQlggNCAyIDAgMgpCSVRNQVAKNTAKQTAKRU5EQ0hBUgpTVEFSVENIQVIgLm5vdGRlZgpFTkNPRElORyAtMQpTV0lEVEggMTAwMCAwCkRXSURUSCA1IDAKQkJYIDUgNSAwIDAKQklUTUFQCkY4CkQ4CkY4CkY4CkY4CkVORENIQVIKU1RBUlRDSEFSIGdseXBoMQpFTkNPRElORyAtMQpTV0lEVEggMTAwMCAwCkRXSURUSCA1IDAKQkJYIDEgMSAwIDAKQklUTUFQCjAwCkVORENIQVIKU1RBUlRDSEFSIGdseXBoMgpFTkNPRElORyAtMQpTV0lEVEggMzk5IDAKRFdJRFRIIDIgMApCQlggMSAxIDAgMApCSVRNQVAKMDAKRU5EQ0hBUgpFTkRGT05UCg==",
  "Dina_r400-6": "U1RBUlRGT05UIDIuMQ0KRk9OVCAtd2luZG93cy1EaW5hLW1lZGl1bS1yLW5vcm1hbC0tOC02MC05Ni05Ni1jLTYwLW1pY3Jvc29mdC1jcDEyNTINClNJWkUgNiA5NiA5Ng0KRk9OVEJPVU5ESU5HQk9YIDYgMTAgMCAtMg0KU1RBUlRQUk9QRVJUSUVTIDE2DQpGQUNFX05BTUUgIkRpbmEiDQpGQU1JTFlfTkFNRSAiRGluYSINCldFSUdIVF9OQU1FICJNZWRpdW0iDQpTTEFOVCAiUiINClBJWEVMX1NJWkUgOA0KUE9JTlRfU0laRSA2MA0KUkVTT0xVVElPTl9YIDk2DQpSRVNPTFVUSU9OX1kgOTYNClNQQUNJTkcgIkMiDQpDSEFSU0VUX1JFR0lTVFJZICJJU084ODU5Ig0KQ0hBUlNFVF9FTkNPRElORyAiMSINCkZPTlRfQVNDRU5UIDgNCkZPTlRfREVTQ0VOVCAyDQpDQVBfSEVJR0hUIDYNCkRFRkFVTFRfQ0hBUiAzMQ0KQ09QWVJJR0hUICJDb3B5cmlnaHQgMjAxMyBKb2VyZ2VuIElic2VuLCBNSVQgbGljZW5zZSwgcmV2IDIxOCINCkVORFBST1BFUlRJRVMNCkNIQVJTIDI1Ng0KU1RBUlRDSEFSIDANCkVOQ09ESU5HIDANClNXSURUSCA3NTAgMA0KRFdJRFRIIDYgMA0KQkJYIDYgMTAgMCAtMg0KQklUTUFQDQowMA0KQTgNCjAwDQo4MA0KMDANCjAwDQo4MA0KMDANCjAwDQo4MA0KRU5EQ0hBUg0KU1RBUlRDSEFSIDENCkVOQ09ESU5HIDENClNXSURUSCA3NTAgMA0KRFdJRFRIIDYgMA0KQkJYIDYgMTAgMCAtMg0KQklUTUFQDQowMA0KMDANCjAwDQozOA0KMjgNCjI4DQozOA0KMDANCjAwDQowMA0KRU5EQ0hBUg0KU1RBUlRDSEFSIDINCkVOQ09ESU5HIDINClNXSURUSCA3NTAgMA0KRFdJRFRIIDYgMA0KQkJYIDYgMTAgMCAtMg0KQklUTUFQDQowMA0KMDANCjAwDQozOA0KMjgNCjI4DQozOA0KMDANCjAwDQowMA0KRU5EQ0hBUg0KU1RBUlRDSEFSIDMNCkVOQ09ESU5HIDMNClNXSURUSCA3NTAgMA0KRFdJRFRIIDYgMA0KQkJYIDYgMTAgMCAtMg0KQklUTUFQDQowMA0KMDANCjAwDQozOA0KMjgNCjI4DQozOA0KMDANCjAwDQowMA0KRU5EQ0hBUg0KU1RBUlRDSEFSIDQNCkVOQ09ESU5HIDQNClNXSURUSCA3NTAgMA0KRFdJRFRIIDYgMA0KQkJYIDYgMTAgMCAtMg0KQklUTUFQDQowMA0KMDANCjAwDQozOA0KMjgNCjI4DQozOA0KMDANCjAwDQowMA0KRU5EQ0hBUg0KU1RBUlRDSEFSIDUNCkVOQ09ESU5HIDUNClNXSURUSCA3NTAgMA0KRFdJRFRIIDYgMA0KQkJYIDYgMTAgMCAtMg0KQklUTUFQDQowMA0KMDANCjAwDQozOA0KMjgNCjI4DQozOA0KMDANCjAwDQowMA0KRU5EQ0hBUg0KU1RBUlRDSEFSIDYNCkVOQ09ESU5HIDYNClNXSURUSCA3NTAgMA0KRFdJRFRIIDYgMA0KQkJYIDYgMTAgMCAtMg0KQklUTUFQDQowMA0KMDANCjAwDQozOA0KMjgNCjI4DQozOA0KMDANCjAwDQowMA0KRU5EQ0hBUg0KU1RBUlRDSEFSIDcNCkVOQ09ESU5HIDcNClNXSURUSCA3NTAgMA0KRFdJRFRIIDYgMA0KQkJYIDYgMTAgMCAtMg0KQklUTUFQDQowMA0KMDANCjAwDQozOA0KMjgNCjI4DQozOA0KMDANCjAwDQowMA0KRU5EQ0hBUg0KU1RBUlRDSEFSIDgNCkVOQ09ESU5HIDgNClNXSURUSCA3NTAgMA0KRFdJRFRIIDYgMA0KQkJYIDYgMTAgMCAtMg0KQklUTUFQDQowMA0KMDANCjAwDQozOA0KMjgNCjI4DQozOA0KMDANCjAwDQowMA0KRU5EQ0hBUg0KU1RBUlRDSEFSIDkNCkVOQ09ESU5HIDkNClNXSURUSCA3NTAgMA0KRFdJRFRIIDYgMA0KQkJYIDYgMTAgMCAtMg0KQklUTUFQDQowMA0KMDANCjAwDQowMA0KMDANCjAwDQowMA0KMDANCjAwDQowMA0KRU5EQ0hBUg0KU1RBUlRDSEFSIDEwDQpFTkNPRElORyAxMA0KU1dJRFRIIDc1MCAwDQpEV0lEVEggNiAwDQpCQlggNiAxMCAwIC0yDQpCSVRNQVANCjAwDQowMA0KMDANCjM4DQoyOA0KMjgNCjM4DQowMA0KMDANCjAwDQpFTkRDSEFSDQpTVEFSVENIQVIgMTENCkVOQ09ESU5HIDExDQpTV0lEVEggNzUwIDANCkRXSURUSCA2IDANCkJCWCA2IDEwIDAgLTINCkJJVE1BUA0KMDANCjAwDQowMA0KMzgNCjI4DQoyOA0KMzgNCjAwDQowMA0KMDANCkVORENIQVINClNUQVJUQ0hBUiAxMg0KRU5DT0RJTkcgMTINClNXSURUSCA3NTAgMA0KRFdJRFRIIDYgMA0KQkJYIDYgMTAgMCAtMg0KQklUTUFQDQowMA0KMDANCjAwDQozOA0KMjgNCjI4DQozOA0KMDANCjAwDQowMA0KRU5EQ0hBUg0KU1RBUlRDSEFSIDEzDQpFTkNPRElORyAxMw0KU1dJRFRIIDc1MCAwDQpEV0lEVEggNiAwDQpCQlggNiAxMCAwIC0yDQpCSVRNQVANCjAwDQowMA0KMDANCjM4DQoyOA0KMjgNCjM4DQowMA0KMDANCjAwDQpFTkRDSEFSDQpTVEFSVENIQVIgMTQNCkVOQ09ESU5HIDE0DQpTV0lEVEggNzUwIDANCkRXSURUSCA2IDANCkJCWCA2IDEwIDAgLTINCkJJVE1BUA0KMjANCjIwDQoyMA0KMjANCkUwDQowMA0KMDANCjAwDQowMA0KMDANCkVORENIQVINClNUQVJUQ0hBUiAxNQ0KRU5DT0RJTkcgMTUNClNXSURUSCA3NTAgMA0KRFdJRFRIIDYgMA0KQkJYIDYgMTAgMCAtMg0KQklUTUFQDQoyMA0KMjANCjIwDQoyMA0KM0MNCjAwDQowMA0KMDANCjAwDQowMA0KRU5EQ0hBUg0KU1RBUlRDSEFSIDE2DQpFTkNPRElORyAxNg0KU1dJRFRIIDc1MCAwDQpEV0lEVEggNiAwDQpCQlggNiAxMCAwIC0yDQpCSVRNQVANCjAwDQowMA0KMDANCjAwDQozQw0KMjANCjIwDQoyMA0KMjANCjIwDQpFTkRDSEFSDQpTVEFSVENIQVIgMTcNCkVOQ09ESU5HIDE3DQpTV0lEVEggNzUwIDANCkRXSURUSCA2IDANCkJCWCA2IDEwIDAgLTINCkJJVE1BUA0KMDANCjAwDQowMA0KMDANCkUwDQoyMA0KMjANCjIwDQoyMA0KMjANCkVORENIQVINClNUQVJUQ0hBUiAxOA0KRU5DT0RJTkcgMTgNClNXSURUSCA3NTAgMA0KRFdJRFRIIDYgMA0KQkJYIDYgMTAgMCAtMg0KQklUTUFQDQowMA0KMDANCjAwDQowMA0KRkMNCjAwDQowMA0KMDANCjAwDQowMA0KRU5EQ0hBUg0KU1RBUlRDSEFSIDE5DQpFTkNPRElORyAxOQ0KU1dJRFRIIDc1MCAwDQpEV0lEVEggNiAwDQpCQlggNiAxMCAwIC0yDQpCSVRNQVANCjIwDQoyMA0KMjANCjIwDQoyMA0KMjANCjIwDQoyMA0KMjANCjIwDQpFTkRDSEFSDQpTVEFSVENIQVIgMjANCkVOQ09ESU5HIDIwDQpTV0lEVEggNzUwIDANCkRXSURUSCA2IDANCkJCWCA2IDEwIDAgLTINCkJJVE1BUA0KMjANCjIwDQoyMA0KMjANCkUwDQoyMA0KMjANCjIwDQoyMA0KMjANCkVORENIQVINClNUQVJUQ0hBUiAyMQ0KRU5DT0RJTkcgMjENClNXSURUSCA3NTAgMA0KRFdJRFRIIDYgMA0KQkJYIDYgMTAgMCAtMg0KQklUTUFQDQoyMA0KMjANCjIwDQoyMA0KRkMNCjAwDQowMA0KMDANCjAwDQowMA0KRU5EQ0hBUg0KU1RBUlRDSEFSIDIyDQpFTkNPRElORyAyMg0KU1dJRFRIIDc1MCAwDQpEV0lEVEggNiAwDQpCQlggNiAxMCAwIC0yDQpCSVRNQVANCjIwDQoyMA0KMjANCjIwDQozQw0KMjANCjIwDQoyMA0KMjANCjIwDQpFTkRDSEFSDQpTVEFSVENIQVIgMjMNCkVOQ09ESU5HIDIzDQpTV0lEVEggNzUwIDANCkRXSURUSCA2IDANCkJCWCA2IDEwIDAgLTINCkJJVE1BUA0KMDANCjAwDQowMA0KMDANCkZDDQoyMA0KMjANCjIwDQoyMA0KMjANCkVORENIQVINClNUQVJUQ0hBUiAyNA0KRU5DT0RJTkcgMjQNClNXSURUSCA3NTAgMA0KRFdJRFRIIDYgMA0KQkJYIDYgMTAgMCAtMg0KQklUTUFQDQoyMA0KMjANCjIwDQoyMA0KRkMNCjIwDQoyMA0KMjANCjIwDQoyMA0KRU5EQ0hBUg0KU1RBUlRDSEFSIDI1DQpFTkNPRElORyAyNQ0KU1dJRFRIIDc1MCAwDQpEV0lEVEggNiAwDQpCQlggNiAxMCAwIC0yDQpCSVRNQVANCkE4DQo1NA0KQTgNCjU0DQpBOA0KNTQNCkE4DQo1NA0KQTgNCjU0DQpFTkRDSEFSDQpTVEFSVENIQVIgMjYNCkVOQ09ESU5HIDI2DQpTV0lEVEggNzUwIDANCkRXSURUSCA2IDANCkJCWCA2IDEwIDAgLTINCkJJVE1BUA0KMDANCjAwDQowMA0KMTANCkY4DQoyMA0KRjgNCjQwDQowMA0KMDANCkVORENIQVINClNUQVJUQ0hBUiAyNw0KRU5DT0RJTkcgMjcNClNXSURUSCA3NTAgMA0KRFdJRFRIIDYgMA0KQkJYIDYgMTAgMCAtMg0KQklUTUFQDQowMA0KMDANCjE4DQoyMA0KQzANCjIwDQo5OA0KNjANCjE4DQowMA0KRU5EQ0hBUg0KU1RBUlRDSEFSIDI4DQpFTkNPRElORyAyOA0KU1dJRFRIIDc1MCAwDQpEV0lEVEggNiAwDQpCQlggNiAxMCAwIC0yDQpCSVRNQVANCjAwDQowMA0KMDANCkY4DQo1MA0KNTANCjUwDQo1MA0KMDANCjAwDQpFTkRDSEFSDQpTVEFSVENIQVIgMjkNCkVOQ09ESU5HIDI5DQpTV0lEVEggNzUwIDANCkRXSURUSCA2IDANCkJCWCA2IDEwIDAgLTINCkJJVE1BUA0KMDANCjAwDQpDMA0KMjANCjE4DQoyMA0KQzgNCjMwDQpDMA0KMDANCkVORENIQVINClNUQVJUQ0hBUiAzMA0KRU5DT0RJTkcgMzANClNXSURUSCA3NTAgMA0KRFdJRFRIIDYgMA0KQkJYIDYgMTAgMCAtMg0KQklUTUFQDQowMA0KMzANCjQwDQo0MA0KRTANCjQwDQo0MA0KQjgNCjAwDQowMA0KRU5EQ0hBUg0KU1RBUlRDSEFSIDMxDQpFTkNPRElORyAzMQ0KU1dJRFRIIDc1MCAwDQpEV0lEVEggNiAwDQpCQlggNiAxMCAwIC0yDQpCSVRNQVANCjAwDQowMA0KMDANCjAwDQowMA0KNzANCjAwDQowMA0KMDANCjAwDQpFTkRDSEFSDQpTVEFSVENIQVIgMzINCkVOQ09ESU5HIDMyDQpTV0lEVEggNzUwIDANCkRXSURUSCA2IDANCkJCWCA2IDEwIDAgLTINCkJJVE1BUA0KMDANCjAwDQowMA0KMDANCjAwDQowMA0KMDANCjAwDQowMA0KMDANCkVORENIQVINClNUQVJUQ0hBUiAzMw0KRU5DT0RJTkcgMzMNClNXSURUSCA3NTAgMA0KRFdJRFRIIDYgMA0KQkJYIDYgMTAgMCAtMg0KQklUTUFQDQowMA0KMDANCjIwDQoyMA0KMjANCjIwDQowMA0KMjANCjAwDQowMA0KRU5EQ0hBUg0KU1RBUlRDSEFSIDM0DQpFTkNPRElORyAzNA0KU1dJRFRIIDc1MCAwDQpEV0lEVEggNiAwDQpCQlggNiAxMCAwIC0yDQpCSVRNQVANCjAwDQo1MA0KNTANCjUwDQowMA0KMDANCjAwDQowMA0KMDANCjAwDQpFTkRDSEFSDQpTVEFSVENIQVIgMzUNCkVOQ09ESU5HIDM1DQpTV0lEVEggNzUwIDANCkRXSURUSCA2IDANCkJCWCA2IDEwIDAgLTINCkJJVE1BUA0KMDANCjAwDQo1MA0KRjgNCjUwDQo1MA0KRjgNCjUwDQowMA0KMDANCkVORENIQVINClNUQVJUQ0hBUiAzNg0KRU5DT0RJTkcgMzYNClNXSURUSCA3NTAgMA0KRFdJRFRIIDYgMA0KQkJYIDYgMTAgMCAtMg0KQklUTUFQDQowMA0KMjANCjcwDQpBMA0KNzANCjI4DQpBOA0KNzANCjIwDQowMA0KRU5EQ0hBUg0KU1RBUlRDSEFSIDM3DQpFTkNPRElORyAzNw0KU1dJRFRIIDc1MCAwDQpEV0lEVEggNiAwDQpCQlggNiAxMCAwIC0yDQpCSVRNQVANCjAwDQpDOA0KRDANCjEwDQoyMA0KNDANCjU4DQo5OA0KMDANCjAwDQpFTkRDSEFSDQpTVEFSVENIQVIgMzgNCkVOQ09ESU5HIDM4DQpTV0lEVEggNzUwIDANCkRXSURUSCA2IDANCkJCWCA2IDEwIDAgLTINCkJJVE1BUA0KMDANCjIwDQo1MA0KMjANCjY4DQo5MA0KOTANCjY4DQowMA0KMDANCkVORENIQVINClNUQVJUQ0hBUiAzOQ0KRU5DT0RJTkcgMzkNClNXSURUSCA3NTAgMA0KRFdJRFRIIDYgMA0KQkJYIDYgMTAgMCAtMg0KQklUTUFQDQowMA0KMjANCjIwDQoyMA0KMDANCjAwDQowMA0KMDANCjAwDQowMA0KRU5EQ0hBUg0KU1RBUlRDSEFSIDQwDQpFTkNPRElORyA0MA0KU1dJRFRIIDc1MCAwDQpEV0lEVEggNiAwDQpCQlggNiAxMCAwIC0yDQpCSVRNQVANCjAwDQoxMA0KMjANCjQwDQo0MA0KNDANCjQwDQo0MA0KMjANCjEwDQpFTkRDSEFSDQpTVEFSVENIQVIgNDENCkVOQ09ESU5HIDQxDQpTV0lEVEggNzUwIDANCkRXSURUSCA2IDANCkJCWCA2IDEwIDAgLTINCkJJVE1BUA0KMDANCjQwDQoyMA0KMTANCjEwDQoxMA0KMTANCjEwDQoyMA0KNDANCkVORENIQVINClNUQVJUQ0hBUiA0Mg0KRU5DT0RJTkcgNDINClNXSURUSCA3NTAgMA0KRFdJRFRIIDYgMA0KQkJYIDYgMTAgMCAtMg0KQklUTUFQDQowMA0KMDANCjAwDQo1MA0KMjANCkY4DQoyMA0KNTANCjAwDQowMA0KRU5EQ0hBUg0KU1RBUlRDSEFSIDQzDQpFTkNPRElORyA0Mw0KU1dJRFRIIDc1MCAwDQpEV0lEVEggNiAwDQpCQlggNiAxMCAwIC0yDQpCSVRNQVANCjAwDQowMA0KMDANCjIwDQoyMA0KRjgNCjIwDQoyMA0KMDANCjAwDQpFTkRDSEFSDQpTVEFSVENIQVIgNDQNCkVOQ09ESU5HIDQ0DQpTV0lEVEggNzUwIDANCkRXSURUSCA2IDANCkJCWCA2IDEwIDAgLTINCkJJVE1BUA0KMDANCjAwDQowMA0KMDANCjAwDQowMA0KMjANCjIwDQo0MA0KMDANCkVORENIQVINClNUQVJUQ0hBUiA0NQ0KRU5DT0RJTkcgNDUNClNXSURUSCA3NTAgMA0KRFdJRFRIIDYgMA0KQkJYIDYgMTAgMCAtMg0KQklUTUFQDQowMA0KMDANCjAwDQowMA0KMDANCkY4DQowMA0KMDANCjAwDQowMA0KRU5EQ0hBUg0KU1RBUlRDSEFSIDQ2DQpFTkNPRElORyA0Ng0KU1dJRFRIIDc1MCAwDQpEV0lEVEggNiAwDQpCQlggNiAxMCAwIC0yDQpCSVRNQVANCjAwDQowMA0KMDANCjAwDQowMA0KMDANCjIwDQoyMA0KMDANCjAwDQpFTkRDSEFSDQpTVEFSVENIQVIgNDcNCkVOQ09ESU5HIDQ3DQpTV0lEVEggNzUwIDANCkRXSURUSCA2IDANCkJCWCA2IDEwIDAgLTINCkJJVE1BUA0KMDANCjA4DQoxMA0KMTANCjIwDQoyMA0KNDANCjQwDQo4MA0KMDANCkVORENIQVINClNUQVJUQ0hBUiA0OA0KRU5DT0RJTkcgNDgNClNXSURUSCA3NTAgMA0KRFdJRFRIIDYgMA0KQkJYIDYgMTAgMCAtMg0KQklUTUFQDQowMA0KMDANCjcwDQo5OA0KQTgNCkE4DQpDOA0KNzANCjAwDQowMA0KRU5EQ0hBUg0KU1RBUlRDSEFSIDQ5DQpFTkNPRElORyA0OQ0KU1dJRFRIIDc1MCAwDQpEV0lEVEggNiAwDQpCQlggNiAxMCAwIC0yDQpCSVRNQVANCjAwDQowMA0KMjANCjYwDQpBMA0KMjANCjIwDQpGOA0KMDANCjAwDQpFTkRDSEFSDQpTVEFSVENIQVIgNTANCkVOQ09ESU5HIDUwDQpTV0lEVEggNzUwIDANCkRXSURUSCA2IDANCkJCWCA2IDEwIDAgLTINCkJJVE1BUA0KMDANCjAwDQo3MA0KODgNCjA4DQo3MA0KODANCkY4DQowMA0KMDANCkVORENIQVINClNUQVJUQ0hBUiA1MQ0KRU5DT0RJTkcgNTENClNXSURUSCA3NTAgMA0KRFdJRFRIIDYgMA0KQkJYIDYgMTAgMCAtMg0KQklUTUFQDQowMA0KMDANCjcwDQo4OA0KMzANCjA4DQo4OA0KNzANCjAwDQowMA0KRU5EQ0hBUg0KU1RBUlRDSEFSIDUyDQpFTkNPRElORyA1Mg0KU1dJRFRIIDc1MCAwDQpEV0lEVEggNiAwDQpCQlggNiAxMCAwIC0yDQpCSVRNQVANCjAwDQowMA0KMTANCjMwDQo1MA0KOTANCkY4DQoxMA0KMDANCjAwDQpFTkRDSEFSDQpTVEFSVENIQVIgNTMNCkVOQ09ESU5HIDUzDQpTV0lEVEggNzUwIDANCkRXSURUSCA2IDANCkJCWCA2IDEwIDAgLTINCkJJVE1BUA0KMDANCjAwDQpGOA0KODANCkYwDQowOA0KODgNCjcwDQowMA0KMDANCkVORENIQVINClNUQVJUQ0hBUiA1NA0KRU5DT0RJTkcgNTQNClNXSURUSCA3NTAgMA0KRFdJRFRIIDYgMA0KQkJYIDYgMTAgMCAtMg0KQklUTUFQDQowMA0KMDANCjMwDQo0MA0KRjANCjg4DQo4OA0KNzANCjAwDQowMA0KRU5EQ0hBUg0KU1RBUlRDSEFSIDU1DQpFTkNPRElORyA1NQ0KU1dJRFRIIDc1MCAwDQpEV0lEVEggNiAwDQpCQlggNiAxMCAwIC0yDQpCSVRNQVANCjAwDQowMA0KRjgNCjA4DQoxMA0KMjANCjQwDQo0MA0KMDANCjAwDQpFTkRDSEFSDQpTVEFSVENIQVIgNTYNCkVOQ09ESU5HIDU2DQpTV0lEVEggNzUwIDANCkRXSURUSCA2IDANCkJCWCA2IDEwIDAgLTINCkJJVE1BUA0KMDANCjAwDQo3MA0KODgNCjcwDQo4OA0KODgNCjcwDQowMA0KMDANCkVORENIQVINClNUQVJUQ0hBUiA1Nw0KRU5DT0RJTkcgNTcNClNXSURUSCA3NTAgMA0KRFdJRFRIIDYgMA0KQkJYIDYgMTAgMCAtMg0KQklUTUFQDQowMA0KMDANCjcwDQo4OA0KODgNCjc4DQowOA0KNzANCjAwDQowMA0KRU5EQ0hBUg0KU1RBUlRDSEFSIDU4DQpFTkNPRElORyA1OA0KU1dJRFRIIDc1MCAwDQpEV0lEVEggNiAwDQpCQlggNiAxMCAwIC0yDQpCSVRNQVANCjAwDQowMA0KMDANCjIwDQoyMA0KMDANCjIwDQoyMA0KMDANCjAwDQpFTkRDSEFSDQpTVEFSVENIQVIgNTkNCkVOQ09ESU5HIDU5DQpTV0lEVEggNzUwIDANCkRXSURUSCA2IDANCkJCWCA2IDEwIDAgLTINCkJJVE1BUA0KMDANCjAwDQowMA0KMjANCjIwDQowMA0KMjANCjIwDQo0MA0KMDANCkVORENIQVINClNUQVJUQ0hBUiA2MA0KRU5DT0RJTkcgNjANClNXSURUSCA3NTAgMA0KRFdJRFRIIDYgMA0KQkJYIDYgMTAgMCAtMg0KQklUTUFQDQowMA0KMDANCjEwDQoyMA0KNDANCjgwDQo0MA0KMjANCjEwDQowMA0KRU5EQ0hBUg0KU1RBUlRDSEFSIDYxDQpFTkNPRElORyA2MQ0KU1dJRFRIIDc1MCAwDQpEV0lEVEggNiAwDQpCQlggNiAxMCAwIC0yDQpCSVRNQVANCjAwDQowMA0KMDANCjAwDQpGOA0KMDANCkY4DQowMA0KMDANCjAwDQpFTkRDSEFSDQpTVEFSVENIQVIgNjINCkVOQ09ESU5HIDYyDQpTV0lEVEggNzUwIDANCkRXSURUSCA2IDANCkJCWCA2IDEwIDAgLTINCkJJVE1BUA0KMDANCjAwDQo0MA0KMjANCjEwDQowOA0KMTANCjIwDQo0MA0KMDANCkVORENIQVINClNUQVJUQ0hBUiA2Mw0KRU5DT0RJTkcgNjMNClNXSURUSCA3NTAgMA0KRFdJRFRIIDYgMA0KQkJYIDYgMTAgMCAtMg0KQklUTUFQDQowMA0KMDANCjcwDQo4OA0KMTANCjIwDQowMA0KMjANCjAwDQowMA0KRU5EQ0hBUg0KU1RBUlRDSEFSIDY0DQpFTkNPRElORyA2NA0KU1dJRFRIIDc1MCAwDQpEV0lEVEggNiAwDQpCQlggNiAxMCAwIC0yDQpCSVRNQVANCjAwDQo3MA0KODgNCjk4DQpBOA0KQTgNCjk4DQo4MA0KNzgNCjAwDQpFTkRDSEFSDQpTVEFSVENIQVIgNjUNCkVOQ09ESU5HIDY1DQpTV0lEVEggNzUwIDANCkRXSURUSCA2IDANCkJCWCA2IDEwIDAgLTINCkJJVE1BUA0KMDANCjAwDQoyMA0KMjANCjUwDQo3MA0KODgNCjg4DQowMA0KMDANCkVORENIQVINClNUQVJUQ0hBUiA2Ng0KRU5DT0RJTkcgNjYNClNXSURUSCA3NTAgMA0KRFdJRFRIIDYgMA0KQkJYIDYgMTAgMCAtMg0KQklUTUFQDQowMA0KMDANCkYwDQo4OA0KRjANCjg4DQo4OA0KRjANCjAwDQowMA0KRU5EQ0hBUg0KU1RBUlRDSEFSIDY3DQpFTkNPRElORyA2Nw0KU1dJRFRIIDc1MCAwDQpEV0lEVEggNiAwDQpCQlggNiAxMCAwIC0yDQpCSVRNQVANCjAwDQowMA0KNzANCjg4DQo4MA0KODANCjg4DQo3MA0KMDANCjAwDQpFTkRDSEFSDQpTVEFSVENIQVIgNjgNCkVOQ09ESU5HIDY4DQpTV0lEVEggNzUwIDANCkRXSURUSCA2IDANCkJCWCA2IDEwIDAgLTINCkJJVE1BUA0KMDANCjAwDQpFMA0KOTANCjg4DQo4OA0KOTANCkUwDQowMA0KMDANCkVORENIQVINClNUQVJUQ0hBUiA2OQ0KRU5DT0RJTkcgNjkNClNXSURUSCA3NTAgMA0KRFdJRFRIIDYgMA0KQkJYIDYgMTAgMCAtMg0KQklUTUFQDQowMA0KMDANCkY4DQo4MA0KRTANCjgwDQo4MA0KRjgNCjAwDQowMA0KRU5EQ0hBUg0KU1RBUlRDSEFSIDcwDQpFTkNPRElORyA3MA0KU1dJRFRIIDc1MCAwDQpEV0lEVEggNiAwDQpCQlggNiAxMCAwIC0yDQpCSVRNQVANCjAwDQowMA0KRjgNCjgwDQpFMA0KODANCjgwDQo4MA0KMDANCjAwDQpFTkRDSEFSDQpTVEFSVENIQVIgNzENCkVOQ09ESU5HIDcxDQpTV0lEVEggNzUwIDANCkRXSURUSCA2IDANCkJCWCA2IDEwIDAgLTINCkJJVE1BUA0KMDANCjAwDQo3MA0KODANCjgwDQpCOA0KODgNCjcwDQowMA0KMDANCkVORENIQVINClNUQVJUQ0hBUiA3Mg0KRU5DT0RJTkcgNzINClNXSURUSCA3NTAgMA0KRFdJRFRIIDYgMA0KQkJYIDYgMTAgMCAtMg0KQklUTUFQDQowMA0KMDANCjg4DQo4OA0KRjgNCjg4DQo4OA0KODgNCjAwDQowMA0KRU5EQ0hBUg0KU1RBUlRDSEFSIDczDQpFTkNPRElORyA3Mw0KU1dJRFRIIDc1MCAwDQpEV0lEVEggNiAwDQpCQlggNiAxMCAwIC0yDQpCSVRNQVANCjAwDQowMA0KNzANCjIwDQoyMA0KMjANCjIwDQo3MA0KMDANCjAwDQpFTkRDSEFSDQpTVEFSVENIQVIgNzQNCkVOQ09ESU5HIDc0DQpTV0lEVEggNzUwIDANCkRXSURUSCA2IDANCkJCWCA2IDEwIDAgLTINCkJJVE1BUA0KMDANCjAwDQozOA0KMDgNCjA4DQo4OA0KODgNCjcwDQowMA0KMDANCkVORENIQVINClNUQVJUQ0hBUiA3NQ0KRU5DT0RJTkcgNzUNClNXSURUSCA3NTAgMA0KRFdJRFRIIDYgMA0KQkJYIDYgMTAgMCAtMg0KQklUTUFQDQowMA0KMDANCjg4DQo5MA0KQTANCkUwDQo5MA0KODgNCjAwDQowMA0KRU5EQ0hBUg0KU1RBUlRDSEFSIDc2DQpFTkNPRElORyA3Ng0KU1dJRFRIIDc1MCAwDQpEV0lEVEggNiAwDQpCQlggNiAxMCAwIC0yDQpCSVRNQVANCjAwDQowMA0KODANCjgwDQo4MA0KODANCjgwDQpGOA0KMDANCjAwDQpFTkRDSEFSDQpTVEFSVENIQVIgNzcNCkVOQ09ESU5HIDc3DQpTV0lEVEggNzUwIDANCkRXSURUSCA2IDANCkJCWCA2IDEwIDAgLTINCkJJVE1BUA0KMDANCjAwDQo4OA0KRDgNCkE4DQo4OA0KODgNCjg4DQowMA0KMDANCkVORENIQVINClNUQVJUQ0hBUiA3OA0KRU5DT0RJTkcgNzgNClNXSURUSCA3NTAgMA0KRFdJRFRIIDYgMA0KQkJYIDYgMTAgMCAtMg0KQklUTUFQDQowMA0KMDANCjg4DQpDOA0KQTgNCjk4DQo4OA0KODgNCjAwDQowMA0KRU5EQ0hBUg0KU1RBUlRDSEFSIDc5DQpFTkNPRElORyA3OQ0KU1dJRFRIIDc1MCAwDQpEV0lEVEggNiAwDQpCQlggNiAxMCAwIC0yDQpCSVRNQVANCjAwDQowMA0KNzANCjg4DQo4OA0KODgNCjg4DQo3MA0KMDANCjAwDQpFTkRDSEFSDQpTVEFSVENIQVIgODANCkVOQ09ESU5HIDgwDQpTV0lEVEggNzUwIDANCkRXSURUSCA2IDANCkJCWCA2IDEwIDAgLTINCkJJVE1BUA0KMDANCjAwDQpGMA0KODgNCjg4DQpGMA0KODANCjgwDQowMA0KMDANCkVORENIQVINClNUQVJUQ0hBUiA4MQ0KRU5DT0RJTkcgODENClNXSURUSCA3NTAgMA0KRFdJRFRIIDYgMA0KQkJYIDYgMTAgMCAtMg0KQklUTUFQDQowMA0KMDANCjcwDQo4OA0KODgNCjg4DQo5MA0KNjgNCjA4DQowMA0KRU5EQ0hBUg0KU1RBUlRDSEFSIDgyDQpFTkNPRElORyA4Mg0KU1dJRFRIIDc1MCAwDQpEV0lEVEggNiAwDQpCQlggNiAxMCAwIC0yDQpCSVRNQVANCjAwDQowMA0KRjANCjg4DQpGMA0KODgNCjg4DQo4OA0KMDANCjAwDQpFTkRDSEFSDQpTVEFSVENIQVIgODMNCkVOQ09ESU5HIDgzDQpTV0lEVEggNzUwIDANCkRXSURUSCA2IDANCkJCWCA2IDEwIDAgLTINCkJJVE1BUA0KMDANCjAwDQo3MA0KODANCjcwDQowOA0KODgNCjcwDQowMA0KMDANCkVORENIQVINClNUQVJUQ0hBUiA4NA0KRU5DT0RJTkcgODQNClNXSURUSCA3NTAgMA0KRFdJRFRIIDYgMA0KQkJYIDYgMTAgMCAtMg0KQklUTUFQDQowMA0KMDANCkY4DQoyMA0KMjANCjIwDQoyMA0KMjANCjAwDQowMA0KRU5EQ0hBUg0KU1RBUlRDSEFSIDg1DQpFTkNPRElORyA4NQ0KU1dJRFRIIDc1MCAwDQpEV0lEVEggNiAwDQpCQlggNiAxMCAwIC0yDQpCSVRNQVANCjAwDQowMA0KODgNCjg4DQo4OA0KODgNCjg4DQo3MA0KMDANCjAwDQpFTkRDSEFSDQpTVEFSVENIQVIgODYNCkVOQ09ESU5HIDg2DQpTV0lEVEggNzUwIDANCkRXSURUSCA2IDANCkJCWCA2IDEwIDAgLTINCkJJVE1BUA0KMDANCjAwDQo4OA0KODgNCjg4DQo1MA0KNTANCjIwDQowMA0KMDANCkVORENIQVINClNUQVJUQ0hBUiA4Nw0KRU5DT0RJTkcgODcNClNXSURUSCA3NTAgMA0KRFdJRFRIIDYgMA0KQkJYIDYgMTAgMCAtMg0KQklUTUFQDQowMA0KMDANCjg4DQo4OA0KQTgNCkE4DQo3MA0KNTANCjAwDQowMA0KRU5EQ0hBUg0KU1RBUlRDSEFSIDg4DQpFTkNPRElORyA4OA0KU1dJRFRIIDc1MCAwDQpEV0lEVEggNiAwDQpCQlggNiAxMCAwIC0yDQpCSVRNQVANCjAwDQowMA0KODgNCjUwDQoyMA0KNTANCjg4DQo4OA0KMDANCjAwDQpFTkRDSEFSDQpTVEFSVENIQVIgODkNCkVOQ09ESU5HIDg5DQpTV0lEVEggNzUwIDANCkRXSURUSCA2IDANCkJCWCA2IDEwIDAgLTINCkJJVE1BUA0KMDANCjAwDQo4OA0KODgNCjUwDQoyMA0KMjANCjIwDQowMA0KMDANCkVORENIQVINClNUQVJUQ0hBUiA5MA0KRU5DT0RJTkcgOTANClNXSURUSCA3NTAgMA0KRFdJRFRIIDYgMA0KQkJYIDYgMTAgMCAtMg0KQklUTUFQDQowMA0KMDANCkY4DQoxMA0KMjANCjQwDQo4MA0KRjgNCjAwDQowMA0KRU5EQ0hBUg0KU1RBUlRDSEFSIDkxDQpFTkNPRElORyA5MQ0KU1dJRFRIIDc1MCAwDQpEV0lEVEggNiAwDQpCQlggNiAxMCAwIC0yDQpCSVRNQVANCjAwDQo3MA0KNDANCjQwDQo0MA0KNDANCjQwDQo0MA0KNDANCjcwDQpFTkRDSEFSDQpTVEFSVENIQVIgOTINCkVOQ09ESU5HIDkyDQpTV0lEVEggNzUwIDANCkRXSURUSCA2IDANCkJCWCA2IDEwIDAgLTINCkJJVE1BUA0KMDANCjgwDQo0MA0KNDANCjIwDQoyMA0KMTANCjEwDQowOA0KMDANCkVORENIQVINClNUQVJUQ0hBUiA5Mw0KRU5DT0RJTkcgOTMNClNXSURUSCA3NTAgMA0KRFdJRFRIIDYgMA0KQkJYIDYgMTAgMCAtMg0KQklUTUFQDQowMA0KNzANCjEwDQoxMA0KMTANCjEwDQoxMA0KMTANCjEwDQo3MA0KRU5EQ0hBUg0KU1RBUlRDSEFSIDk0DQpFTkNPRElORyA5NA0KU1dJRFRIIDc1MCAwDQpEV0lEVEggNiAwDQpCQlggNiAxMCAwIC0yDQpCSVRNQVANCjAwDQoyMA0KMjANCjUwDQo1MA0KODgNCjAwDQowMA0KMDANCjAwDQpFTkRDSEFSDQpTVEFSVENIQVIgOTUNCkVOQ09ESU5HIDk1DQpTV0lEVEggNzUwIDANCkRXSURUSCA2IDANCkJCWCA2IDEwIDAgLTINCkJJVE1BUA0KMDANCjAwDQowMA0KMDANCjAwDQowMA0KMDANCjAwDQpGQw0KMDANCkVORENIQVINClNUQVJUQ0hBUiA5Ng0KRU5DT0RJTkcgOTYNClNXSURUSCA3NTAgMA0KRFdJRFRIIDYgMA0KQkJYIDYgMTAgMCAtMg0KQklUTUFQDQowMA0KNDANCjIwDQowMA0KMDANCjAwDQowMA0KMDANCjAwDQowMA0KRU5EQ0hBUg0KU1RBUlRDSEFSIDk3DQpFTkNPRElORyA5Nw0KU1dJRFRIIDc1MCAwDQpEV0lEVEggNiAwDQpCQlggNiAxMCAwIC0yDQpCSVRNQVANCjAwDQowMA0KMDANCjcwDQowOA0KNzgNCjg4DQo3OA0KMDANCjAwDQpFTkRDSEFSDQpTVEFSVENIQVIgOTgNCkVOQ09ESU5HIDk4DQpTV0lEVEggNzUwIDANCkRXSURUSCA2IDANCkJCWCA2IDEwIDAgLTINCkJJVE1BUA0KMDANCjgwDQo4MA0KRjANCjg4DQo4OA0KODgNCkYwDQowMA0KMDANCkVORENIQVINClNUQVJUQ0hBUiA5OQ0KRU5DT0RJTkcgOTkNClNXSURUSCA3NTAgMA0KRFdJRFRIIDYgMA0KQkJYIDYgMTAgMCAtMg0KQklUTUFQDQowMA0KMDANCjAwDQo3MA0KODANCjgwDQo4MA0KNzgNCjAwDQowMA0KRU5EQ0hBUg0KU1RBUlRDSEFSIDEwMA0KRU5DT0RJTkcgMTAwDQpTV0lEVEggNzUwIDANCkRXSURUSCA2IDANCkJCWCA2IDEwIDAgLTINCkJJVE1BUA0KMDANCjA4DQowOA0KNzgNCjg4DQo4OA0KODgNCjc4DQowMA0KMDANCkVORENIQVINClNUQVJUQ0hBUiAxMDENCkVOQ09ESU5HIDEwMQ0KU1dJRFRIIDc1MCAwDQpEV0lEVEggNiAwDQpCQlggNiAxMCAwIC0yDQpCSVRNQVANCjAwDQowMA0KMDANCjcwDQo4OA0KRjgNCjgwDQo3OA0KMDANCjAwDQpFTkRDSEFSDQpTVEFSVENIQVIgMTAyDQpFTkNPRElORyAxMDINClNXSURUSCA3NTAgMA0KRFdJRFRIIDYgMA0KQkJYIDYgMTAgMCAtMg0KQklUTUFQDQowMA0KMzgNCjQwDQpGMA0KNDANCjQwDQo0MA0KNDANCjAwDQowMA0KRU5EQ0hBUg0KU1RBUlRDSEFSIDEwMw0KRU5DT0RJTkcgMTAzDQpTV0lEVEggNzUwIDANCkRXSURUSCA2IDANCkJCWCA2IDEwIDAgLTINCkJJVE1BUA0KMDANCjAwDQowMA0KNzgNCjg4DQo4OA0KODgNCjc4DQowOA0KNzANCkVORENIQVINClNUQVJUQ0hBUiAxMDQNCkVOQ09ESU5HIDEwNA0KU1dJRFRIIDc1MCAwDQpEV0lEVEggNiAwDQpCQlggNiAxMCAwIC0yDQpCSVRNQVANCjAwDQo4MA0KODANCkYwDQo4OA0KODgNCjg4DQo4OA0KMDANCjAwDQpFTkRDSEFSDQpTVEFSVENIQVIgMTA1DQpFTkNPRElORyAxMDUNClNXSURUSCA3NTAgMA0KRFdJRFRIIDYgMA0KQkJYIDYgMTAgMCAtMg0KQklUTUFQDQowMA0KMjANCjAwDQo2MA0KMjANCjIwDQoyMA0KNzANCjAwDQowMA0KRU5EQ0hBUg0KU1RBUlRDSEFSIDEwNg0KRU5DT0RJTkcgMTA2DQpTV0lEVEggNzUwIDANCkRXSURUSCA2IDANCkJCWCA2IDEwIDAgLTINCkJJVE1BUA0KMDANCjEwDQowMA0KNzANCjEwDQoxMA0KMTANCjEwDQoxMA0KRTANCkVORENIQVINClNUQVJUQ0hBUiAxMDcNCkVOQ09ESU5HIDEwNw0KU1dJRFRIIDc1MCAwDQpEV0lEVEggNiAwDQpCQlggNiAxMCAwIC0yDQpCSVRNQVANCjAwDQo4MA0KODANCjg4DQo5MA0KRTANCjkwDQo4OA0KMDANCjAwDQpFTkRDSEFSDQpTVEFSVENIQVIgMTA4DQpFTkNPRElORyAxMDgNClNXSURUSCA3NTAgMA0KRFdJRFRIIDYgMA0KQkJYIDYgMTAgMCAtMg0KQklUTUFQDQowMA0KNjANCjIwDQoyMA0KMjANCjIwDQoyMA0KMzANCjAwDQowMA0KRU5EQ0hBUg0KU1RBUlRDSEFSIDEwOQ0KRU5DT0RJTkcgMTA5DQpTV0lEVEggNzUwIDANCkRXSURUSCA2IDANCkJCWCA2IDEwIDAgLTINCkJJVE1BUA0KMDANCjAwDQowMA0KRDANCkE4DQpBOA0KQTgNCkE4DQowMA0KMDANCkVORENIQVINClNUQVJUQ0hBUiAxMTANCkVOQ09ESU5HIDExMA0KU1dJRFRIIDc1MCAwDQpEV0lEVEggNiAwDQpCQlggNiAxMCAwIC0yDQpCSVRNQVANCjAwDQowMA0KMDANCkYwDQo4OA0KODgNCjg4DQo4OA0KMDANCjAwDQpFTkRDSEFSDQpTVEFSVENIQVIgMTExDQpFTkNPRElORyAxMTENClNXSURUSCA3NTAgMA0KRFdJRFRIIDYgMA0KQkJYIDYgMTAgMCAtMg0KQklUTUFQDQowMA0KMDANCjAwDQo3MA0KODgNCjg4DQo4OA0KNzANCjAwDQowMA0KRU5EQ0hBUg0KU1RBUlRDSEFSIDExMg0KRU5DT0RJTkcgMTEyDQpTV0lEVEggNzUwIDANCkRXSURUSCA2IDANCkJCWCA2IDEwIDAgLTINCkJJVE1BUA0KMDANCjAwDQowMA0KRjANCjg4DQo4OA0KODgNCkYwDQo4MA0KODANCkVORENIQVINClNUQVJUQ0hBUiAxMTMNCkVOQ09ESU5HIDExMw0KU1dJRFRIIDc1MCAwDQpEV0lEVEggNiAwDQpCQlggNiAxMCAwIC0yDQpCSVRNQVANCjAwDQowMA0KMDANCjc4DQo4OA0KODgNCjg4DQo3OA0KMDgNCjA4DQpFTkRDSEFSDQpTVEFSVENIQVIgMTE0DQpFTkNPRElORyAxMTQNClNXSURUSCA3NTAgMA0KRFdJRFRIIDYgMA0KQkJYIDYgMTAgMCAtMg0KQklUTUFQDQowMA0KMDANCjAwDQpCMA0KQzgNCjgwDQo4MA0KODANCjAwDQowMA0KRU5EQ0hBUg0KU1RBUlRDSEFSIDExNQ0KRU5DT0RJTkcgMTE1DQpTV0lEVEggNzUwIDANCkRXSURUSCA2IDANCkJCWCA2IDEwIDAgLTINCkJJVE1BUA0KMDANCjAwDQowMA0KNzANCjgwDQo3MA0KMDgNCkYwDQowMA0KMDANCkVORENIQVINClNUQVJUQ0hBUiAxMTYNCkVOQ09ESU5HIDExNg0KU1dJRFRIIDc1MCAwDQpEV0lEVEggNiAwDQpCQlggNiAxMCAwIC0yDQpCSVRNQVANCjAwDQo0MA0KNDANCkYwDQo0MA0KNDANCjQwDQozOA0KMDANCjAwDQpFTkRDSEFSDQpTVEFSVENIQVIgMTE3DQpFTkNPRElORyAxMTcNClNXSURUSCA3NTAgMA0KRFdJRFRIIDYgMA0KQkJYIDYgMTAgMCAtMg0KQklUTUFQDQowMA0KMDANCjAwDQo4OA0KODgNCjg4DQo4OA0KNzgNCjAwDQowMA0KRU5EQ0hBUg0KU1RBUlRDSEFSIDExOA0KRU5DT0RJTkcgMTE4DQpTV0lEVEggNzUwIDANCkRXSURUSCA2IDANCkJCWCA2IDEwIDAgLTINCkJJVE1BUA0KMDANCjAwDQowMA0KODgNCjg4DQo1MA0KNTANCjIwDQowMA0KMDANCkVORENIQVINClNUQVJUQ0hBUiAxMTkNCkVOQ09ESU5HIDExOQ0KU1dJRFRIIDc1MCAwDQpEV0lEVEggNiAwDQpCQlggNiAxMCAwIC0yDQpCSVRNQVANCjAwDQowMA0KMDANCjg4DQo4OA0KQTgNCkE4DQo1MA0KMDANCjAwDQpFTkRDSEFSDQpTVEFSVENIQVIgMTIwDQpFTkNPRElORyAxMjANClNXSURUSCA3NTAgMA0KRFdJRFRIIDYgMA0KQkJYIDYgMTAgMCAtMg0KQklUTUFQDQowMA0KMDANCjAwDQo4OA0KNTANCjIwDQo1MA0KODgNCjAwDQowMA0KRU5EQ0hBUg0KU1RBUlRDSEFSIDEyMQ0KRU5DT0RJTkcgMTIxDQpTV0lEVEggNzUwIDANCkRXSURUSCA2IDANCkJCWCA2IDEwIDAgLTINCkJJVE1BUA0KMDANCjAwDQowMA0KODgNCjg4DQo4OA0KODgNCjc4DQowOA0KNzANCkVORENIQVINClNUQVJUQ0hBUiAxMjINCkVOQ09ESU5HIDEyMg0KU1dJRFRIIDc1MCAwDQpEV0lEVEggNiAwDQpCQlggNiAxMCAwIC0yDQpCSVRNQVANCjAwDQowMA0KMDANCkY4DQoxMA0KMjANCjQwDQpGOA0KMDANCjAwDQpFTkRDSEFSDQpTVEFSVENIQVIgMTIzDQpFTkNPRElORyAxMjMNClNXSURUSCA3NTAgMA0KRFdJRFRIIDYgMA0KQkJYIDYgMTAgMCAtMg0KQklUTUFQDQowMA0KMTgNCjIwDQoyMA0KMjANCkMwDQoyMA0KMjANCjIwDQoxOA0KRU5EQ0hBUg0KU1RBUlRDSEFSIDEyNA0KRU5DT0RJTkcgMTI0DQpTV0lEVEggNzUwIDANCkRXSURUSCA2IDANCkJCWCA2IDEwIDAgLTINCkJJVE1BUA0KMDANCjIwDQoyMA0KMjANCjIwDQoyMA0KMjANCjIwDQoyMA0KMjANCkVORENIQVINClNUQVJUQ0hBUiAxMjUNCkVOQ09ESU5HIDEyNQ0KU1dJRFRIIDc1MCAwDQpEV0lEVEggNiAwDQpCQlggNiAxMCAwIC0yDQpCSVRNQVANCjAwDQpDMA0KMjANCjIwDQoyMA0KMTgNCjIwDQoyMA0KMjANCkMwDQpFTkRDSEFSDQpTVEFSVENIQVIgMTI2DQpFTkNPRElORyAxMjYNClNXSURUSCA3NTAgMA0KRFdJRFRIIDYgMA0KQkJYIDYgMTAgMCAtMg0KQklUTUFQDQowMA0KMDANCjAwDQowMA0KNDgNCkE4DQo5MA0KMDANCjAwDQowMA0KRU5EQ0hBUg0KU1RBUlRDSEFSIDEyNw0KRU5DT0RJTkcgMTI3DQpTV0lEVEggNzUwIDANCkRXSURUSCA2IDANCkJCWCA2IDEwIDAgLTINCkJJVE1BUA0KMDANCjAwDQowMA0KMDANCjAwDQowMA0KMDANCjAwDQowMA0KMDANCkVORENIQVINClNUQVJUQ0hBUiAxMjgNCkVOQ09ESU5HIDEyOA0KU1dJRFRIIDc1MCAwDQpEV0lEVEggNiAwDQpCQlggNiAxMCAwIC0yDQpCSVRNQVANCjAwDQozMA0KNDgNCkUwDQo0MA0KRTANCjQ4DQozMA0KMDANCjAwDQpFTkRDSEFSDQpTVEFSVENIQVIgMTI5DQpFTkNPRElORyAxMjkNClNXSURUSCA3NTAgMA0KRFdJRFRIIDYgMA0KQkJYIDYgMTAgMCAtMg0KQklUTUFQDQowMA0KMDANCjAwDQowMA0KMDANCjAwDQowMA0KMDANCjAwDQowMA0KRU5EQ0hBUg0KU1RBUlRDSEFSIDEzMA0KRU5DT0RJTkcgMTMwDQpTV0lEVEggNzUwIDANCkRXSURUSCA2IDANCkJCWCA2IDEwIDAgLTINCkJJVE1BUA0KMDANCjAwDQowMA0KMDANCjAwDQowMA0KNjANCjIwDQo0MA0KMDANCkVORENIQVINClNUQVJUQ0hBUiAxMzENCkVOQ09ESU5HIDEzMQ0KU1dJRFRIIDc1MCAwDQpEV0lEVEggNiAwDQpCQlggNiAxMCAwIC0yDQpCSVRNQVANCjAwDQoxOA0KMjANCjIwDQo3MA0KMjANCjIwDQoyMA0KMjANCkMwDQpFTkRDSEFSDQpTVEFSVENIQVIgMTMyDQpFTkNPRElORyAxMzINClNXSURUSCA3NTAgMA0KRFdJRFRIIDYgMA0KQkJYIDYgMTAgMCAtMg0KQklUTUFQDQowMA0KMDANCjAwDQowMA0KMDANCjAwDQpEOA0KNDgNCjkwDQowMA0KRU5EQ0hBUg0KU1RBUlRDSEFSIDEzMw0KRU5DT0RJTkcgMTMzDQpTV0lEVEggNzUwIDANCkRXSURUSCA2IDANCkJCWCA2IDEwIDAgLTINCkJJVE1BUA0KMDANCjAwDQowMA0KMDANCjAwDQowMA0KMDANCkE4DQowMA0KMDANCkVORENIQVINClNUQVJUQ0hBUiAxMzQNCkVOQ09ESU5HIDEzNA0KU1dJRFRIIDc1MCAwDQpEV0lEVEggNiAwDQpCQlggNiAxMCAwIC0yDQpCSVRNQVANCjAwDQowMA0KMjANCjIwDQpGOA0KMjANCjIwDQoyMA0KMjANCjIwDQpFTkRDSEFSDQpTVEFSVENIQVIgMTM1DQpFTkNPRElORyAxMzUNClNXSURUSCA3NTAgMA0KRFdJRFRIIDYgMA0KQkJYIDYgMTAgMCAtMg0KQklUTUFQDQowMA0KMDANCjIwDQoyMA0KRjgNCjIwDQoyMA0KRjgNCjIwDQoyMA0KRU5EQ0hBUg0KU1RBUlRDSEFSIDEzNg0KRU5DT0RJTkcgMTM2DQpTV0lEVEggNzUwIDANCkRXSURUSCA2IDANCkJCWCA2IDEwIDAgLTINCkJJVE1BUA0KMDANCjAwDQoyMA0KNTANCjAwDQowMA0KMDANCjAwDQowMA0KMDANCkVORENIQVINClNUQVJUQ0hBUiAxMzcNCkVOQ09ESU5HIDEzNw0KU1dJRFRIIDc1MCAwDQpEV0lEVEggNiAwDQpCQlggNiAxMCAwIC0yDQpCSVRNQVANCjAwDQpDOA0KRDANCjIwDQo0MA0KODANCkQ4DQpEOA0KMDANCjAwDQpFTkRDSEFSDQpTVEFSVENIQVIgMTM4DQpFTkNPRElORyAxMzgNClNXSURUSCA3NTAgMA0KRFdJRFRIIDYgMA0KQkJYIDYgMTAgMCAtMg0KQklUTUFQDQo1MA0KMjANCjcwDQo4MA0KNzANCjA4DQo4OA0KNzANCjAwDQowMA0KRU5EQ0hBUg0KU1RBUlRDSEFSIDEzOQ0KRU5DT0RJTkcgMTM5DQpTV0lEVEggNzUwIDANCkRXSURUSCA2IDANCkJCWCA2IDEwIDAgLTINCkJJVE1BUA0KMDANCjAwDQowMA0KMTANCjIwDQo0MA0KMjANCjEwDQowMA0KMDANCkVORENIQVINClNUQVJUQ0hBUiAxNDANCkVOQ09ESU5HIDE0MA0KU1dJRFRIIDc1MCAwDQpEV0lEVEggNiAwDQpCQlggNiAxMCAwIC0yDQpCSVRNQVANCjAwDQowMA0KNzgNCkEwDQpCMA0KQTANCkEwDQo3OA0KMDANCjAwDQpFTkRDSEFSDQpTVEFSVENIQVIgMTQxDQpFTkNPRElORyAxNDENClNXSURUSCA3NTAgMA0KRFdJRFRIIDYgMA0KQkJYIDYgMTAgMCAtMg0KQklUTUFQDQowMA0KMDANCjAwDQowMA0KMDANCjAwDQowMA0KMDANCjAwDQowMA0KRU5EQ0hBUg0KU1RBUlRDSEFSIDE0Mg0KRU5DT0RJTkcgMTQyDQpTV0lEVEggNzUwIDANCkRXSURUSCA2IDANCkJCWCA2IDEwIDAgLTINCkJJVE1BUA0KNTANCjIwDQpGOA0KMTANCjIwDQo0MA0KODANCkY4DQowMA0KMDANCkVORENIQVINClNUQVJUQ0hBUiAxNDMNCkVOQ09ESU5HIDE0Mw0KU1dJRFRIIDc1MCAwDQpEV0lEVEggNiAwDQpCQlggNiAxMCAwIC0yDQpCSVRNQVANCjAwDQowMA0KMDANCjAwDQowMA0KMDANCjAwDQowMA0KMDANCjAwDQpFTkRDSEFSDQpTVEFSVENIQVIgMTQ0DQpFTkNPRElORyAxNDQNClNXSURUSCA3NTAgMA0KRFdJRFRIIDYgMA0KQkJYIDYgMTAgMCAtMg0KQklUTUFQDQowMA0KMDANCjAwDQowMA0KMDANCjAwDQowMA0KMDANCjAwDQowMA0KRU5EQ0hBUg0KU1RBUlRDSEFSIDE0NQ0KRU5DT0RJTkcgMTQ1DQpTV0lEVEggNzUwIDANCkRXSURUSCA2IDANCkJCWCA2IDEwIDAgLTINCkJJVE1BUA0KMDANCjEwDQoyMA0KMzANCjAwDQowMA0KMDANCjAwDQowMA0KMDANCkVORENIQVINClNUQVJUQ0hBUiAxNDYNCkVOQ09ESU5HIDE0Ng0KU1dJRFRIIDc1MCAwDQpEV0lEVEggNiAwDQpCQlggNiAxMCAwIC0yDQpCSVRNQVANCjAwDQo2MA0KMjANCjQwDQowMA0KMDANCjAwDQowMA0KMDANCjAwDQpFTkRDSEFSDQpTVEFSVENIQVIgMTQ3DQpFTkNPRElORyAxNDcNClNXSURUSCA3NTAgMA0KRFdJRFRIIDYgMA0KQkJYIDYgMTAgMCAtMg0KQklUTUFQDQowMA0KNDgNCjkwDQpEOA0KMDANCjAwDQowMA0KMDANCjAwDQowMA0KRU5EQ0hBUg0KU1RBUlRDSEFSIDE0OA0KRU5DT0RJTkcgMTQ4DQpTV0lEVEggNzUwIDANCkRXSURUSCA2IDANCkJCWCA2IDEwIDAgLTINCkJJVE1BUA0KMDANCkQ4DQo0OA0KOTANCjAwDQowMA0KMDANCjAwDQowMA0KMDANCkVORENIQVINClNUQVJUQ0hBUiAxNDkNCkVOQ09ESU5HIDE0OQ0KU1dJRFRIIDc1MCAwDQpEV0lEVEggNiAwDQpCQlggNiAxMCAwIC0yDQpCSVRNQVANCjAwDQowMA0KMDANCjIwDQo3MA0KRjgNCjcwDQoyMA0KMDANCjAwDQpFTkRDSEFSDQpTVEFSVENIQVIgMTUwDQpFTkNPRElORyAxNTANClNXSURUSCA3NTAgMA0KRFdJRFRIIDYgMA0KQkJYIDYgMTAgMCAtMg0KQklUTUFQDQowMA0KMDANCjAwDQowMA0KMDANCjcwDQowMA0KMDANCjAwDQowMA0KRU5EQ0hBUg0KU1RBUlRDSEFSIDE1MQ0KRU5DT0RJTkcgMTUxDQpTV0lEVEggNzUwIDANCkRXSURUSCA2IDANCkJCWCA2IDEwIDAgLTINCkJJVE1BUA0KMDANCjAwDQowMA0KMDANCjAwDQpGOA0KMDANCjAwDQowMA0KMDANCkVORENIQVINClNUQVJUQ0hBUiAxNTINCkVOQ09ESU5HIDE1Mg0KU1dJRFRIIDc1MCAwDQpEV0lEVEggNiAwDQpCQlggNiAxMCAwIC0yDQpCSVRNQVANCjAwDQowMA0KNjgNCkIwDQowMA0KMDANCjAwDQowMA0KMDANCjAwDQpFTkRDSEFSDQpTVEFSVENIQVIgMTUzDQpFTkNPRElORyAxNTMNClNXSURUSCA3NTAgMA0KRFdJRFRIIDYgMA0KQkJYIDYgMTAgMCAtMg0KQklUTUFQDQowMA0KMDANCkY4DQo1OA0KNTgNCjAwDQowMA0KMDANCjAwDQowMA0KRU5EQ0hBUg0KU1RBUlRDSEFSIDE1NA0KRU5DT0RJTkcgMTU0DQpTV0lEVEggNzUwIDANCkRXSURUSCA2IDANCkJCWCA2IDEwIDAgLTINCkJJVE1BUA0KNTANCjIwDQowMA0KNzANCjgwDQo3MA0KMDgNCkYwDQowMA0KMDANCkVORENIQVINClNUQVJUQ0hBUiAxNTUNCkVOQ09ESU5HIDE1NQ0KU1dJRFRIIDc1MCAwDQpEV0lEVEggNiAwDQpCQlggNiAxMCAwIC0yDQpCSVRNQVANCjAwDQowMA0KMDANCjQwDQoyMA0KMTANCjIwDQo0MA0KMDANCjAwDQpFTkRDSEFSDQpTVEFSVENIQVIgMTU2DQpFTkNPRElORyAxNTYNClNXSURUSCA3NTAgMA0KRFdJRFRIIDYgMA0KQkJYIDYgMTAgMCAtMg0KQklUTUFQDQowMA0KMDANCjAwDQo1MA0KQTgNCkIwDQpBMA0KNTgNCjAwDQowMA0KRU5EQ0hBUg0KU1RBUlRDSEFSIDE1Nw0KRU5DT0RJTkcgMTU3DQpTV0lEVEggNzUwIDANCkRXSURUSCA2IDANCkJCWCA2IDEwIDAgLTINCkJJVE1BUA0KMDANCjAwDQowMA0KMDANCjAwDQowMA0KMDANCjAwDQowMA0KMDANCkVORENIQVINClNUQVJUQ0hBUiAxNTgNCkVOQ09ESU5HIDE1OA0KU1dJRFRIIDc1MCAwDQpEV0lEVEggNiAwDQpCQlggNiAxMCAwIC0yDQpCSVRNQVANCjUwDQoyMA0KMDANCkY4DQoxMA0KMjANCjQwDQpGOA0KMDANCjAwDQpFTkRDSEFSDQpTVEFSVENIQVIgMTU5DQpFTkNPRElORyAxNTkNClNXSURUSCA3NTAgMA0KRFdJRFRIIDYgMA0KQkJYIDYgMTAgMCAtMg0KQklUTUFQDQo1MA0KMDANCjg4DQo4OA0KNTANCjIwDQoyMA0KMjANCjAwDQowMA0KRU5EQ0hBUg0KU1RBUlRDSEFSIDE2MA0KRU5DT0RJTkcgMTYwDQpTV0lEVEggNzUwIDANCkRXSURUSCA2IDANCkJCWCA2IDEwIDAgLTINCkJJVE1BUA0KMDANCjAwDQowMA0KMDANCjAwDQowMA0KMDANCjAwDQowMA0KMDANCkVORENIQVINClNUQVJUQ0hBUiAxNjENCkVOQ09ESU5HIDE2MQ0KU1dJRFRIIDc1MCAwDQpEV0lEVEggNiAwDQpCQlggNiAxMCAwIC0yDQpCSVRNQVANCjAwDQowMA0KMDANCjIwDQowMA0KMjANCjIwDQoyMA0KMjANCjAwDQpFTkRDSEFSDQpTVEFSVENIQVIgMTYyDQpFTkNPRElORyAxNjINClNXSURUSCA3NTAgMA0KRFdJRFRIIDYgMA0KQkJYIDYgMTAgMCAtMg0KQklUTUFQDQowMA0KMjANCjIwDQo3MA0KQTANCkEwDQpBMA0KNzgNCjIwDQoyMA0KRU5EQ0hBUg0KU1RBUlRDSEFSIDE2Mw0KRU5DT0RJTkcgMTYzDQpTV0lEVEggNzUwIDANCkRXSURUSCA2IDANCkJCWCA2IDEwIDAgLTINCkJJVE1BUA0KMDANCjAwDQozMA0KNDANCkUwDQo0MA0KNDANCkI4DQowMA0KMDANCkVORENIQVINClNUQVJUQ0hBUiAxNjQNCkVOQ09ESU5HIDE2NA0KU1dJRFRIIDc1MCAwDQpEV0lEVEggNiAwDQpCQlggNiAxMCAwIC0yDQpCSVRNQVANCjAwDQowMA0KMDANCjg4DQo3MA0KNTANCjcwDQo4OA0KMDANCjAwDQpFTkRDSEFSDQpTVEFSVENIQVIgMTY1DQpFTkNPRElORyAxNjUNClNXSURUSCA3NTAgMA0KRFdJRFRIIDYgMA0KQkJYIDYgMTAgMCAtMg0KQklUTUFQDQowMA0KODgNCjg4DQo1MA0KRjgNCjIwDQpGOA0KMjANCjAwDQowMA0KRU5EQ0hBUg0KU1RBUlRDSEFSIDE2Ng0KRU5DT0RJTkcgMTY2DQpTV0lEVEggNzUwIDANCkRXSURUSCA2IDANCkJCWCA2IDEwIDAgLTINCkJJVE1BUA0KMDANCjIwDQoyMA0KMjANCjAwDQowMA0KMDANCjIwDQoyMA0KMjANCkVORENIQVINClNUQVJUQ0hBUiAxNjcNCkVOQ09ESU5HIDE2Nw0KU1dJRFRIIDc1MCAwDQpEV0lEVEggNiAwDQpCQlggNiAxMCAwIC0yDQpCSVRNQVANCjAwDQo3MA0KODANCjYwDQo5MA0KNDgNCjMwDQowOA0KRjANCjAwDQpFTkRDSEFSDQpTVEFSVENIQVIgMTY4DQpFTkNPRElORyAxNjgNClNXSURUSCA3NTAgMA0KRFdJRFRIIDYgMA0KQkJYIDYgMTAgMCAtMg0KQklUTUFQDQowMA0KNTANCjAwDQowMA0KMDANCjAwDQowMA0KMDANCjAwDQowMA0KRU5EQ0hBUg0KU1RBUlRDSEFSIDE2OQ0KRU5DT0RJTkcgMTY5DQpTV0lEVEggNzUwIDANCkRXSURUSCA2IDANCkJCWCA2IDEwIDAgLTINCkJJVE1BUA0KMDANCjcwDQo4OA0KQTgNCkM4DQpDOA0KQTgNCjg4DQo3MA0KMDANCkVORENIQVINClNUQVJUQ0hBUiAxNzANCkVOQ09ESU5HIDE3MA0KU1dJRFRIIDc1MCAwDQpEV0lEVEggNiAwDQpCQlggNiAxMCAwIC0yDQpCSVRNQVANCjAwDQo2MA0KMTANCjcwDQo5MA0KNzANCjAwDQowMA0KMDANCjAwDQpFTkRDSEFSDQpTVEFSVENIQVIgMTcxDQpFTkNPRElORyAxNzENClNXSURUSCA3NTAgMA0KRFdJRFRIIDYgMA0KQkJYIDYgMTAgMCAtMg0KQklUTUFQDQowMA0KMDANCjAwDQoyOA0KNTANCkEwDQo1MA0KMjgNCjAwDQowMA0KRU5EQ0hBUg0KU1RBUlRDSEFSIDE3Mg0KRU5DT0RJTkcgMTcyDQpTV0lEVEggNzUwIDANCkRXSURUSCA2IDANCkJCWCA2IDEwIDAgLTINCkJJVE1BUA0KMDANCjAwDQowMA0KMDANCjAwDQpGMA0KMTANCjEwDQowMA0KMDANCkVORENIQVINClNUQVJUQ0hBUiAxNzMNCkVOQ09ESU5HIDE3Mw0KU1dJRFRIIDc1MCAwDQpEV0lEVEggNiAwDQpCQlggNiAxMCAwIC0yDQpCSVRNQVANCjAwDQowMA0KMDANCjAwDQowMA0KMDANCjAwDQowMA0KMDANCjAwDQpFTkRDSEFSDQpTVEFSVENIQVIgMTc0DQpFTkNPRElORyAxNzQNClNXSURUSCA3NTAgMA0KRFdJRFRIIDYgMA0KQkJYIDYgMTAgMCAtMg0KQklUTUFQDQowMA0KNzANCjg4DQpFOA0KRDgNCkU4DQpEOA0KODgNCjcwDQowMA0KRU5EQ0hBUg0KU1RBUlRDSEFSIDE3NQ0KRU5DT0RJTkcgMTc1DQpTV0lEVEggNzUwIDANCkRXSURUSCA2IDANCkJCWCA2IDEwIDAgLTINCkJJVE1BUA0KMDANCkZDDQowMA0KMDANCjAwDQowMA0KMDANCjAwDQowMA0KMDANCkVORENIQVINClNUQVJUQ0hBUiAxNzYNCkVOQ09ESU5HIDE3Ng0KU1dJRFRIIDc1MCAwDQpEV0lEVEggNiAwDQpCQlggNiAxMCAwIC0yDQpCSVRNQVANCjAwDQo2MA0KOTANCjkwDQo2MA0KMDANCjAwDQowMA0KMDANCjAwDQpFTkRDSEFSDQpTVEFSVENIQVIgMTc3DQpFTkNPRElORyAxNzcNClNXSURUSCA3NTAgMA0KRFdJRFRIIDYgMA0KQkJYIDYgMTAgMCAtMg0KQklUTUFQDQowMA0KMDANCjIwDQoyMA0KRjgNCjIwDQoyMA0KRjgNCjAwDQowMA0KRU5EQ0hBUg0KU1RBUlRDSEFSIDE3OA0KRU5DT0RJTkcgMTc4DQpTV0lEVEggNzUwIDANCkRXSURUSCA2IDANCkJCWCA2IDEwIDAgLTINCkJJVE1BUA0KMDANCjYwDQoxMA0KMjANCjQwDQo3MA0KMDANCjAwDQowMA0KMDANCkVORENIQVINClNUQVJUQ0hBUiAxNzkNCkVOQ09ESU5HIDE3OQ0KU1dJRFRIIDc1MCAwDQpEV0lEVEggNiAwDQpCQlggNiAxMCAwIC0yDQpCSVRNQVANCjAwDQo2MA0KMTANCjIwDQoxMA0KNjANCjAwDQowMA0KMDANCjAwDQpFTkRDSEFSDQpTVEFSVENIQVIgMTgwDQpFTkNPRElORyAxODANClNXSURUSCA3NTAgMA0KRFdJRFRIIDYgMA0KQkJYIDYgMTAgMCAtMg0KQklUTUFQDQowMA0KMTANCjIwDQowMA0KMDANCjAwDQowMA0KMDANCjAwDQowMA0KRU5EQ0hBUg0KU1RBUlRDSEFSIDE4MQ0KRU5DT0RJTkcgMTgxDQpTV0lEVEggNzUwIDANCkRXSURUSCA2IDANCkJCWCA2IDEwIDAgLTINCkJJVE1BUA0KMDANCjAwDQowMA0KNDgNCjQ4DQo0OA0KNjgNCjUwDQo0MA0KODANCkVORENIQVINClNUQVJUQ0hBUiAxODINCkVOQ09ESU5HIDE4Mg0KU1dJRFRIIDc1MCAwDQpEV0lEVEggNiAwDQpCQlggNiAxMCAwIC0yDQpCSVRNQVANCjAwDQowMA0KNzgNCkU4DQo2OA0KMjgNCjI4DQoyOA0KMjgNCjAwDQpFTkRDSEFSDQpTVEFSVENIQVIgMTgzDQpFTkNPRElORyAxODMNClNXSURUSCA3NTAgMA0KRFdJRFRIIDYgMA0KQkJYIDYgMTAgMCAtMg0KQklUTUFQDQowMA0KMDANCjAwDQowMA0KMzANCjMwDQowMA0KMDANCjAwDQowMA0KRU5EQ0hBUg0KU1RBUlRDSEFSIDE4NA0KRU5DT0RJTkcgMTg0DQpTV0lEVEggNzUwIDANCkRXSURUSCA2IDANCkJCWCA2IDEwIDAgLTINCkJJVE1BUA0KMDANCjAwDQowMA0KMDANCjAwDQowMA0KMDANCjIwDQoxMA0KNjANCkVORENIQVINClNUQVJUQ0hBUiAxODUNCkVOQ09ESU5HIDE4NQ0KU1dJRFRIIDc1MCAwDQpEV0lEVEggNiAwDQpCQlggNiAxMCAwIC0yDQpCSVRNQVANCjAwDQoyMA0KNjANCjIwDQoyMA0KNzANCjAwDQowMA0KMDANCjAwDQpFTkRDSEFSDQpTVEFSVENIQVIgMTg2DQpFTkNPRElORyAxODYNClNXSURUSCA3NTAgMA0KRFdJRFRIIDYgMA0KQkJYIDYgMTAgMCAtMg0KQklUTUFQDQowMA0KNjANCjkwDQo5MA0KOTANCjYwDQowMA0KMDANCjAwDQowMA0KRU5EQ0hBUg0KU1RBUlRDSEFSIDE4Nw0KRU5DT0RJTkcgMTg3DQpTV0lEVEggNzUwIDANCkRXSURUSCA2IDANCkJCWCA2IDEwIDAgLTINCkJJVE1BUA0KMDANCjAwDQowMA0KQTANCjUwDQoyOA0KNTANCkEwDQowMA0KMDANCkVORENIQVINClNUQVJUQ0hBUiAxODgNCkVOQ09ESU5HIDE4OA0KU1dJRFRIIDc1MCAwDQpEV0lEVEggNiAwDQpCQlggNiAxMCAwIC0yDQpCSVRNQVANCjAwDQowMA0KOTANCjkwDQpBMA0KMjANCjQ4DQo1OA0KQjgNCjg4DQpFTkRDSEFSDQpTVEFSVENIQVIgMTg5DQpFTkNPRElORyAxODkNClNXSURUSCA3NTAgMA0KRFdJRFRIIDYgMA0KQkJYIDYgMTAgMCAtMg0KQklUTUFQDQowMA0KMDANCjkwDQo5MA0KQTANCjIwDQo1OA0KNDgNCjkwDQo5OA0KRU5EQ0hBUg0KU1RBUlRDSEFSIDE5MA0KRU5DT0RJTkcgMTkwDQpTV0lEVEggNzUwIDANCkRXSURUSCA2IDANCkJCWCA2IDEwIDAgLTINCkJJVE1BUA0KMDANCjgwDQo1MA0KOTANCjYwDQpBMA0KNDgNCjU4DQpCOA0KODgNCkVORENIQVINClNUQVJUQ0hBUiAxOTENCkVOQ09ESU5HIDE5MQ0KU1dJRFRIIDc1MCAwDQpEV0lEVEggNiAwDQpCQlggNiAxMCAwIC0yDQpCSVRNQVANCjAwDQowMA0KMDANCjIwDQowMA0KMjANCjQwDQo4OA0KNzANCjAwDQpFTkRDSEFSDQpTVEFSVENIQVIgMTkyDQpFTkNPRElORyAxOTINClNXSURUSCA3NTAgMA0KRFdJRFRIIDYgMA0KQkJYIDYgMTAgMCAtMg0KQklUTUFQDQo0MA0KMjANCjIwDQoyMA0KNTANCjcwDQo4OA0KODgNCjAwDQowMA0KRU5EQ0hBUg0KU1RBUlRDSEFSIDE5Mw0KRU5DT0RJTkcgMTkzDQpTV0lEVEggNzUwIDANCkRXSURUSCA2IDANCkJCWCA2IDEwIDAgLTINCkJJVE1BUA0KMTANCjIwDQoyMA0KMjANCjUwDQo3MA0KODgNCjg4DQowMA0KMDANCkVORENIQVINClNUQVJUQ0hBUiAxOTQNCkVOQ09ESU5HIDE5NA0KU1dJRFRIIDc1MCAwDQpEV0lEVEggNiAwDQpCQlggNiAxMCAwIC0yDQpCSVRNQVANCjIwDQo1MA0KMDANCjIwDQo1MA0KNzANCjg4DQo4OA0KMDANCjAwDQpFTkRDSEFSDQpTVEFSVENIQVIgMTk1DQpFTkNPRElORyAxOTUNClNXSURUSCA3NTAgMA0KRFdJRFRIIDYgMA0KQkJYIDYgMTAgMCAtMg0KQklUTUFQDQo2OA0KQjANCjIwDQoyMA0KNTANCjcwDQo4OA0KODgNCjAwDQowMA0KRU5EQ0hBUg0KU1RBUlRDSEFSIDE5Ng0KRU5DT0RJTkcgMTk2DQpTV0lEVEggNzUwIDANCkRXSURUSCA2IDANCkJCWCA2IDEwIDAgLTINCkJJVE1BUA0KNTANCjAwDQoyMA0KMjANCjUwDQo3MA0KODgNCjg4DQowMA0KMDANCkVORENIQVINClNUQVJUQ0hBUiAxOTcNCkVOQ09ESU5HIDE5Nw0KU1dJRFRIIDc1MCAwDQpEV0lEVEggNiAwDQpCQlggNiAxMCAwIC0yDQpCSVRNQVANCjIwDQo1MA0KMjANCjIwDQo1MA0KNzANCjg4DQo4OA0KMDANCjAwDQpFTkRDSEFSDQpTVEFSVENIQVIgMTk4DQpFTkNPRElORyAxOTgNClNXSURUSCA3NTAgMA0KRFdJRFRIIDYgMA0KQkJYIDYgMTAgMCAtMg0KQklUTUFQDQowMA0KMDANCjM4DQo2MA0KQjANCkUwDQpBMA0KQjgNCjAwDQowMA0KRU5EQ0hBUg0KU1RBUlRDSEFSIDE5OQ0KRU5DT0RJTkcgMTk5DQpTV0lEVEggNzUwIDANCkRXSURUSCA2IDANCkJCWCA2IDEwIDAgLTINCkJJVE1BUA0KMDANCjAwDQo3MA0KODgNCjgwDQo4MA0KODgNCjcwDQoyMA0KNDANCkVORENIQVINClNUQVJUQ0hBUiAyMDANCkVOQ09ESU5HIDIwMA0KU1dJRFRIIDc1MCAwDQpEV0lEVEggNiAwDQpCQlggNiAxMCAwIC0yDQpCSVRNQVANCjQwDQoyMA0KRjgNCjgwDQpFMA0KODANCjgwDQpGOA0KMDANCjAwDQpFTkRDSEFSDQpTVEFSVENIQVIgMjAxDQpFTkNPRElORyAyMDENClNXSURUSCA3NTAgMA0KRFdJRFRIIDYgMA0KQkJYIDYgMTAgMCAtMg0KQklUTUFQDQoxMA0KMjANCkY4DQo4MA0KRTANCjgwDQo4MA0KRjgNCjAwDQowMA0KRU5EQ0hBUg0KU1RBUlRDSEFSIDIwMg0KRU5DT0RJTkcgMjAyDQpTV0lEVEggNzUwIDANCkRXSURUSCA2IDANCkJCWCA2IDEwIDAgLTINCkJJVE1BUA0KMjANCjUwDQpGOA0KODANCkUwDQo4MA0KODANCkY4DQowMA0KMDANCkVORENIQVINClNUQVJUQ0hBUiAyMDMNCkVOQ09ESU5HIDIwMw0KU1dJRFRIIDc1MCAwDQpEV0lEVEggNiAwDQpCQlggNiAxMCAwIC0yDQpCSVRNQVANCjUwDQowMA0KRjgNCjgwDQpFMA0KODANCjgwDQpGOA0KMDANCjAwDQpFTkRDSEFSDQpTVEFSVENIQVIgMjA0DQpFTkNPRElORyAyMDQNClNXSURUSCA3NTAgMA0KRFdJRFRIIDYgMA0KQkJYIDYgMTAgMCAtMg0KQklUTUFQDQo0MA0KMjANCjcwDQoyMA0KMjANCjIwDQoyMA0KNzANCjAwDQowMA0KRU5EQ0hBUg0KU1RBUlRDSEFSIDIwNQ0KRU5DT0RJTkcgMjA1DQpTV0lEVEggNzUwIDANCkRXSURUSCA2IDANCkJCWCA2IDEwIDAgLTINCkJJVE1BUA0KMTANCjIwDQo3MA0KMjANCjIwDQoyMA0KMjANCjcwDQowMA0KMDANCkVORENIQVINClNUQVJUQ0hBUiAyMDYNCkVOQ09ESU5HIDIwNg0KU1dJRFRIIDc1MCAwDQpEV0lEVEggNiAwDQpCQlggNiAxMCAwIC0yDQpCSVRNQVANCjIwDQo1MA0KNzANCjIwDQoyMA0KMjANCjIwDQo3MA0KMDANCjAwDQpFTkRDSEFSDQpTVEFSVENIQVIgMjA3DQpFTkNPRElORyAyMDcNClNXSURUSCA3NTAgMA0KRFdJRFRIIDYgMA0KQkJYIDYgMTAgMCAtMg0KQklUTUFQDQo1MA0KMDANCjcwDQoyMA0KMjANCjIwDQoyMA0KNzANCjAwDQowMA0KRU5EQ0hBUg0KU1RBUlRDSEFSIDIwOA0KRU5DT0RJTkcgMjA4DQpTV0lEVEggNzUwIDANCkRXSURUSCA2IDANCkJCWCA2IDEwIDAgLTINCkJJVE1BUA0KMDANCjAwDQo2MA0KNTANCkU4DQo0OA0KNTANCjYwDQowMA0KMDANCkVORENIQVINClNUQVJUQ0hBUiAyMDkNCkVOQ09ESU5HIDIwOQ0KU1dJRFRIIDc1MCAwDQpEV0lEVEggNiAwDQpCQlggNiAxMCAwIC0yDQpCSVRNQVANCjY4DQpCMA0KODgNCkM4DQpBOA0KOTgNCjg4DQo4OA0KMDANCjAwDQpFTkRDSEFSDQpTVEFSVENIQVIgMjEwDQpFTkNPRElORyAyMTANClNXSURUSCA3NTAgMA0KRFdJRFRIIDYgMA0KQkJYIDYgMTAgMCAtMg0KQklUTUFQDQo0MA0KMjANCjcwDQo4OA0KODgNCjg4DQo4OA0KNzANCjAwDQowMA0KRU5EQ0hBUg0KU1RBUlRDSEFSIDIxMQ0KRU5DT0RJTkcgMjExDQpTV0lEVEggNzUwIDANCkRXSURUSCA2IDANCkJCWCA2IDEwIDAgLTINCkJJVE1BUA0KMTANCjIwDQo3MA0KODgNCjg4DQo4OA0KODgNCjcwDQowMA0KMDANCkVORENIQVINClNUQVJUQ0hBUiAyMTINCkVOQ09ESU5HIDIxMg0KU1dJRFRIIDc1MCAwDQpEV0lEVEggNiAwDQpCQlggNiAxMCAwIC0yDQpCSVRNQVANCjIwDQo1MA0KNzANCjg4DQo4OA0KODgNCjg4DQo3MA0KMDANCjAwDQpFTkRDSEFSDQpTVEFSVENIQVIgMjEzDQpFTkNPRElORyAyMTMNClNXSURUSCA3NTAgMA0KRFdJRFRIIDYgMA0KQkJYIDYgMTAgMCAtMg0KQklUTUFQDQo2OA0KQjANCjcwDQo4OA0KODgNCjg4DQo4OA0KNzANCjAwDQowMA0KRU5EQ0hBUg0KU1RBUlRDSEFSIDIxNA0KRU5DT0RJTkcgMjE0DQpTV0lEVEggNzUwIDANCkRXSURUSCA2IDANCkJCWCA2IDEwIDAgLTINCkJJVE1BUA0KNTANCjAwDQo3MA0KODgNCjg4DQo4OA0KODgNCjcwDQowMA0KMDANCkVORENIQVINClNUQVJUQ0hBUiAyMTUNCkVOQ09ESU5HIDIxNQ0KU1dJRFRIIDc1MCAwDQpEV0lEVEggNiAwDQpCQlggNiAxMCAwIC0yDQpCSVRNQVANCjAwDQowMA0KMDANCjAwDQo1MA0KMjANCjUwDQowMA0KMDANCjAwDQpFTkRDSEFSDQpTVEFSVENIQVIgMjE2DQpFTkNPRElORyAyMTYNClNXSURUSCA3NTAgMA0KRFdJRFRIIDYgMA0KQkJYIDYgMTAgMCAtMg0KQklUTUFQDQowMA0KMDgNCjcwDQo5OA0KQTgNCkE4DQpDOA0KNzANCjgwDQowMA0KRU5EQ0hBUg0KU1RBUlRDSEFSIDIxNw0KRU5DT0RJTkcgMjE3DQpTV0lEVEggNzUwIDANCkRXSURUSCA2IDANCkJCWCA2IDEwIDAgLTINCkJJVE1BUA0KNDANCjIwDQo4OA0KODgNCjg4DQo4OA0KODgNCjcwDQowMA0KMDANCkVORENIQVINClNUQVJUQ0hBUiAyMTgNCkVOQ09ESU5HIDIxOA0KU1dJRFRIIDc1MCAwDQpEV0lEVEggNiAwDQpCQlggNiAxMCAwIC0yDQpCSVRNQVANCjEwDQoyMA0KODgNCjg4DQo4OA0KODgNCjg4DQo3MA0KMDANCjAwDQpFTkRDSEFSDQpTVEFSVENIQVIgMjE5DQpFTkNPRElORyAyMTkNClNXSURUSCA3NTAgMA0KRFdJRFRIIDYgMA0KQkJYIDYgMTAgMCAtMg0KQklUTUFQDQoyMA0KNTANCjg4DQo4OA0KODgNCjg4DQo4OA0KNzANCjAwDQowMA0KRU5EQ0hBUg0KU1RBUlRDSEFSIDIyMA0KRU5DT0RJTkcgMjIwDQpTV0lEVEggNzUwIDANCkRXSURUSCA2IDANCkJCWCA2IDEwIDAgLTINCkJJVE1BUA0KNTANCjAwDQo4OA0KODgNCjg4DQo4OA0KODgNCjcwDQowMA0KMDANCkVORENIQVINClNUQVJUQ0hBUiAyMjENCkVOQ09ESU5HIDIyMQ0KU1dJRFRIIDc1MCAwDQpEV0lEVEggNiAwDQpCQlggNiAxMCAwIC0yDQpCSVRNQVANCjEwDQoyMA0KODgNCjg4DQo1MA0KMjANCjIwDQoyMA0KMDANCjAwDQpFTkRDSEFSDQpTVEFSVENIQVIgMjIyDQpFTkNPRElORyAyMjINClNXSURUSCA3NTAgMA0KRFdJRFRIIDYgMA0KQkJYIDYgMTAgMCAtMg0KQklUTUFQDQowMA0KMDANCjgwDQpGMA0KODgNCjg4DQpGMA0KODANCjAwDQowMA0KRU5EQ0hBUg0KU1RBUlRDSEFSIDIyMw0KRU5DT0RJTkcgMjIzDQpTV0lEVEggNzUwIDANCkRXSURUSCA2IDANCkJCWCA2IDEwIDAgLTINCkJJVE1BUA0KMDANCjAwDQo2MA0KOTANCkEwDQo5OA0KODgNCkIwDQowMA0KMDANCkVORENIQVINClNUQVJUQ0hBUiAyMjQNCkVOQ09ESU5HIDIyNA0KU1dJRFRIIDc1MCAwDQpEV0lEVEggNiAwDQpCQlggNiAxMCAwIC0yDQpCSVRNQVANCjQwDQoyMA0KMDANCjcwDQowOA0KNzgNCjg4DQo3OA0KMDANCjAwDQpFTkRDSEFSDQpTVEFSVENIQVIgMjI1DQpFTkNPRElORyAyMjUNClNXSURUSCA3NTAgMA0KRFdJRFRIIDYgMA0KQkJYIDYgMTAgMCAtMg0KQklUTUFQDQoxMA0KMjANCjAwDQo3MA0KMDgNCjc4DQo4OA0KNzgNCjAwDQowMA0KRU5EQ0hBUg0KU1RBUlRDSEFSIDIyNg0KRU5DT0RJTkcgMjI2DQpTV0lEVEggNzUwIDANCkRXSURUSCA2IDANCkJCWCA2IDEwIDAgLTINCkJJVE1BUA0KMjANCjUwDQowMA0KNzANCjA4DQo3OA0KODgNCjc4DQowMA0KMDANCkVORENIQVINClNUQVJUQ0hBUiAyMjcNCkVOQ09ESU5HIDIyNw0KU1dJRFRIIDc1MCAwDQpEV0lEVEggNiAwDQpCQlggNiAxMCAwIC0yDQpCSVRNQVANCjY4DQpCMA0KMDANCjcwDQowOA0KNzgNCjg4DQo3OA0KMDANCjAwDQpFTkRDSEFSDQpTVEFSVENIQVIgMjI4DQpFTkNPRElORyAyMjgNClNXSURUSCA3NTAgMA0KRFdJRFRIIDYgMA0KQkJYIDYgMTAgMCAtMg0KQklUTUFQDQowMA0KNTANCjAwDQo3MA0KMDgNCjc4DQo4OA0KNzgNCjAwDQowMA0KRU5EQ0hBUg0KU1RBUlRDSEFSIDIyOQ0KRU5DT0RJTkcgMjI5DQpTV0lEVEggNzUwIDANCkRXSURUSCA2IDANCkJCWCA2IDEwIDAgLTINCkJJVE1BUA0KMjANCjUwDQoyMA0KNzANCjA4DQo3OA0KODgNCjc4DQowMA0KMDANCkVORENIQVINClNUQVJUQ0hBUiAyMzANCkVOQ09ESU5HIDIzMA0KU1dJRFRIIDc1MCAwDQpEV0lEVEggNiAwDQpCQlggNiAxMCAwIC0yDQpCSVRNQVANCjAwDQowMA0KMDANCkYwDQoyOA0KRjgNCkEwDQo1OA0KMDANCjAwDQpFTkRDSEFSDQpTVEFSVENIQVIgMjMxDQpFTkNPRElORyAyMzENClNXSURUSCA3NTAgMA0KRFdJRFRIIDYgMA0KQkJYIDYgMTAgMCAtMg0KQklUTUFQDQowMA0KMDANCjAwDQo3MA0KODANCjgwDQo4MA0KNzgNCjIwDQo0MA0KRU5EQ0hBUg0KU1RBUlRDSEFSIDIzMg0KRU5DT0RJTkcgMjMyDQpTV0lEVEggNzUwIDANCkRXSURUSCA2IDANCkJCWCA2IDEwIDAgLTINCkJJVE1BUA0KNDANCjIwDQowMA0KNzANCjg4DQpGMA0KODANCjc4DQowMA0KMDANCkVORENIQVINClNUQVJUQ0hBUiAyMzMNCkVOQ09ESU5HIDIzMw0KU1dJRFRIIDc1MCAwDQpEV0lEVEggNiAwDQpCQlggNiAxMCAwIC0yDQpCSVRNQVANCjEwDQoyMA0KMDANCjcwDQo4OA0KRjANCjgwDQo3OA0KMDANCjAwDQpFTkRDSEFSDQpTVEFSVENIQVIgMjM0DQpFTkNPRElORyAyMzQNClNXSURUSCA3NTAgMA0KRFdJRFRIIDYgMA0KQkJYIDYgMTAgMCAtMg0KQklUTUFQDQoyMA0KNTANCjAwDQo3MA0KODgNCkYwDQo4MA0KNzgNCjAwDQowMA0KRU5EQ0hBUg0KU1RBUlRDSEFSIDIzNQ0KRU5DT0RJTkcgMjM1DQpTV0lEVEggNzUwIDANCkRXSURUSCA2IDANCkJCWCA2IDEwIDAgLTINCkJJVE1BUA0KMDANCjUwDQowMA0KNzANCjg4DQpGMA0KODANCjc4DQowMA0KMDANCkVORENIQVINClNUQVJUQ0hBUiAyMzYNCkVOQ09ESU5HIDIzNg0KU1dJRFRIIDc1MCAwDQpEV0lEVEggNiAwDQpCQlggNiAxMCAwIC0yDQpCSVRNQVANCjQwDQoyMA0KMDANCjYwDQoyMA0KMjANCjIwDQo3MA0KMDANCjAwDQpFTkRDSEFSDQpTVEFSVENIQVIgMjM3DQpFTkNPRElORyAyMzcNClNXSURUSCA3NTAgMA0KRFdJRFRIIDYgMA0KQkJYIDYgMTAgMCAtMg0KQklUTUFQDQoxMA0KMjANCjAwDQo2MA0KMjANCjIwDQoyMA0KNzANCjAwDQowMA0KRU5EQ0hBUg0KU1RBUlRDSEFSIDIzOA0KRU5DT0RJTkcgMjM4DQpTV0lEVEggNzUwIDANCkRXSURUSCA2IDANCkJCWCA2IDEwIDAgLTINCkJJVE1BUA0KMjANCjUwDQowMA0KNjANCjIwDQoyMA0KMjANCjcwDQowMA0KMDANCkVORENIQVINClNUQVJUQ0hBUiAyMzkNCkVOQ09ESU5HIDIzOQ0KU1dJRFRIIDc1MCAwDQpEV0lEVEggNiAwDQpCQlggNiAxMCAwIC0yDQpCSVRNQVANCjAwDQo1MA0KMDANCjYwDQoyMA0KMjANCjIwDQo3MA0KMDANCjAwDQpFTkRDSEFSDQpTVEFSVENIQVIgMjQwDQpFTkNPRElORyAyNDANClNXSURUSCA3NTAgMA0KRFdJRFRIIDYgMA0KQkJYIDYgMTAgMCAtMg0KQklUTUFQDQowMA0KNTANCjYwDQoxMA0KNzANCjg4DQo4OA0KNzANCjAwDQowMA0KRU5EQ0hBUg0KU1RBUlRDSEFSIDI0MQ0KRU5DT0RJTkcgMjQxDQpTV0lEVEggNzUwIDANCkRXSURUSCA2IDANCkJCWCA2IDEwIDAgLTINCkJJVE1BUA0KNjgNCkIwDQowMA0KRjANCjg4DQo4OA0KODgNCjg4DQowMA0KMDANCkVORENIQVINClNUQVJUQ0hBUiAyNDINCkVOQ09ESU5HIDI0Mg0KU1dJRFRIIDc1MCAwDQpEV0lEVEggNiAwDQpCQlggNiAxMCAwIC0yDQpCSVRNQVANCjQwDQoyMA0KMDANCjcwDQo4OA0KODgNCjg4DQo3MA0KMDANCjAwDQpFTkRDSEFSDQpTVEFSVENIQVIgMjQzDQpFTkNPRElORyAyNDMNClNXSURUSCA3NTAgMA0KRFdJRFRIIDYgMA0KQkJYIDYgMTAgMCAtMg0KQklUTUFQDQoxMA0KMjANCjAwDQo3MA0KODgNCjg4DQo4OA0KNzANCjAwDQowMA0KRU5EQ0hBUg0KU1RBUlRDSEFSIDI0NA0KRU5DT0RJTkcgMjQ0DQpTV0lEVEggNzUwIDANCkRXSURUSCA2IDANCkJCWCA2IDEwIDAgLTINCkJJVE1BUA0KMjANCjUwDQowMA0KNzANCjg4DQo4OA0KODgNCjcwDQowMA0KMDANCkVORENIQVINClNUQVJUQ0hBUiAyNDUNCkVOQ09ESU5HIDI0NQ0KU1dJRFRIIDc1MCAwDQpEV0lEVEggNiAwDQpCQlggNiAxMCAwIC0yDQpCSVRNQVANCjY4DQpCMA0KMDANCjcwDQo4OA0KODgNCjg4DQo3MA0KMDANCjAwDQpFTkRDSEFSDQpTVEFSVENIQVIgMjQ2DQpFTkNPRElORyAyNDYNClNXSURUSCA3NTAgMA0KRFdJRFRIIDYgMA0KQkJYIDYgMTAgMCAtMg0KQklUTUFQDQowMA0KNTANCjAwDQo3MA0KODgNCjg4DQo4OA0KNzANCjAwDQowMA0KRU5EQ0hBUg0KU1RBUlRDSEFSIDI0Nw0KRU5DT0RJTkcgMjQ3DQpTV0lEVEggNzUwIDANCkRXSURUSCA2IDANCkJCWCA2IDEwIDAgLTINCkJJVE1BUA0KMDANCjAwDQowMA0KMjANCjAwDQpGOA0KMDANCjIwDQowMA0KMDANCkVORENIQVINClNUQVJUQ0hBUiAyNDgNCkVOQ09ESU5HIDI0OA0KU1dJRFRIIDc1MCAwDQpEV0lEVEggNiAwDQpCQlggNiAxMCAwIC0yDQpCSVRNQVANCjAwDQowMA0KMDgNCjcwDQo5OA0KQTgNCkM4DQo3MA0KODANCjAwDQpFTkRDSEFSDQpTVEFSVENIQVIgMjQ5DQpFTkNPRElORyAyNDkNClNXSURUSCA3NTAgMA0KRFdJRFRIIDYgMA0KQkJYIDYgMTAgMCAtMg0KQklUTUFQDQo0MA0KMjANCjAwDQo4OA0KODgNCjg4DQo4OA0KNzgNCjAwDQowMA0KRU5EQ0hBUg0KU1RBUlRDSEFSIDI1MA0KRU5DT0RJTkcgMjUwDQpTV0lEVEggNzUwIDANCkRXSURUSCA2IDANCkJCWCA2IDEwIDAgLTINCkJJVE1BUA0KMTANCjIwDQowMA0KODgNCjg4DQo4OA0KODgNCjc4DQowMA0KMDANCkVORENIQVINClNUQVJUQ0hBUiAyNTENCkVOQ09ESU5HIDI1MQ0KU1dJRFRIIDc1MCAwDQpEV0lEVEggNiAwDQpCQlggNiAxMCAwIC0yDQpCSVRNQVANCjIwDQo1MA0KMDANCjg4DQo4OA0KODgNCjg4DQo3OA0KMDANCjAwDQpFTkRDSEFSDQpTVEFSVENIQVIgMjUyDQpFTkNPRElORyAyNTINClNXSURUSCA3NTAgMA0KRFdJRFRIIDYgMA0KQkJYIDYgMTAgMCAtMg0KQklUTUFQDQowMA0KNTANCjAwDQo4OA0KODgNCjg4DQo4OA0KNzgNCjAwDQowMA0KRU5EQ0hBUg0KU1RBUlRDSEFSIDI1Mw0KRU5DT0RJTkcgMjUzDQpTV0lEVEggNzUwIDANCkRXSURUSCA2IDANCkJCWCA2IDEwIDAgLTINCkJJVE1BUA0KMTANCjIwDQowMA0KODgNCjg4DQo4OA0KODgNCjc4DQowOA0KNzANCkVORENIQVINClNUQVJUQ0hBUiAyNTQNCkVOQ09ESU5HIDI1NA0KU1dJRFRIIDc1MCAwDQpEV0lEVEggNiAwDQpCQlggNiAxMCAwIC0yDQpCSVRNQVANCjAwDQo4MA0KODANCkIwDQpDOA0KODgNCkM4DQpCMA0KODANCjgwDQpFTkRDSEFSDQpTVEFSVENIQVIgMjU1DQpFTkNPRElORyAyNTUNClNXSURUSCA3NTAgMA0KRFdJRFRIIDYgMA0KQkJYIDYgMTAgMCAtMg0KQklUTUFQDQowMA0KNTANCjAwDQo4OA0KODgNCjg4DQo4OA0KNzgNCjA4DQo3MA0KRU5EQ0hBUg0KRU5ERk9OVA0K",
+ "emoji": "U1RBUlRGT05UIDIuMQpDT01NRU5UIFBpeGVsIGVtb2ppLCBkcmF3biBmb3IgUGl4bGV0IHRvIGJlIHVzZWQgYXMgYSBmYWxsYmFjayBmb250LgpGT05UIC1QaXhsZXQtRW1vamktTWVkaXVtLVItTm9ybWFsLS04LTgwLTc1LTc1LUMtODAtSVNPMTA2NDYtMQpTSVpFIDggNzUgNzUKRk9OVEJPVU5ESU5HQk9YIDcgNyAwIDAKU1RBUlRQUk9QRVJUSUVTIDUKRk9OVF9BU0NFTlQgNwpGT05UX0RFU0NFTlQgMQpDSEFSU0VUX1JFR0lTVFJZICJJU08xMDY0NiIKQ0hBUlNFVF9FTkNPRElORyAiMSIKREVGQVVMVF9DSEFSIDY1MDM5CkVORFBST1BFUlRJRVMKQ0hBUlMgMjMKU1RBUlRDSEFSIHVuaTIwMEQKRU5DT0RJTkcgODIwNQpTV0lEVEggMCAwCkRXSURUSCAwIDAKQkJYIDAgMCAwIDAKQklUTUFQCkVORENIQVIKU1RBUlRDSEFSIHVuaUZFMEUKRU5DT0RJTkcgNjUwMzgKU1dJRFRIIDAgMApEV0lEVEggMCAwCkJCWCAwIDAgMCAwCkJJVE1BUApFTkRDSEFSClNUQVJUQ0hBUiB1bmlGRTBGCkVOQ09ESU5HIDY1MDM5ClNXSURUSCAwIDAKRFdJRFRIIDAgMApCQlggMCAwIDAgMApCSVRNQVAKRU5EQ0hBUgpTVEFSVENIQVIgdTIzRjAKRU5DT0RJTkcgOTIwMApTV0lEVEggMTAwMCAwCkRXSURUSCA4IDAKQkJYIDcgNyAwIDAKQklUTUFQCjgyCjdDCjkyCjlBCjgyCjdDCjgyCkVORENIQVIKU1RBUlRDSEFSIHUyNjAwCkVOQ09ESU5HIDk3MjgKU1dJRFRIIDEwMDAgMApEV0lEVEggOCAwCkJCWCA3IDcgMCAwCkJJVE1BUAo5Mgo0NAozOApCQQozOAo0NAo5MgpFTkRDSEFSClNUQVJUQ0hBUiB1MjYwMQpFTkNPRElORyA5NzI5ClNXSURUSCAxMDAwIDAKRFdJRFRIIDggMApCQlggNyA3IDAgMApCSVRNQVAKMDAKMzAKNzgKN0MKRkUKN0MKMDAKRU5EQ0hBUgpTVEFSVENIQVIgdTI2MTQKRU5DT0RJTkcgOTc0OApTV0lEVEggMTAwMCAwCkRXSURUSCA4IDAKQkJYIDcgNyAwIDAKQklUTUFQCjM4CjdDCkZFCjEwCjEwCjEwCjMwCkVORENIQVIKU1RBUlRDSEFSIHUyNkExCkVOQ09ESU5HIDk4ODkKU1dJRFRIIDEwMDAgMApEV0lEVEggOCAwCkJCWCA3IDcgMCAwCkJJVE1BUAowQwoxOAozMAo3QwoxOAozMAo2MApFTkRDSEFSClNUQVJUQ0hBUiB1Mjc0NApFTkNPRElORyAxMDA1MgpTV0lEVEggMTAwMCAwCkRXSURUSCA4IDAKQkJYIDcgNyAwIDAKQklUTUFQCjkyCjU0CjM4CkZFCjM4CjU0CjkyCkVORENIQVIKU1RBUlRDSEFSIHUyNzY0CkVOQ09ESU5HIDEwMDg0ClNXSURUSCAxMDAwIDAKRFdJRFRIIDggMApCQlggNyA3IDAgMApCSVRNQVAKNkMKRkUKRkUKRkUKN0MKMzgKMTAKRU5EQ0hBUgpTVEFSVENIQVIgdTJCNTAKRU5DT0RJTkcgMTEwODgKU1dJRFRIIDEwMDAgMApEV0lEVEggOCAwCkJCWCA3IDcgMCAwCkJJVE1BUAoxMAoxMApGRQo3QwozOAo2Qwo4MgpFTkRDSEFSClNUQVJUQ0hBUiB1MUYzMTkKRU5DT0RJTkcgMTI3NzY5ClNXSURUSCAxMDAwIDAKRFdJRFRIIDggMApCQlggNyA3IDAgMApCSVRNQVAKMzgKNjAKQzAKQzAKQzAKNjAKMzgKRU5EQ0hBUgpTVEFSVENIQVIgdTFGMzI3CkVOQ09ESU5HIDEyNzc4MwpTV0lEVEggMTAwMCAwCkRXSURUSCA4IDAKQkJYIDcgNyAwIDAKQklUTUFQCjMwCjc4CkZFCjdDCjAwCjU0CkE4CkVORENIQVIKU1RBUlRDSEFSIHUxRjNCNQpFTkNPRElORyAxMjc5MjUKU1dJRFRIIDEwMDAgMApEV0lEVEggOCAwCkJCWCA3IDcgMCAwCkJJVE1BUAozRQoyMgoyMgoyMgo2NgpFRQo0NApFTkRDSEFSClNUQVJUQ0hBUiB1MUYzRTAKRU5DT0RJTkcgMTI3OTY4ClNXSURUSCAxMDAwIDAKRFdJRFRIIDggMApCQlggNyA3IDAgMApCSVRNQVAKMTAKMzgKN0MKRkUKNDQKNTQKNTQKRU5EQ0hBUgpTVEFSVENIQVIgdTFGNDRECkVOQ09ESU5HIDEyODA3NwpTV0lEVEggMTAwMCAwCkRXSURUSCA4IDAKQkJYIDcgNyAwIDAKQklUTUFQCjE4CjE4CjMwCkJFCkJDCkJFCkJDCkVORENIQVIKU1RBUlRDSEFSIHUxRjRBNwpFTkNPRElORyAxMjgxNjcKU1dJRFRIIDEwMDAgMApEV0lEVEggOCAwCkJCWCA3IDcgMCAwCkJJVE1BUAoxMAoxMAozOAo3Qwo3Qwo3QwozOApFTkRDSEFSClNUQVJUQ0hBUiB1MUY1MjUKRU5DT0RJTkcgMTI4MjkzClNXSURUSCAxMDAwIDAKRFdJRFRIIDggMApCQlggNyA3IDAgMApCSVRNQVAKMTAKMzAKNzQKN0MKRUUKQzYKN0MKRU5EQ0hBUgpTVEFSVENIQVIgdTFGNjAwCkVOQ09ESU5HIDEyODUxMgpTV0lEVEggMTAwMCAwCkRXSURUSCA4IDAKQkJYIDcgNyAwIDAKQklUTUFQCjdDCjgyCkFBCjgyCkJBCjgyCjdDCkVORENIQVIKU1RBUlRDSEFSIHUxRjYyMgpFTkNPRElORyAxMjg1NDYKU1dJRFRIIDEwMDAgMApEV0lEVEggOCAwCkJCWCA3IDcgMCAwCkJJVE1BUAo3Qwo4MgpBQQo4Mgo5MgpBQQo3QwpFTkRDSEFSClNUQVJUQ0hBUiB1MUY2NDIKRU5DT0RJTkcgMTI4NTc4ClNXSURUSCAxMDAwIDAKRFdJRFRIIDggMApCQlggNyA3IDAgMApCSVRNQVAKN0MKODIKQUEKODIKQUEKOTIKN0MKRU5EQ0hBUgpTVEFSVENIQVIgdTFGNjg2CkVOQ09ESU5HIDEyODY0NgpTV0lEVEggMTAwMCAwCkRXSURUSCA4IDAKQkJYIDcgNyAwIDAKQklUTUFQCjdDCjgyCjgyCkZFCkJBCkZFCjQ0CkVORENIQVIKU1RBUlRDSEFSIHUxRjY4QwpFTkNPRElORyAxMjg2NTIKU1dJRFRIIDEwMDAgMApEV0lEVEggOCAwCkJCWCA3IDcgMCAwCkJJVE1BUAo3QwpGRQpBQQpGRQpGRQo0NAowMApFTkRDSEFSCkVOREZPTlQK",
  "tb-8": "U1RBUlRGT05UIDIuMQpDT01NRU5UICRJZDogNXg4LmJkZix2IDEuMzIgMjAwNi0wMS0wNSAyMDowMzoxNyswMCBtZ2syNSBSZWwgJApDT01NRU5UIFNlbmQgYnVnIHJlcG9ydHMgdG8gTWFya3VzIEt1aG4gPGh0dHA6Ly93d3cuY2wuY2FtLmFjLnVrL35tZ2syNS8+CkZPTlQgLU1pc2MtRml4ZWQtTWVkaXVtLVItTm9ybWFsLS04LTgwLTc1LTc1LUMtNTAtSVNPMTA2NDYtMQpTSVpFIDExIDc1IDc1CkZPTlRCT1VORElOR0JPWCA1IDggMCAtMQpTVEFSVFBST1BFUlRJRVMgMjIKRk9OVE5BTUVfUkVHSVNUUlkgIiIKRk9VTkRSWSAiTWlzYyIKRkFNSUxZX05BTUUgIkZpeGVkIgpXRUlHSFRfTkFNRSAiTWVkaXVtIgpTTEFOVCAiUiIKU0VUV0lEVEhfTkFNRSAiTm9ybWFsIgpBRERfU1RZTEVfTkFNRSAiIgpQSVhFTF9TSVpFIDgKUE9JTlRfU0laRSA4MApSRVNPTFVUSU9OX1ggNzUKUkVTT0xVVElPTl9ZIDc1ClNQQUNJTkcgIlAiCkFWRVJBR0VfV0lEVEggNTAKQ0hBUlNFVF9SRUdJU1RSWSAiSVNPMTA2NDYiCkNIQVJTRVRfRU5DT0RJTkcgIjEiCkZPTlRfREVTQ0VOVCAxCkZPTlRfQVNDRU5UIDcKQ09QWVJJR0hUICJQdWJsaWMgZG9tYWluIGZvbnQuICBTaGFyZSBhbmQgZW5qb3kuIgpERUZBVUxUX0NIQVIgMApfWE1CREZFRF9JTkZPICJFZGl0ZWQgd2l0aCB4bWJkZmVkIDQuNS4iCkNBUF9IRUlHSFQgNgpYX0hFSUdIVCA0CkVORFBST1BFUlRJRVMKQ0hBUlMgMTQyNgpTVEFSVENIQVIgY2hhcjAKRU5DT0RJTkcgMApTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCmEwCjEwCjgwCjEwCjgwCjUwCjAwCkVORENIQVIKU1RBUlRDSEFSIHNwYWNlCkVOQ09ESU5HIDMyClNXSURUSCA0MzYgMApEV0lEVEggMyAwCkJCWCAzIDggMCAtMQpCSVRNQVAKMDAKMDAKMDAKMDAKMDAKMDAKMDAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgZXhjbGFtCkVOQ09ESU5HIDMzClNXSURUSCA0MzYgMApEV0lEVEggMiAwCkJCWCAyIDggMCAtMQpCSVRNQVAKMDAKODAKODAKODAKODAKMDAKODAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgcXVvdGVkYmwKRU5DT0RJTkcgMzQKU1dJRFRIIDQzNiAwCkRXSURUSCA0IDAKQkJYIDQgOCAwIC0xCkJJVE1BUAowMAphMAphMAphMAowMAowMAowMAowMApFTkRDSEFSClNUQVJUQ0hBUiBudW1iZXJzaWduCkVOQ09ESU5HIDM1ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKNTAKNTAKZjgKNTAKZjgKNTAKNTAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgZG9sbGFyCkVOQ09ESU5HIDM2ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMjAKNzAKYTAKNzAKMjgKNzAKMjAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgcGVyY2VudApFTkNPRElORyAzNwpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjAwCjkwCjIwCjQwCjkwCjAwCjAwCkVORENIQVIKU1RBUlRDSEFSIGFtcGVyc2FuZApFTkNPRElORyAzOApTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjQwCmEwCmEwCjQwCmEwCmEwCjUwCjAwCkVORENIQVIKU1RBUlRDSEFSIHF1b3Rlc2luZ2xlCkVOQ09ESU5HIDM5ClNXSURUSCA0MzYgMApEV0lEVEggMiAwCkJCWCAyIDggMCAtMQpCSVRNQVAKMDAKODAKODAKODAKMDAKMDAKMDAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgcGFyZW5sZWZ0CkVOQ09ESU5HIDQwClNXSURUSCA0MzYgMApEV0lEVEggMyAwCkJCWCAzIDggMCAtMQpCSVRNQVAKMDAKNDAKODAKODAKODAKODAKNDAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgcGFyZW5yaWdodApFTkNPRElORyA0MQpTV0lEVEggNDM2IDAKRFdJRFRIIDMgMApCQlggMyA4IDAgLTEKQklUTUFQCjAwCjgwCjQwCjQwCjQwCjQwCjgwCjAwCkVORENIQVIKU1RBUlRDSEFSIGFzdGVyaXNrCkVOQ09ESU5HIDQyClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMDAKOTAKNjAKZjAKNjAKOTAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgcGx1cwpFTkNPRElORyA0MwpTV0lEVEggNDM2IDAKRFdJRFRIIDQgMApCQlggNCA4IDAgLTEKQklUTUFQCjAwCjAwCjAwCjQwCmUwCjQwCjAwCjAwCkVORENIQVIKU1RBUlRDSEFSIGNvbW1hCkVOQ09ESU5HIDQ0ClNXSURUSCA0MzYgMApEV0lEVEggMyAwCkJCWCAzIDggMCAtMQpCSVRNQVAKMDAKMDAKMDAKMDAKMDAKMDAKNDAKODAKRU5EQ0hBUgpTVEFSVENIQVIgaHlwaGVuCkVOQ09ESU5HIDQ1ClNXSURUSCA0MzYgMApEV0lEVEggNCAwCkJCWCA0IDggMCAtMQpCSVRNQVAKMDAKMDAKMDAKMDAKZTAKMDAKMDAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgcGVyaW9kCkVOQ09ESU5HIDQ2ClNXSURUSCA0MzYgMApEV0lEVEggMiAwCkJCWCAyIDggMCAtMQpCSVRNQVAKMDAKMDAKMDAKMDAKMDAKMDAKODAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgc2xhc2gKRU5DT0RJTkcgNDcKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAoxMAoxMAoyMAo0MAo4MAo4MAowMApFTkRDSEFSClNUQVJUQ0hBUiB6ZXJvCkVOQ09ESU5HIDQ4ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMjAKNTAKNTAKNTAKNTAKMjAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgb25lCkVOQ09ESU5HIDQ5ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMjAKNjAKMjAKMjAKMjAKNzAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgdHdvCkVOQ09ESU5HIDUwClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKNjAKOTAKMTAKNjAKODAKZjAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgdGhyZWUKRU5DT0RJTkcgNTEKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMApmMAoyMAo2MAoxMAo5MAo2MAowMApFTkRDSEFSClNUQVJUQ0hBUiBmb3VyCkVOQ09ESU5HIDUyClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMjAKNjAKYTAKZjAKMjAKMjAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgZml2ZQpFTkNPRElORyA1MwpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCmYwCjgwCmUwCjEwCjkwCjYwCjAwCkVORENIQVIKU1RBUlRDSEFSIHNpeApFTkNPRElORyA1NApTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjYwCjgwCmUwCjkwCjkwCjYwCjAwCkVORENIQVIKU1RBUlRDSEFSIHNldmVuCkVOQ09ESU5HIDU1ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKZjAKMTAKMjAKMjAKNDAKNDAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgZWlnaHQKRU5DT0RJTkcgNTYKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAo2MAo5MAo2MAo5MAo5MAo2MAowMApFTkRDSEFSClNUQVJUQ0hBUiBuaW5lCkVOQ09ESU5HIDU3ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKNjAKOTAKOTAKNzAKMTAKNjAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgY29sb24KRU5DT0RJTkcgNTgKU1dJRFRIIDQzNiAwCkRXSURUSCAyIDAKQkJYIDIgOCAwIC0xCkJJVE1BUAowMAowMAo4MAowMAowMAo4MAowMAowMApFTkRDSEFSClNUQVJUQ0hBUiBzZW1pY29sb24KRU5DT0RJTkcgNTkKU1dJRFRIIDQzNiAwCkRXSURUSCAzIDAKQkJYIDMgOCAwIC0xCkJJVE1BUAowMAowMAo0MAowMAowMAo0MAo4MAowMApFTkRDSEFSClNUQVJUQ0hBUiBsZXNzCkVOQ09ESU5HIDYwClNXSURUSCA0MzYgMApEV0lEVEggNCAwCkJCWCA0IDggMCAtMQpCSVRNQVAKMDAKMjAKNDAKODAKODAKNDAKMjAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgZXF1YWwKRU5DT0RJTkcgNjEKU1dJRFRIIDQzNiAwCkRXSURUSCA0IDAKQkJYIDQgOCAwIC0xCkJJVE1BUAowMAowMAowMAplMAowMAplMAowMAowMApFTkRDSEFSClNUQVJUQ0hBUiBncmVhdGVyCkVOQ09ESU5HIDYyClNXSURUSCA0MzYgMApEV0lEVEggNCAwCkJCWCA0IDggMCAtMQpCSVRNQVAKMDAKODAKNDAKMjAKMjAKNDAKODAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgcXVlc3Rpb24KRU5DT0RJTkcgNjMKU1dJRFRIIDQzNiAwCkRXSURUSCA0IDAKQkJYIDQgOCAwIC0xCkJJVE1BUAowMAo0MAphMAoyMAo0MAowMAo0MAowMApFTkRDSEFSClNUQVJUQ0hBUiBhdApFTkNPRElORyA2NApTV0lEVEggNDM2IDAKRFdJRFRIIDYgMApCQlggNiA4IDAgLTEKQklUTUFQCjMwCjQ4Cjk4CmE4CmE4CjkwCjQwCjMwCkVORENIQVIKU1RBUlRDSEFSIEEKRU5DT0RJTkcgNjUKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAo2MAo5MAo5MApmMAo5MAo5MAowMApFTkRDSEFSClNUQVJUQ0hBUiBCCkVOQ09ESU5HIDY2ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKZTAKOTAKZTAKOTAKOTAKZTAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgQwpFTkNPRElORyA2NwpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjYwCjkwCjgwCjgwCjkwCjYwCjAwCkVORENIQVIKU1RBUlRDSEFSIEQKRU5DT0RJTkcgNjgKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAplMAo5MAo5MAo5MAo5MAplMAowMApFTkRDSEFSClNUQVJUQ0hBUiBFCkVOQ09ESU5HIDY5ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKZjAKODAKZTAKODAKODAKZjAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgRgpFTkNPRElORyA3MApTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCmYwCjgwCmUwCjgwCjgwCjgwCjAwCkVORENIQVIKU1RBUlRDSEFSIEcKRU5DT0RJTkcgNzEKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAo2MAo5MAo4MApiMAo5MAo2MAowMApFTkRDSEFSClNUQVJUQ0hBUiBICkVOQ09ESU5HIDcyClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKOTAKOTAKZjAKOTAKOTAKOTAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgSQpFTkNPRElORyA3MwpTV0lEVEggNDM2IDAKRFdJRFRIIDQgMApCQlggNCA4IDAgLTEKQklUTUFQCjAwCmUwCjQwCjQwCjQwCjQwCmUwCjAwCkVORENIQVIKU1RBUlRDSEFSIEoKRU5DT0RJTkcgNzQKU1dJRFRIIDQzNiAwCkRXSURUSCA0IDAKQkJYIDQgOCAwIC0xCkJJVE1BUAowMAoyMAoyMAoyMAoyMAphMAo0MAowMApFTkRDSEFSClNUQVJUQ0hBUiBLCkVOQ09ESU5HIDc1ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKOTAKYTAKYzAKYTAKYTAKOTAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgTApFTkNPRElORyA3NgpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjgwCjgwCjgwCjgwCjgwCmYwCjAwCkVORENIQVIKU1RBUlRDSEFSIE0KRU5DT0RJTkcgNzcKU1dJRFRIIDQzNiAwCkRXSURUSCA2IDAKQkJYIDYgOCAwIC0xCkJJVE1BUAowMAo4OApkOAphOAo4OAo4OAo4OAowMApFTkRDSEFSClNUQVJUQ0hBUiBOCkVOQ09ESU5HIDc4ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKOTAKZDAKZjAKYjAKYjAKOTAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgTwpFTkNPRElORyA3OQpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjYwCjkwCjkwCjkwCjkwCjYwCjAwCkVORENIQVIKU1RBUlRDSEFSIFAKRU5DT0RJTkcgODAKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAplMAo5MAo5MAplMAo4MAo4MAowMApFTkRDSEFSClNUQVJUQ0hBUiBRCkVOQ09ESU5HIDgxClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKNjAKOTAKOTAKZDAKYjAKNjAKMTAKRU5EQ0hBUgpTVEFSVENIQVIgUgpFTkNPRElORyA4MgpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCmUwCjkwCjkwCmUwCjkwCjkwCjAwCkVORENIQVIKU1RBUlRDSEFSIFMKRU5DT0RJTkcgODMKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAo2MAo5MAo0MAoyMAo5MAo2MAowMApFTkRDSEFSClNUQVJUQ0hBUiBUCkVOQ09ESU5HIDg0ClNXSURUSCA0MzYgMApEV0lEVEggNCAwCkJCWCA0IDggMCAtMQpCSVRNQVAKMDAKZTAKNDAKNDAKNDAKNDAKNDAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgVQpFTkNPRElORyA4NQpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjkwCjkwCjkwCjkwCjkwCjYwCjAwCkVORENIQVIKU1RBUlRDSEFSIFYKRU5DT0RJTkcgODYKU1dJRFRIIDQzNiAwCkRXSURUSCA2IDAKQkJYIDYgOCAwIC0xCkJJVE1BUAowMAo4OAo4OAo4OAo4OAo1MAoyMAowMApFTkRDSEFSClNUQVJUQ0hBUiBXCkVOQ09ESU5HIDg3ClNXSURUSCA0MzYgMApEV0lEVEggNiAwCkJCWCA2IDggMCAtMQpCSVRNQVAKMDAKODgKODgKODgKYTgKYTgKNTAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgWApFTkNPRElORyA4OApTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjkwCjkwCjYwCjYwCjkwCjkwCjAwCkVORENIQVIKU1RBUlRDSEFSIFkKRU5DT0RJTkcgODkKU1dJRFRIIDQzNiAwCkRXSURUSCA2IDAKQkJYIDYgOCAwIC0xCkJJVE1BUAowMAo4OAo4OAo1MAoyMAoyMAoyMAowMApFTkRDSEFSClNUQVJUQ0hBUiBaCkVOQ09ESU5HIDkwClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKZjAKMTAKMjAKNDAKODAKZjAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgYnJhY2tldGxlZnQKRU5DT0RJTkcgOTEKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAo3MAo0MAo0MAo0MAo0MAo3MAowMApFTkRDSEFSClNUQVJUQ0hBUiBiYWNrc2xhc2gKRU5DT0RJTkcgOTIKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAo4MAo4MAo0MAoyMAoxMAoxMAowMApFTkRDSEFSClNUQVJUQ0hBUiBicmFja2V0cmlnaHQKRU5DT0RJTkcgOTMKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAo3MAoxMAoxMAoxMAoxMAo3MAowMApFTkRDSEFSClNUQVJUQ0hBUiBhc2NpaWNpcmN1bQpFTkNPRElORyA5NApTV0lEVEggNDM2IDAKRFdJRFRIIDQgMApCQlggNCA4IDAgLTEKQklUTUFQCjAwCjQwCmEwCjAwCjAwCjAwCjAwCjAwCkVORENIQVIKU1RBUlRDSEFSIHVuZGVyc2NvcmUKRU5DT0RJTkcgOTUKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAowMAowMAowMAowMAowMAowMApmMApFTkRDSEFSClNUQVJUQ0hBUiBncmF2ZQpFTkNPRElORyA5NgpTV0lEVEggNDM2IDAKRFdJRFRIIDMgMApCQlggMyA4IDAgLTEKQklUTUFQCjAwCjgwCjQwCjAwCjAwCjAwCjAwCjAwCkVORENIQVIKU1RBUlRDSEFSIGEKRU5DT0RJTkcgOTcKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAowMAowMAo3MAo5MAo5MAo3MAowMApFTkRDSEFSClNUQVJUQ0hBUiBiCkVOQ09ESU5HIDk4ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKODAKODAKZTAKOTAKOTAKZTAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgYwpFTkNPRElORyA5OQpTV0lEVEggNDM2IDAKRFdJRFRIIDQgMApCQlggNCA4IDAgLTEKQklUTUFQCjAwCjAwCjAwCjYwCjgwCjgwCjYwCjAwCkVORENIQVIKU1RBUlRDSEFSIGQKRU5DT0RJTkcgMTAwClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMTAKMTAKNzAKOTAKOTAKNzAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgZQpFTkNPRElORyAxMDEKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAowMAowMAo2MApiMApjMAo2MAowMApFTkRDSEFSClNUQVJUQ0hBUiBmCkVOQ09ESU5HIDEwMgpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjIwCjUwCjQwCmUwCjQwCjQwCjAwCkVORENIQVIKU1RBUlRDSEFSIGcKRU5DT0RJTkcgMTAzClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMDAKMDAKNjAKOTAKNzAKMTAKNjAKRU5EQ0hBUgpTVEFSVENIQVIgaApFTkNPRElORyAxMDQKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAo4MAo4MAplMAo5MAo5MAo5MAowMApFTkRDSEFSClNUQVJUQ0hBUiBpCkVOQ09ESU5HIDEwNQpTV0lEVEggNDM2IDAKRFdJRFRIIDQgMApCQlggNCA4IDAgLTEKQklUTUFQCjAwCjQwCjAwCmMwCjQwCjQwCmUwCjAwCkVORENIQVIKU1RBUlRDSEFSIGoKRU5DT0RJTkcgMTA2ClNXSURUSCA0MzYgMApEV0lEVEggNCAwCkJCWCA0IDggMCAtMQpCSVRNQVAKMDAKMjAKMDAKMjAKMjAKMjAKYTAKNDAKRU5EQ0hBUgpTVEFSVENIQVIgawpFTkNPRElORyAxMDcKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAo4MAo4MAo5MAplMAo5MAo5MAowMApFTkRDSEFSClNUQVJUQ0hBUiBsCkVOQ09ESU5HIDEwOApTV0lEVEggNDM2IDAKRFdJRFRIIDQgMApCQlggNCA4IDAgLTEKQklUTUFQCjAwCmMwCjQwCjQwCjQwCjQwCmUwCjAwCkVORENIQVIKU1RBUlRDSEFSIG0KRU5DT0RJTkcgMTA5ClNXSURUSCA0MzYgMApEV0lEVEggNiAwCkJCWCA2IDggMCAtMQpCSVRNQVAKMDAKMDAKMDAKZDAKYTgKYTgKYTgKMDAKRU5EQ0hBUgpTVEFSVENIQVIgbgpFTkNPRElORyAxMTAKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAowMAowMAplMAo5MAo5MAo5MAowMApFTkRDSEFSClNUQVJUQ0hBUiBvCkVOQ09ESU5HIDExMQpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjAwCjAwCjYwCjkwCjkwCjYwCjAwCkVORENIQVIKU1RBUlRDSEFSIHAKRU5DT0RJTkcgMTEyClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMDAKMDAKZTAKOTAKZTAKODAKODAKRU5EQ0hBUgpTVEFSVENIQVIgcQpFTkNPRElORyAxMTMKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAowMAowMAo3MAo5MAo3MAoxMAoxMApFTkRDSEFSClNUQVJUQ0hBUiByCkVOQ09ESU5HIDExNApTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjAwCjAwCmEwCmQwCjgwCjgwCjAwCkVORENIQVIKU1RBUlRDSEFSIHMKRU5DT0RJTkcgMTE1ClNXSURUSCA0MzYgMApEV0lEVEggNCAwCkJCWCA0IDggMCAtMQpCSVRNQVAKMDAKMDAKMDAKNjAKYzAKMjAKYzAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgdApFTkNPRElORyAxMTYKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAo0MAo0MAplMAo0MAo1MAoyMAowMApFTkRDSEFSClNUQVJUQ0hBUiB1CkVOQ09ESU5HIDExNwpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjAwCjAwCjkwCjkwCjkwCjcwCjAwCkVORENIQVIKU1RBUlRDSEFSIHYKRU5DT0RJTkcgMTE4ClNXSURUSCA0MzYgMApEV0lEVEggNCAwCkJCWCA0IDggMCAtMQpCSVRNQVAKMDAKMDAKMDAKYTAKYTAKYTAKNDAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgdwpFTkNPRElORyAxMTkKU1dJRFRIIDQzNiAwCkRXSURUSCA2IDAKQkJYIDYgOCAwIC0xCkJJVE1BUAowMAowMAowMAo4OAphOAphOAo1MAowMApFTkRDSEFSClNUQVJUQ0hBUiB4CkVOQ09ESU5HIDEyMApTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjAwCjAwCjkwCjYwCjYwCjkwCjAwCkVORENIQVIKU1RBUlRDSEFSIHkKRU5DT0RJTkcgMTIxClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMDAKMDAKOTAKOTAKNzAKOTAKNjAKRU5EQ0hBUgpTVEFSVENIQVIgegpFTkNPRElORyAxMjIKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAowMAowMApmMAoyMAo0MApmMAowMApFTkRDSEFSClNUQVJUQ0hBUiBicmFjZWxlZnQKRU5DT0RJTkcgMTIzClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMzAKNDAKMjAKYzAKMjAKNDAKMzAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgYmFyCkVOQ09ESU5HIDEyNApTV0lEVEggNDM2IDAKRFdJRFRIIDIgMApCQlggMiA4IDAgLTEKQklUTUFQCjAwCjgwCjgwCjgwCjgwCjgwCjgwCjAwCkVORENIQVIKU1RBUlRDSEFSIGJyYWNlcmlnaHQKRU5DT0RJTkcgMTI1ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKYzAKMjAKNDAKMzAKNDAKMjAKYzAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgYXNjaWl0aWxkZQpFTkNPRElORyAxMjYKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAo1MAphMAowMAowMAowMAowMAowMApFTkRDSEFSClNUQVJUQ0hBUiBzcGFjZQpFTkNPRElORyAxNjAKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAowMAowMAowMAowMAowMAowMAowMApFTkRDSEFSClNUQVJUQ0hBUiBleGNsYW1kb3duCkVOQ09ESU5HIDE2MQpTV0lEVEggNDM2IDAKRFdJRFRIIDIgMApCQlggMiA4IDAgLTEKQklUTUFQCjAwCjgwCjAwCjgwCjgwCjgwCjgwCjAwCkVORENIQVIKU1RBUlRDSEFSIGNlbnQKRU5DT0RJTkcgMTYyClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMDAKMjAKNzAKYTAKYTAKNzAKMjAKRU5EQ0hBUgpTVEFSVENIQVIgc3RlcmxpbmcKRU5DT0RJTkcgMTYzClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMjAKNTAKNDAKZTAKNDAKNTAKYTAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgY3VycmVuY3kKRU5DT0RJTkcgMTY0ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMDAKODgKNzAKNTAKNzAKODgKMDAKRU5EQ0hBUgpTVEFSVENIQVIgeWVuCkVOQ09ESU5HIDE2NQpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjg4CjUwCmY4CjIwCmY4CjIwCjAwCkVORENIQVIKU1RBUlRDSEFSIGJyb2tlbmJhcgpFTkNPRElORyAxNjYKU1dJRFRIIDQzNiAwCkRXSURUSCAyIDAKQkJYIDIgOCAwIC0xCkJJVE1BUAo4MAo4MAo4MAowMAo4MAo4MAo4MAowMApFTkRDSEFSClNUQVJUQ0hBUiBzZWN0aW9uCkVOQ09ESU5HIDE2NwpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjcwCjgwCmUwCjkwCjcwCjEwCmUwCjAwCkVORENIQVIKU1RBUlRDSEFSIGRpZXJlc2lzCkVOQ09ESU5HIDE2OApTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjUwCjAwCjAwCjAwCjAwCjAwCjAwCkVORENIQVIKU1RBUlRDSEFSIGNvcHlyaWdodApFTkNPRElORyAxNjkKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAo3MAphOApjOApjOAphOAo3MAowMApFTkRDSEFSClNUQVJUQ0hBUiBvcmRmZW1pbmluZQpFTkNPRElORyAxNzAKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAozMAo1MAozMAowMAo3MAowMAowMAowMApFTkRDSEFSClNUQVJUQ0hBUiBndWlsbGVtb3RsZWZ0CkVOQ09ESU5HIDE3MQpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjAwCjAwCjUwCmEwCjUwCjAwCjAwCkVORENIQVIKU1RBUlRDSEFSIGxvZ2ljYWxub3QKRU5DT0RJTkcgMTcyClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMDAKMDAKMDAKNzAKMTAKMTAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgaHlwaGVuCkVOQ09ESU5HIDE3MwpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjAwCjAwCjAwCjcwCjAwCjAwCjAwCkVORENIQVIKU1RBUlRDSEFSIHJlZ2lzdGVyZWQKRU5DT0RJTkcgMTc0ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKNzAKZTgKZDgKZTgKZDgKNzAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgbWFjcm9uCkVOQ09ESU5HIDE3NQpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjcwCjAwCjAwCjAwCjAwCjAwCjAwCkVORENIQVIKU1RBUlRDSEFSIGRlZ3JlZQpFTkNPRElORyAxNzYKU1dJRFRIIDQzNiAwCkRXSURUSCA0IDAKQkJYIDQgOCAwIC0xCkJJVE1BUAo0MAphMAo0MAowMAowMAowMAowMAowMApFTkRDSEFSClNUQVJUQ0hBUiBwbHVzbWludXMKRU5DT0RJTkcgMTc3ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMDAKMjAKNzAKMjAKMDAKNzAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgdHdvc3VwZXJpb3IKRU5DT0RJTkcgMTc4ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMjAKNTAKMTAKMjAKNzAKMDAKMDAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgdGhyZWVzdXBlcmlvcgpFTkNPRElORyAxNzkKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAo2MAoxMAo2MAoxMAo2MAowMAowMAowMApFTkRDSEFSClNUQVJUQ0hBUiBhY3V0ZQpFTkNPRElORyAxODAKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAoyMAo0MAowMAowMAowMAowMAowMApFTkRDSEFSClNUQVJUQ0hBUiBtdQpFTkNPRElORyAxODEKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAowMAowMAo5MAo5MAo5MAplMAo4MApFTkRDSEFSClNUQVJUQ0hBUiBwYXJhZ3JhcGgKRU5DT0RJTkcgMTgyClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKNzgKZTgKZTgKNjgKMjgKMjgKMDAKRU5EQ0hBUgpTVEFSVENIQVIgcGVyaW9kY2VudGVyZWQKRU5DT0RJTkcgMTgzClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMDAKMDAKMDAKMjAKMDAKMDAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgY2VkaWxsYQpFTkNPRElORyAxODQKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAowMAowMAowMAowMAowMAoyMAo0MApFTkRDSEFSClNUQVJUQ0hBUiBvbmVzdXBlcmlvcgpFTkNPRElORyAxODUKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAoyMAo2MAoyMAoyMAo3MAowMAowMAowMApFTkRDSEFSClNUQVJUQ0hBUiBvcmRtYXNjdWxpbmUKRU5DT0RJTkcgMTg2ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMjAKNTAKMjAKMDAKNzAKMDAKMDAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgZ3VpbGxlbW90cmlnaHQKRU5DT0RJTkcgMTg3ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMDAKMDAKYTAKNTAKYTAKMDAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgb25lcXVhcnRlcgpFTkNPRElORyAxODgKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAo4MAo4MAo4MAphMAo2MApmMAoyMAowMApFTkRDSEFSClNUQVJUQ0hBUiBvbmVoYWxmCkVOQ09ESU5HIDE4OQpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjgwCjgwCmEwCmQwCjEwCjIwCjcwCjAwCkVORENIQVIKU1RBUlRDSEFSIHRocmVlcXVhcnRlcnMKRU5DT0RJTkcgMTkwClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKODAKNDAKODAKNjAKYTAKZjAKMjAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgcXVlc3Rpb25kb3duCkVOQ09ESU5HIDE5MQpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjIwCjAwCjIwCjQwCjUwCjIwCjAwCkVORENIQVIKU1RBUlRDSEFSIEFncmF2ZQpFTkNPRElORyAxOTIKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAo0MAoyMAo2MAo5MApmMAo5MAo5MAowMApFTkRDSEFSClNUQVJUQ0hBUiBBYWN1dGUKRU5DT0RJTkcgMTkzClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMjAKNDAKNjAKOTAKZjAKOTAKOTAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgQWNpcmN1bWZsZXgKRU5DT0RJTkcgMTk0ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKNjAKOTAKNjAKOTAKZjAKOTAKOTAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgQXRpbGRlCkVOQ09ESU5HIDE5NQpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjUwCmEwCjYwCjkwCmYwCjkwCjkwCjAwCkVORENIQVIKU1RBUlRDSEFSIEFkaWVyZXNpcwpFTkNPRElORyAxOTYKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAo5MAowMAo2MAo5MApmMAo5MAo5MAowMApFTkRDSEFSClNUQVJUQ0hBUiBBcmluZwpFTkNPRElORyAxOTcKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAo2MAo5MAo2MAo5MApmMAo5MAo5MAowMApFTkRDSEFSClNUQVJUQ0hBUiBBRQpFTkNPRElORyAxOTgKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAo3MAphMAphMApmMAphMApiMAowMApFTkRDSEFSClNUQVJUQ0hBUiBDY2VkaWxsYQpFTkNPRElORyAxOTkKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAo2MAo5MAo4MAo4MAo5MAo2MAo0MApFTkRDSEFSClNUQVJUQ0hBUiBFZ3JhdmUKRU5DT0RJTkcgMjAwClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKNDAKMjAKZjAKODAKZTAKODAKZjAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgRWFjdXRlCkVOQ09ESU5HIDIwMQpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjIwCjQwCmYwCjgwCmUwCjgwCmYwCjAwCkVORENIQVIKU1RBUlRDSEFSIEVjaXJjdW1mbGV4CkVOQ09ESU5HIDIwMgpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjYwCjkwCmYwCjgwCmUwCjgwCmYwCjAwCkVORENIQVIKU1RBUlRDSEFSIEVkaWVyZXNpcwpFTkNPRElORyAyMDMKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAo5MAowMApmMAo4MAplMAo4MApmMAowMApFTkRDSEFSClNUQVJUQ0hBUiBJZ3JhdmUKRU5DT0RJTkcgMjA0ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKNDAKMjAKNzAKMjAKMjAKMjAKNzAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgSWFjdXRlCkVOQ09ESU5HIDIwNQpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjEwCjIwCjcwCjIwCjIwCjIwCjcwCjAwCkVORENIQVIKU1RBUlRDSEFSIEljaXJjdW1mbGV4CkVOQ09ESU5HIDIwNgpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjIwCjUwCjcwCjIwCjIwCjIwCjcwCjAwCkVORENIQVIKU1RBUlRDSEFSIElkaWVyZXNpcwpFTkNPRElORyAyMDcKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAo1MAowMAo3MAoyMAoyMAoyMAo3MAowMApFTkRDSEFSClNUQVJUQ0hBUiBFdGgKRU5DT0RJTkcgMjA4ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKNzAKNDgKZTgKNDgKNDgKNzAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgTnRpbGRlCkVOQ09ESU5HIDIwOQpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjUwCmEwCjkwCmQwCmIwCjkwCjkwCjAwCkVORENIQVIKU1RBUlRDSEFSIE9ncmF2ZQpFTkNPRElORyAyMTAKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAo0MAoyMAo2MAo5MAo5MAo5MAo2MAowMApFTkRDSEFSClNUQVJUQ0hBUiBPYWN1dGUKRU5DT0RJTkcgMjExClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMjAKNDAKNjAKOTAKOTAKOTAKNjAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgT2NpcmN1bWZsZXgKRU5DT0RJTkcgMjEyClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKNjAKOTAKNjAKOTAKOTAKOTAKNjAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgT3RpbGRlCkVOQ09ESU5HIDIxMwpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjUwCmEwCjYwCjkwCjkwCjkwCjYwCjAwCkVORENIQVIKU1RBUlRDSEFSIE9kaWVyZXNpcwpFTkNPRElORyAyMTQKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAo5MAowMAo2MAo5MAo5MAo5MAo2MAowMApFTkRDSEFSClNUQVJUQ0hBUiBtdWx0aXBseQpFTkNPRElORyAyMTUKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAowMAowMAowMAo1MAoyMAo1MAowMApFTkRDSEFSClNUQVJUQ0hBUiBPc2xhc2gKRU5DT0RJTkcgMjE2ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKNzAKYjAKYjAKZDAKZDAKZTAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgVWdyYXZlCkVOQ09ESU5HIDIxNwpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjQwCjIwCjkwCjkwCjkwCjkwCjYwCjAwCkVORENIQVIKU1RBUlRDSEFSIFVhY3V0ZQpFTkNPRElORyAyMTgKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAoyMAo0MAo5MAo5MAo5MAo5MAo2MAowMApFTkRDSEFSClNUQVJUQ0hBUiBVY2lyY3VtZmxleApFTkNPRElORyAyMTkKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAo2MAo5MAo5MAo5MAo5MAo5MAo2MAowMApFTkRDSEFSClNUQVJUQ0hBUiBVZGllcmVzaXMKRU5DT0RJTkcgMjIwClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKOTAKMDAKOTAKOTAKOTAKOTAKNjAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgWWFjdXRlCkVOQ09ESU5HIDIyMQpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjEwCjIwCjg4CjUwCjIwCjIwCjIwCjAwCkVORENIQVIKU1RBUlRDSEFSIFRob3JuCkVOQ09ESU5HIDIyMgpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjgwCmUwCjkwCjkwCmUwCjgwCjAwCkVORENIQVIKU1RBUlRDSEFSIGdlcm1hbmRibHMKRU5DT0RJTkcgMjIzClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKNjAKOTAKYTAKYTAKOTAKYTAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgYWdyYXZlCkVOQ09ESU5HIDIyNApTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjQwCjIwCjAwCjcwCjkwCjkwCjcwCjAwCkVORENIQVIKU1RBUlRDSEFSIGFhY3V0ZQpFTkNPRElORyAyMjUKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAoyMAo0MAowMAo3MAo5MAo5MAo3MAowMApFTkRDSEFSClNUQVJUQ0hBUiBhY2lyY3VtZmxleApFTkNPRElORyAyMjYKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAoyMAo1MAowMAo3MAo5MAo5MAo3MAowMApFTkRDSEFSClNUQVJUQ0hBUiBhdGlsZGUKRU5DT0RJTkcgMjI3ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKNTAKYTAKMDAKNzAKOTAKOTAKNzAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgYWRpZXJlc2lzCkVOQ09ESU5HIDIyOApTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjUwCjAwCjcwCjkwCjkwCjcwCjAwCkVORENIQVIKU1RBUlRDSEFSIGFyaW5nCkVOQ09ESU5HIDIyOQpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjYwCjkwCjYwCjcwCjkwCjkwCjcwCjAwCkVORENIQVIKU1RBUlRDSEFSIGFlCkVOQ09ESU5HIDIzMApTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjAwCjAwCmYwCjY4CmIwCjc4CjAwCkVORENIQVIKU1RBUlRDSEFSIGNjZWRpbGxhCkVOQ09ESU5HIDIzMQpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjAwCjAwCjMwCjQwCjQwCjMwCjIwCkVORENIQVIKU1RBUlRDSEFSIGVncmF2ZQpFTkNPRElORyAyMzIKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAo0MAoyMAowMAo2MApiMApjMAo2MAowMApFTkRDSEFSClNUQVJUQ0hBUiBlYWN1dGUKRU5DT0RJTkcgMjMzClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMjAKNDAKMDAKNjAKYjAKYzAKNjAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgZWNpcmN1bWZsZXgKRU5DT0RJTkcgMjM0ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKNjAKOTAKMDAKNjAKYjAKYzAKNjAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgZWRpZXJlc2lzCkVOQ09ESU5HIDIzNQpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjUwCjAwCjYwCmIwCmMwCjYwCjAwCkVORENIQVIKU1RBUlRDSEFSIGlncmF2ZQpFTkNPRElORyAyMzYKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAo0MAoyMAowMAo2MAoyMAoyMAo3MAowMApFTkRDSEFSClNUQVJUQ0hBUiBpYWN1dGUKRU5DT0RJTkcgMjM3ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMTAKMjAKMDAKNjAKMjAKMjAKNzAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgaWNpcmN1bWZsZXgKRU5DT0RJTkcgMjM4ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMjAKNTAKMDAKNjAKMjAKMjAKNzAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgaWRpZXJlc2lzCkVOQ09ESU5HIDIzOQpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjUwCjAwCjYwCjIwCjIwCjcwCjAwCkVORENIQVIKU1RBUlRDSEFSIGV0aApFTkNPRElORyAyNDAKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAphMAo0MAphMAoxMAo3MAo5MAo2MAowMApFTkRDSEFSClNUQVJUQ0hBUiBudGlsZGUKRU5DT0RJTkcgMjQxClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKNTAKYTAKMDAKZTAKOTAKOTAKOTAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgb2dyYXZlCkVOQ09ESU5HIDI0MgpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjQwCjIwCjAwCjYwCjkwCjkwCjYwCjAwCkVORENIQVIKU1RBUlRDSEFSIG9hY3V0ZQpFTkNPRElORyAyNDMKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAoyMAo0MAowMAo2MAo5MAo5MAo2MAowMApFTkRDSEFSClNUQVJUQ0hBUiBvY2lyY3VtZmxleApFTkNPRElORyAyNDQKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAo2MAo5MAowMAo2MAo5MAo5MAo2MAowMApFTkRDSEFSClNUQVJUQ0hBUiBvdGlsZGUKRU5DT0RJTkcgMjQ1ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKNTAKYTAKMDAKNjAKOTAKOTAKNjAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgb2RpZXJlc2lzCkVOQ09ESU5HIDI0NgpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjkwCjAwCjYwCjkwCjkwCjYwCjAwCkVORENIQVIKU1RBUlRDSEFSIGRpdmlkZQpFTkNPRElORyAyNDcKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAowMAoyMAowMAo3MAowMAoyMAowMApFTkRDSEFSClNUQVJUQ0hBUiBvc2xhc2gKRU5DT0RJTkcgMjQ4ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMDAKMDAKNzAKYjAKZDAKZTAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgdWdyYXZlCkVOQ09ESU5HIDI0OQpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjQwCjIwCjAwCjkwCjkwCjkwCjcwCjAwCkVORENIQVIKU1RBUlRDSEFSIHVhY3V0ZQpFTkNPRElORyAyNTAKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAoyMAo0MAowMAo5MAo5MAo5MAo3MAowMApFTkRDSEFSClNUQVJUQ0hBUiB1Y2lyY3VtZmxleApFTkNPRElORyAyNTEKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAo2MAo5MAowMAo5MAo5MAo5MAo3MAowMApFTkRDSEFSClNUQVJUQ0hBUiB1ZGllcmVzaXMKRU5DT0RJTkcgMjUyClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKOTAKMDAKOTAKOTAKOTAKNzAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgeWFjdXRlCkVOQ09ESU5HIDI1MwpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjIwCjQwCjAwCjkwCjkwCjcwCjkwCjYwCkVORENIQVIKU1RBUlRDSEFSIHRob3JuCkVOQ09ESU5HIDI1NApTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjgwCjgwCmUwCjkwCmUwCjgwCjgwCkVORENIQVIKU1RBUlRDSEFSIHlkaWVyZXNpcwpFTkNPRElORyAyNTUKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAo5MAowMAo5MAo5MAo3MAo5MAo2MApFTkRDSEFSClNUQVJUQ0hBUiBBbWFjcm9uCkVOQ09ESU5HIDI1NgpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCmYwCjAwCjYwCjkwCmYwCjkwCjkwCjAwCkVORENIQVIKU1RBUlRDSEFSIGFtYWNyb24KRU5DT0RJTkcgMjU3ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKZjAKMDAKNzAKOTAKOTAKNzAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgQWJyZXZlCkVOQ09ESU5HIDI1OApTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjkwCjYwCjYwCjkwCmYwCjkwCjkwCjAwCkVORENIQVIKU1RBUlRDSEFSIGFicmV2ZQpFTkNPRElORyAyNTkKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAo5MAo2MAowMAo3MAo5MAo5MAo3MAowMApFTkRDSEFSClNUQVJUQ0hBUiBBb2dvbmVrCkVOQ09ESU5HIDI2MApTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjYwCjkwCjkwCmYwCjkwCjkwCjMwCkVORENIQVIKU1RBUlRDSEFSIGFvZ29uZWsKRU5DT0RJTkcgMjYxClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMDAKMDAKNzAKOTAKOTAKNzAKMjAKRU5EQ0hBUgpTVEFSVENIQVIgQ2FjdXRlCkVOQ09ESU5HIDI2MgpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjIwCjQwCjYwCjkwCjgwCjkwCjYwCjAwCkVORENIQVIKU1RBUlRDSEFSIGNhY3V0ZQpFTkNPRElORyAyNjMKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAoxMAoyMAowMAozMAo0MAo0MAozMAowMApFTkRDSEFSClNUQVJUQ0hBUiBDY2lyY3VtZmxleApFTkNPRElORyAyNjQKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAo2MAo5MAo2MAo5MAo4MAo5MAo2MAowMApFTkRDSEFSClNUQVJUQ0hBUiBjY2lyY3VtZmxleApFTkNPRElORyAyNjUKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAo2MAo5MAowMAo2MAo4MAo4MAo2MAowMApFTkRDSEFSClNUQVJUQ0hBUiBDZG90YWNjZW50CkVOQ09ESU5HIDI2NgpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjQwCjAwCjYwCjkwCjgwCjkwCjYwCjAwCkVORENIQVIKU1RBUlRDSEFSIGNkb3RhY2NlbnQKRU5DT0RJTkcgMjY3ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMjAKMDAKMzAKNDAKNDAKMzAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgQ2Nhcm9uCkVOQ09ESU5HIDI2OApTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjkwCjYwCjYwCjkwCjgwCjkwCjYwCjAwCkVORENIQVIKU1RBUlRDSEFSIGNjYXJvbgpFTkNPRElORyAyNjkKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAo5MAo2MAowMAo2MAo4MAo4MAo2MAowMApFTkRDSEFSClNUQVJUQ0hBUiBEY2Fyb24KRU5DT0RJTkcgMjcwClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKOTAKNjAKZTAKOTAKOTAKOTAKZTAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgZGNhcm9uCkVOQ09ESU5HIDI3MQpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCmEwCjUwCjEwCjcwCjkwCjkwCjcwCjAwCkVORENIQVIKU1RBUlRDSEFSIERjcm9hdApFTkNPRElORyAyNzIKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAo3MAo0OAplOAo0OAo0OAo3MAowMApFTkRDSEFSClNUQVJUQ0hBUiBkY3JvYXQKRU5DT0RJTkcgMjczClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMjAKNzAKMjAKNjAKYTAKNjAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgRW1hY3JvbgpFTkNPRElORyAyNzQKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUApmMAowMApmMAo4MAplMAo4MApmMAowMApFTkRDSEFSClNUQVJUQ0hBUiBlbWFjcm9uCkVOQ09ESU5HIDI3NQpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCmYwCjAwCjYwCmIwCmMwCjYwCjAwCkVORENIQVIKU1RBUlRDSEFSIEVicmV2ZQpFTkNPRElORyAyNzYKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAo5MAo2MApmMAo4MAplMAo4MApmMAowMApFTkRDSEFSClNUQVJUQ0hBUiBlYnJldmUKRU5DT0RJTkcgMjc3ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKOTAKNjAKMDAKNjAKYjAKYzAKNjAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgRWRvdGFjY2VudApFTkNPRElORyAyNzgKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAo2MAowMApmMAo4MAplMAo4MApmMAowMApFTkRDSEFSClNUQVJUQ0hBUiBlZG90YWNjZW50CkVOQ09ESU5HIDI3OQpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjYwCjAwCjYwCmIwCmMwCjYwCjAwCkVORENIQVIKU1RBUlRDSEFSIEVvZ29uZWsKRU5DT0RJTkcgMjgwClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKZjAKODAKZTAKODAKODAKZjAKMjAKRU5EQ0hBUgpTVEFSVENIQVIgZW9nb25lawpFTkNPRElORyAyODEKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAowMAowMAo2MApiMApjMAo2MAoyMApFTkRDSEFSClNUQVJUQ0hBUiBFY2Fyb24KRU5DT0RJTkcgMjgyClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKOTAKNjAKZjAKODAKZTAKODAKZjAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgZWNhcm9uCkVOQ09ESU5HIDI4MwpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjkwCjYwCjAwCjYwCmIwCmMwCjYwCjAwCkVORENIQVIKU1RBUlRDSEFSIEdjaXJjdW1mbGV4CkVOQ09ESU5HIDI4NApTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjYwCjkwCjcwCjgwCmIwCjkwCjYwCjAwCkVORENIQVIKU1RBUlRDSEFSIGdjaXJjdW1mbGV4CkVOQ09ESU5HIDI4NQpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjYwCjkwCjAwCjYwCjkwCjcwCjEwCjYwCkVORENIQVIKU1RBUlRDSEFSIEdicmV2ZQpFTkNPRElORyAyODYKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAo5MAo2MAo3MAo4MApiMAo5MAo2MAowMApFTkRDSEFSClNUQVJUQ0hBUiBnYnJldmUKRU5DT0RJTkcgMjg3ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKOTAKNjAKMDAKNjAKOTAKNzAKMTAKNjAKRU5EQ0hBUgpTVEFSVENIQVIgR2RvdGFjY2VudApFTkNPRElORyAyODgKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAo2MAowMAo3MAo4MApiMAo5MAo2MAowMApFTkRDSEFSClNUQVJUQ0hBUiBnZG90YWNjZW50CkVOQ09ESU5HIDI4OQpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjYwCjAwCjYwCjkwCjcwCjEwCjYwCkVORENIQVIKU1RBUlRDSEFSIEdjb21tYWFjY2VudApFTkNPRElORyAyOTAKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAo2MAo5MAo4MApiMAo5MAo2MAoyMApFTkRDSEFSClNUQVJUQ0hBUiBnY29tbWFhY2NlbnQKRU5DT0RJTkcgMjkxClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKNjAKNDAKMDAKNjAKOTAKNzAKMTAKNjAKRU5EQ0hBUgpTVEFSVENIQVIgSGNpcmN1bWZsZXgKRU5DT0RJTkcgMjkyClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKNjAKOTAKMDAKOTAKZjAKOTAKOTAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgaGNpcmN1bWZsZXgKRU5DT0RJTkcgMjkzClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKNjAKOTAKODAKZTAKOTAKOTAKOTAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgSGJhcgpFTkNPRElORyAyOTQKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAo1MApmOAo1MAo3MAo1MAo1MAowMApFTkRDSEFSClNUQVJUQ0hBUiBoYmFyCkVOQ09ESU5HIDI5NQpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjQwCmYwCjQwCjcwCjQ4CjQ4CjAwCkVORENIQVIKU1RBUlRDSEFSIEl0aWxkZQpFTkNPRElORyAyOTYKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAo1MAphMAplMAo0MAo0MAo0MAplMAowMApFTkRDSEFSClNUQVJUQ0hBUiBpdGlsZGUKRU5DT0RJTkcgMjk3ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKNTAKYTAKMDAKYzAKNDAKNDAKZTAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgSW1hY3JvbgpFTkNPRElORyAyOTgKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAo3MAowMAo3MAoyMAoyMAoyMAo3MAowMApFTkRDSEFSClNUQVJUQ0hBUiBpbWFjcm9uCkVOQ09ESU5HIDI5OQpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjcwCjAwCjYwCjIwCjIwCjcwCjAwCkVORENIQVIKU1RBUlRDSEFSIElicmV2ZQpFTkNPRElORyAzMDAKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAo5MAo2MAo3MAoyMAoyMAoyMAo3MAowMApFTkRDSEFSClNUQVJUQ0hBUiBpYnJldmUKRU5DT0RJTkcgMzAxClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKOTAKNjAKMDAKNjAKMjAKMjAKNzAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgSW9nb25lawpFTkNPRElORyAzMDIKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAo3MAoyMAoyMAoyMAoyMAo3MAoxMApFTkRDSEFSClNUQVJUQ0hBUiBpb2dvbmVrCkVOQ09ESU5HIDMwMwpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjIwCjAwCjYwCjIwCjIwCjcwCjEwCkVORENIQVIKU1RBUlRDSEFSIElkb3RhY2NlbnQKRU5DT0RJTkcgMzA0ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMjAKMDAKNzAKMjAKMjAKMjAKNzAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgZG90bGVzc2kKRU5DT0RJTkcgMzA1ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMDAKMDAKNjAKMjAKMjAKNzAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgSUoKRU5DT0RJTkcgMzA2ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKYjAKOTAKOTAKOTAKZDAKYTAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgaWoKRU5DT0RJTkcgMzA3ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKNTAKMDAKNTAKNTAKNTAKNTAKMjAKRU5EQ0hBUgpTVEFSVENIQVIgSmNpcmN1bWZsZXgKRU5DT0RJTkcgMzA4ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMjAKNTAKNzAKMjAKMjAKYTAKNDAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgamNpcmN1bWZsZXgKRU5DT0RJTkcgMzA5ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMjAKNTAKMDAKMjAKMjAKMjAKYTAKNDAKRU5EQ0hBUgpTVEFSVENIQVIgS2NvbW1hYWNjZW50CkVOQ09ESU5HIDMxMApTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjQ4CjUwCjYwCjUwCjUwCjQ4CjgwCkVORENIQVIKU1RBUlRDSEFSIGtjb21tYWFjY2VudApFTkNPRElORyAzMTEKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAo0MAo0MAo0OAo3MAo0OAo0OAo4MApFTkRDSEFSClNUQVJUQ0hBUiBrZ3JlZW5sYW5kaWMKRU5DT0RJTkcgMzEyClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMDAKMDAKNTAKNjAKNTAKNTAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgTGFjdXRlCkVOQ09ESU5HIDMxMwpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjEwCjIwCjgwCjgwCjgwCjgwCmYwCjAwCkVORENIQVIKU1RBUlRDSEFSIGxhY3V0ZQpFTkNPRElORyAzMTQKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAoxMAoyMAo2MAoyMAoyMAoyMAo3MAowMApFTkRDSEFSClNUQVJUQ0hBUiBMY29tbWFhY2NlbnQKRU5DT0RJTkcgMzE1ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKODAKODAKODAKODAKODAKZjAKMjAKRU5EQ0hBUgpTVEFSVENIQVIgbGNvbW1hYWNjZW50CkVOQ09ESU5HIDMxNgpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjYwCjIwCjIwCjIwCjIwCjcwCjIwCkVORENIQVIKU1RBUlRDSEFSIExjYXJvbgpFTkNPRElORyAzMTcKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAo1MAoyMAo4MAo4MAo4MAo4MApmMAowMApFTkRDSEFSClNUQVJUQ0hBUiBsY2Fyb24KRU5DT0RJTkcgMzE4ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKNTAKMjAKNjAKMjAKMjAKMjAKNzAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgTGRvdApFTkNPRElORyAzMTkKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAo4MAo4MAphMAo4MAo4MApmMAowMApFTkRDSEFSClNUQVJUQ0hBUiBsZG90CkVOQ09ESU5HIDMyMApTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCmMwCjQwCjUwCjQwCjQwCmUwCjAwCkVORENIQVIKU1RBUlRDSEFSIExzbGFzaApFTkNPRElORyAzMjEKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAo0MAo2MApjMAo0MAo0MAo3OAowMApFTkRDSEFSClNUQVJUQ0hBUiBsc2xhc2gKRU5DT0RJTkcgMzIyClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKNjAKMjAKMzAKNjAKMjAKNzAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgTmFjdXRlCkVOQ09ESU5HIDMyMwpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjIwCjQwCjkwCmQwCmIwCjkwCjkwCjAwCkVORENIQVIKU1RBUlRDSEFSIG5hY3V0ZQpFTkNPRElORyAzMjQKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAoyMAo0MAowMAplMAo5MAo5MAo5MAowMApFTkRDSEFSClNUQVJUQ0hBUiBOY29tbWFhY2NlbnQKRU5DT0RJTkcgMzI1ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKOTAKZDAKZjAKYjAKYjAKOTAKNDAKRU5EQ0hBUgpTVEFSVENIQVIgbmNvbW1hYWNjZW50CkVOQ09ESU5HIDMyNgpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjAwCjAwCmUwCjkwCjkwCjkwCjQwCkVORENIQVIKU1RBUlRDSEFSIE5jYXJvbgpFTkNPRElORyAzMjcKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAo5MAo2MAo5MApkMApiMAo5MAo5MAowMApFTkRDSEFSClNUQVJUQ0hBUiBuY2Fyb24KRU5DT0RJTkcgMzI4ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKOTAKNjAKMDAKZTAKOTAKOTAKOTAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgbmFwb3N0cm9waGUKRU5DT0RJTkcgMzI5ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKYzAKNDAKODAKMzAKMjgKMjgKMjgKMDAKRU5EQ0hBUgpTVEFSVENIQVIgRW5nCkVOQ09ESU5HIDMzMApTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjkwCmQwCmYwCmIwCmIwCjkwCjIwCkVORENIQVIKU1RBUlRDSEFSIGVuZwpFTkNPRElORyAzMzEKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAowMAowMAplMAo5MAo5MAo5MAoyMApFTkRDSEFSClNUQVJUQ0hBUiBPbWFjcm9uCkVOQ09ESU5HIDMzMgpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCmYwCjAwCjYwCjkwCjkwCjkwCjYwCjAwCkVORENIQVIKU1RBUlRDSEFSIG9tYWNyb24KRU5DT0RJTkcgMzMzClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKZjAKMDAKNjAKOTAKOTAKNjAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgT2JyZXZlCkVOQ09ESU5HIDMzNApTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjkwCjYwCjYwCjkwCjkwCjkwCjYwCjAwCkVORENIQVIKU1RBUlRDSEFSIG9icmV2ZQpFTkNPRElORyAzMzUKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAo5MAo2MAowMAo2MAo5MAo5MAo2MAowMApFTkRDSEFSClNUQVJUQ0hBUiBPaHVuZ2FydW1sYXV0CkVOQ09ESU5HIDMzNgpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjQ4CjkwCjMwCjQ4CjQ4CjQ4CjMwCjAwCkVORENIQVIKU1RBUlRDSEFSIG9odW5nYXJ1bWxhdXQKRU5DT0RJTkcgMzM3ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKNDgKOTAKMDAKMzAKNDgKNDgKMzAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgT0UKRU5DT0RJTkcgMzM4ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKNzAKYTAKYjAKYTAKYTAKNzAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgb2UKRU5DT0RJTkcgMzM5ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMDAKMDAKNzAKYTgKYjAKNzgKMDAKRU5EQ0hBUgpTVEFSVENIQVIgUmFjdXRlCkVOQ09ESU5HIDM0MApTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjIwCjQwCmUwCjkwCmUwCjkwCjkwCjAwCkVORENIQVIKU1RBUlRDSEFSIHJhY3V0ZQpFTkNPRElORyAzNDEKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAoyMAo0MAowMAphMApkMAo4MAo4MAowMApFTkRDSEFSClNUQVJUQ0hBUiBSY29tbWFhY2NlbnQKRU5DT0RJTkcgMzQyClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKZTAKOTAKOTAKZTAKOTAKOTAKMjAKRU5EQ0hBUgpTVEFSVENIQVIgcmNvbW1hYWNjZW50CkVOQ09ESU5HIDM0MwpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjAwCjAwCjUwCjY4CjQwCjQwCjgwCkVORENIQVIKU1RBUlRDSEFSIFJjYXJvbgpFTkNPRElORyAzNDQKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAo5MAo2MAplMAo5MAplMAo5MAo5MAowMApFTkRDSEFSClNUQVJUQ0hBUiByY2Fyb24KRU5DT0RJTkcgMzQ1ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKOTAKNjAKMDAKYTAKZDAKODAKODAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgU2FjdXRlCkVOQ09ESU5HIDM0NgpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjIwCjQwCjcwCjgwCjYwCjEwCmUwCjAwCkVORENIQVIKU1RBUlRDSEFSIHNhY3V0ZQpFTkNPRElORyAzNDcKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAoxMAoyMAowMAozMAo2MAoxMAo2MAowMApFTkRDSEFSClNUQVJUQ0hBUiBTY2lyY3VtZmxleApFTkNPRElORyAzNDgKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAo2MAo5MAo3MAo4MAo2MAoxMAplMAowMApFTkRDSEFSClNUQVJUQ0hBUiBzY2lyY3VtZmxleApFTkNPRElORyAzNDkKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAoyMAo1MAowMAozMAo2MAoxMAo2MAowMApFTkRDSEFSClNUQVJUQ0hBUiBTY2VkaWxsYQpFTkNPRElORyAzNTAKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAo2MAo5MAo0MAoyMAo5MAo2MAoyMApFTkRDSEFSClNUQVJUQ0hBUiBzY2VkaWxsYQpFTkNPRElORyAzNTEKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAowMAowMAozMAo2MAoxMAo2MAoyMApFTkRDSEFSClNUQVJUQ0hBUiBTY2Fyb24KRU5DT0RJTkcgMzUyClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKOTAKNjAKNzAKODAKNjAKMTAKZTAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgc2Nhcm9uCkVOQ09ESU5HIDM1MwpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjUwCjIwCjAwCjMwCjYwCjEwCjYwCjAwCkVORENIQVIKU1RBUlRDSEFSIFRjb21tYWFjY2VudApFTkNPRElORyAzNTQKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAo3MAoyMAoyMAoyMAoyMAoyMAo0MApFTkRDSEFSClNUQVJUQ0hBUiB0Y29tbWFhY2NlbnQKRU5DT0RJTkcgMzU1ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKNDAKNDAKZTAKNDAKNTAKMjAKNDAKRU5EQ0hBUgpTVEFSVENIQVIgVGNhcm9uCkVOQ09ESU5HIDM1NgpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjUwCjIwCjcwCjIwCjIwCjIwCjIwCjAwCkVORENIQVIKU1RBUlRDSEFSIHRjYXJvbgpFTkNPRElORyAzNTcKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAphMAo0MAo0MAplMAo0MAo1MAoyMAowMApFTkRDSEFSClNUQVJUQ0hBUiBUYmFyCkVOQ09ESU5HIDM1OApTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjcwCjIwCjcwCjIwCjIwCjIwCjAwCkVORENIQVIKU1RBUlRDSEFSIHRiYXIKRU5DT0RJTkcgMzU5ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKNDAKZTAKNDAKZTAKNDAKNTAKMjAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgVXRpbGRlCkVOQ09ESU5HIDM2MApTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjUwCmEwCjAwCjkwCjkwCjkwCjYwCjAwCkVORENIQVIKU1RBUlRDSEFSIHV0aWxkZQpFTkNPRElORyAzNjEKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAo1MAphMAowMAo5MAo5MAo5MAo3MAowMApFTkRDSEFSClNUQVJUQ0hBUiBVbWFjcm9uCkVOQ09ESU5HIDM2MgpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCmYwCjAwCjkwCjkwCjkwCjkwCjYwCjAwCkVORENIQVIKU1RBUlRDSEFSIHVtYWNyb24KRU5DT0RJTkcgMzYzClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKZjAKMDAKOTAKOTAKOTAKNzAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgVWJyZXZlCkVOQ09ESU5HIDM2NApTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjkwCjYwCjAwCjkwCjkwCjkwCjYwCjAwCkVORENIQVIKU1RBUlRDSEFSIHVicmV2ZQpFTkNPRElORyAzNjUKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAo5MAo2MAowMAo5MAo5MAo5MAo3MAowMApFTkRDSEFSClNUQVJUQ0hBUiBVcmluZwpFTkNPRElORyAzNjYKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAoyMAo1MAoyMAo5MAo5MAo5MAo2MAowMApFTkRDSEFSClNUQVJUQ0hBUiB1cmluZwpFTkNPRElORyAzNjcKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAoyMAo1MAoyMAo5MAo5MAo5MAo3MAowMApFTkRDSEFSClNUQVJUQ0hBUiBVaHVuZ2FydW1sYXV0CkVOQ09ESU5HIDM2OApTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjQ4CjkwCjAwCjQ4CjQ4CjQ4CjMwCjAwCkVORENIQVIKU1RBUlRDSEFSIHVodW5nYXJ1bWxhdXQKRU5DT0RJTkcgMzY5ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKNDgKOTAKMDAKNDgKNDgKNDgKMzgKMDAKRU5EQ0hBUgpTVEFSVENIQVIgVW9nb25lawpFTkNPRElORyAzNzAKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAo5MAo5MAo5MAo5MAo5MAo2MAoyMApFTkRDSEFSClNUQVJUQ0hBUiB1b2dvbmVrCkVOQ09ESU5HIDM3MQpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjAwCjAwCjkwCjkwCjkwCjcwCjIwCkVORENIQVIKU1RBUlRDSEFSIFdjaXJjdW1mbGV4CkVOQ09ESU5HIDM3MgpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjYwCjAwCjkwCjkwCmYwCmYwCjkwCjAwCkVORENIQVIKU1RBUlRDSEFSIHdjaXJjdW1mbGV4CkVOQ09ESU5HIDM3MwpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjIwCjUwCjAwCjg4CmE4CmE4CjUwCjAwCkVORENIQVIKU1RBUlRDSEFSIFljaXJjdW1mbGV4CkVOQ09ESU5HIDM3NApTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjIwCjUwCjAwCjUwCjUwCjIwCjIwCjAwCkVORENIQVIKU1RBUlRDSEFSIHljaXJjdW1mbGV4CkVOQ09ESU5HIDM3NQpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjYwCjkwCjAwCjkwCjkwCjcwCjkwCjYwCkVORENIQVIKU1RBUlRDSEFSIFlkaWVyZXNpcwpFTkNPRElORyAzNzYKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAo1MAowMAo4OAo1MAoyMAoyMAoyMAowMApFTkRDSEFSClNUQVJUQ0hBUiBaYWN1dGUKRU5DT0RJTkcgMzc3ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMjAKNDAKZjAKMjAKNDAKODAKZjAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgemFjdXRlCkVOQ09ESU5HIDM3OApTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjIwCjQwCjAwCmYwCjIwCjQwCmYwCjAwCkVORENIQVIKU1RBUlRDSEFSIFpkb3RhY2NlbnQKRU5DT0RJTkcgMzc5ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKNjAKMDAKZjAKMjAKNDAKODAKZjAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgemRvdGFjY2VudApFTkNPRElORyAzODAKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAo2MAowMApmMAoyMAo0MApmMAowMApFTkRDSEFSClNUQVJUQ0hBUiBaY2Fyb24KRU5DT0RJTkcgMzgxClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKOTAKNjAKZjAKMjAKNDAKODAKZjAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgemNhcm9uCkVOQ09ESU5HIDM4MgpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjkwCjYwCjAwCmYwCjIwCjQwCmYwCjAwCkVORENIQVIKU1RBUlRDSEFSIGxvbmdzCkVOQ09ESU5HIDM4MwpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjIwCjUwCjQwCmMwCjQwCjQwCjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTAxOEYKRU5DT0RJTkcgMzk5ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKNjAKOTAKMTAKZjAKOTAKNjAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgZmxvcmluCkVOQ09ESU5HIDQwMgpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjEwCjI4CjIwCjcwCjIwCmEwCjQwCkVORENIQVIKU1RBUlRDSEFSIE9ob3JuCkVOQ09ESU5HIDQxNgpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjY4CjkwCjkwCjkwCjkwCjYwCjAwCkVORENIQVIKU1RBUlRDSEFSIG9ob3JuCkVOQ09ESU5HIDQxNwpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjAwCjAwCjY4CjkwCjkwCjYwCjAwCkVORENIQVIKU1RBUlRDSEFSIFVob3JuCkVOQ09ESU5HIDQzMQpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCmE4CmIwCmEwCmEwCmEwCjQwCjAwCkVORENIQVIKU1RBUlRDSEFSIHVob3JuCkVOQ09ESU5HIDQzMgpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjAwCjAwCmE4CmIwCmEwCjYwCjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTAxQjUKRU5DT0RJTkcgNDM3ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKZjAKMTAKZjAKNDAKODAKZjAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMDFCNgpFTkNPRElORyA0MzgKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAowMAowMApmMApmMAo0MApmMAowMApFTkRDSEFSClNUQVJUQ0hBUiB1bmkwMUQxCkVOQ09ESU5HIDQ2NQpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjkwCjYwCjYwCjkwCjkwCjkwCjYwCjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTAxRDIKRU5DT0RJTkcgNDY2ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKOTAKNjAKMDAKNjAKOTAKOTAKNjAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgR2Nhcm9uCkVOQ09ESU5HIDQ4NgpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjkwCjYwCjcwCjgwCmIwCjkwCjcwCjAwCkVORENIQVIKU1RBUlRDSEFSIGdjYXJvbgpFTkNPRElORyA0ODcKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAo5MAo2MAowMAo2MAo5MAo3MAoxMAo2MApFTkRDSEFSClNUQVJUQ0hBUiBBcmluZ2FjdXRlCkVOQ09ESU5HIDUwNgpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjEwCjYwCjkwCjYwCjkwCmYwCjkwCjAwCkVORENIQVIKU1RBUlRDSEFSIGFyaW5nYWN1dGUKRU5DT0RJTkcgNTA3ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMTAKNjAKOTAKNjAKNzAKOTAKNzAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgQUVhY3V0ZQpFTkNPRElORyA1MDgKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAoxMAoyMAo3MAphMApmMAphMApiMAowMApFTkRDSEFSClNUQVJUQ0hBUiBhZWFjdXRlCkVOQ09ESU5HIDUwOQpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjEwCjIwCjAwCmYwCjY4CmIwCjc4CjAwCkVORENIQVIKU1RBUlRDSEFSIE9zbGFzaGFjdXRlCkVOQ09ESU5HIDUxMApTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjEwCjIwCjcwCmIwCmQwCmQwCmUwCjAwCkVORENIQVIKU1RBUlRDSEFSIG9zbGFzaGFjdXRlCkVOQ09ESU5HIDUxMQpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjEwCjIwCjAwCjcwCmIwCmQwCmUwCjAwCkVORENIQVIKU1RBUlRDSEFSIFNjb21tYWFjY2VudApFTkNPRElORyA1MzYKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAo2MAo5MAo0MAoyMAo5MAo2MAoyMApFTkRDSEFSClNUQVJUQ0hBUiBzY29tbWFhY2NlbnQKRU5DT0RJTkcgNTM3ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMDAKMDAKMzAKNjAKMTAKNjAKMjAKRU5EQ0hBUgpTVEFSVENIQVIgVGNvbW1hYWNjZW50CkVOQ09ESU5HIDUzOApTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjcwCjIwCjIwCjIwCjIwCjIwCjQwCkVORENIQVIKU1RBUlRDSEFSIHRjb21tYWFjY2VudApFTkNPRElORyA1MzkKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAo0MAo0MAplMAo0MAo1MAoyMAo0MApFTkRDSEFSClNUQVJUQ0hBUiB1bmkwMjU5CkVOQ09ESU5HIDYwMQpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjAwCjAwCjYwCjMwCmQwCjYwCjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTAyQkIKRU5DT0RJTkcgNjk5ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMjAKNDAKNjAKMDAKMDAKMDAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgYWZpaTU3OTI5CkVOQ09ESU5HIDcwMApTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjYwCjIwCjQwCjAwCjAwCjAwCjAwCkVORENIQVIKU1RBUlRDSEFSIGFmaWk2NDkzNwpFTkNPRElORyA3MDEKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAo2MAo0MAoyMAowMAowMAowMAowMApFTkRDSEFSClNUQVJUQ0hBUiB1bmkwMkJFCkVOQ09ESU5HIDcwMgpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjQwCjIwCjQwCjAwCjAwCjAwCjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTAyQkYKRU5DT0RJTkcgNzAzClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMjAKNDAKMjAKMDAKMDAKMDAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgY2lyY3VtZmxleApFTkNPRElORyA3MTAKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAoyMAo1MAowMAowMAowMAowMAowMAowMApFTkRDSEFSClNUQVJUQ0hBUiBjYXJvbgpFTkNPRElORyA3MTEKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAo1MAoyMAowMAowMAowMAowMAowMAowMApFTkRDSEFSClNUQVJUQ0hBUiBtYWNyb24KRU5DT0RJTkcgNzEzClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKNzAKMDAKMDAKMDAKMDAKMDAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgYnJldmUKRU5DT0RJTkcgNzI4ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKODgKNzAKMDAKMDAKMDAKMDAKMDAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgZG90YWNjZW50CkVOQ09ESU5HIDcyOQpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjIwCjAwCjAwCjAwCjAwCjAwCjAwCjAwCkVORENIQVIKU1RBUlRDSEFSIHJpbmcKRU5DT0RJTkcgNzMwClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMjAKNTAKMjAKMDAKMDAKMDAKMDAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgb2dvbmVrCkVOQ09ESU5HIDczMQpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjAwCjAwCjAwCjAwCjAwCjQwCjYwCkVORENIQVIKU1RBUlRDSEFSIHRpbGRlCkVOQ09ESU5HIDczMgpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjUwCmEwCjAwCjAwCjAwCjAwCjAwCkVORENIQVIKU1RBUlRDSEFSIGh1bmdhcnVtbGF1dApFTkNPRElORyA3MzMKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAo0OAo5MAowMAowMAowMAowMAowMAowMApFTkRDSEFSClNUQVJUQ0hBUiBncmF2ZWNvbWIKRU5DT0RJTkcgNzY4ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKNDAKMjAKMDAKMDAKMDAKMDAKMDAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgYWN1dGVjb21iCkVOQ09ESU5HIDc2OQpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjIwCjQwCjAwCjAwCjAwCjAwCjAwCjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTAzMDIKRU5DT0RJTkcgNzcwClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMjAKNTAKMDAKMDAKMDAKMDAKMDAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgdGlsZGVjb21iCkVOQ09ESU5HIDc3MQpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjUwCmEwCjAwCjAwCjAwCjAwCjAwCjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTAzMDQKRU5DT0RJTkcgNzcyClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKZjAKMDAKMDAKMDAKMDAKMDAKMDAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMDMwNQpFTkNPRElORyA3NzMKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUApmOAowMAowMAowMAowMAowMAowMAowMApFTkRDSEFSClNUQVJUQ0hBUiB1bmkwMzA2CkVOQ09ESU5HIDc3NApTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjkwCjYwCjAwCjAwCjAwCjAwCjAwCjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTAzMDcKRU5DT0RJTkcgNzc1ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMjAKMDAKMDAKMDAKMDAKMDAKMDAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMDMwOApFTkNPRElORyA3NzYKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAo1MAowMAowMAowMAowMAowMAowMAowMApFTkRDSEFSClNUQVJUQ0hBUiBob29rYWJvdmVjb21iCkVOQ09ESU5HIDc3NwpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjYwCjIwCjAwCjAwCjAwCjAwCjAwCjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTAzMEEKRU5DT0RJTkcgNzc4ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKNjAKOTAKNjAKMDAKMDAKMDAKMDAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMDMwQgpFTkNPRElORyA3NzkKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAo0OAo5MAowMAowMAowMAowMAowMAowMApFTkRDSEFSClNUQVJUQ0hBUiB1bmkwMzBDCkVOQ09ESU5HIDc4MApTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjUwCjIwCjAwCjAwCjAwCjAwCjAwCjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTAzMEQKRU5DT0RJTkcgNzgxClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMjAKMjAKMDAKMDAKMDAKMDAKMDAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMDMwRQpFTkNPRElORyA3ODIKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAo1MAo1MAowMAowMAowMAowMAowMAowMApFTkRDSEFSClNUQVJUQ0hBUiB1bmkwMzBGCkVOQ09ESU5HIDc4MwpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjkwCjQ4CjAwCjAwCjAwCjAwCjAwCjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTAzMTAKRU5DT0RJTkcgNzg0ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKYTgKNzAKMDAKMDAKMDAKMDAKMDAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMDMxMQpFTkNPRElORyA3ODUKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAo2MAo5MAowMAowMAowMAowMAowMAowMApFTkRDSEFSClNUQVJUQ0hBUiB1bmkwMzEyCkVOQ09ESU5HIDc4NgpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjIwCjQwCjYwCjAwCjAwCjAwCjAwCjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTAzMTMKRU5DT0RJTkcgNzg3ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKNjAKMjAKNDAKMDAKMDAKMDAKMDAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMDMxNApFTkNPRElORyA3ODgKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAo2MAo0MAoyMAowMAowMAowMAowMAowMApFTkRDSEFSClNUQVJUQ0hBUiB1bmkwMzE1CkVOQ09ESU5HIDc4OQpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjE4CjA4CjEwCjAwCjAwCjAwCjAwCjAwCkVORENIQVIKU1RBUlRDSEFSIGRvdGJlbG93Y29tYgpFTkNPRElORyA4MDMKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAowMAowMAowMAowMAowMAowMAoyMApFTkRDSEFSClNUQVJUQ0hBUiB1bmkwMzI0CkVOQ09ESU5HIDgwNApTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjAwCjAwCjAwCjAwCjAwCjAwCjUwCkVORENIQVIKU1RBUlRDSEFSIHVuaTAzMzEKRU5DT0RJTkcgODE3ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMDAKMDAKMDAKMDAKMDAKMDAKZjAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMDMzMgpFTkNPRElORyA4MTgKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAowMAowMAowMAowMAowMAowMApmOApFTkRDSEFSClNUQVJUQ0hBUiB1bmkwMzM4CkVOQ09ESU5HIDgyNApTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjEwCjIwCjIwCjQwCjQwCjgwCjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTAzNDAKRU5DT0RJTkcgODMyClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKNDAKMjAKMDAKMDAKMDAKMDAKMDAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMDM0MQpFTkNPRElORyA4MzMKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAoyMAo0MAowMAowMAowMAowMAowMAowMApFTkRDSEFSClNUQVJUQ0hBUiB1bmkwMzc0CkVOQ09ESU5HIDg4NApTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjIwCjQwCjAwCjAwCjAwCjAwCjAwCjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTAzNzUKRU5DT0RJTkcgODg1ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMDAKMDAKMDAKMDAKMDAKMjAKNDAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMDM3QQpFTkNPRElORyA4OTAKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAowMAowMAowMAowMAowMAo0MAo2MApFTkRDSEFSClNUQVJUQ0hBUiB1bmkwMzdFCkVOQ09ESU5HIDg5NApTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjAwCjMwCjMwCjAwCjMwCjIwCjQwCkVORENIQVIKU1RBUlRDSEFSIHRvbm9zCkVOQ09ESU5HIDkwMApTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjIwCjQwCjAwCjAwCjAwCjAwCjAwCjAwCkVORENIQVIKU1RBUlRDSEFSIGRpZXJlc2lzdG9ub3MKRU5DT0RJTkcgOTAxClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMTAKMjAKNTAKMDAKMDAKMDAKMDAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgQWxwaGF0b25vcwpFTkNPRElORyA5MDIKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAoyMAo0MAo2MAo5MApmMAo5MAo5MAowMApFTkRDSEFSClNUQVJUQ0hBUiBhbm90ZWxlaWEKRU5DT0RJTkcgOTAzClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMDAKMDAKMjAKMDAKMDAKMDAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgRXBzaWxvbnRvbm9zCkVOQ09ESU5HIDkwNApTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjIwCjQwCmYwCjgwCmUwCjgwCmYwCjAwCkVORENIQVIKU1RBUlRDSEFSIEV0YXRvbm9zCkVOQ09ESU5HIDkwNQpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjIwCjQwCjkwCjkwCmYwCjkwCjkwCjAwCkVORENIQVIKU1RBUlRDSEFSIElvdGF0b25vcwpFTkNPRElORyA5MDYKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAoxMAoyMAo3MAoyMAoyMAoyMAo3MAowMApFTkRDSEFSClNUQVJUQ0hBUiBPbWljcm9udG9ub3MKRU5DT0RJTkcgOTA4ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMjAKNDAKNjAKOTAKOTAKOTAKNjAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgVXBzaWxvbnRvbm9zCkVOQ09ESU5HIDkxMApTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjEwCjIwCjg4CjUwCjIwCjIwCjIwCjAwCkVORENIQVIKU1RBUlRDSEFSIE9tZWdhdG9ub3MKRU5DT0RJTkcgOTExClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMTAKMjAKNzAKODgKODgKNTAKZDgKMDAKRU5EQ0hBUgpTVEFSVENIQVIgaW90YWRpZXJlc2lzdG9ub3MKRU5DT0RJTkcgOTEyClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMjAKNDAKYTAKNDAKNDAKNTAKMjAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgQWxwaGEKRU5DT0RJTkcgOTEzClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKNjAKOTAKOTAKZjAKOTAKOTAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgQmV0YQpFTkNPRElORyA5MTQKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAplMAo5MAplMAo5MAo5MAplMAowMApFTkRDSEFSClNUQVJUQ0hBUiBHYW1tYQpFTkNPRElORyA5MTUKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMApmMAo4MAo4MAo4MAo4MAo4MAowMApFTkRDSEFSClNUQVJUQ0hBUiBEZWx0YQpFTkNPRElORyA5MTYKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAoyMAoyMAo1MAo1MAo4OApmOAowMApFTkRDSEFSClNUQVJUQ0hBUiBFcHNpbG9uCkVOQ09ESU5HIDkxNwpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCmYwCjgwCmUwCjgwCjgwCmYwCjAwCkVORENIQVIKU1RBUlRDSEFSIFpldGEKRU5DT0RJTkcgOTE4ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKZjAKMTAKMjAKNDAKODAKZjAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgRXRhCkVOQ09ESU5HIDkxOQpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjkwCjkwCmYwCjkwCjkwCjkwCjAwCkVORENIQVIKU1RBUlRDSEFSIFRoZXRhCkVOQ09ESU5HIDkyMApTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjYwCjkwCmYwCjkwCjkwCjYwCjAwCkVORENIQVIKU1RBUlRDSEFSIElvdGEKRU5DT0RJTkcgOTIxClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKNzAKMjAKMjAKMjAKMjAKNzAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgS2FwcGEKRU5DT0RJTkcgOTIyClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKOTAKYTAKYzAKYTAKYTAKOTAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgTGFtYmRhCkVOQ09ESU5HIDkyMwpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjYwCjYwCjkwCjkwCjkwCjkwCjAwCkVORENIQVIKU1RBUlRDSEFSIE11CkVOQ09ESU5HIDkyNApTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjkwCmYwCmYwCjkwCjkwCjkwCjAwCkVORENIQVIKU1RBUlRDSEFSIE51CkVOQ09ESU5HIDkyNQpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjkwCmQwCmYwCmIwCmIwCjkwCjAwCkVORENIQVIKU1RBUlRDSEFSIFhpCkVOQ09ESU5HIDkyNgpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCmYwCjAwCjYwCjAwCjAwCmYwCjAwCkVORENIQVIKU1RBUlRDSEFSIE9taWNyb24KRU5DT0RJTkcgOTI3ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKNjAKOTAKOTAKOTAKOTAKNjAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgUGkKRU5DT0RJTkcgOTI4ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKZjAKOTAKOTAKOTAKOTAKOTAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgUmhvCkVOQ09ESU5HIDkyOQpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCmUwCjkwCjkwCmUwCjgwCjgwCjAwCkVORENIQVIKU1RBUlRDSEFSIFNpZ21hCkVOQ09ESU5HIDkzMQpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCmYwCjgwCjQwCjQwCjgwCmYwCjAwCkVORENIQVIKU1RBUlRDSEFSIFRhdQpFTkNPRElORyA5MzIKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAo3MAoyMAoyMAoyMAoyMAoyMAowMApFTkRDSEFSClNUQVJUQ0hBUiBVcHNpbG9uCkVOQ09ESU5HIDkzMwpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjg4Cjg4CjUwCjIwCjIwCjIwCjAwCkVORENIQVIKU1RBUlRDSEFSIFBoaQpFTkNPRElORyA5MzQKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAoyMAo3MAphOAphOAo3MAoyMAowMApFTkRDSEFSClNUQVJUQ0hBUiBDaGkKRU5DT0RJTkcgOTM1ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKOTAKOTAKNjAKNjAKOTAKOTAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgUHNpCkVOQ09ESU5HIDkzNgpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCmE4CmE4CjcwCjIwCjIwCjIwCjAwCkVORENIQVIKU1RBUlRDSEFSIE9tZWdhCkVOQ09ESU5HIDkzNwpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjcwCjg4Cjg4Cjg4CjUwCmQ4CjAwCkVORENIQVIKU1RBUlRDSEFSIElvdGFkaWVyZXNpcwpFTkNPRElORyA5MzgKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAo1MAowMAo3MAoyMAoyMAoyMAo3MAowMApFTkRDSEFSClNUQVJUQ0hBUiBVcHNpbG9uZGllcmVzaXMKRU5DT0RJTkcgOTM5ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKNTAKMDAKNTAKNTAKMjAKMjAKMjAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgYWxwaGF0b25vcwpFTkNPRElORyA5NDAKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAoyMAo0MAowMAo3MAo5MAo5MAo3MAowMApFTkRDSEFSClNUQVJUQ0hBUiBlcHNpbG9udG9ub3MKRU5DT0RJTkcgOTQxClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMjAKNDAKMDAKNzAKZTAKODAKNzAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgZXRhdG9ub3MKRU5DT0RJTkcgOTQyClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMjAKNDAKMDAKYTAKZDAKOTAKOTAKMTAKRU5EQ0hBUgpTVEFSVENIQVIgaW90YXRvbm9zCkVOQ09ESU5HIDk0MwpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjIwCjQwCjAwCjQwCjQwCjUwCjIwCjAwCkVORENIQVIKU1RBUlRDSEFSIHVwc2lsb25kaWVyZXNpc3Rvbm9zCkVOQ09ESU5HIDk0NApTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjIwCmQwCjAwCjkwCjkwCjkwCjYwCjAwCkVORENIQVIKU1RBUlRDSEFSIGFscGhhCkVOQ09ESU5HIDk0NQpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjAwCjAwCjcwCjkwCjkwCjcwCjAwCkVORENIQVIKU1RBUlRDSEFSIGJldGEKRU5DT0RJTkcgOTQ2ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKNjAKOTAKZTAKOTAKOTAKZTAKODAKRU5EQ0hBUgpTVEFSVENIQVIgZ2FtbWEKRU5DT0RJTkcgOTQ3ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMDAKMDAKNTAKNTAKNTAKMjAKMjAKRU5EQ0hBUgpTVEFSVENIQVIgZGVsdGEKRU5DT0RJTkcgOTQ4ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKNjAKODAKNjAKOTAKOTAKNjAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgZXBzaWxvbgpFTkNPRElORyA5NDkKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAowMAowMAo3MAplMAo4MAo3MAowMApFTkRDSEFSClNUQVJUQ0hBUiB6ZXRhCkVOQ09ESU5HIDk1MApTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjAwCmYwCjQwCjgwCjYwCjEwCjIwCkVORENIQVIKU1RBUlRDSEFSIGV0YQpFTkNPRElORyA5NTEKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAowMAowMAphMApkMAo5MAo5MAoxMApFTkRDSEFSClNUQVJUQ0hBUiB0aGV0YQpFTkNPRElORyA5NTIKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAo2MAo5MApmMAo5MAo5MAo2MAowMApFTkRDSEFSClNUQVJUQ0hBUiBpb3RhCkVOQ09ESU5HIDk1MwpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjAwCjAwCjQwCjQwCjUwCjIwCjAwCkVORENIQVIKU1RBUlRDSEFSIGthcHBhCkVOQ09ESU5HIDk1NApTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjAwCjAwCjkwCmUwCmEwCjkwCjAwCkVORENIQVIKU1RBUlRDSEFSIGxhbWJkYQpFTkNPRElORyA5NTUKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAplMAoxMAo3MAo5MAo5MAo5MAowMApFTkRDSEFSClNUQVJUQ0hBUiBtdQpFTkNPRElORyA5NTYKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAowMAowMAo5MAo5MAo5MAplMAo4MApFTkRDSEFSClNUQVJUQ0hBUiBudQpFTkNPRElORyA5NTcKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAowMAowMAo1MAo1MAoyMAoyMAowMApFTkRDSEFSClNUQVJUQ0hBUiB4aQpFTkNPRElORyA5NTgKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMApmMAo0MAo2MAo4MAo2MAoxMAo2MApFTkRDSEFSClNUQVJUQ0hBUiBvbWljcm9uCkVOQ09ESU5HIDk1OQpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjAwCjAwCjYwCjkwCjkwCjYwCjAwCkVORENIQVIKU1RBUlRDSEFSIHBpCkVOQ09ESU5HIDk2MApTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjAwCjAwCmY4CjUwCjUwCjUwCjAwCkVORENIQVIKU1RBUlRDSEFSIHJobwpFTkNPRElORyA5NjEKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAowMAowMAo2MAo5MAo5MAplMAo4MApFTkRDSEFSClNUQVJUQ0hBUiBzaWdtYTEKRU5DT0RJTkcgOTYyClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMDAKMDAKNjAKODAKNjAKMTAKNjAKRU5EQ0hBUgpTVEFSVENIQVIgc2lnbWEKRU5DT0RJTkcgOTYzClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMDAKMDAKNzAKOTAKOTAKNjAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgdGF1CkVOQ09ESU5HIDk2NApTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjAwCjAwCmYwCjQwCjUwCjIwCjAwCkVORENIQVIKU1RBUlRDSEFSIHVwc2lsb24KRU5DT0RJTkcgOTY1ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMDAKMDAKOTAKOTAKOTAKNjAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgcGhpCkVOQ09ESU5HIDk2NgpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjAwCjAwCmIwCmE4CmE4CjcwCjIwCkVORENIQVIKU1RBUlRDSEFSIGNoaQpFTkNPRElORyA5NjcKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAowMAowMAo1MAo1MAoyMAo1MAo1MApFTkRDSEFSClNUQVJUQ0hBUiBwc2kKRU5DT0RJTkcgOTY4ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMDAKMDAKYTgKYTgKNzAKMjAKMjAKRU5EQ0hBUgpTVEFSVENIQVIgb21lZ2EKRU5DT0RJTkcgOTY5ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMDAKMDAKODgKYTgKYTgKNTAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgaW90YWRpZXJlc2lzCkVOQ09ESU5HIDk3MApTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCmEwCjAwCjQwCjQwCjUwCjIwCjAwCkVORENIQVIKU1RBUlRDSEFSIHVwc2lsb25kaWVyZXNpcwpFTkNPRElORyA5NzEKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAo5MAowMAo5MAo5MAo5MAo2MAowMApFTkRDSEFSClNUQVJUQ0hBUiBvbWljcm9udG9ub3MKRU5DT0RJTkcgOTcyClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMjAKNDAKMDAKNjAKOTAKOTAKNjAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgdXBzaWxvbnRvbm9zCkVOQ09ESU5HIDk3MwpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjIwCjQwCjAwCjkwCjkwCjkwCjYwCjAwCkVORENIQVIKU1RBUlRDSEFSIG9tZWdhdG9ub3MKRU5DT0RJTkcgOTc0ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMTAKMjAKMDAKODgKYTgKYTgKNTAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgdGhldGExCkVOQ09ESU5HIDk3NwpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjYwCjkwCjc4CjEwCjkwCjYwCjAwCkVORENIQVIKU1RBUlRDSEFSIFVwc2lsb24xCkVOQ09ESU5HIDk3OApTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjkwCjY4CjIwCjIwCjIwCjIwCjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTAzRDMKRU5DT0RJTkcgOTc5ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKZDAKMjgKYTAKYTAKMjAKMjAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMDNENApFTkNPRElORyA5ODAKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAo5MAowMAo5MAo2OAoyMAoyMAoyMAowMApFTkRDSEFSClNUQVJUQ0hBUiBwaGkxCkVOQ09ESU5HIDk4MQpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjIwCjcwCmE4CmE4CmE4CjcwCjIwCkVORENIQVIKU1RBUlRDSEFSIG9tZWdhMQpFTkNPRElORyA5ODIKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAowMAowMApmOAo4OAphOAo1MAowMApFTkRDSEFSClNUQVJUQ0hBUiB1bmkwM0RFCkVOQ09ESU5HIDk5MApTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjgwCjkwCmIwCmQwCjkwCjEwCjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTAzREYKRU5DT0RJTkcgOTkxClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMjAKNDAKZjAKMTAKMjAKNDAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMDNFOQpFTkNPRElORyAxMDAxClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMDAKMDAKNjAKOTAKNjAKODAKNzAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMDNGMApFTkNPRElORyAxMDA4ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMDAKMDAKOTAKNTAKNjAKOTAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMDNGMQpFTkNPRElORyAxMDA5ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMDAKMDAKNjAKOTAKZTAKODAKNzAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMDNGMgpFTkNPRElORyAxMDEwClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMDAKMDAKNzAKODAKODAKNzAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMDNGMwpFTkNPRElORyAxMDExClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMTAKMDAKMzAKMTAKMTAKOTAKNjAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMDNGNApFTkNPRElORyAxMDEyClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKNjAKOTAKZjAKOTAKOTAKNjAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMDNGNQpFTkNPRElORyAxMDEzClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMDAKMDAKNzAKZTAKODAKNzAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgYWZpaTEwMDIzCkVOQ09ESU5HIDEwMjUKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMApmMAo4MAplMAo4MAo4MApmMAowMApFTkRDSEFSClNUQVJUQ0hBUiBhZmlpMTAwNTEKRU5DT0RJTkcgMTAyNgpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCmUwCjQwCjYwCjUwCjUwCjEwCjIwCkVORENIQVIKU1RBUlRDSEFSIGFmaWkxMDA1MgpFTkNPRElORyAxMDI3ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKZjAKODAKODAKODAKODAKODAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgYWZpaTEwMDUzCkVOQ09ESU5HIDEwMjgKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAo2MAo5MApjMAo4MAo5MAo2MAowMApFTkRDSEFSClNUQVJUQ0hBUiBhZmlpMTAwNTQKRU5DT0RJTkcgMTAyOQpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjYwCjkwCjQwCjIwCjkwCjYwCjAwCkVORENIQVIKU1RBUlRDSEFSIGFmaWkxMDA1NQpFTkNPRElORyAxMDMwClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKNzAKMjAKMjAKMjAKMjAKNzAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgYWZpaTEwMDU2CkVOQ09ESU5HIDEwMzEKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAo3MAoyMAoyMAoyMAoyMAo3MAowMApFTkRDSEFSClNUQVJUQ0hBUiBhZmlpMTAwNTcKRU5DT0RJTkcgMTAzMgpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjEwCjEwCjEwCjEwCjkwCjYwCjAwCkVORENIQVIKU1RBUlRDSEFSIGFmaWkxMDA1OApFTkNPRElORyAxMDMzClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKNjAKYTAKYjAKYTgKYTgKYjAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgYWZpaTEwMDU5CkVOQ09ESU5HIDEwMzQKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAphMAphMApmMAphOAphOApiMAowMApFTkRDSEFSClNUQVJUQ0hBUiBhZmlpMTAwNjAKRU5DT0RJTkcgMTAzNQpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCmUwCjQwCjYwCjUwCjUwCjUwCjAwCkVORENIQVIKU1RBUlRDSEFSIGFmaWkxMDA2MQpFTkNPRElORyAxMDM2ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKOTAKYTAKYzAKYzAKYTAKOTAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMDQwRApFTkNPRElORyAxMDM3ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMDAKMDAKMDAKMDAKMDAKMDAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgYWZpaTEwMDYyCkVOQ09ESU5HIDEwMzgKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAo1MAo1MAo1MAoyMAoyMAo0MAowMApFTkRDSEFSClNUQVJUQ0hBUiBhZmlpMTAxNDUKRU5DT0RJTkcgMTAzOQpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjUwCjUwCjUwCjUwCjUwCjcwCjIwCkVORENIQVIKU1RBUlRDSEFSIGFmaWkxMDAxNwpFTkNPRElORyAxMDQwClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKNjAKOTAKOTAKZjAKOTAKOTAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgYWZpaTEwMDE4CkVOQ09ESU5HIDEwNDEKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAplMAo4MAplMAo5MAo5MAplMAowMApFTkRDSEFSClNUQVJUQ0hBUiBhZmlpMTAwMTkKRU5DT0RJTkcgMTA0MgpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCmUwCjkwCmUwCjkwCjkwCmUwCjAwCkVORENIQVIKU1RBUlRDSEFSIGFmaWkxMDAyMApFTkNPRElORyAxMDQzClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKZjAKOTAKODAKODAKODAKODAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgYWZpaTEwMDIxCkVOQ09ESU5HIDEwNDQKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAo2MAphMAphMAphMAphMApmMAo5MApFTkRDSEFSClNUQVJUQ0hBUiBhZmlpMTAwMjIKRU5DT0RJTkcgMTA0NQpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCmYwCjgwCmUwCjgwCjgwCmYwCjAwCkVORENIQVIKU1RBUlRDSEFSIGFmaWkxMDAyNApFTkNPRElORyAxMDQ2ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKYTgKYTgKNzAKYTgKYTgKYTgKMDAKRU5EQ0hBUgpTVEFSVENIQVIgYWZpaTEwMDI1CkVOQ09ESU5HIDEwNDcKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAo2MAo5MAoyMAoxMAo5MAo2MAowMApFTkRDSEFSClNUQVJUQ0hBUiBhZmlpMTAwMjYKRU5DT0RJTkcgMTA0OApTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjkwCjkwCmIwCmQwCjkwCjkwCjAwCkVORENIQVIKU1RBUlRDSEFSIGFmaWkxMDAyNwpFTkNPRElORyAxMDQ5ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKOTAKNjAKOTAKYjAKZDAKOTAKOTAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgYWZpaTEwMDI4CkVOQ09ESU5HIDEwNTAKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAo5MAphMApjMAphMAo5MAo5MAowMApFTkRDSEFSClNUQVJUQ0hBUiBhZmlpMTAwMjkKRU5DT0RJTkcgMTA1MQpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjMwCjUwCjUwCjUwCjUwCjkwCjAwCkVORENIQVIKU1RBUlRDSEFSIGFmaWkxMDAzMApFTkNPRElORyAxMDUyClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKOTAKZjAKZjAKOTAKOTAKOTAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgYWZpaTEwMDMxCkVOQ09ESU5HIDEwNTMKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAo5MAo5MApmMAo5MAo5MAo5MAowMApFTkRDSEFSClNUQVJUQ0hBUiBhZmlpMTAwMzIKRU5DT0RJTkcgMTA1NApTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjYwCjkwCjkwCjkwCjkwCjYwCjAwCkVORENIQVIKU1RBUlRDSEFSIGFmaWkxMDAzMwpFTkNPRElORyAxMDU1ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKZjAKOTAKOTAKOTAKOTAKOTAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgYWZpaTEwMDM0CkVOQ09ESU5HIDEwNTYKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAplMAo5MAo5MAplMAo4MAo4MAowMApFTkRDSEFSClNUQVJUQ0hBUiBhZmlpMTAwMzUKRU5DT0RJTkcgMTA1NwpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjYwCjkwCjgwCjgwCjkwCjYwCjAwCkVORENIQVIKU1RBUlRDSEFSIGFmaWkxMDAzNgpFTkNPRElORyAxMDU4ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKNzAKMjAKMjAKMjAKMjAKMjAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgYWZpaTEwMDM3CkVOQ09ESU5HIDEwNTkKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAo5MAo5MAo5MAo3MAoxMAplMAowMApFTkRDSEFSClNUQVJUQ0hBUiBhZmlpMTAwMzgKRU5DT0RJTkcgMTA2MApTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjIwCjcwCmE4CmE4CjcwCjIwCjAwCkVORENIQVIKU1RBUlRDSEFSIGFmaWkxMDAzOQpFTkNPRElORyAxMDYxClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKNTAKNTAKMjAKMjAKNTAKNTAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgYWZpaTEwMDQwCkVOQ09ESU5HIDEwNjIKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAphMAphMAphMAphMAphMApmMAoxMApFTkRDSEFSClNUQVJUQ0hBUiBhZmlpMTAwNDEKRU5DT0RJTkcgMTA2MwpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjUwCjUwCjUwCjMwCjEwCjEwCjAwCkVORENIQVIKU1RBUlRDSEFSIGFmaWkxMDA0MgpFTkNPRElORyAxMDY0ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKYTgKYTgKYTgKYTgKYTgKZjgKMDAKRU5EQ0hBUgpTVEFSVENIQVIgYWZpaTEwMDQzCkVOQ09ESU5HIDEwNjUKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAphOAphOAphOAphOAphOApmOAowOApFTkRDSEFSClNUQVJUQ0hBUiBhZmlpMTAwNDQKRU5DT0RJTkcgMTA2NgpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCmUwCjIwCjMwCjI4CjI4CjMwCjAwCkVORENIQVIKU1RBUlRDSEFSIGFmaWkxMDA0NQpFTkNPRElORyAxMDY3ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKODgKODgKYzgKYTgKYTgKYzgKMDAKRU5EQ0hBUgpTVEFSVENIQVIgYWZpaTEwMDQ2CkVOQ09ESU5HIDEwNjgKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAo0MAo0MAo2MAo1MAo1MAo2MAowMApFTkRDSEFSClNUQVJUQ0hBUiBhZmlpMTAwNDcKRU5DT0RJTkcgMTA2OQpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCmUwCjEwCjcwCjEwCjEwCmUwCjAwCkVORENIQVIKU1RBUlRDSEFSIGFmaWkxMDA0OApFTkNPRElORyAxMDcwClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKOTAKYTgKZTgKYTgKYTgKOTAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgYWZpaTEwMDQ5CkVOQ09ESU5HIDEwNzEKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAo3MAo5MAo5MAo3MAo5MAo5MAowMApFTkRDSEFSClNUQVJUQ0hBUiBhZmlpMTAwNjUKRU5DT0RJTkcgMTA3MgpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjAwCjAwCjcwCjkwCjkwCjcwCjAwCkVORENIQVIKU1RBUlRDSEFSIGFmaWkxMDA2NgpFTkNPRElORyAxMDczClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKNjAKODAKZTAKOTAKOTAKNjAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgYWZpaTEwMDY3CkVOQ09ESU5HIDEwNzQKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAowMAowMAplMAplMAo5MAplMAowMApFTkRDSEFSClNUQVJUQ0hBUiBhZmlpMTAwNjgKRU5DT0RJTkcgMTA3NQpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjAwCjAwCjcwCjQwCjQwCjQwCjAwCkVORENIQVIKU1RBUlRDSEFSIGFmaWkxMDA2OQpFTkNPRElORyAxMDc2ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMDAKMDAKNzAKNTAKOTAKZjAKOTAKRU5EQ0hBUgpTVEFSVENIQVIgYWZpaTEwMDcwCkVOQ09ESU5HIDEwNzcKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAowMAowMAo2MApiMApjMAo2MAowMApFTkRDSEFSClNUQVJUQ0hBUiBhZmlpMTAwNzIKRU5DT0RJTkcgMTA3OApTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjAwCjAwCmE4CjcwCmE4CmE4CjAwCkVORENIQVIKU1RBUlRDSEFSIGFmaWkxMDA3MwpFTkNPRElORyAxMDc5ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMDAKMDAKNzAKMzAKMTAKNjAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgYWZpaTEwMDc0CkVOQ09ESU5HIDEwODAKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAowMAowMAo5MApiMApkMAo5MAowMApFTkRDSEFSClNUQVJUQ0hBUiBhZmlpMTAwNzUKRU5DT0RJTkcgMTA4MQpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjkwCjYwCjkwCmIwCmQwCjkwCjAwCkVORENIQVIKU1RBUlRDSEFSIGFmaWkxMDA3NgpFTkNPRElORyAxMDgyClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMDAKMDAKOTAKZTAKYTAKOTAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgYWZpaTEwMDc3CkVOQ09ESU5HIDEwODMKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAowMAowMAozMAo1MAo1MAo5MAowMApFTkRDSEFSClNUQVJUQ0hBUiBhZmlpMTAwNzgKRU5DT0RJTkcgMTA4NApTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjAwCjAwCjg4CmQ4CmE4CmE4CjAwCkVORENIQVIKU1RBUlRDSEFSIGFmaWkxMDA3OQpFTkNPRElORyAxMDg1ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMDAKMDAKOTAKZjAKOTAKOTAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgYWZpaTEwMDgwCkVOQ09ESU5HIDEwODYKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAowMAowMAo2MAo5MAo5MAo2MAowMApFTkRDSEFSClNUQVJUQ0hBUiBhZmlpMTAwODEKRU5DT0RJTkcgMTA4NwpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjAwCjAwCmYwCjkwCjkwCjkwCjAwCkVORENIQVIKU1RBUlRDSEFSIGFmaWkxMDA4MgpFTkNPRElORyAxMDg4ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMDAKMDAKZTAKOTAKOTAKZTAKODAKRU5EQ0hBUgpTVEFSVENIQVIgYWZpaTEwMDgzCkVOQ09ESU5HIDEwODkKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAowMAowMAozMAo0MAo0MAozMAowMApFTkRDSEFSClNUQVJUQ0hBUiBhZmlpMTAwODQKRU5DT0RJTkcgMTA5MApTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjAwCjAwCjcwCjIwCjIwCjIwCjAwCkVORENIQVIKU1RBUlRDSEFSIGFmaWkxMDA4NQpFTkNPRElORyAxMDkxClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMDAKMDAKOTAKOTAKNTAKMjAKNDAKRU5EQ0hBUgpTVEFSVENIQVIgYWZpaTEwMDg2CkVOQ09ESU5HIDEwOTIKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAo2MAoyMAoyMAo3MAo1MAo3MAoyMApFTkRDSEFSClNUQVJUQ0hBUiBhZmlpMTAwODcKRU5DT0RJTkcgMTA5MwpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjAwCjAwCjkwCjYwCjYwCjkwCjAwCkVORENIQVIKU1RBUlRDSEFSIGFmaWkxMDA4OApFTkNPRElORyAxMDk0ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMDAKMDAKOTAKOTAKOTAKZjAKMTAKRU5EQ0hBUgpTVEFSVENIQVIgYWZpaTEwMDg5CkVOQ09ESU5HIDEwOTUKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAowMAowMAo5MAo5MAo3MAoxMAowMApFTkRDSEFSClNUQVJUQ0hBUiBhZmlpMTAwOTAKRU5DT0RJTkcgMTA5NgpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjAwCjAwCmE4CmE4CmE4CmY4CjAwCkVORENIQVIKU1RBUlRDSEFSIGFmaWkxMDA5MQpFTkNPRElORyAxMDk3ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMDAKMDAKYTgKYTgKYTgKZjgKMDgKRU5EQ0hBUgpTVEFSVENIQVIgYWZpaTEwMDkyCkVOQ09ESU5HIDEwOTgKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAowMAowMApjMAo2MAo1MAo2MAowMApFTkRDSEFSClNUQVJUQ0hBUiBhZmlpMTAwOTMKRU5DT0RJTkcgMTA5OQpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjAwCjAwCjkwCmQwCmIwCmQwCjAwCkVORENIQVIKU1RBUlRDSEFSIGFmaWkxMDA5NApFTkNPRElORyAxMTAwClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMDAKMDAKODAKZTAKOTAKZTAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgYWZpaTEwMDk1CkVOQ09ESU5HIDExMDEKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAowMAowMAo2MAozMAoxMAo2MAowMApFTkRDSEFSClNUQVJUQ0hBUiBhZmlpMTAwOTYKRU5DT0RJTkcgMTEwMgpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjAwCjAwCmEwCmQwCmQwCmEwCjAwCkVORENIQVIKU1RBUlRDSEFSIGFmaWkxMDA5NwpFTkNPRElORyAxMTAzClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMDAKMDAKMzAKNTAKMzAKNTAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgYWZpaTEwMDcxCkVOQ09ESU5HIDExMDUKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAphMAowMAo2MApiMApjMAo2MAowMApFTkRDSEFSClNUQVJUQ0hBUiBhZmlpMTAwOTkKRU5DT0RJTkcgMTEwNgpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjQwCmUwCjQwCjYwCjUwCjEwCjIwCkVORENIQVIKU1RBUlRDSEFSIGFmaWkxMDEwMApFTkNPRElORyAxMTA3ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMjAKNDAKZjAKODAKODAKODAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgYWZpaTEwMTAxCkVOQ09ESU5HIDExMDgKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAowMAowMAozMAo2MAo0MAozMAowMApFTkRDSEFSClNUQVJUQ0hBUiBhZmlpMTAxMDIKRU5DT0RJTkcgMTEwOQpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjAwCjAwCjcwCmMwCjMwCmUwCjAwCkVORENIQVIKU1RBUlRDSEFSIGFmaWkxMDEwMwpFTkNPRElORyAxMTEwClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMjAKMDAKNjAKMjAKMjAKNzAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgYWZpaTEwMTA0CkVOQ09ESU5HIDExMTEKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAo1MAowMAo2MAoyMAoyMAo3MAowMApFTkRDSEFSClNUQVJUQ0hBUiBhZmlpMTAxMDUKRU5DT0RJTkcgMTExMgpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjEwCjAwCjEwCjEwCjEwCjUwCjIwCkVORENIQVIKU1RBUlRDSEFSIGFmaWkxMDEwNgpFTkNPRElORyAxMTEzClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMDAKMDAKNjAKYjgKYTgKYjAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgYWZpaTEwMTA3CkVOQ09ESU5HIDExMTQKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAowMAowMAphMApmMAphOApiMAowMApFTkRDSEFSClNUQVJUQ0hBUiBhZmlpMTAxMDgKRU5DT0RJTkcgMTExNQpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjQwCmUwCjQwCjYwCjUwCjUwCjAwCkVORENIQVIKU1RBUlRDSEFSIGFmaWkxMDEwOQpFTkNPRElORyAxMTE2ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMjAKNDAKOTAKZTAKYTAKOTAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMDQ1RApFTkNPRElORyAxMTE3ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMDAKMDAKMDAKMDAKMDAKMDAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgYWZpaTEwMTEwCkVOQ09ESU5HIDExMTgKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAo5MAo2MAowMAo5MAo1MAoyMAo0MApFTkRDSEFSClNUQVJUQ0hBUiBhZmlpMTAxOTMKRU5DT0RJTkcgMTExOQpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjAwCjAwCjUwCjUwCjUwCjcwCjIwCkVORENIQVIKU1RBUlRDSEFSIGFmaWkxMDA1MApFTkNPRElORyAxMTY4ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMTAKZjAKODAKODAKODAKODAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgYWZpaTEwMDk4CkVOQ09ESU5HIDExNjkKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAowMAoxMApmMAo4MAo4MAo4MAowMApFTkRDSEFSClNUQVJUQ0hBUiB1bmkwNDkyCkVOQ09ESU5HIDExNzAKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAo3MAo0MAplMAo0MAo0MAo0MAowMApFTkRDSEFSClNUQVJUQ0hBUiB1bmkwNDkzCkVOQ09ESU5HIDExNzEKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAowMAowMAo3MAo0MAplMAo0MAowMApFTkRDSEFSClNUQVJUQ0hBUiB1bmkwNDk2CkVOQ09ESU5HIDExNzQKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAphOAphOAo3MAphOAphOAphOAowOApFTkRDSEFSClNUQVJUQ0hBUiB1bmkwNDk3CkVOQ09ESU5HIDExNzUKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAowMAowMAphOAo3MAphOAphOAowOApFTkRDSEFSClNUQVJUQ0hBUiB1bmkwNDlBCkVOQ09ESU5HIDExNzgKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAo5MAphMApjMAphMAo5MAo4OAowOApFTkRDSEFSClNUQVJUQ0hBUiB1bmkwNDlCCkVOQ09ESU5HIDExNzkKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAowMAowMAo5MAplMAphMAo5MAowOApFTkRDSEFSClNUQVJUQ0hBUiB1bmkwNEFFCkVOQ09ESU5HIDExOTgKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAo4OAo4OAo1MAoyMAoyMAoyMAowMApFTkRDSEFSClNUQVJUQ0hBUiB1bmkwNEFGCkVOQ09ESU5HIDExOTkKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAowMAowMAo4OAo1MAoyMAoyMAoyMApFTkRDSEFSClNUQVJUQ0hBUiB1bmkwNEIwCkVOQ09ESU5HIDEyMDAKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAo4OAo4OAo1MApmOAoyMAoyMAowMApFTkRDSEFSClNUQVJUQ0hBUiB1bmkwNEIxCkVOQ09ESU5HIDEyMDEKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAowMAowMAo4OAo1MApmOAoyMAoyMApFTkRDSEFSClNUQVJUQ0hBUiB1bmkwNEIyCkVOQ09ESU5HIDEyMDIKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAo1MAo1MAoyMAoyMAo1MAo1MAowOApFTkRDSEFSClNUQVJUQ0hBUiB1bmkwNEIzCkVOQ09ESU5HIDEyMDMKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAowMAowMAo5MAo2MAo2MAo5MAowOApFTkRDSEFSClNUQVJUQ0hBUiB1bmkwNEJBCkVOQ09ESU5HIDEyMTAKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAo4MAo4MAplMAo5MAo5MAo5MAowMApFTkRDSEFSClNUQVJUQ0hBUiB1bmkwNEJCCkVOQ09ESU5HIDEyMTEKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAo0MAo0MAo2MAo1MAo1MAo1MAowMApFTkRDSEFSClNUQVJUQ0hBUiB1bmkwNEQ4CkVOQ09ESU5HIDEyNDAKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAo2MAo5MAoxMApmMAo5MAo2MAowMApFTkRDSEFSClNUQVJUQ0hBUiBhZmlpMTA4NDYKRU5DT0RJTkcgMTI0MQpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjAwCjAwCjYwCjMwCmQwCjYwCjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTA0RTIKRU5DT0RJTkcgMTI1MApTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjcwCjAwCjcwCjIwCjIwCjIwCjcwCjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTA0RTMKRU5DT0RJTkcgMTI1MQpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjcwCjAwCjYwCjIwCjIwCjcwCjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTA0RTgKRU5DT0RJTkcgMTI1NgpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjYwCjkwCmYwCjkwCjkwCjYwCjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTA0RTkKRU5DT0RJTkcgMTI1NwpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjAwCjAwCjYwCmYwCjkwCjYwCjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTA0RUUKRU5DT0RJTkcgMTI2MgpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCmYwCjAwCjkwCjkwCjkwCjkwCjYwCjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTA0RUYKRU5DT0RJTkcgMTI2MwpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCmYwCjAwCjkwCjkwCjkwCjcwCjAwCkVORENIQVIKU1RBUlRDSEFSIGFmaWk1NzY2NApFTkNPRElORyAxNDg4ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMDAKMDAKOTAKNTAKYTAKOTAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgYWZpaTU3NjY1CkVOQ09ESU5HIDE0ODkKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAowMAowMAplMAoyMAoyMApmMAowMApFTkRDSEFSClNUQVJUQ0hBUiBhZmlpNTc2NjYKRU5DT0RJTkcgMTQ5MApTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjAwCjQwCjIwCjIwCjIwCmQwCjAwCkVORENIQVIKU1RBUlRDSEFSIGFmaWk1NzY2NwpFTkNPRElORyAxNDkxClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMDAKMDAKZjAKMjAKMjAKMjAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgYWZpaTU3NjY4CkVOQ09ESU5HIDE0OTIKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAowMAowMApmMAoxMAo5MAo5MAowMApFTkRDSEFSClNUQVJUQ0hBUiBhZmlpNTc2NjkKRU5DT0RJTkcgMTQ5MwpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjAwCjQwCjIwCjIwCjIwCjIwCjAwCkVORENIQVIKU1RBUlRDSEFSIGFmaWk1NzY3MApFTkNPRElORyAxNDk0ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMDAKMDAKNzAKMjAKMjAKMjAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgYWZpaTU3NjcxCkVOQ09ESU5HIDE0OTUKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAowMAowMApmMAo1MAo1MAo1MAowMApFTkRDSEFSClNUQVJUQ0hBUiBhZmlpNTc2NzIKRU5DT0RJTkcgMTQ5NgpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjAwCjAwCjkwCjkwCjkwCmYwCjAwCkVORENIQVIKU1RBUlRDSEFSIGFmaWk1NzY3MwpFTkNPRElORyAxNDk3ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMDAKNDAKMjAKMDAKMDAKMDAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgYWZpaTU3Njc0CkVOQ09ESU5HIDE0OTgKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAowMAowMApmMAoxMAoyMAoyMAowMApFTkRDSEFSClNUQVJUQ0hBUiBhZmlpNTc2NzUKRU5DT0RJTkcgMTQ5OQpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjAwCjgwCjcwCjEwCjEwCmUwCjAwCkVORENIQVIKU1RBUlRDSEFSIGFmaWk1NzY3NgpFTkNPRElORyAxNTAwClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMDAKODAKNzAKMTAKMjAKNDAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgYWZpaTU3Njc3CkVOQ09ESU5HIDE1MDEKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAowMAo4MAo3MAo5MAo5MApmMAowMApFTkRDSEFSClNUQVJUQ0hBUiBhZmlpNTc2NzgKRU5DT0RJTkcgMTUwMgpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjAwCjgwCjcwCjkwCjkwCmIwCjAwCkVORENIQVIKU1RBUlRDSEFSIGFmaWk1NzY3OQpFTkNPRElORyAxNTAzClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMDAKNDAKMjAKNDAKNDAKNDAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgYWZpaTU3NjgwCkVOQ09ESU5HIDE1MDQKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAowMAo0MAoyMAoyMAoyMAo2MAowMApFTkRDSEFSClNUQVJUQ0hBUiBhZmlpNTc2ODEKRU5DT0RJTkcgMTUwNQpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjAwCjgwCjc4CjQ4CjQ4CjMwCjAwCkVORENIQVIKU1RBUlRDSEFSIGFmaWk1NzY4MgpFTkNPRElORyAxNTA2ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMDAKMDAKOTAKNTAKNTAKZjAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgYWZpaTU3NjgzCkVOQ09ESU5HIDE1MDcKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAowMAowMAo3MAo5MAoxMAoxMAowMApFTkRDSEFSClNUQVJUQ0hBUiBhZmlpNTc2ODQKRU5DT0RJTkcgMTUwOApTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjAwCjAwCmYwCjkwCjEwCmYwCjAwCkVORENIQVIKU1RBUlRDSEFSIGFmaWk1NzY4NQpFTkNPRElORyAxNTA5ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMDAKMDAKNTAKNjAKNDAKNDAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgYWZpaTU3Njg2CkVOQ09ESU5HIDE1MTAKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAowMAowMAo5MAo2MAoyMApmMAowMApFTkRDSEFSClNUQVJUQ0hBUiBhZmlpNTc2ODcKRU5DT0RJTkcgMTUxMQpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjAwCjAwCmUwCjEwCmEwCjgwCjAwCkVORENIQVIKU1RBUlRDSEFSIGFmaWk1NzY4OApFTkNPRElORyAxNTEyClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMDAKMDAKZjAKMTAKMTAKMTAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgYWZpaTU3Njg5CkVOQ09ESU5HIDE1MTMKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAowMAowMAphOApjOAo4OApmMAowMApFTkRDSEFSClNUQVJUQ0hBUiBhZmlpNTc2OTAKRU5DT0RJTkcgMTUxNApTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjAwCjAwCmYwCjUwCjUwCmQwCjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTFFMDIKRU5DT0RJTkcgNzY4MgpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjIwCjAwCmUwCjkwCmUwCjkwCmUwCjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTFFMDMKRU5DT0RJTkcgNzY4MwpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjIwCjgwCjgwCmUwCjkwCjkwCmUwCjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTFFMEEKRU5DT0RJTkcgNzY5MApTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjIwCjAwCmUwCjkwCjkwCjkwCmUwCjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTFFMEIKRU5DT0RJTkcgNzY5MQpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjQwCjEwCjEwCjcwCjkwCjkwCjcwCjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTFFMUUKRU5DT0RJTkcgNzcxMApTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjIwCjAwCmYwCjgwCmUwCjgwCjgwCjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTFFMUYKRU5DT0RJTkcgNzcxMQpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjgwCjIwCjUwCjQwCmUwCjQwCjQwCjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTFFNDAKRU5DT0RJTkcgNzc0NApTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjIwCjAwCjkwCmYwCmYwCjkwCjkwCjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTFFNDEKRU5DT0RJTkcgNzc0NQpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjIwCjAwCmQwCmE4CmE4CmE4CjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTFFNTYKRU5DT0RJTkcgNzc2NgpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjQwCjAwCmUwCjkwCmUwCjgwCjgwCjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTFFNTcKRU5DT0RJTkcgNzc2NwpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjQwCjAwCmUwCjkwCmUwCjgwCjgwCkVORENIQVIKU1RBUlRDSEFSIHVuaTFFNjAKRU5DT0RJTkcgNzc3NgpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjIwCjAwCjcwCjgwCjYwCjEwCmUwCjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTFFNjEKRU5DT0RJTkcgNzc3NwpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjIwCjAwCjMwCjYwCjEwCjYwCjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTFFNkEKRU5DT0RJTkcgNzc4NgpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjIwCjAwCjcwCjIwCjIwCjIwCjIwCjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTFFNkIKRU5DT0RJTkcgNzc4NwpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjQwCjAwCjQwCmUwCjQwCjUwCjIwCjAwCkVORENIQVIKU1RBUlRDSEFSIFdncmF2ZQpFTkNPRElORyA3ODA4ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKNDAKMjAKOTAKOTAKZjAKZjAKOTAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgd2dyYXZlCkVOQ09ESU5HIDc4MDkKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAo0MAoyMAowMAo4OAphOAphOAo1MAowMApFTkRDSEFSClNUQVJUQ0hBUiBXYWN1dGUKRU5DT0RJTkcgNzgxMApTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjIwCjQwCjkwCjkwCmYwCmYwCjkwCjAwCkVORENIQVIKU1RBUlRDSEFSIHdhY3V0ZQpFTkNPRElORyA3ODExClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMTAKMjAKMDAKODgKYTgKYTgKNTAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgV2RpZXJlc2lzCkVOQ09ESU5HIDc4MTIKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAo5MAowMAo5MAo5MApmMApmMAo5MAowMApFTkRDSEFSClNUQVJUQ0hBUiB3ZGllcmVzaXMKRU5DT0RJTkcgNzgxMwpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjUwCjAwCjg4CmE4CmE4CjUwCjAwCkVORENIQVIKU1RBUlRDSEFSIFlncmF2ZQpFTkNPRElORyA3OTIyClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKNDAKMjAKODgKNTAKMjAKMjAKMjAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgeWdyYXZlCkVOQ09ESU5HIDc5MjMKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAo0MAoyMAowMAo5MAo5MAo3MAo5MAo2MApFTkRDSEFSClNUQVJUQ0hBUiB1bmkyMDEwCkVOQ09ESU5HIDgyMDgKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAowMAowMAowMAo3MAowMAowMAowMApFTkRDSEFSClNUQVJUQ0hBUiB1bmkyMDExCkVOQ09ESU5HIDgyMDkKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAowMAowMAowMAo3MAowMAowMAowMApFTkRDSEFSClNUQVJUQ0hBUiBmaWd1cmVkYXNoCkVOQ09ESU5HIDgyMTAKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAowMAowMAowMApmMAowMAowMAowMApFTkRDSEFSClNUQVJUQ0hBUiBlbmRhc2gKRU5DT0RJTkcgODIxMQpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjAwCjAwCjAwCmYwCjAwCjAwCjAwCkVORENIQVIKU1RBUlRDSEFSIGVtZGFzaApFTkNPRElORyA4MjEyClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMDAKMDAKMDAKZjgKMDAKMDAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgYWZpaTAwMjA4CkVOQ09ESU5HIDgyMTMKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAowMAowMAowMApmOAowMAowMAowMApFTkRDSEFSClNUQVJUQ0hBUiB1bmkyMDE2CkVOQ09ESU5HIDgyMTQKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAo1MAo1MAo1MAo1MAo1MAo1MAowMApFTkRDSEFSClNUQVJUQ0hBUiB1bmRlcnNjb3JlZGJsCkVOQ09ESU5HIDgyMTUKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAowMAowMAowMAowMApmOAowMApmOApFTkRDSEFSClNUQVJUQ0hBUiBxdW90ZWxlZnQKRU5DT0RJTkcgODIxNgpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjIwCjQwCjYwCjAwCjAwCjAwCjAwCjAwCkVORENIQVIKU1RBUlRDSEFSIHF1b3RlcmlnaHQKRU5DT0RJTkcgODIxNwpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjYwCjIwCjQwCjAwCjAwCjAwCjAwCjAwCkVORENIQVIKU1RBUlRDSEFSIHF1b3Rlc2luZ2xiYXNlCkVOQ09ESU5HIDgyMTgKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAowMAowMAowMAowMAo2MAoyMAo0MApFTkRDSEFSClNUQVJUQ0hBUiBxdW90ZXJldmVyc2VkCkVOQ09ESU5HIDgyMTkKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAo2MAo0MAoyMAowMAowMAowMAowMAowMApFTkRDSEFSClNUQVJUQ0hBUiBxdW90ZWRibGxlZnQKRU5DT0RJTkcgODIyMApTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjQ4CjkwCmQ4CjAwCjAwCjAwCjAwCjAwCkVORENIQVIKU1RBUlRDSEFSIHF1b3RlZGJscmlnaHQKRU5DT0RJTkcgODIyMQpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCmQ4CjQ4CjkwCjAwCjAwCjAwCjAwCjAwCkVORENIQVIKU1RBUlRDSEFSIHF1b3RlZGJsYmFzZQpFTkNPRElORyA4MjIyClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMDAKMDAKMDAKMDAKZDgKNDgKOTAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjAxRgpFTkNPRElORyA4MjIzClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKZDgKOTAKNDgKMDAKMDAKMDAKMDAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgZGFnZ2VyCkVOQ09ESU5HIDgyMjQKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAoyMAo3MAoyMAoyMAoyMAoyMAowMApFTkRDSEFSClNUQVJUQ0hBUiBkYWdnZXJkYmwKRU5DT0RJTkcgODIyNQpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjIwCjcwCjIwCjcwCjIwCjIwCjAwCkVORENIQVIKU1RBUlRDSEFSIGJ1bGxldApFTkNPRElORyA4MjI2ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMDAKMDAKNjAKZjAKZjAKNjAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgZWxsaXBzaXMKRU5DT0RJTkcgODIzMApTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjAwCjAwCjAwCjAwCjAwCmE4CjAwCkVORENIQVIKU1RBUlRDSEFSIHBlcnRob3VzYW5kCkVOQ09ESU5HIDgyNDAKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAo4MAphMAo0MAphOAoyOAowMAowMApFTkRDSEFSClNUQVJUQ0hBUiBtaW51dGUKRU5DT0RJTkcgODI0MgpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjIwCjIwCjQwCjAwCjAwCjAwCjAwCkVORENIQVIKU1RBUlRDSEFSIHNlY29uZApFTkNPRElORyA4MjQzClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKNTAKNTAKYTAKMDAKMDAKMDAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjAzNApFTkNPRElORyA4MjQ0ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKYTgKYTgKZDAKMDAKMDAKMDAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjAzNQpFTkNPRElORyA4MjQ1ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKNDAKNDAKMjAKMDAKMDAKMDAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjAzNgpFTkNPRElORyA4MjQ2ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKYTAKYTAKNTAKMDAKMDAKMDAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgZ3VpbHNpbmdsbGVmdApFTkNPRElORyA4MjQ5ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMDAKMDAKMjAKNDAKMjAKMDAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgZ3VpbHNpbmdscmlnaHQKRU5DT0RJTkcgODI1MApTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjAwCjAwCjQwCjIwCjQwCjAwCjAwCkVORENIQVIKU1RBUlRDSEFSIGV4Y2xhbWRibApFTkNPRElORyA4MjUyClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKNTAKNTAKNTAKNTAKMDAKNTAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjAzRApFTkNPRElORyA4MjUzClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMjAKNzAKMzAKMjAKMDAKMjAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjAzRQpFTkNPRElORyA4MjU0ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKZjgKMDAKMDAKMDAKMDAKMDAKMDAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgZnJhY3Rpb24KRU5DT0RJTkcgODI2MApTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjEwCjIwCjIwCjQwCjQwCjgwCjAwCkVORENIQVIKU1RBUlRDSEFSIHplcm9zdXBlcmlvcgpFTkNPRElORyA4MzA0ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMjAKNTAKNTAKNTAKMjAKMDAKMDAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjA3MQpFTkNPRElORyA4MzA1ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMjAKMDAKNjAKMjAKNzAKMDAKMDAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgZm91cnN1cGVyaW9yCkVOQ09ESU5HIDgzMDgKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAo0MAo0MAo2MAo3MAoyMAowMAowMAowMApFTkRDSEFSClNUQVJUQ0hBUiBmaXZlc3VwZXJpb3IKRU5DT0RJTkcgODMwOQpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjcwCjQwCjYwCjEwCjYwCjAwCjAwCjAwCkVORENIQVIKU1RBUlRDSEFSIHNpeHN1cGVyaW9yCkVOQ09ESU5HIDgzMTAKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAozMAo0MAo2MAo1MAoyMAowMAowMAowMApFTkRDSEFSClNUQVJUQ0hBUiBzZXZlbnN1cGVyaW9yCkVOQ09ESU5HIDgzMTEKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAo3MAoxMAoyMAoyMAoyMAowMAowMAowMApFTkRDSEFSClNUQVJUQ0hBUiBlaWdodHN1cGVyaW9yCkVOQ09ESU5HIDgzMTIKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAoyMAo1MAoyMAo1MAoyMAowMAowMAowMApFTkRDSEFSClNUQVJUQ0hBUiBuaW5lc3VwZXJpb3IKRU5DT0RJTkcgODMxMwpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjIwCjUwCjMwCjEwCjYwCjAwCjAwCjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTIwN0EKRU5DT0RJTkcgODMxNApTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjIwCjcwCjIwCjAwCjAwCjAwCjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTIwN0IKRU5DT0RJTkcgODMxNQpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjAwCjcwCjAwCjAwCjAwCjAwCjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTIwN0MKRU5DT0RJTkcgODMxNgpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjcwCjAwCjcwCjAwCjAwCjAwCjAwCkVORENIQVIKU1RBUlRDSEFSIHBhcmVubGVmdHN1cGVyaW9yCkVOQ09ESU5HIDgzMTcKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAoyMAo0MAo0MAo0MAoyMAowMAowMAowMApFTkRDSEFSClNUQVJUQ0hBUiBwYXJlbnJpZ2h0c3VwZXJpb3IKRU5DT0RJTkcgODMxOApTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjQwCjIwCjIwCjIwCjQwCjAwCjAwCjAwCkVORENIQVIKU1RBUlRDSEFSIG5zdXBlcmlvcgpFTkNPRElORyA4MzE5ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMDAKNjAKNTAKNTAKMDAKMDAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgemVyb2luZmVyaW9yCkVOQ09ESU5HIDgzMjAKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAowMAowMAoyMAo1MAo1MAo1MAoyMApFTkRDSEFSClNUQVJUQ0hBUiBvbmVpbmZlcmlvcgpFTkNPRElORyA4MzIxClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMDAKMDAKMjAKNjAKMjAKMjAKNzAKRU5EQ0hBUgpTVEFSVENIQVIgdHdvaW5mZXJpb3IKRU5DT0RJTkcgODMyMgpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjAwCjAwCjIwCjUwCjEwCjIwCjcwCkVORENIQVIKU1RBUlRDSEFSIHRocmVlaW5mZXJpb3IKRU5DT0RJTkcgODMyMwpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjAwCjAwCjYwCjEwCjYwCjEwCjYwCkVORENIQVIKU1RBUlRDSEFSIGZvdXJpbmZlcmlvcgpFTkNPRElORyA4MzI0ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMDAKMDAKNDAKNDAKNjAKNzAKMjAKRU5EQ0hBUgpTVEFSVENIQVIgZml2ZWluZmVyaW9yCkVOQ09ESU5HIDgzMjUKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAowMAowMAo3MAo0MAo2MAoxMAo2MApFTkRDSEFSClNUQVJUQ0hBUiBzaXhpbmZlcmlvcgpFTkNPRElORyA4MzI2ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMDAKMDAKMzAKNDAKNjAKNTAKMjAKRU5EQ0hBUgpTVEFSVENIQVIgc2V2ZW5pbmZlcmlvcgpFTkNPRElORyA4MzI3ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMDAKMDAKNzAKMTAKMjAKMjAKMjAKRU5EQ0hBUgpTVEFSVENIQVIgZWlnaHRpbmZlcmlvcgpFTkNPRElORyA4MzI4ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMDAKMDAKMjAKNTAKMjAKNTAKMjAKRU5EQ0hBUgpTVEFSVENIQVIgbmluZWluZmVyaW9yCkVOQ09ESU5HIDgzMjkKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAowMAowMAoyMAo1MAozMAoxMAo2MApFTkRDSEFSClNUQVJUQ0hBUiB1bmkyMDhBCkVOQ09ESU5HIDgzMzAKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAowMAowMAowMAoyMAo3MAoyMAowMApFTkRDSEFSClNUQVJUQ0hBUiB1bmkyMDhCCkVOQ09ESU5HIDgzMzEKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAowMAowMAowMAowMAo3MAowMAowMApFTkRDSEFSClNUQVJUQ0hBUiB1bmkyMDhDCkVOQ09ESU5HIDgzMzIKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAowMAowMAowMAo3MAowMAo3MAowMApFTkRDSEFSClNUQVJUQ0hBUiBwYXJlbmxlZnRpbmZlcmlvcgpFTkNPRElORyA4MzMzClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMDAKMDAKMjAKNDAKNDAKNDAKMjAKRU5EQ0hBUgpTVEFSVENIQVIgcGFyZW5yaWdodGluZmVyaW9yCkVOQ09ESU5HIDgzMzQKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAowMAowMAo0MAoyMAoyMAoyMAo0MApFTkRDSEFSClNUQVJUQ0hBUiBmcmFuYwpFTkNPRElORyA4MzU1ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKZjAKODAKZjAKYTAKYTAKYTAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgbGlyYQpFTkNPRElORyA4MzU2ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMjAKNTAKZTAKZTAKNTAKYTAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgcGVzZXRhCkVOQ09ESU5HIDgzNTkKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAo3MAo0OApmOAo3MAo0MAo0MAowMApFTkRDSEFSClNUQVJUQ0hBUiBkb25nCkVOQ09ESU5HIDgzNjMKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAoxMAozOAo3MAo5MAo5MAo3MApmMApFTkRDSEFSClNUQVJUQ0hBUiBFdXJvCkVOQ09ESU5HIDgzNjQKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAozMAo0MAplMAo0MAplMAo0MAozMAowMApFTkRDSEFSClNUQVJUQ0hBUiB1bmkyMEFGCkVOQ09ESU5HIDgzNjcKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAo0MAplMAo1MAo1MAo1MApkMAplMAowMApFTkRDSEFSClNUQVJUQ0hBUiB1bmkyMEQwCkVOQ09ESU5HIDg0MDAKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAo0MApmMAowMAowMAowMAowMAowMAowMApFTkRDSEFSClNUQVJUQ0hBUiB1bmkyMEQxCkVOQ09ESU5HIDg0MDEKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAoyMApmMAowMAowMAowMAowMAowMAowMApFTkRDSEFSClNUQVJUQ0hBUiB1bmkyMEQyCkVOQ09ESU5HIDg0MDIKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAoyMAoyMAoyMAoyMAoyMAoyMAoyMAoyMApFTkRDSEFSClNUQVJUQ0hBUiB1bmkyMEQzCkVOQ09ESU5HIDg0MDMKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAowMAoyMAoyMAoyMAoyMAoyMAoyMApFTkRDSEFSClNUQVJUQ0hBUiB1bmkyMEQ0CkVOQ09ESU5HIDg0MDQKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAplMApkMAowMAowMAowMAowMAowMAowMApFTkRDSEFSClNUQVJUQ0hBUiB1bmkyMEQ1CkVOQ09ESU5HIDg0MDUKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAo3MApiMAowMAowMAowMAowMAowMAowMApFTkRDSEFSClNUQVJUQ0hBUiB1bmkyMEQ2CkVOQ09ESU5HIDg0MDYKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAo0MApmMAo0MAowMAowMAowMAowMAowMApFTkRDSEFSClNUQVJUQ0hBUiB1bmkyMEQ3CkVOQ09ESU5HIDg0MDcKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAoyMApmMAoyMAowMAowMAowMAowMAowMApFTkRDSEFSClNUQVJUQ0hBUiB1bmkyMTAyCkVOQ09ESU5HIDg0NTAKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAo2MApiMAphMAphMApiMAo2MAowMApFTkRDSEFSClNUQVJUQ0hBUiBhZmlpNjEyNDgKRU5DT0RJTkcgODQ1MwpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjQwCjkwCmEwCjcwCjI4CjY4CjkwCjAwCkVORENIQVIKU1RBUlRDSEFSIGFmaWk2MTI4OQpFTkNPRElORyA4NDY3ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMjAKNTAKNTAKNjAKNDAKYjAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjExNQpFTkNPRElORyA4NDY5ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKZDAKZDAKZjAKZjAKZDAKZDAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgYWZpaTYxMzUyCkVOQ09ESU5HIDg0NzAKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMApjMApiMAphOApiMAphMApiOAowMApFTkRDSEFSClNUQVJUQ0hBUiB1bmkyMTFBCkVOQ09ESU5HIDg0NzQKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAo2MApkMApkMApkMApmMAo2MAoxMApFTkRDSEFSClNUQVJUQ0hBUiB1bmkyMTFECkVOQ09ESU5HIDg0NzcKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAplMApkMApkMAplMApkMApkMAowMApFTkRDSEFSClNUQVJUQ0hBUiB0cmFkZW1hcmsKRU5DT0RJTkcgODQ4MgpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjcwCjIwCjIwCjAwCjcwCjcwCjUwCjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTIxMjQKRU5DT0RJTkcgODQ4NApTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCmYwCjUwCjUwCmEwCmEwCmYwCjAwCkVORENIQVIKU1RBUlRDSEFSIE9tZWdhCkVOQ09ESU5HIDg0ODYKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAo3MAo4OAo4OAo4OAo1MApkOAowMApFTkRDSEFSClNUQVJUQ0hBUiBlc3RpbWF0ZWQKRU5DT0RJTkcgODQ5NApTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjYwCmQwCmYwCmMwCmQwCjYwCjAwCkVORENIQVIKU1RBUlRDSEFSIG9uZWVpZ2h0aApFTkNPRElORyA4NTM5ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKODAKODAKYTAKZDAKYTAKNTAKMjAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgdGhyZWVlaWdodGhzCkVOQ09ESU5HIDg1NDAKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAo4MAo0MAo5MAo2OAo5MAoyOAoxMAowMApFTkRDSEFSClNUQVJUQ0hBUiBmaXZlZWlnaHRocwpFTkNPRElORyA4NTQxClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKYzAKODAKZDAKNjgKOTAKMjgKMTAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgc2V2ZW5laWdodGhzCkVOQ09ESU5HIDg1NDIKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUApjMAo0MAo1MAphOAo5MAoyOAoxMAowMApFTkRDSEFSClNUQVJUQ0hBUiBhcnJvd2xlZnQKRU5DT0RJTkcgODU5MgpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjAwCjIwCjQwCmY4CjQwCjIwCjAwCkVORENIQVIKU1RBUlRDSEFSIGFycm93dXAKRU5DT0RJTkcgODU5MwpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjIwCjcwCmE4CjIwCjIwCjIwCjAwCkVORENIQVIKU1RBUlRDSEFSIGFycm93cmlnaHQKRU5DT0RJTkcgODU5NApTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjAwCjIwCjEwCmY4CjEwCjIwCjAwCkVORENIQVIKU1RBUlRDSEFSIGFycm93ZG93bgpFTkNPRElORyA4NTk1ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMjAKMjAKMjAKYTgKNzAKMjAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgYXJyb3dib3RoCkVOQ09ESU5HIDg1OTYKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAowMAowMAo1MApmOAo1MAowMAowMApFTkRDSEFSClNUQVJUQ0hBUiBhcnJvd3VwZG4KRU5DT0RJTkcgODU5NwpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjIwCjcwCjIwCjIwCjcwCjIwCjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTIxOTYKRU5DT0RJTkcgODU5OApTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCmUwCmMwCmEwCjIwCjEwCjEwCjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTIxOTcKRU5DT0RJTkcgODU5OQpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjcwCjMwCjUwCjQwCjgwCjgwCjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTIxOTgKRU5DT0RJTkcgODYwMApTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjgwCjgwCjQwCjUwCjMwCjcwCjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTIxOTkKRU5DT0RJTkcgODYwMQpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjEwCjEwCjIwCmEwCmMwCmUwCjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTIxQTQKRU5DT0RJTkcgODYxMgpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjAwCjIwCjQ4CmY4CjQ4CjIwCjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTIxQTUKRU5DT0RJTkcgODYxMwpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjIwCjcwCmE4CjIwCjIwCjcwCjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTIxQTYKRU5DT0RJTkcgODYxNApTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjAwCjIwCjkwCmY4CjkwCjIwCjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTIxQTcKRU5DT0RJTkcgODYxNQpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjcwCjIwCjIwCmE4CjcwCjIwCjAwCkVORENIQVIKU1RBUlRDSEFSIGFycm93dXBkbmJzZQpFTkNPRElORyA4NjE2ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMjAKNzAKMjAKMjAKNzAKMjAKNzAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjFCMApFTkNPRElORyA4NjI0ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKNDAKZjAKNTAKMTAKMTAKMTAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjFCMQpFTkNPRElORyA4NjI1ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMjAKZjAKYTAKODAKODAKODAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjFCMgpFTkNPRElORyA4NjI2ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMTAKMTAKMTAKNTAKZjAKNDAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjFCMwpFTkNPRElORyA4NjI3ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKODAKODAKODAKYTAKZjAKMjAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjFCNApFTkNPRElORyA4NjI4ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMDAKZTAKMjAKMjAKNzAKMjAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgY2FycmlhZ2VyZXR1cm4KRU5DT0RJTkcgODYyOQpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjAwCjEwCjEwCjUwCmYwCjQwCjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTIxQkMKRU5DT0RJTkcgODYzNgpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjAwCjIwCjQwCmY4CjAwCjAwCjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTIxQkQKRU5DT0RJTkcgODYzNwpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjAwCjAwCjAwCmY4CjQwCjIwCjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTIxQkUKRU5DT0RJTkcgODYzOApTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjIwCjMwCjI4CjIwCjIwCjIwCjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTIxQkYKRU5DT0RJTkcgODYzOQpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjIwCjYwCmEwCjIwCjIwCjIwCjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTIxQzAKRU5DT0RJTkcgODY0MApTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjAwCjIwCjEwCmY4CjAwCjAwCjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTIxQzEKRU5DT0RJTkcgODY0MQpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjAwCjAwCjAwCmY4CjEwCjIwCjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTIxQzIKRU5DT0RJTkcgODY0MgpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjIwCjIwCjIwCjI4CjMwCjIwCjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTIxQzMKRU5DT0RJTkcgODY0MwpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjIwCjIwCjIwCmEwCjYwCjIwCjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTIxQ0IKRU5DT0RJTkcgODY1MQpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjAwCjQwCmYwCjAwCmYwCjIwCjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTIxQ0MKRU5DT0RJTkcgODY1MgpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjAwCjIwCmYwCjAwCmYwCjQwCjAwCkVORENIQVIKU1RBUlRDSEFSIGFycm93ZGJsbGVmdApFTkNPRElORyA4NjU2ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMjAKNzgKODAKNzgKMjAKMDAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgYXJyb3dkYmx1cApFTkNPRElORyA4NjU3ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMjAKNTAKZDgKNTAKNTAKNTAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgYXJyb3dkYmxyaWdodApFTkNPRElORyA4NjU4ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMjAKZjAKMDgKZjAKMjAKMDAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgYXJyb3dkYmxkb3duCkVOQ09ESU5HIDg2NTkKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAo1MAo1MAo1MApkOAo1MAoyMAowMApFTkRDSEFSClNUQVJUQ0hBUiBhcnJvd2RibGJvdGgKRU5DT0RJTkcgODY2MApTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjIwCjcwCjg4CjcwCjIwCjAwCjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTIxRDUKRU5DT0RJTkcgODY2MQpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjIwCjUwCmQ4CjUwCmQ4CjUwCjIwCkVORENIQVIKU1RBUlRDSEFSIHVuaTIxRDYKRU5DT0RJTkcgODY2MgpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjAwCmYwCmEwCmQwCmE4CjEwCjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTIxRDcKRU5DT0RJTkcgODY2MwpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjAwCjc4CjI4CjU4CmE4CjQwCjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTIxRDgKRU5DT0RJTkcgODY2NApTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjAwCjQwCmE4CjU4CjI4Cjc4CjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTIxRDkKRU5DT0RJTkcgODY2NQpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjAwCjEwCmE4CmQwCmEwCmYwCjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTIxRTAKRU5DT0RJTkcgODY3MgpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjAwCjIwCjQwCmE4CjQwCjIwCjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTIxRTEKRU5DT0RJTkcgODY3MwpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjIwCjcwCjg4CjIwCjAwCjIwCjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTIxRTIKRU5DT0RJTkcgODY3NApTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjAwCjIwCjEwCmE4CjEwCjIwCjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTIxRTMKRU5DT0RJTkcgODY3NQpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjIwCjAwCjIwCjg4CjcwCjIwCjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTIxRTQKRU5DT0RJTkcgODY3NgpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjAwCmEwCmMwCmY4CmMwCmEwCjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTIxRTUKRU5DT0RJTkcgODY3NwpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjAwCjI4CjE4CmY4CjE4CjI4CjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTIxRTYKRU5DT0RJTkcgODY3OApTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjIwCjc4Cjg4Cjc4CjIwCjAwCjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTIxRTcKRU5DT0RJTkcgODY3OQpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjIwCjUwCmQ4CjUwCjUwCjcwCjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTIxRTgKRU5DT0RJTkcgODY4MApTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjIwCmYwCjg4CmYwCjIwCjAwCjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTIxRTkKRU5DT0RJTkcgODY4MQpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjcwCjUwCjUwCmQ4CjUwCjIwCjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaXZlcnNhbApFTkNPRElORyA4NzA0ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKOTAKOTAKZjAKOTAKOTAKNjAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjIwMQpFTkNPRElORyA4NzA1ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMjAKNTAKNDAKNDAKNTAKMjAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgcGFydGlhbGRpZmYKRU5DT0RJTkcgODcwNgpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjYwCjEwCjUwCmIwCjkwCjYwCjAwCkVORENIQVIKU1RBUlRDSEFSIGV4aXN0ZW50aWFsCkVOQ09ESU5HIDg3MDcKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMApmMAoxMAo3MAoxMAoxMApmMAowMApFTkRDSEFSClNUQVJUQ0hBUiB1bmkyMjA0CkVOQ09ESU5HIDg3MDgKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAoyMApmMAozMAo3MAo1MAo1MApmMAo0MApFTkRDSEFSClNUQVJUQ0hBUiBlbXB0eXNldApFTkNPRElORyA4NzA5ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKNzAKYjAKYjAKZDAKZDAKZTAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgRGVsdGEKRU5DT0RJTkcgODcxMApTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjIwCjIwCjUwCjUwCjg4CmY4CjAwCkVORENIQVIKU1RBUlRDSEFSIGdyYWRpZW50CkVOQ09ESU5HIDg3MTEKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMApmOAo4OAo1MAo1MAoyMAoyMAowMApFTkRDSEFSClNUQVJUQ0hBUiBlbGVtZW50CkVOQ09ESU5HIDg3MTIKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAowMAo3MAo4MAplMAo4MAo3MAowMApFTkRDSEFSClNUQVJUQ0hBUiBub3RlbGVtZW50CkVOQ09ESU5HIDg3MTMKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAoyMAo3MAphMAplMApjMAo3MAo0MApFTkRDSEFSClNUQVJUQ0hBUiBzdWNodGhhdApFTkNPRElORyA4NzE1ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMDAKZTAKMTAKNzAKMTAKZTAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjIwQwpFTkNPRElORyA4NzE2ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMjAKZTAKMzAKNzAKNTAKZTAKNDAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjIwRQpFTkNPRElORyA4NzE4ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMDAKNzAKNzAKNzAKNzAKMDAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgcHJvZHVjdApFTkNPRElORyA4NzE5ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKZjgKNTAKNTAKNTAKNTAKNTAKNTAKZDgKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjIxMApFTkNPRElORyA4NzIwClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKZDgKNTAKNTAKNTAKNTAKNTAKNTAKZjgKRU5EQ0hBUgpTVEFSVENIQVIgc3VtbWF0aW9uCkVOQ09ESU5HIDg3MjEKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUApmOAo4MAo0MAoyMAoyMAo0MAo4MApmOApFTkRDSEFSClNUQVJUQ0hBUiBtaW51cwpFTkNPRElORyA4NzIyClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMDAKMDAKMDAKZjAKMDAKMDAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjIxMwpFTkNPRElORyA4NzIzClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMDAKNzAKMDAKMjAKNzAKMjAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjIxNApFTkNPRElORyA4NzI0ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMDAKMjAKMDAKMjAKNzAKMjAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgZnJhY3Rpb24KRU5DT0RJTkcgODcyNQpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjEwCjIwCjIwCjQwCjQwCjgwCjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTIyMTYKRU5DT0RJTkcgODcyNgpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjgwCjQwCjQwCjIwCjIwCjEwCjAwCkVORENIQVIKU1RBUlRDSEFSIGFzdGVyaXNrbWF0aApFTkNPRElORyA4NzI3ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMDAKOTAKNjAKZjAKNjAKOTAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjIxOApFTkNPRElORyA4NzI4ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMDAKMDAKMjAKNTAKMjAKMDAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgcGVyaW9kY2VudGVyZWQKRU5DT0RJTkcgODcyOQpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjAwCjAwCjIwCjcwCjIwCjAwCjAwCkVORENIQVIKU1RBUlRDSEFSIHJhZGljYWwKRU5DT0RJTkcgODczMApTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjE4CjEwCjEwCjEwCmQwCjUwCjIwCjIwCkVORENIQVIKU1RBUlRDSEFSIHByb3BvcnRpb25hbApFTkNPRElORyA4NzMzClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMDAKNTAKYTAKYTAKNTAKMDAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgaW5maW5pdHkKRU5DT0RJTkcgODczNApTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjAwCjUwCmE4CmE4CjUwCjAwCjAwCkVORENIQVIKU1RBUlRDSEFSIG9ydGhvZ29uYWwKRU5DT0RJTkcgODczNQpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjAwCjAwCjgwCjgwCjgwCmYwCjAwCkVORENIQVIKU1RBUlRDSEFSIGFuZ2xlCkVOQ09ESU5HIDg3MzYKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAowMAoxMAoyMAo0MAo4MApmMAowMApFTkRDSEFSClNUQVJUQ0hBUiB1bmkyMjIxCkVOQ09ESU5HIDg3MzcKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAowMAoxMAphMAo0MAphMApmMAoyMApFTkRDSEFSClNUQVJUQ0hBUiB1bmkyMjIyCkVOQ09ESU5HIDg3MzgKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAo5MAo2MAphMAphMAo2MAo5MAowMApFTkRDSEFSClNUQVJUQ0hBUiB1bmkyMjIzCkVOQ09ESU5HIDg3MzkKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAoyMAoyMAoyMAoyMAoyMAoyMAowMApFTkRDSEFSClNUQVJUQ0hBUiB1bmkyMjI0CkVOQ09ESU5HIDg3NDAKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAoyMAoyMAozMAo2MAoyMAoyMAowMApFTkRDSEFSClNUQVJUQ0hBUiB1bmkyMjI1CkVOQ09ESU5HIDg3NDEKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAo1MAo1MAo1MAo1MAo1MAo1MAowMApFTkRDSEFSClNUQVJUQ0hBUiB1bmkyMjI2CkVOQ09ESU5HIDg3NDIKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAo1MAo1OAo3MApkMAo1MAo1MAowMApFTkRDSEFSClNUQVJUQ0hBUiBsb2dpY2FsYW5kCkVOQ09ESU5HIDg3NDMKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAowMAowMAo2MAo2MAo5MAo5MAowMApFTkRDSEFSClNUQVJUQ0hBUiBsb2dpY2Fsb3IKRU5DT0RJTkcgODc0NApTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjAwCjAwCjkwCjkwCjYwCjYwCjAwCkVORENIQVIKU1RBUlRDSEFSIGludGVyc2VjdGlvbgpFTkNPRElORyA4NzQ1ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMDAKMDAKNjAKOTAKOTAKOTAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pb24KRU5DT0RJTkcgODc0NgpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjAwCjAwCjkwCjkwCjkwCjYwCjAwCkVORENIQVIKU1RBUlRDSEFSIGludGVncmFsCkVOQ09ESU5HIDg3NDcKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAoxMAoyOAoyMAoyMAoyMAoyMAphMAo0MApFTkRDSEFSClNUQVJUQ0hBUiB1bmkyMjJFCkVOQ09ESU5HIDg3NTAKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAoxMAoyOAoyMAo3MAo3MAoyMAphMAo0MApFTkRDSEFSClNUQVJUQ0hBUiB0aGVyZWZvcmUKRU5DT0RJTkcgODc1NgpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjAwCjAwCjIwCjAwCjUwCjAwCjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTIyMzUKRU5DT0RJTkcgODc1NwpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjAwCjAwCjUwCjAwCjIwCjAwCjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTIyMzYKRU5DT0RJTkcgODc1OApTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjAwCjAwCjIwCjAwCjIwCjAwCjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTIyMzcKRU5DT0RJTkcgODc1OQpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjAwCjAwCjUwCjAwCjUwCjAwCjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTIyMzgKRU5DT0RJTkcgODc2MApTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjAwCjYwCjAwCmYwCjAwCjAwCjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTIyMzkKRU5DT0RJTkcgODc2MQpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjAwCjAwCjEwCmMwCjEwCjAwCjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTIyM0EKRU5DT0RJTkcgODc2MgpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjAwCjkwCjAwCmYwCjAwCjkwCjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTIyM0IKRU5DT0RJTkcgODc2MwpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjIwCjAwCjUwCmEwCjAwCjQwCjAwCkVORENIQVIKU1RBUlRDSEFSIHNpbWlsYXIKRU5DT0RJTkcgODc2NApTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjAwCjAwCjUwCmEwCjAwCjAwCjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTIyM0QKRU5DT0RJTkcgODc2NQpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjAwCjAwCmEwCjUwCjAwCjAwCjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTIyNDAKRU5DT0RJTkcgODc2OApTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjQwCjIwCjIwCjQwCjQwCjIwCjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTIyNDIKRU5DT0RJTkcgODc3MApTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjAwCjAwCmYwCjAwCjUwCmEwCjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTIyNDMKRU5DT0RJTkcgODc3MQpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjAwCjAwCjUwCmEwCjAwCmYwCjAwCkVORENIQVIKU1RBUlRDSEFSIGNvbmdydWVudApFTkNPRElORyA4NzczClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKNTAKYTAKMDAKZjAKMDAKZjAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgYXBwcm94ZXF1YWwKRU5DT0RJTkcgODc3NgpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjAwCjAwCjUwCmEwCjUwCmEwCjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTIyNDkKRU5DT0RJTkcgODc3NwpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjAwCjIwCjcwCmEwCjUwCmUwCjQwCkVORENIQVIKU1RBUlRDSEFSIHVuaTIyNEEKRU5DT0RJTkcgODc3OApTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjUwCmEwCjUwCmEwCjAwCmYwCjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTIyNEIKRU5DT0RJTkcgODc3OQpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjUwCmEwCjUwCmEwCjUwCmEwCjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTIyNTgKRU5DT0RJTkcgODc5MgpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjYwCjkwCjAwCmYwCjAwCmYwCjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTIyNTkKRU5DT0RJTkcgODc5MwpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjIwCjUwCjAwCmYwCjAwCmYwCjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTIyNUEKRU5DT0RJTkcgODc5NApTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjUwCjIwCjAwCmYwCjAwCmYwCjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTIyNUYKRU5DT0RJTkcgODc5OQpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjQwCjIwCjQwCjAwCjQwCmYwCjAwCmYwCkVORENIQVIKU1RBUlRDSEFSIG5vdGVxdWFsCkVOQ09ESU5HIDg4MDAKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAowMAoyMApmMAo2MApmMAo0MAowMApFTkRDSEFSClNUQVJUQ0hBUiBlcXVpdmFsZW5jZQpFTkNPRElORyA4ODAxClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMDAKZjAKMDAKZjAKMDAKZjAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjI2MgpFTkNPRElORyA4ODAyClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMTAKZjAKMjAKZjAKNDAKZjAKODAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjI2MwpFTkNPRElORyA4ODAzClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKZjAKMDAKZjAKMDAKZjAKMDAKZjAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgbGVzc2VxdWFsCkVOQ09ESU5HIDg4MDQKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAoxMAoyMAo0MAoyMAoxMAo3MAowMApFTkRDSEFSClNUQVJUQ0hBUiBncmVhdGVyZXF1YWwKRU5DT0RJTkcgODgwNQpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjQwCjIwCjEwCjIwCjQwCjcwCjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTIyNkEKRU5DT0RJTkcgODgxMApTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjAwCjI4CjUwCmEwCjUwCjI4CjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTIyNkIKRU5DT0RJTkcgODgxMQpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjAwCmEwCjUwCjI4CjUwCmEwCjAwCkVORENIQVIKU1RBUlRDSEFSIHByb3BlcnN1YnNldApFTkNPRElORyA4ODM0ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMDAKMDAKNzAKODAKNzAKMDAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgcHJvcGVyc3VwZXJzZXQKRU5DT0RJTkcgODgzNQpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjAwCjAwCmUwCjEwCmUwCjAwCjAwCkVORENIQVIKU1RBUlRDSEFSIG5vdHN1YnNldApFTkNPRElORyA4ODM2ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMDAKMjAKNzAKYTAKNzAKMjAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjI4NQpFTkNPRElORyA4ODM3ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMDAKNDAKZTAKNTAKZTAKNDAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgcmVmbGV4c3Vic2V0CkVOQ09ESU5HIDg4MzgKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAowMAo3MAo4MAo3MAowMApmMAowMApFTkRDSEFSClNUQVJUQ0hBUiByZWZsZXhzdXBlcnNldApFTkNPRElORyA4ODM5ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMDAKZTAKMTAKZTAKMDAKZjAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjI4OApFTkNPRElORyA4ODQwClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMjAKNzAKYTAKNzAKMjAKZjAKMjAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjI4OQpFTkNPRElORyA4ODQxClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKNDAKZTAKNTAKZTAKNDAKZjAKNDAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjI4QQpFTkNPRElORyA4ODQyClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMDAKNzAKODAKNzAKMjAKZjAKNDAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjI4QgpFTkNPRElORyA4ODQzClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMDAKZTAKMTAKZTAKMjAKZjAKNDAKRU5EQ0hBUgpTVEFSVENIQVIgY2lyY2xlcGx1cwpFTkNPRElORyA4ODUzClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMDAKNzAKYTgKZjgKYTgKNzAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjI5NgpFTkNPRElORyA4ODU0ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMDAKNzAKODgKZjgKODgKNzAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgY2lyY2xlbXVsdGlwbHkKRU5DT0RJTkcgODg1NQpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjAwCjcwCmQ4CmE4CmQ4CjcwCjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTIyOTgKRU5DT0RJTkcgODg1NgpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjAwCjcwCjk4CmE4CmM4CjcwCjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTIyOTkKRU5DT0RJTkcgODg1NwpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjAwCjcwCjg4CmE4Cjg4CjcwCjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTIyOUUKRU5DT0RJTkcgODg2MgpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjAwCmY4CmE4CmY4CmE4CmY4CjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTIyOUYKRU5DT0RJTkcgODg2MwpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjAwCmY4Cjg4CmY4Cjg4CmY4CjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTIyQTAKRU5DT0RJTkcgODg2NApTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjAwCmY4CmQ4CmE4CmQ4CmY4CjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTIyQTEKRU5DT0RJTkcgODg2NQpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjAwCmY4Cjg4CmE4Cjg4CmY4CjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTIyQTIKRU5DT0RJTkcgODg2NgpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjAwCjgwCjgwCmYwCjgwCjgwCjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTIyQTMKRU5DT0RJTkcgODg2NwpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjAwCjEwCjEwCmYwCjEwCjEwCjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTIyQTQKRU5DT0RJTkcgODg2OApTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCmY4CjIwCjIwCjIwCjIwCjIwCjAwCkVORENIQVIKU1RBUlRDSEFSIHBlcnBlbmRpY3VsYXIKRU5DT0RJTkcgODg2OQpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjIwCjIwCjIwCjIwCjIwCmY4CjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTIyQTYKRU5DT0RJTkcgODg3MApTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjAwCjQwCjQwCjcwCjQwCjQwCjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTIyQTcKRU5DT0RJTkcgODg3MQpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjAwCjQwCjcwCjQwCjcwCjQwCjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTIyQTgKRU5DT0RJTkcgODg3MgpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjAwCjgwCmYwCjgwCmYwCjgwCjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTIyQzAKRU5DT0RJTkcgODg5NgpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjIwCjIwCjUwCjUwCjUwCjg4Cjg4Cjg4CkVORENIQVIKU1RBUlRDSEFSIHVuaTIyQzEKRU5DT0RJTkcgODg5NwpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjg4Cjg4Cjg4CjUwCjUwCjUwCjIwCjIwCkVORENIQVIKU1RBUlRDSEFSIHVuaTIyQzIKRU5DT0RJTkcgODg5OApTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjIwCjUwCjg4Cjg4Cjg4Cjg4Cjg4Cjg4CkVORENIQVIKU1RBUlRDSEFSIHVuaTIyQzMKRU5DT0RJTkcgODg5OQpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjg4Cjg4Cjg4Cjg4Cjg4Cjg4CjUwCjIwCkVORENIQVIKU1RBUlRDSEFSIGRvdG1hdGgKRU5DT0RJTkcgODkwMQpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjAwCjAwCjAwCjIwCjAwCjAwCjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTIzMDAKRU5DT0RJTkcgODk2MApTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjAwCjAwCjUwCmEwCjUwCmEwCjAwCkVORENIQVIKU1RBUlRDSEFSIGhvdXNlCkVOQ09ESU5HIDg5NjIKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAo2MAo5MAo5MAo5MAo5MApmMAowMApFTkRDSEFSClNUQVJUQ0hBUiB1bmkyMzA4CkVOQ09ESU5HIDg5NjgKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAo3MAo0MAo0MAo0MAo0MAo0MAowMApFTkRDSEFSClNUQVJUQ0hBUiB1bmkyMzA5CkVOQ09ESU5HIDg5NjkKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAo3MAoxMAoxMAoxMAoxMAoxMAowMApFTkRDSEFSClNUQVJUQ0hBUiB1bmkyMzBBCkVOQ09ESU5HIDg5NzAKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAo0MAo0MAo0MAo0MAo0MAo3MAowMApFTkRDSEFSClNUQVJUQ0hBUiB1bmkyMzBCCkVOQ09ESU5HIDg5NzEKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAoxMAoxMAoxMAoxMAoxMAo3MAowMApFTkRDSEFSClNUQVJUQ0hBUiByZXZsb2dpY2Fsbm90CkVOQ09ESU5HIDg5NzYKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAowMAowMAowMAo3MAo0MAo0MAowMApFTkRDSEFSClNUQVJUQ0hBUiB1bmkyMzE1CkVOQ09ESU5HIDg5ODEKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAowMAowMAo2MAo5MApkMAplMAowMApFTkRDSEFSClNUQVJUQ0hBUiBpbnRlZ3JhbHRwCkVOQ09ESU5HIDg5OTIKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAoxMAoyOAoyMAoyMAoyMAoyMAoyMApFTkRDSEFSClNUQVJUQ0hBUiBpbnRlZ3JhbGJ0CkVOQ09ESU5HIDg5OTMKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAoyMAoyMAoyMAoyMAoyMAphMAo0MAowMApFTkRDSEFSClNUQVJUQ0hBUiB1bmkyM0JBCkVOQ09ESU5HIDkxNDYKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUApmOAowMAowMAowMAowMAowMAowMAowMApFTkRDSEFSClNUQVJUQ0hBUiB1bmkyM0JCCkVOQ09ESU5HIDkxNDcKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMApmOAowMAowMAowMAowMAowMAowMApFTkRDSEFSClNUQVJUQ0hBUiB1bmkyM0JDCkVOQ09ESU5HIDkxNDgKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAowMAowMAowMAowMAowMApmOAowMApFTkRDSEFSClNUQVJUQ0hBUiB1bmkyM0JECkVOQ09ESU5HIDkxNDkKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAowMAowMAowMAowMAowMAowMApmOApFTkRDSEFSClNUQVJUQ0hBUiB1bmkyNDA5CkVOQ09ESU5HIDkyMjUKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAphMAphMAplMAphMAphMAo3MAoyMAoyMApFTkRDSEFSClNUQVJUQ0hBUiB1bmkyNDBBCkVOQ09ESU5HIDkyMjYKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAo4MAo4MAo4MAplMAozOAoyMAozMAoyMApFTkRDSEFSClNUQVJUQ0hBUiB1bmkyNDBCCkVOQ09ESU5HIDkyMjcKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAphMAphMAphMAo0MAozOAoxMAoxMAoxMApFTkRDSEFSClNUQVJUQ0hBUiB1bmkyNDBDCkVOQ09ESU5HIDkyMjgKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAplMAo4MApjMApiOAphMAozMAoyMAoyMApFTkRDSEFSClNUQVJUQ0hBUiB1bmkyNDBECkVOQ09ESU5HIDkyMjkKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAo2MAo4MAo4MAo2MAozMAoyOAozMAoyOApFTkRDSEFSClNUQVJUQ0hBUiB1bmkyNDI0CkVOQ09ESU5HIDkyNTIKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAo5MApkMApiMAo5MAoyMAoyMAoyMAozOApFTkRDSEFSClNUQVJUQ0hBUiBTRjEwMDAwMApFTkNPRElORyA5NDcyClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMDAKMDAKZjgKMDAKMDAKMDAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjUwMQpFTkNPRElORyA5NDczClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMDAKMDAKZjgKZjgKMDAKMDAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgU0YxMTAwMDAKRU5DT0RJTkcgOTQ3NApTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjIwCjIwCjIwCjIwCjIwCjIwCjIwCjIwCkVORENIQVIKU1RBUlRDSEFSIHVuaTI1MDMKRU5DT0RJTkcgOTQ3NQpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjMwCjMwCjMwCjMwCjMwCjMwCjMwCjMwCkVORENIQVIKU1RBUlRDSEFSIHVuaTI1MDQKRU5DT0RJTkcgOTQ3NgpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjAwCjAwCmE4CjAwCjAwCjAwCjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTI1MDUKRU5DT0RJTkcgOTQ3NwpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjAwCjAwCmE4CmE4CjAwCjAwCjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTI1MDYKRU5DT0RJTkcgOTQ3OApTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjIwCjIwCjAwCjIwCjIwCjAwCjIwCjIwCkVORENIQVIKU1RBUlRDSEFSIHVuaTI1MDcKRU5DT0RJTkcgOTQ3OQpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjMwCjMwCjAwCjMwCjMwCjAwCjMwCjMwCkVORENIQVIKU1RBUlRDSEFSIHVuaTI1MDgKRU5DT0RJTkcgOTQ4MApTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjAwCjAwCmE4CjAwCjAwCjAwCjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTI1MDkKRU5DT0RJTkcgOTQ4MQpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjAwCjAwCmE4CmE4CjAwCjAwCjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTI1MEEKRU5DT0RJTkcgOTQ4MgpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjIwCjAwCjIwCjAwCjIwCjAwCjIwCjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTI1MEIKRU5DT0RJTkcgOTQ4MwpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjMwCjAwCjMwCjAwCjMwCjAwCjMwCjAwCkVORENIQVIKU1RBUlRDSEFSIFNGMDEwMDAwCkVOQ09ESU5HIDk0ODQKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAowMAowMAozOAoyMAoyMAoyMAoyMApFTkRDSEFSClNUQVJUQ0hBUiB1bmkyNTBECkVOQ09ESU5HIDk0ODUKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAowMAowMAozOAozOAoyMAoyMAoyMApFTkRDSEFSClNUQVJUQ0hBUiB1bmkyNTBFCkVOQ09ESU5HIDk0ODYKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAowMAowMAozOAozMAozMAozMAozMApFTkRDSEFSClNUQVJUQ0hBUiB1bmkyNTBGCkVOQ09ESU5HIDk0ODcKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAowMAowMAozOAozOAozMAozMAozMApFTkRDSEFSClNUQVJUQ0hBUiBTRjAzMDAwMApFTkNPRElORyA5NDg4ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMDAKMDAKZTAKMjAKMjAKMjAKMjAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjUxMQpFTkNPRElORyA5NDg5ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMDAKMDAKZTAKZTAKMjAKMjAKMjAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjUxMgpFTkNPRElORyA5NDkwClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMDAKMDAKZjAKMzAKMzAKMzAKMzAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjUxMwpFTkNPRElORyA5NDkxClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMDAKMDAKZjAKZjAKMzAKMzAKMzAKRU5EQ0hBUgpTVEFSVENIQVIgU0YwMjAwMDAKRU5DT0RJTkcgOTQ5MgpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjIwCjIwCjIwCjM4CjAwCjAwCjAwCjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTI1MTUKRU5DT0RJTkcgOTQ5MwpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjIwCjIwCjIwCjM4CjM4CjAwCjAwCjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTI1MTYKRU5DT0RJTkcgOTQ5NApTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjMwCjMwCjMwCjM4CjAwCjAwCjAwCjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTI1MTcKRU5DT0RJTkcgOTQ5NQpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjMwCjMwCjMwCjM4CjM4CjAwCjAwCjAwCkVORENIQVIKU1RBUlRDSEFSIFNGMDQwMDAwCkVOQ09ESU5HIDk0OTYKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAoyMAoyMAoyMAplMAowMAowMAowMAowMApFTkRDSEFSClNUQVJUQ0hBUiB1bmkyNTE5CkVOQ09ESU5HIDk0OTcKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAoyMAoyMAoyMAplMAplMAowMAowMAowMApFTkRDSEFSClNUQVJUQ0hBUiB1bmkyNTFBCkVOQ09ESU5HIDk0OTgKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAozMAozMAozMApmMAowMAowMAowMAowMApFTkRDSEFSClNUQVJUQ0hBUiB1bmkyNTFCCkVOQ09ESU5HIDk0OTkKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAozMAozMAozMApmMApmMAowMAowMAowMApFTkRDSEFSClNUQVJUQ0hBUiBTRjA4MDAwMApFTkNPRElORyA5NTAwClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMjAKMjAKMjAKMzgKMjAKMjAKMjAKMjAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjUxRApFTkNPRElORyA5NTAxClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMjAKMjAKMjAKMzgKMzgKMjAKMjAKMjAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjUxRQpFTkNPRElORyA5NTAyClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMzAKMzAKMzAKMzgKMjAKMjAKMjAKMjAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjUxRgpFTkNPRElORyA5NTAzClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMjAKMjAKMjAKMzgKMzAKMzAKMzAKMzAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjUyMApFTkNPRElORyA5NTA0ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMzAKMzAKMzAKMzgKMzAKMzAKMzAKMzAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjUyMQpFTkNPRElORyA5NTA1ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMzAKMzAKMzAKMzgKMzgKMjAKMjAKMjAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjUyMgpFTkNPRElORyA5NTA2ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMjAKMjAKMjAKMzgKMzgKMzAKMzAKMzAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjUyMwpFTkNPRElORyA5NTA3ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMzAKMzAKMzAKMzgKMzgKMzAKMzAKMzAKRU5EQ0hBUgpTVEFSVENIQVIgU0YwOTAwMDAKRU5DT0RJTkcgOTUwOApTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjIwCjIwCjIwCmUwCjIwCjIwCjIwCjIwCkVORENIQVIKU1RBUlRDSEFSIHVuaTI1MjUKRU5DT0RJTkcgOTUwOQpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjIwCjIwCjIwCmUwCmUwCjIwCjIwCjIwCkVORENIQVIKU1RBUlRDSEFSIHVuaTI1MjYKRU5DT0RJTkcgOTUxMApTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjMwCjMwCjMwCmYwCjIwCjIwCjIwCjIwCkVORENIQVIKU1RBUlRDSEFSIHVuaTI1MjcKRU5DT0RJTkcgOTUxMQpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjIwCjIwCjIwCmYwCjMwCjMwCjMwCjMwCkVORENIQVIKU1RBUlRDSEFSIHVuaTI1MjgKRU5DT0RJTkcgOTUxMgpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjMwCjMwCjMwCmYwCjMwCjMwCjMwCjMwCkVORENIQVIKU1RBUlRDSEFSIHVuaTI1MjkKRU5DT0RJTkcgOTUxMwpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjMwCjMwCjMwCmYwCmYwCjIwCjIwCjIwCkVORENIQVIKU1RBUlRDSEFSIHVuaTI1MkEKRU5DT0RJTkcgOTUxNApTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjIwCjIwCjIwCmYwCmYwCjMwCjMwCjMwCkVORENIQVIKU1RBUlRDSEFSIHVuaTI1MkIKRU5DT0RJTkcgOTUxNQpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjMwCjMwCjMwCmYwCmYwCjMwCjMwCjMwCkVORENIQVIKU1RBUlRDSEFSIFNGMDYwMDAwCkVOQ09ESU5HIDk1MTYKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAowMAowMApmOAoyMAoyMAoyMAoyMApFTkRDSEFSClNUQVJUQ0hBUiB1bmkyNTJECkVOQ09ESU5HIDk1MTcKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAowMAowMApmOAplMAoyMAoyMAoyMApFTkRDSEFSClNUQVJUQ0hBUiB1bmkyNTJFCkVOQ09ESU5HIDk1MTgKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAowMAowMApmOAozOAoyMAoyMAoyMApFTkRDSEFSClNUQVJUQ0hBUiB1bmkyNTJGCkVOQ09ESU5HIDk1MTkKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAowMAowMApmOApmOAoyMAoyMAoyMApFTkRDSEFSClNUQVJUQ0hBUiB1bmkyNTMwCkVOQ09ESU5HIDk1MjAKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAowMAowMApmOAozMAozMAozMAozMApFTkRDSEFSClNUQVJUQ0hBUiB1bmkyNTMxCkVOQ09ESU5HIDk1MjEKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAowMAowMApmOApmMAozMAozMAozMApFTkRDSEFSClNUQVJUQ0hBUiB1bmkyNTMyCkVOQ09ESU5HIDk1MjIKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAowMAowMApmOAozOAozMAozMAozMApFTkRDSEFSClNUQVJUQ0hBUiB1bmkyNTMzCkVOQ09ESU5HIDk1MjMKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAowMAowMApmOApmOAozMAozMAozMApFTkRDSEFSClNUQVJUQ0hBUiBTRjA3MDAwMApFTkNPRElORyA5NTI0ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMjAKMjAKMjAKZjgKMDAKMDAKMDAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjUzNQpFTkNPRElORyA5NTI1ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMjAKMjAKMjAKZjgKZTAKMDAKMDAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjUzNgpFTkNPRElORyA5NTI2ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMjAKMjAKMjAKZjgKMzgKMDAKMDAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjUzNwpFTkNPRElORyA5NTI3ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMjAKMjAKMjAKZjgKZjgKMDAKMDAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjUzOApFTkNPRElORyA5NTI4ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMzAKMzAKMzAKZjgKMDAKMDAKMDAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjUzOQpFTkNPRElORyA5NTI5ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMzAKMzAKMzAKZjgKZjAKMDAKMDAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjUzQQpFTkNPRElORyA5NTMwClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMzAKMzAKMzAKZjgKMzgKMDAKMDAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjUzQgpFTkNPRElORyA5NTMxClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMzAKMzAKMzAKZjgKZjgKMDAKMDAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgU0YwNTAwMDAKRU5DT0RJTkcgOTUzMgpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjIwCjIwCjIwCmY4CjIwCjIwCjIwCjIwCkVORENIQVIKU1RBUlRDSEFSIHVuaTI1M0QKRU5DT0RJTkcgOTUzMwpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjIwCjIwCjIwCmY4CmUwCjIwCjIwCjIwCkVORENIQVIKU1RBUlRDSEFSIHVuaTI1M0UKRU5DT0RJTkcgOTUzNApTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjIwCjIwCjIwCmY4CjM4CjIwCjIwCjIwCkVORENIQVIKU1RBUlRDSEFSIHVuaTI1M0YKRU5DT0RJTkcgOTUzNQpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjIwCjIwCjIwCmY4CmY4CjIwCjIwCjIwCkVORENIQVIKU1RBUlRDSEFSIHVuaTI1NDAKRU5DT0RJTkcgOTUzNgpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjMwCjMwCjMwCmY4CjIwCjIwCjIwCjIwCkVORENIQVIKU1RBUlRDSEFSIHVuaTI1NDEKRU5DT0RJTkcgOTUzNwpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjIwCjIwCjIwCmY4CjMwCjMwCjMwCjMwCkVORENIQVIKU1RBUlRDSEFSIHVuaTI1NDIKRU5DT0RJTkcgOTUzOApTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjMwCjMwCjMwCmY4CjMwCjMwCjMwCjMwCkVORENIQVIKU1RBUlRDSEFSIHVuaTI1NDMKRU5DT0RJTkcgOTUzOQpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjMwCjMwCjMwCmY4CmYwCjIwCjIwCjIwCkVORENIQVIKU1RBUlRDSEFSIHVuaTI1NDQKRU5DT0RJTkcgOTU0MApTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjMwCjMwCjMwCmY4CjM4CjIwCjIwCjIwCkVORENIQVIKU1RBUlRDSEFSIHVuaTI1NDUKRU5DT0RJTkcgOTU0MQpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjIwCjIwCjIwCmY4CmYwCjMwCjMwCjMwCkVORENIQVIKU1RBUlRDSEFSIHVuaTI1NDYKRU5DT0RJTkcgOTU0MgpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjIwCjIwCjIwCmY4CjM4CjMwCjMwCjMwCkVORENIQVIKU1RBUlRDSEFSIHVuaTI1NDcKRU5DT0RJTkcgOTU0MwpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjMwCjMwCjMwCmY4CmY4CjIwCjIwCjIwCkVORENIQVIKU1RBUlRDSEFSIHVuaTI1NDgKRU5DT0RJTkcgOTU0NApTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjIwCjIwCjIwCmY4CmY4CjMwCjMwCjMwCkVORENIQVIKU1RBUlRDSEFSIHVuaTI1NDkKRU5DT0RJTkcgOTU0NQpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjMwCjMwCjMwCmY4CmYwCjMwCjMwCjMwCkVORENIQVIKU1RBUlRDSEFSIHVuaTI1NEEKRU5DT0RJTkcgOTU0NgpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjMwCjMwCjMwCmY4CjM4CjMwCjMwCjMwCkVORENIQVIKU1RBUlRDSEFSIHVuaTI1NEIKRU5DT0RJTkcgOTU0NwpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjMwCjMwCjMwCmY4CmY4CjMwCjMwCjMwCkVORENIQVIKU1RBUlRDSEFSIHVuaTI1NEMKRU5DT0RJTkcgOTU0OApTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjAwCjAwCmIwCjAwCjAwCjAwCjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTI1NEQKRU5DT0RJTkcgOTU0OQpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjAwCjAwCmIwCmIwCjAwCjAwCjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTI1NEUKRU5DT0RJTkcgOTU1MApTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjIwCjIwCjIwCjAwCjIwCjIwCjIwCjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTI1NEYKRU5DT0RJTkcgOTU1MQpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjMwCjMwCjMwCjAwCjMwCjMwCjMwCjAwCkVORENIQVIKU1RBUlRDSEFSIFNGNDMwMDAwCkVOQ09ESU5HIDk1NTIKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAowMApmOAowMApmOAowMAowMAowMApFTkRDSEFSClNUQVJUQ0hBUiBTRjI0MDAwMApFTkNPRElORyA5NTUzClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKNTAKNTAKNTAKNTAKNTAKNTAKNTAKNTAKRU5EQ0hBUgpTVEFSVENIQVIgU0Y1MTAwMDAKRU5DT0RJTkcgOTU1NApTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjAwCjM4CjIwCjM4CjIwCjIwCjIwCkVORENIQVIKU1RBUlRDSEFSIFNGNTIwMDAwCkVOQ09ESU5HIDk1NTUKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAowMAowMAo3OAo1MAo1MAo1MAo1MApFTkRDSEFSClNUQVJUQ0hBUiBTRjM5MDAwMApFTkNPRElORyA5NTU2ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMDAKNzgKNDAKNTgKNTAKNTAKNTAKRU5EQ0hBUgpTVEFSVENIQVIgU0YyMjAwMDAKRU5DT0RJTkcgOTU1NwpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjAwCmUwCjIwCmUwCjIwCjIwCjIwCkVORENIQVIKU1RBUlRDSEFSIFNGMjEwMDAwCkVOQ09ESU5HIDk1NTgKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAowMAowMApmMAo1MAo1MAo1MAo1MApFTkRDSEFSClNUQVJUQ0hBUiBTRjI1MDAwMApFTkNPRElORyA5NTU5ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMDAKZjAKMTAKZDAKNTAKNTAKNTAKRU5EQ0hBUgpTVEFSVENIQVIgU0Y1MDAwMDAKRU5DT0RJTkcgOTU2MApTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjIwCjIwCjM4CjIwCjM4CjAwCjAwCjAwCkVORENIQVIKU1RBUlRDSEFSIFNGNDkwMDAwCkVOQ09ESU5HIDk1NjEKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAo1MAo1MAo1MAo3OAowMAowMAowMAowMApFTkRDSEFSClNUQVJUQ0hBUiBTRjM4MDAwMApFTkNPRElORyA5NTYyClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKNTAKNTAKNTgKNDAKNzgKMDAKMDAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgU0YyODAwMDAKRU5DT0RJTkcgOTU2MwpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjIwCjIwCmUwCjIwCmUwCjAwCjAwCjAwCkVORENIQVIKU1RBUlRDSEFSIFNGMjcwMDAwCkVOQ09ESU5HIDk1NjQKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAo1MAo1MAo1MApmMAowMAowMAowMAowMApFTkRDSEFSClNUQVJUQ0hBUiBTRjI2MDAwMApFTkNPRElORyA5NTY1ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKNTAKNTAKZDAKMTAKZjAKMDAKMDAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgU0YzNjAwMDAKRU5DT0RJTkcgOTU2NgpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjIwCjIwCjM4CjIwCjM4CjIwCjIwCjIwCkVORENIQVIKU1RBUlRDSEFSIFNGMzcwMDAwCkVOQ09ESU5HIDk1NjcKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAo1MAo1MAo1MAo1OAo1MAo1MAo1MAo1MApFTkRDSEFSClNUQVJUQ0hBUiBTRjQyMDAwMApFTkNPRElORyA5NTY4ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKNTAKNTAKNTgKNDAKNTgKNTAKNTAKNTAKRU5EQ0hBUgpTVEFSVENIQVIgU0YxOTAwMDAKRU5DT0RJTkcgOTU2OQpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjIwCjIwCmUwCjIwCmUwCjIwCjIwCjIwCkVORENIQVIKU1RBUlRDSEFSIFNGMjAwMDAwCkVOQ09ESU5HIDk1NzAKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAo1MAo1MAo1MApkMAo1MAo1MAo1MAo1MApFTkRDSEFSClNUQVJUQ0hBUiBTRjIzMDAwMApFTkNPRElORyA5NTcxClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKNTAKNTAKZDAKMTAKZDAKNTAKNTAKNTAKRU5EQ0hBUgpTVEFSVENIQVIgU0Y0NzAwMDAKRU5DT0RJTkcgOTU3MgpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjAwCmY4CjAwCmY4CjIwCjIwCjIwCkVORENIQVIKU1RBUlRDSEFSIFNGNDgwMDAwCkVOQ09ESU5HIDk1NzMKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAowMAowMApmOAo1MAo1MAo1MAo1MApFTkRDSEFSClNUQVJUQ0hBUiBTRjQxMDAwMApFTkNPRElORyA5NTc0ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMDAKZjgKMDAKZDgKNTAKNTAKNTAKRU5EQ0hBUgpTVEFSVENIQVIgU0Y0NTAwMDAKRU5DT0RJTkcgOTU3NQpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjIwCjIwCmY4CjAwCmY4CjAwCjAwCjAwCkVORENIQVIKU1RBUlRDSEFSIFNGNDYwMDAwCkVOQ09ESU5HIDk1NzYKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAo1MAo1MAo1MApmOAowMAowMAowMAowMApFTkRDSEFSClNUQVJUQ0hBUiBTRjQwMDAwMApFTkNPRElORyA5NTc3ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKNTAKNTAKZDgKMDAKZjgKMDAKMDAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgU0Y1NDAwMDAKRU5DT0RJTkcgOTU3OApTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjIwCjIwCmY4CjIwCmY4CjIwCjIwCjIwCkVORENIQVIKU1RBUlRDSEFSIFNGNTMwMDAwCkVOQ09ESU5HIDk1NzkKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAo1MAo1MAo1MApmOAo1MAo1MAo1MAo1MApFTkRDSEFSClNUQVJUQ0hBUiBTRjQ0MDAwMApFTkNPRElORyA5NTgwClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKNTAKNTAKZDgKMDAKZDgKNTAKNTAKNTAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjU2RApFTkNPRElORyA5NTgxClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMDAKMDAKMTgKMjAKMjAKMjAKMjAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjU2RQpFTkNPRElORyA5NTgyClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMDAKMDAKYzAKMjAKMjAKMjAKMjAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjU2RgpFTkNPRElORyA5NTgzClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMjAKMjAKMjAKYzAKMDAKMDAKMDAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjU3MApFTkNPRElORyA5NTg0ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMjAKMjAKMjAKMTgKMDAKMDAKMDAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjU3MQpFTkNPRElORyA5NTg1ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDgKMTAKMTAKMjAKMjAKNDAKNDAKODAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjU3MgpFTkNPRElORyA5NTg2ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKODAKNDAKNDAKMjAKMjAKMTAKMTAKMDgKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjU3MwpFTkNPRElORyA5NTg3ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKODgKNTAKNTAKMjAKMjAKNTAKNTAKODgKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjU3NApFTkNPRElORyA5NTg4ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMDAKMDAKZTAKMDAKMDAKMDAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjU3NQpFTkNPRElORyA5NTg5ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMjAKMjAKMjAKMjAKMDAKMDAKMDAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjU3NgpFTkNPRElORyA5NTkwClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMDAKMDAKMzgKMDAKMDAKMDAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjU3NwpFTkNPRElORyA5NTkxClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMDAKMDAKMjAKMjAKMjAKMjAKMjAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjU3OApFTkNPRElORyA5NTkyClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMDAKMDAKZTAKZTAKMDAKMDAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjU3OQpFTkNPRElORyA5NTkzClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMzAKMzAKMzAKMzAKMDAKMDAKMDAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjU3QQpFTkNPRElORyA5NTk0ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMDAKMDAKMzgKMzgKMDAKMDAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjU3QgpFTkNPRElORyA5NTk1ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMDAKMDAKMzAKMzAKMzAKMzAKMzAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjU3QwpFTkNPRElORyA5NTk2ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMDAKMDAKZjgKMzgKMDAKMDAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjU3RApFTkNPRElORyA5NTk3ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMjAKMjAKMjAKMzAKMzAKMzAKMzAKMzAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjU3RQpFTkNPRElORyA5NTk4ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMDAKMDAKZjgKZTAKMDAKMDAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjU3RgpFTkNPRElORyA5NTk5ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMzAKMzAKMzAKMzAKMzAKMjAKMjAKMjAKRU5EQ0hBUgpTVEFSVENIQVIgdXBibG9jawpFTkNPRElORyA5NjAwClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKZjgKZjgKZjgKZjgKMDAKMDAKMDAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjU4MQpFTkNPRElORyA5NjAxClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMDAKMDAKMDAKMDAKMDAKMDAKZjgKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjU4MgpFTkNPRElORyA5NjAyClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMDAKMDAKMDAKMDAKMDAKZjgKZjgKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjU4MwpFTkNPRElORyA5NjAzClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMDAKMDAKMDAKMDAKZjgKZjgKZjgKRU5EQ0hBUgpTVEFSVENIQVIgZG5ibG9jawpFTkNPRElORyA5NjA0ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMDAKMDAKMDAKZjgKZjgKZjgKZjgKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjU4NQpFTkNPRElORyA5NjA1ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMDAKMDAKZjgKZjgKZjgKZjgKZjgKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjU4NgpFTkNPRElORyA5NjA2ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMDAKZjgKZjgKZjgKZjgKZjgKZjgKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjU4NwpFTkNPRElORyA5NjA3ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKZjgKZjgKZjgKZjgKZjgKZjgKZjgKRU5EQ0hBUgpTVEFSVENIQVIgYmxvY2sKRU5DT0RJTkcgOTYwOApTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCmY4CmY4CmY4CmY4CmY4CmY4CmY4CmY4CkVORENIQVIKU1RBUlRDSEFSIHVuaTI1ODkKRU5DT0RJTkcgOTYwOQpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCmYwCmYwCmYwCmYwCmYwCmYwCmYwCmYwCkVORENIQVIKU1RBUlRDSEFSIHVuaTI1OEEKRU5DT0RJTkcgOTYxMApTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCmYwCmYwCmYwCmYwCmYwCmYwCmYwCmYwCkVORENIQVIKU1RBUlRDSEFSIHVuaTI1OEIKRU5DT0RJTkcgOTYxMQpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCmUwCmUwCmUwCmUwCmUwCmUwCmUwCmUwCkVORENIQVIKU1RBUlRDSEFSIGxmYmxvY2sKRU5DT0RJTkcgOTYxMgpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCmUwCmUwCmUwCmUwCmUwCmUwCmUwCmUwCkVORENIQVIKU1RBUlRDSEFSIHVuaTI1OEQKRU5DT0RJTkcgOTYxMwpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCmMwCmMwCmMwCmMwCmMwCmMwCmMwCmMwCkVORENIQVIKU1RBUlRDSEFSIHVuaTI1OEUKRU5DT0RJTkcgOTYxNApTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCmMwCmMwCmMwCmMwCmMwCmMwCmMwCmMwCkVORENIQVIKU1RBUlRDSEFSIHVuaTI1OEYKRU5DT0RJTkcgOTYxNQpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjgwCjgwCjgwCjgwCjgwCjgwCjgwCjgwCkVORENIQVIKU1RBUlRDSEFSIHJ0YmxvY2sKRU5DT0RJTkcgOTYxNgpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjE4CjE4CjE4CjE4CjE4CjE4CjE4CjE4CkVORENIQVIKU1RBUlRDSEFSIGx0c2hhZGUKRU5DT0RJTkcgOTYxNwpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCmE4CjAwCjUwCjAwCmE4CjAwCjUwCjAwCkVORENIQVIKU1RBUlRDSEFSIHNoYWRlCkVOQ09ESU5HIDk2MTgKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAo1MAphOAo1MAphOAo1MAphOAo1MAphOApFTkRDSEFSClNUQVJUQ0hBUiBka3NoYWRlCkVOQ09ESU5HIDk2MTkKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAo1MApmOAphOApmOAo1MApmOAphOApmOApFTkRDSEFSClNUQVJUQ0hBUiB1bmkyNTk0CkVOQ09ESU5HIDk2MjAKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUApmOAowMAowMAowMAowMAowMAowMAowMApFTkRDSEFSClNUQVJUQ0hBUiB1bmkyNTk1CkVOQ09ESU5HIDk2MjEKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowOAowOAowOAowOAowOAowOAowOAowOApFTkRDSEFSClNUQVJUQ0hBUiB1bmkyNTk2CkVOQ09ESU5HIDk2MjIKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAowMAowMAowMAplMAplMAplMAplMApFTkRDSEFSClNUQVJUQ0hBUiB1bmkyNTk3CkVOQ09ESU5HIDk2MjMKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAowMAowMAowMAoxOAoxOAoxOAoxOApFTkRDSEFSClNUQVJUQ0hBUiB1bmkyNTk4CkVOQ09ESU5HIDk2MjQKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAplMAplMAplMAplMAowMAowMAowMAowMApFTkRDSEFSClNUQVJUQ0hBUiB1bmkyNTk5CkVOQ09ESU5HIDk2MjUKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAplMAplMAplMAplMApmOApmOApmOApmOApFTkRDSEFSClNUQVJUQ0hBUiB1bmkyNTlBCkVOQ09ESU5HIDk2MjYKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAplMAplMAplMAplMAoxOAoxOAoxOAoxOApFTkRDSEFSClNUQVJUQ0hBUiB1bmkyNTlCCkVOQ09ESU5HIDk2MjcKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUApmOApmOApmOApmOAplMAplMAplMAplMApFTkRDSEFSClNUQVJUQ0hBUiB1bmkyNTlDCkVOQ09ESU5HIDk2MjgKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUApmOApmOApmOApmOAoxOAoxOAoxOAoxOApFTkRDSEFSClNUQVJUQ0hBUiB1bmkyNTlECkVOQ09ESU5HIDk2MjkKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAoxOAoxOAoxOAoxOAowMAowMAowMAowMApFTkRDSEFSClNUQVJUQ0hBUiB1bmkyNTlFCkVOQ09ESU5HIDk2MzAKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAoxOAoxOAoxOAoxOAplMAplMAplMAplMApFTkRDSEFSClNUQVJUQ0hBUiB1bmkyNTlGCkVOQ09ESU5HIDk2MzEKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAoxOAoxOAoxOAoxOApmOApmOApmOApmOApFTkRDSEFSClNUQVJUQ0hBUiBmaWxsZWRib3gKRU5DT0RJTkcgOTYzMgpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjAwCjAwCmYwCmYwCmYwCmYwCjAwCkVORENIQVIKU1RBUlRDSEFSIEgyMjA3MwpFTkNPRElORyA5NjMzClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMDAKMDAKZjAKOTAKOTAKZjAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjVBMgpFTkNPRElORyA5NjM0ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMDAKMDAKNjAKOTAKOTAKNjAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjVBNwpFTkNPRElORyA5NjM5ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMDAKMDAKZjAKZDAKYjAKZjAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjVBOApFTkNPRElORyA5NjQwClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMDAKMDAKZjAKYjAKZDAKZjAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgSDE4NTQzCkVOQ09ESU5HIDk2NDIKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAowMAowMAo3MAo3MAo3MAowMAowMApFTkRDSEFSClNUQVJUQ0hBUiBIMTg1NTEKRU5DT0RJTkcgOTY0MwpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjAwCjAwCjcwCjUwCjcwCjAwCjAwCkVORENIQVIKU1RBUlRDSEFSIGZpbGxlZHJlY3QKRU5DT0RJTkcgOTY0NApTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjAwCjAwCmYwCmYwCmYwCjAwCjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTI1QUQKRU5DT0RJTkcgOTY0NQpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjAwCjAwCmYwCjkwCmYwCjAwCjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTI1QUUKRU5DT0RJTkcgOTY0NgpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjcwCjcwCjcwCjcwCjcwCjcwCjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTI1QUYKRU5DT0RJTkcgOTY0NwpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjcwCjUwCjUwCjUwCjUwCjcwCjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTI1QjAKRU5DT0RJTkcgOTY0OApTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjAwCjAwCjM4CjcwCmUwCjAwCjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTI1QjEKRU5DT0RJTkcgOTY0OQpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjAwCjAwCjM4CjUwCmUwCjAwCjAwCkVORENIQVIKU1RBUlRDSEFSIHRyaWFndXAKRU5DT0RJTkcgOTY1MApTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjIwCjIwCjcwCjcwCmY4CmY4CjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTI1QjMKRU5DT0RJTkcgOTY1MQpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjIwCjIwCjUwCjUwCjg4CmY4CjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTI1QjQKRU5DT0RJTkcgOTY1MgpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjAwCjIwCjIwCjcwCjcwCjAwCjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTI1QjUKRU5DT0RJTkcgOTY1MwpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjAwCjIwCjIwCjUwCjcwCjAwCjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTI1QjYKRU5DT0RJTkcgOTY1NApTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjQwCjYwCjcwCjcwCjYwCjQwCjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTI1QjcKRU5DT0RJTkcgOTY1NQpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjQwCjYwCjUwCjUwCjYwCjQwCjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTI1QjgKRU5DT0RJTkcgOTY1NgpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjAwCjQwCjYwCjcwCjYwCjQwCjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTI1QjkKRU5DT0RJTkcgOTY1NwpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjAwCjQwCjYwCjUwCjYwCjQwCjAwCkVORENIQVIKU1RBUlRDSEFSIHRyaWFncnQKRU5DT0RJTkcgOTY1OApTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjAwCjgwCmUwCmY4CmUwCjgwCjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTI1QkIKRU5DT0RJTkcgOTY1OQpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjAwCjgwCmUwCjk4CmUwCjgwCjAwCkVORENIQVIKU1RBUlRDSEFSIHRyaWFnZG4KRU5DT0RJTkcgOTY2MApTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCmY4CmY4CjcwCjcwCjIwCjIwCjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTI1QkQKRU5DT0RJTkcgOTY2MQpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCmY4Cjg4CjUwCjUwCjIwCjIwCjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTI1QkUKRU5DT0RJTkcgOTY2MgpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjAwCjcwCjcwCjIwCjIwCjAwCjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTI1QkYKRU5DT0RJTkcgOTY2MwpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjAwCjcwCjUwCjIwCjIwCjAwCjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTI1QzAKRU5DT0RJTkcgOTY2NApTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjEwCjMwCjcwCjcwCjMwCjEwCjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTI1QzEKRU5DT0RJTkcgOTY2NQpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjEwCjMwCjUwCjUwCjMwCjEwCjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTI1QzIKRU5DT0RJTkcgOTY2NgpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjAwCjEwCjMwCjcwCjMwCjEwCjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTI1QzMKRU5DT0RJTkcgOTY2NwpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjAwCjEwCjMwCjUwCjMwCjEwCjAwCkVORENIQVIKU1RBUlRDSEFSIHRyaWFnbGYKRU5DT0RJTkcgOTY2OApTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjAwCjA4CjM4CmY4CjM4CjA4CjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTI1QzUKRU5DT0RJTkcgOTY2OQpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjAwCjA4CjM4CmM4CjM4CjA4CjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTI1QzYKRU5DT0RJTkcgOTY3MApTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjAwCjIwCjcwCmY4CjcwCjIwCjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTI1QzcKRU5DT0RJTkcgOTY3MQpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjAwCjIwCjUwCjg4CjUwCjIwCjAwCkVORENIQVIKU1RBUlRDSEFSIGxvemVuZ2UKRU5DT0RJTkcgOTY3NApTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjIwCjUwCjUwCjg4CjUwCjUwCjIwCjAwCkVORENIQVIKU1RBUlRDSEFSIGNpcmNsZQpFTkNPRElORyA5Njc1ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMDAKNzAKODgKODgKODgKNzAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjVDQwpFTkNPRElORyA5Njc2ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMDAKNTAKMDAKODgKMDAKNTAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjVDRApFTkNPRElORyA5Njc3ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMDAKNzAKYTgKYTgKYTgKNzAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjVDRQpFTkNPRElORyA5Njc4ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMDAKNzAKYTgKZDgKYTgKNzAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgSDE4NTMzCkVOQ09ESU5HIDk2NzkKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAowMAo3MApmOApmOApmOAo3MAowMApFTkRDSEFSClNUQVJUQ0hBUiB1bmkyNUQwCkVOQ09ESU5HIDk2ODAKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAowMAo3MAplOAplOAplOAo3MAowMApFTkRDSEFSClNUQVJUQ0hBUiB1bmkyNUQxCkVOQ09ESU5HIDk2ODEKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAowMAo3MApiOApiOApiOAo3MAowMApFTkRDSEFSClNUQVJUQ0hBUiB1bmkyNUQyCkVOQ09ESU5HIDk2ODIKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAowMAo3MAo4OApmOApmOAo3MAowMApFTkRDSEFSClNUQVJUQ0hBUiB1bmkyNUQzCkVOQ09ESU5HIDk2ODMKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAowMAo3MApmOApmOAo4OAo3MAowMApFTkRDSEFSClNUQVJUQ0hBUiB1bmkyNUQ0CkVOQ09ESU5HIDk2ODQKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAowMAo3MApiOApiOAo4OAo3MAowMApFTkRDSEFSClNUQVJUQ0hBUiB1bmkyNUQ1CkVOQ09ESU5HIDk2ODUKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAowMAo3MAo5OAo5OApmOAo3MAowMApFTkRDSEFSClNUQVJUQ0hBUiBpbnZidWxsZXQKRU5DT0RJTkcgOTY4OApTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCmY4CmY4CmQ4Cjg4Cjg4CmQ4CmY4CmY4CkVORENIQVIKU1RBUlRDSEFSIGludmNpcmNsZQpFTkNPRElORyA5Njg5ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKZjgKZDgKYTgKNzAKNzAKYTgKZDgKZjgKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjVEQQpFTkNPRElORyA5NjkwClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKZjgKZDgKYTgKNzAKMDAKMDAKMDAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjVEQgpFTkNPRElORyA5NjkxClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMDAKMDAKMDAKNzAKYTgKZDgKZjgKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjVFMgpFTkNPRElORyA5Njk4ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMDAKMDAKMTAKMzAKNzAKZjAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjVFMwpFTkNPRElORyA5Njk5ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMDAKMDAKODAKYzAKZTAKZjAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjVFNApFTkNPRElORyA5NzAwClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMDAKMDAKZjAKZTAKYzAKODAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjVFNQpFTkNPRElORyA5NzAxClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMDAKMDAKZjAKNzAKMzAKMTAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgb3BlbmJ1bGxldApFTkNPRElORyA5NzAyClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMDAKMDAKNjAKOTAKOTAKNjAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjVFNwpFTkNPRElORyA5NzAzClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMDAKMDAKZjAKZDAKZDAKZjAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjVFOApFTkNPRElORyA5NzA0ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMDAKMDAKZjAKYjAKYjAKZjAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjVFOQpFTkNPRElORyA5NzA1ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMDAKMDAKZjAKZjAKZDAKZjAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjVFQQpFTkNPRElORyA5NzA2ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMDAKMDAKZjAKYjAKZjAKZjAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjYyNQpFTkNPRElORyA5NzY1ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMjAKNTAKMjAKZjgKMjAKMjAKMjAKMjAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjYyNgpFTkNPRElORyA5NzY2ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMjAKNzAKMjAKZjgKMjAKMzAKNjAKMjAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjYyNwpFTkNPRElORyA5NzY3ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMzAKMjgKMzAKMjAKYTgKNzAKYTgKMDAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjYyOApFTkNPRElORyA5NzY4ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMjAKNzAKMjAKZjgKMjAKMjAKMjAKMjAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjYyOQpFTkNPRElORyA5NzY5ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKNzAKMjAKYTgKZjgKYTgKMjAKNzAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjYzMApFTkNPRElORyA5Nzc2ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMDAKNzAKMDAKNzAKMDAKNzAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjYzMQpFTkNPRElORyA5Nzc3ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMDAKNTAKMDAKNzAKMDAKNzAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjYzMgpFTkNPRElORyA5Nzc4ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMDAKNzAKMDAKNTAKMDAKNzAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjYzMwpFTkNPRElORyA5Nzc5ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMDAKNTAKMDAKNTAKMDAKNzAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjYzNApFTkNPRElORyA5NzgwClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMDAKNzAKMDAKNzAKMDAKNTAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjYzNQpFTkNPRElORyA5NzgxClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMDAKNTAKMDAKNzAKMDAKNTAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjYzNgpFTkNPRElORyA5NzgyClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMDAKNzAKMDAKNTAKMDAKNTAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjYzNwpFTkNPRElORyA5NzgzClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMDAKNTAKMDAKNTAKMDAKNTAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjYzOApFTkNPRElORyA5Nzg0ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMjAKYTgKNzAKNTAKNzAKYTgKMjAKRU5EQ0hBUgpTVEFSVENIQVIgc21pbGVmYWNlCkVOQ09ESU5HIDk3ODYKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAo3MAo4OApkOAo4OAphOAo4OAo3MApFTkRDSEFSClNUQVJUQ0hBUiBpbnZzbWlsZWZhY2UKRU5DT0RJTkcgOTc4NwpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjcwCmE4CmY4CmE4CmQ4CjcwCjAwCkVORENIQVIKU1RBUlRDSEFSIHN1bgpFTkNPRElORyA5Nzg4ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMjAKODgKMjAKNTAKMjAKODgKMjAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjYzRApFTkNPRElORyA5Nzg5ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKNzAKYzgKMjgKMjgKYzgKNzAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjYzRQpFTkNPRElORyA5NzkwClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKNzAKOTgKYTAKYTAKOTgKNzAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgZmVtYWxlCkVOQ09ESU5HIDk3OTIKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAo3MAo4OAo4OAo3MAoyMAo3MAoyMApFTkRDSEFSClNUQVJUQ0hBUiB1bmkyNjQxCkVOQ09ESU5HIDk3OTMKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAoyMAo3MAoyMAo3MAo4OAo4OAo3MApFTkRDSEFSClNUQVJUQ0hBUiBtYWxlCkVOQ09ESU5HIDk3OTQKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAowMAozOAoxOAo2OAo5MAo5MAo2MApFTkRDSEFSClNUQVJUQ0hBUiBzcGFkZQpFTkNPRElORyA5ODI0ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMjAKMjAKNzAKZjgKMjAKNzAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjY2MQpFTkNPRElORyA5ODI1ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKNTAKYTgKODgKNTAKMjAKMjAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjY2MgpFTkNPRElORyA5ODI2ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMjAKNTAKODgKNTAKMjAKMDAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgY2x1YgpFTkNPRElORyA5ODI3ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMjAKNzAKYTgKZjgKYTgKNzAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjY2NApFTkNPRElORyA5ODI4ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMjAKNTAKNTAKZjgKMjAKNzAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgaGVhcnQKRU5DT0RJTkcgOTgyOQpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjUwCmY4CmY4CjcwCjIwCjIwCjAwCkVORENIQVIKU1RBUlRDSEFSIGRpYW1vbmQKRU5DT0RJTkcgOTgzMApTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjIwCjcwCmY4CjcwCjIwCjAwCjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTI2NjcKRU5DT0RJTkcgOTgzMQpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjIwCjcwCmE4CjcwCjIwCjcwCjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTI2NjkKRU5DT0RJTkcgOTgzMwpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjEwCjEwCjEwCjMwCjcwCjMwCjAwCkVORENIQVIKU1RBUlRDSEFSIG11c2ljYWxub3RlCkVOQ09ESU5HIDk4MzQKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAozMAoyOAoyMAo2MAplMAo2MAowMApFTkRDSEFSClNUQVJUQ0hBUiBtdXNpY2Fsbm90ZWRibApFTkNPRElORyA5ODM1ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKNDAKNjAKNTAKZDAKZDAKMzAKMzAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjY2QwpFTkNPRElORyA5ODM2ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKNDAKNjAKNTAKZjAKZDAKMzAKMzAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjY2RApFTkNPRElORyA5ODM3ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKODAKYTAKZDAKOTAKYTAKYzAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjY2RQpFTkNPRElORyA5ODM4ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKODAKODAKYjAKZDAKYjAKZDAKMTAKMTAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjY2RgpFTkNPRElORyA5ODM5ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMTAKNTgKNzAKZDAKNzgKZDAKNDAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjdFOApFTkNPRElORyAxMDIxNgpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjIwCjIwCjQwCjQwCjIwCjIwCjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTI3RTkKRU5DT0RJTkcgMTAyMTcKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAo0MAo0MAoyMAoyMAo0MAo0MAowMApFTkRDSEFSClNUQVJUQ0hBUiB1bmkyODAwCkVOQ09ESU5HIDEwMjQwClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMDAKMDAKMDAKMDAKMDAKMDAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjgwMQpFTkNPRElORyAxMDI0MQpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjQwCjAwCjAwCjAwCjAwCjAwCjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTI4MDIKRU5DT0RJTkcgMTAyNDIKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAowMAowMAo0MAowMAowMAowMAowMApFTkRDSEFSClNUQVJUQ0hBUiB1bmkyODAzCkVOQ09ESU5HIDEwMjQzClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKNDAKMDAKNDAKMDAKMDAKMDAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjgwNApFTkNPRElORyAxMDI0NApTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjAwCjAwCjAwCjAwCjQwCjAwCjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTI4MDUKRU5DT0RJTkcgMTAyNDUKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAo0MAowMAowMAowMAo0MAowMAowMApFTkRDSEFSClNUQVJUQ0hBUiB1bmkyODA2CkVOQ09ESU5HIDEwMjQ2ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMDAKMDAKNDAKMDAKNDAKMDAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjgwNwpFTkNPRElORyAxMDI0NwpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjQwCjAwCjQwCjAwCjQwCjAwCjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTI4MDgKRU5DT0RJTkcgMTAyNDgKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAoxMAowMAowMAowMAowMAowMAowMApFTkRDSEFSClNUQVJUQ0hBUiB1bmkyODA5CkVOQ09ESU5HIDEwMjQ5ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKNTAKMDAKMDAKMDAKMDAKMDAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjgwQQpFTkNPRElORyAxMDI1MApTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjEwCjAwCjQwCjAwCjAwCjAwCjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTI4MEIKRU5DT0RJTkcgMTAyNTEKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAo1MAowMAo0MAowMAowMAowMAowMApFTkRDSEFSClNUQVJUQ0hBUiB1bmkyODBDCkVOQ09ESU5HIDEwMjUyClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMTAKMDAKMDAKMDAKNDAKMDAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjgwRApFTkNPRElORyAxMDI1MwpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjUwCjAwCjAwCjAwCjQwCjAwCjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTI4MEUKRU5DT0RJTkcgMTAyNTQKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAoxMAowMAo0MAowMAo0MAowMAowMApFTkRDSEFSClNUQVJUQ0hBUiB1bmkyODBGCkVOQ09ESU5HIDEwMjU1ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKNTAKMDAKNDAKMDAKNDAKMDAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjgxMApFTkNPRElORyAxMDI1NgpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjAwCjAwCjEwCjAwCjAwCjAwCjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTI4MTEKRU5DT0RJTkcgMTAyNTcKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAo0MAowMAoxMAowMAowMAowMAowMApFTkRDSEFSClNUQVJUQ0hBUiB1bmkyODEyCkVOQ09ESU5HIDEwMjU4ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMDAKMDAKNTAKMDAKMDAKMDAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjgxMwpFTkNPRElORyAxMDI1OQpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjQwCjAwCjUwCjAwCjAwCjAwCjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTI4MTQKRU5DT0RJTkcgMTAyNjAKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAowMAowMAoxMAowMAo0MAowMAowMApFTkRDSEFSClNUQVJUQ0hBUiB1bmkyODE1CkVOQ09ESU5HIDEwMjYxClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKNDAKMDAKMTAKMDAKNDAKMDAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjgxNgpFTkNPRElORyAxMDI2MgpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjAwCjAwCjUwCjAwCjQwCjAwCjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTI4MTcKRU5DT0RJTkcgMTAyNjMKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAo0MAowMAo1MAowMAo0MAowMAowMApFTkRDSEFSClNUQVJUQ0hBUiB1bmkyODE4CkVOQ09ESU5HIDEwMjY0ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMTAKMDAKMTAKMDAKMDAKMDAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjgxOQpFTkNPRElORyAxMDI2NQpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjUwCjAwCjEwCjAwCjAwCjAwCjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTI4MUEKRU5DT0RJTkcgMTAyNjYKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAoxMAowMAo1MAowMAowMAowMAowMApFTkRDSEFSClNUQVJUQ0hBUiB1bmkyODFCCkVOQ09ESU5HIDEwMjY3ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKNTAKMDAKNTAKMDAKMDAKMDAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjgxQwpFTkNPRElORyAxMDI2OApTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjEwCjAwCjEwCjAwCjQwCjAwCjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTI4MUQKRU5DT0RJTkcgMTAyNjkKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAo1MAowMAoxMAowMAo0MAowMAowMApFTkRDSEFSClNUQVJUQ0hBUiB1bmkyODFFCkVOQ09ESU5HIDEwMjcwClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMTAKMDAKNTAKMDAKNDAKMDAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjgxRgpFTkNPRElORyAxMDI3MQpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjUwCjAwCjUwCjAwCjQwCjAwCjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTI4MjAKRU5DT0RJTkcgMTAyNzIKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAowMAowMAowMAowMAoxMAowMAowMApFTkRDSEFSClNUQVJUQ0hBUiB1bmkyODIxCkVOQ09ESU5HIDEwMjczClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKNDAKMDAKMDAKMDAKMTAKMDAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjgyMgpFTkNPRElORyAxMDI3NApTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjAwCjAwCjQwCjAwCjEwCjAwCjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTI4MjMKRU5DT0RJTkcgMTAyNzUKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAo0MAowMAo0MAowMAoxMAowMAowMApFTkRDSEFSClNUQVJUQ0hBUiB1bmkyODI0CkVOQ09ESU5HIDEwMjc2ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMDAKMDAKMDAKMDAKNTAKMDAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjgyNQpFTkNPRElORyAxMDI3NwpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjQwCjAwCjAwCjAwCjUwCjAwCjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTI4MjYKRU5DT0RJTkcgMTAyNzgKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAowMAowMAo0MAowMAo1MAowMAowMApFTkRDSEFSClNUQVJUQ0hBUiB1bmkyODI3CkVOQ09ESU5HIDEwMjc5ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKNDAKMDAKNDAKMDAKNTAKMDAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjgyOApFTkNPRElORyAxMDI4MApTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjEwCjAwCjAwCjAwCjEwCjAwCjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTI4MjkKRU5DT0RJTkcgMTAyODEKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAo1MAowMAowMAowMAoxMAowMAowMApFTkRDSEFSClNUQVJUQ0hBUiB1bmkyODJBCkVOQ09ESU5HIDEwMjgyClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMTAKMDAKNDAKMDAKMTAKMDAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjgyQgpFTkNPRElORyAxMDI4MwpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjUwCjAwCjQwCjAwCjEwCjAwCjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTI4MkMKRU5DT0RJTkcgMTAyODQKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAoxMAowMAowMAowMAo1MAowMAowMApFTkRDSEFSClNUQVJUQ0hBUiB1bmkyODJECkVOQ09ESU5HIDEwMjg1ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKNTAKMDAKMDAKMDAKNTAKMDAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjgyRQpFTkNPRElORyAxMDI4NgpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjEwCjAwCjQwCjAwCjUwCjAwCjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTI4MkYKRU5DT0RJTkcgMTAyODcKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAo1MAowMAo0MAowMAo1MAowMAowMApFTkRDSEFSClNUQVJUQ0hBUiB1bmkyODMwCkVOQ09ESU5HIDEwMjg4ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMDAKMDAKMTAKMDAKMTAKMDAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjgzMQpFTkNPRElORyAxMDI4OQpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjQwCjAwCjEwCjAwCjEwCjAwCjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTI4MzIKRU5DT0RJTkcgMTAyOTAKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAowMAowMAo1MAowMAoxMAowMAowMApFTkRDSEFSClNUQVJUQ0hBUiB1bmkyODMzCkVOQ09ESU5HIDEwMjkxClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKNDAKMDAKNTAKMDAKMTAKMDAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjgzNApFTkNPRElORyAxMDI5MgpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjAwCjAwCjEwCjAwCjUwCjAwCjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTI4MzUKRU5DT0RJTkcgMTAyOTMKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAo0MAowMAoxMAowMAo1MAowMAowMApFTkRDSEFSClNUQVJUQ0hBUiB1bmkyODM2CkVOQ09ESU5HIDEwMjk0ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMDAKMDAKNTAKMDAKNTAKMDAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjgzNwpFTkNPRElORyAxMDI5NQpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjQwCjAwCjUwCjAwCjUwCjAwCjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTI4MzgKRU5DT0RJTkcgMTAyOTYKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAoxMAowMAoxMAowMAoxMAowMAowMApFTkRDSEFSClNUQVJUQ0hBUiB1bmkyODM5CkVOQ09ESU5HIDEwMjk3ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKNTAKMDAKMTAKMDAKMTAKMDAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjgzQQpFTkNPRElORyAxMDI5OApTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjEwCjAwCjUwCjAwCjEwCjAwCjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTI4M0IKRU5DT0RJTkcgMTAyOTkKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAo1MAowMAo1MAowMAoxMAowMAowMApFTkRDSEFSClNUQVJUQ0hBUiB1bmkyODNDCkVOQ09ESU5HIDEwMzAwClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMTAKMDAKMTAKMDAKNTAKMDAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjgzRApFTkNPRElORyAxMDMwMQpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjUwCjAwCjEwCjAwCjUwCjAwCjAwCkVORENIQVIKU1RBUlRDSEFSIHVuaTI4M0UKRU5DT0RJTkcgMTAzMDIKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAoxMAowMAo1MAowMAo1MAowMAowMApFTkRDSEFSClNUQVJUQ0hBUiB1bmkyODNGCkVOQ09ESU5HIDEwMzAzClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKNTAKMDAKNTAKMDAKNTAKMDAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjg0MApFTkNPRElORyAxMDMwNApTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjAwCjAwCjAwCjAwCjAwCjAwCjQwCkVORENIQVIKU1RBUlRDSEFSIHVuaTI4NDEKRU5DT0RJTkcgMTAzMDUKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAo0MAowMAowMAowMAowMAowMAo0MApFTkRDSEFSClNUQVJUQ0hBUiB1bmkyODQyCkVOQ09ESU5HIDEwMzA2ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMDAKMDAKNDAKMDAKMDAKMDAKNDAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjg0MwpFTkNPRElORyAxMDMwNwpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjQwCjAwCjQwCjAwCjAwCjAwCjQwCkVORENIQVIKU1RBUlRDSEFSIHVuaTI4NDQKRU5DT0RJTkcgMTAzMDgKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAowMAowMAowMAowMAo0MAowMAo0MApFTkRDSEFSClNUQVJUQ0hBUiB1bmkyODQ1CkVOQ09ESU5HIDEwMzA5ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKNDAKMDAKMDAKMDAKNDAKMDAKNDAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjg0NgpFTkNPRElORyAxMDMxMApTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjAwCjAwCjQwCjAwCjQwCjAwCjQwCkVORENIQVIKU1RBUlRDSEFSIHVuaTI4NDcKRU5DT0RJTkcgMTAzMTEKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAo0MAowMAo0MAowMAo0MAowMAo0MApFTkRDSEFSClNUQVJUQ0hBUiB1bmkyODQ4CkVOQ09ESU5HIDEwMzEyClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMTAKMDAKMDAKMDAKMDAKMDAKNDAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjg0OQpFTkNPRElORyAxMDMxMwpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjUwCjAwCjAwCjAwCjAwCjAwCjQwCkVORENIQVIKU1RBUlRDSEFSIHVuaTI4NEEKRU5DT0RJTkcgMTAzMTQKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAoxMAowMAo0MAowMAowMAowMAo0MApFTkRDSEFSClNUQVJUQ0hBUiB1bmkyODRCCkVOQ09ESU5HIDEwMzE1ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKNTAKMDAKNDAKMDAKMDAKMDAKNDAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjg0QwpFTkNPRElORyAxMDMxNgpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjEwCjAwCjAwCjAwCjQwCjAwCjQwCkVORENIQVIKU1RBUlRDSEFSIHVuaTI4NEQKRU5DT0RJTkcgMTAzMTcKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAo1MAowMAowMAowMAo0MAowMAo0MApFTkRDSEFSClNUQVJUQ0hBUiB1bmkyODRFCkVOQ09ESU5HIDEwMzE4ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMTAKMDAKNDAKMDAKNDAKMDAKNDAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjg0RgpFTkNPRElORyAxMDMxOQpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjUwCjAwCjQwCjAwCjQwCjAwCjQwCkVORENIQVIKU1RBUlRDSEFSIHVuaTI4NTAKRU5DT0RJTkcgMTAzMjAKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAowMAowMAoxMAowMAowMAowMAo0MApFTkRDSEFSClNUQVJUQ0hBUiB1bmkyODUxCkVOQ09ESU5HIDEwMzIxClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKNDAKMDAKMTAKMDAKMDAKMDAKNDAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjg1MgpFTkNPRElORyAxMDMyMgpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjAwCjAwCjUwCjAwCjAwCjAwCjQwCkVORENIQVIKU1RBUlRDSEFSIHVuaTI4NTMKRU5DT0RJTkcgMTAzMjMKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAo0MAowMAo1MAowMAowMAowMAo0MApFTkRDSEFSClNUQVJUQ0hBUiB1bmkyODU0CkVOQ09ESU5HIDEwMzI0ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMDAKMDAKMTAKMDAKNDAKMDAKNDAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjg1NQpFTkNPRElORyAxMDMyNQpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjQwCjAwCjEwCjAwCjQwCjAwCjQwCkVORENIQVIKU1RBUlRDSEFSIHVuaTI4NTYKRU5DT0RJTkcgMTAzMjYKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAowMAowMAo1MAowMAo0MAowMAo0MApFTkRDSEFSClNUQVJUQ0hBUiB1bmkyODU3CkVOQ09ESU5HIDEwMzI3ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKNDAKMDAKNTAKMDAKNDAKMDAKNDAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjg1OApFTkNPRElORyAxMDMyOApTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjEwCjAwCjEwCjAwCjAwCjAwCjQwCkVORENIQVIKU1RBUlRDSEFSIHVuaTI4NTkKRU5DT0RJTkcgMTAzMjkKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAo1MAowMAoxMAowMAowMAowMAo0MApFTkRDSEFSClNUQVJUQ0hBUiB1bmkyODVBCkVOQ09ESU5HIDEwMzMwClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMTAKMDAKNTAKMDAKMDAKMDAKNDAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjg1QgpFTkNPRElORyAxMDMzMQpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjUwCjAwCjUwCjAwCjAwCjAwCjQwCkVORENIQVIKU1RBUlRDSEFSIHVuaTI4NUMKRU5DT0RJTkcgMTAzMzIKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAoxMAowMAoxMAowMAo0MAowMAo0MApFTkRDSEFSClNUQVJUQ0hBUiB1bmkyODVECkVOQ09ESU5HIDEwMzMzClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKNTAKMDAKMTAKMDAKNDAKMDAKNDAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjg1RQpFTkNPRElORyAxMDMzNApTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjEwCjAwCjUwCjAwCjQwCjAwCjQwCkVORENIQVIKU1RBUlRDSEFSIHVuaTI4NUYKRU5DT0RJTkcgMTAzMzUKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAo1MAowMAo1MAowMAo0MAowMAo0MApFTkRDSEFSClNUQVJUQ0hBUiB1bmkyODYwCkVOQ09ESU5HIDEwMzM2ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMDAKMDAKMDAKMDAKMTAKMDAKNDAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjg2MQpFTkNPRElORyAxMDMzNwpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjQwCjAwCjAwCjAwCjEwCjAwCjQwCkVORENIQVIKU1RBUlRDSEFSIHVuaTI4NjIKRU5DT0RJTkcgMTAzMzgKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAowMAowMAo0MAowMAoxMAowMAo0MApFTkRDSEFSClNUQVJUQ0hBUiB1bmkyODYzCkVOQ09ESU5HIDEwMzM5ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKNDAKMDAKNDAKMDAKMTAKMDAKNDAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjg2NApFTkNPRElORyAxMDM0MApTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjAwCjAwCjAwCjAwCjUwCjAwCjQwCkVORENIQVIKU1RBUlRDSEFSIHVuaTI4NjUKRU5DT0RJTkcgMTAzNDEKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAo0MAowMAowMAowMAo1MAowMAo0MApFTkRDSEFSClNUQVJUQ0hBUiB1bmkyODY2CkVOQ09ESU5HIDEwMzQyClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMDAKMDAKNDAKMDAKNTAKMDAKNDAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjg2NwpFTkNPRElORyAxMDM0MwpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjQwCjAwCjQwCjAwCjUwCjAwCjQwCkVORENIQVIKU1RBUlRDSEFSIHVuaTI4NjgKRU5DT0RJTkcgMTAzNDQKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAoxMAowMAowMAowMAoxMAowMAo0MApFTkRDSEFSClNUQVJUQ0hBUiB1bmkyODY5CkVOQ09ESU5HIDEwMzQ1ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKNTAKMDAKMDAKMDAKMTAKMDAKNDAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjg2QQpFTkNPRElORyAxMDM0NgpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjEwCjAwCjQwCjAwCjEwCjAwCjQwCkVORENIQVIKU1RBUlRDSEFSIHVuaTI4NkIKRU5DT0RJTkcgMTAzNDcKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAo1MAowMAo0MAowMAoxMAowMAo0MApFTkRDSEFSClNUQVJUQ0hBUiB1bmkyODZDCkVOQ09ESU5HIDEwMzQ4ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMTAKMDAKMDAKMDAKNTAKMDAKNDAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjg2RApFTkNPRElORyAxMDM0OQpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjUwCjAwCjAwCjAwCjUwCjAwCjQwCkVORENIQVIKU1RBUlRDSEFSIHVuaTI4NkUKRU5DT0RJTkcgMTAzNTAKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAoxMAowMAo0MAowMAo1MAowMAo0MApFTkRDSEFSClNUQVJUQ0hBUiB1bmkyODZGCkVOQ09ESU5HIDEwMzUxClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKNTAKMDAKNDAKMDAKNTAKMDAKNDAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjg3MApFTkNPRElORyAxMDM1MgpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjAwCjAwCjEwCjAwCjEwCjAwCjQwCkVORENIQVIKU1RBUlRDSEFSIHVuaTI4NzEKRU5DT0RJTkcgMTAzNTMKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAo0MAowMAoxMAowMAoxMAowMAo0MApFTkRDSEFSClNUQVJUQ0hBUiB1bmkyODcyCkVOQ09ESU5HIDEwMzU0ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMDAKMDAKNTAKMDAKMTAKMDAKNDAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjg3MwpFTkNPRElORyAxMDM1NQpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjQwCjAwCjUwCjAwCjEwCjAwCjQwCkVORENIQVIKU1RBUlRDSEFSIHVuaTI4NzQKRU5DT0RJTkcgMTAzNTYKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAowMAowMAoxMAowMAo1MAowMAo0MApFTkRDSEFSClNUQVJUQ0hBUiB1bmkyODc1CkVOQ09ESU5HIDEwMzU3ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKNDAKMDAKMTAKMDAKNTAKMDAKNDAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjg3NgpFTkNPRElORyAxMDM1OApTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjAwCjAwCjUwCjAwCjUwCjAwCjQwCkVORENIQVIKU1RBUlRDSEFSIHVuaTI4NzcKRU5DT0RJTkcgMTAzNTkKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAo0MAowMAo1MAowMAo1MAowMAo0MApFTkRDSEFSClNUQVJUQ0hBUiB1bmkyODc4CkVOQ09ESU5HIDEwMzYwClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMTAKMDAKMTAKMDAKMTAKMDAKNDAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjg3OQpFTkNPRElORyAxMDM2MQpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjUwCjAwCjEwCjAwCjEwCjAwCjQwCkVORENIQVIKU1RBUlRDSEFSIHVuaTI4N0EKRU5DT0RJTkcgMTAzNjIKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAoxMAowMAo1MAowMAoxMAowMAo0MApFTkRDSEFSClNUQVJUQ0hBUiB1bmkyODdCCkVOQ09ESU5HIDEwMzYzClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKNTAKMDAKNTAKMDAKMTAKMDAKNDAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjg3QwpFTkNPRElORyAxMDM2NApTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjEwCjAwCjEwCjAwCjUwCjAwCjQwCkVORENIQVIKU1RBUlRDSEFSIHVuaTI4N0QKRU5DT0RJTkcgMTAzNjUKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAo1MAowMAoxMAowMAo1MAowMAo0MApFTkRDSEFSClNUQVJUQ0hBUiB1bmkyODdFCkVOQ09ESU5HIDEwMzY2ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMTAKMDAKNTAKMDAKNTAKMDAKNDAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjg3RgpFTkNPRElORyAxMDM2NwpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjUwCjAwCjUwCjAwCjUwCjAwCjQwCkVORENIQVIKU1RBUlRDSEFSIHVuaTI4ODAKRU5DT0RJTkcgMTAzNjgKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAowMAowMAowMAowMAowMAowMAoxMApFTkRDSEFSClNUQVJUQ0hBUiB1bmkyODgxCkVOQ09ESU5HIDEwMzY5ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKNDAKMDAKMDAKMDAKMDAKMDAKMTAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjg4MgpFTkNPRElORyAxMDM3MApTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjAwCjAwCjQwCjAwCjAwCjAwCjEwCkVORENIQVIKU1RBUlRDSEFSIHVuaTI4ODMKRU5DT0RJTkcgMTAzNzEKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAo0MAowMAo0MAowMAowMAowMAoxMApFTkRDSEFSClNUQVJUQ0hBUiB1bmkyODg0CkVOQ09ESU5HIDEwMzcyClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMDAKMDAKMDAKMDAKNDAKMDAKMTAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjg4NQpFTkNPRElORyAxMDM3MwpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjQwCjAwCjAwCjAwCjQwCjAwCjEwCkVORENIQVIKU1RBUlRDSEFSIHVuaTI4ODYKRU5DT0RJTkcgMTAzNzQKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAowMAowMAo0MAowMAo0MAowMAoxMApFTkRDSEFSClNUQVJUQ0hBUiB1bmkyODg3CkVOQ09ESU5HIDEwMzc1ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKNDAKMDAKNDAKMDAKNDAKMDAKMTAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjg4OApFTkNPRElORyAxMDM3NgpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjEwCjAwCjAwCjAwCjAwCjAwCjEwCkVORENIQVIKU1RBUlRDSEFSIHVuaTI4ODkKRU5DT0RJTkcgMTAzNzcKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAo1MAowMAowMAowMAowMAowMAoxMApFTkRDSEFSClNUQVJUQ0hBUiB1bmkyODhBCkVOQ09ESU5HIDEwMzc4ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMTAKMDAKNDAKMDAKMDAKMDAKMTAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjg4QgpFTkNPRElORyAxMDM3OQpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjUwCjAwCjQwCjAwCjAwCjAwCjEwCkVORENIQVIKU1RBUlRDSEFSIHVuaTI4OEMKRU5DT0RJTkcgMTAzODAKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAoxMAowMAowMAowMAo0MAowMAoxMApFTkRDSEFSClNUQVJUQ0hBUiB1bmkyODhECkVOQ09ESU5HIDEwMzgxClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKNTAKMDAKMDAKMDAKNDAKMDAKMTAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjg4RQpFTkNPRElORyAxMDM4MgpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjEwCjAwCjQwCjAwCjQwCjAwCjEwCkVORENIQVIKU1RBUlRDSEFSIHVuaTI4OEYKRU5DT0RJTkcgMTAzODMKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAo1MAowMAo0MAowMAo0MAowMAoxMApFTkRDSEFSClNUQVJUQ0hBUiB1bmkyODkwCkVOQ09ESU5HIDEwMzg0ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMDAKMDAKMTAKMDAKMDAKMDAKMTAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjg5MQpFTkNPRElORyAxMDM4NQpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjQwCjAwCjEwCjAwCjAwCjAwCjEwCkVORENIQVIKU1RBUlRDSEFSIHVuaTI4OTIKRU5DT0RJTkcgMTAzODYKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAowMAowMAo1MAowMAowMAowMAoxMApFTkRDSEFSClNUQVJUQ0hBUiB1bmkyODkzCkVOQ09ESU5HIDEwMzg3ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKNDAKMDAKNTAKMDAKMDAKMDAKMTAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjg5NApFTkNPRElORyAxMDM4OApTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjAwCjAwCjEwCjAwCjQwCjAwCjEwCkVORENIQVIKU1RBUlRDSEFSIHVuaTI4OTUKRU5DT0RJTkcgMTAzODkKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAo0MAowMAoxMAowMAo0MAowMAoxMApFTkRDSEFSClNUQVJUQ0hBUiB1bmkyODk2CkVOQ09ESU5HIDEwMzkwClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMDAKMDAKNTAKMDAKNDAKMDAKMTAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjg5NwpFTkNPRElORyAxMDM5MQpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjQwCjAwCjUwCjAwCjQwCjAwCjEwCkVORENIQVIKU1RBUlRDSEFSIHVuaTI4OTgKRU5DT0RJTkcgMTAzOTIKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAoxMAowMAoxMAowMAowMAowMAoxMApFTkRDSEFSClNUQVJUQ0hBUiB1bmkyODk5CkVOQ09ESU5HIDEwMzkzClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKNTAKMDAKMTAKMDAKMDAKMDAKMTAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjg5QQpFTkNPRElORyAxMDM5NApTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjEwCjAwCjUwCjAwCjAwCjAwCjEwCkVORENIQVIKU1RBUlRDSEFSIHVuaTI4OUIKRU5DT0RJTkcgMTAzOTUKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAo1MAowMAo1MAowMAowMAowMAoxMApFTkRDSEFSClNUQVJUQ0hBUiB1bmkyODlDCkVOQ09ESU5HIDEwMzk2ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMTAKMDAKMTAKMDAKNDAKMDAKMTAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjg5RApFTkNPRElORyAxMDM5NwpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjUwCjAwCjEwCjAwCjQwCjAwCjEwCkVORENIQVIKU1RBUlRDSEFSIHVuaTI4OUUKRU5DT0RJTkcgMTAzOTgKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAoxMAowMAo1MAowMAo0MAowMAoxMApFTkRDSEFSClNUQVJUQ0hBUiB1bmkyODlGCkVOQ09ESU5HIDEwMzk5ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKNTAKMDAKNTAKMDAKNDAKMDAKMTAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjhBMApFTkNPRElORyAxMDQwMApTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjAwCjAwCjAwCjAwCjEwCjAwCjEwCkVORENIQVIKU1RBUlRDSEFSIHVuaTI4QTEKRU5DT0RJTkcgMTA0MDEKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAo0MAowMAowMAowMAoxMAowMAoxMApFTkRDSEFSClNUQVJUQ0hBUiB1bmkyOEEyCkVOQ09ESU5HIDEwNDAyClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMDAKMDAKNDAKMDAKMTAKMDAKMTAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjhBMwpFTkNPRElORyAxMDQwMwpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjQwCjAwCjQwCjAwCjEwCjAwCjEwCkVORENIQVIKU1RBUlRDSEFSIHVuaTI4QTQKRU5DT0RJTkcgMTA0MDQKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAowMAowMAowMAowMAo1MAowMAoxMApFTkRDSEFSClNUQVJUQ0hBUiB1bmkyOEE1CkVOQ09ESU5HIDEwNDA1ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKNDAKMDAKMDAKMDAKNTAKMDAKMTAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjhBNgpFTkNPRElORyAxMDQwNgpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjAwCjAwCjQwCjAwCjUwCjAwCjEwCkVORENIQVIKU1RBUlRDSEFSIHVuaTI4QTcKRU5DT0RJTkcgMTA0MDcKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAo0MAowMAo0MAowMAo1MAowMAoxMApFTkRDSEFSClNUQVJUQ0hBUiB1bmkyOEE4CkVOQ09ESU5HIDEwNDA4ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMTAKMDAKMDAKMDAKMTAKMDAKMTAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjhBOQpFTkNPRElORyAxMDQwOQpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjUwCjAwCjAwCjAwCjEwCjAwCjEwCkVORENIQVIKU1RBUlRDSEFSIHVuaTI4QUEKRU5DT0RJTkcgMTA0MTAKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAoxMAowMAo0MAowMAoxMAowMAoxMApFTkRDSEFSClNUQVJUQ0hBUiB1bmkyOEFCCkVOQ09ESU5HIDEwNDExClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKNTAKMDAKNDAKMDAKMTAKMDAKMTAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjhBQwpFTkNPRElORyAxMDQxMgpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjEwCjAwCjAwCjAwCjUwCjAwCjEwCkVORENIQVIKU1RBUlRDSEFSIHVuaTI4QUQKRU5DT0RJTkcgMTA0MTMKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAo1MAowMAowMAowMAo1MAowMAoxMApFTkRDSEFSClNUQVJUQ0hBUiB1bmkyOEFFCkVOQ09ESU5HIDEwNDE0ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMTAKMDAKNDAKMDAKNTAKMDAKMTAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjhBRgpFTkNPRElORyAxMDQxNQpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjUwCjAwCjQwCjAwCjUwCjAwCjEwCkVORENIQVIKU1RBUlRDSEFSIHVuaTI4QjAKRU5DT0RJTkcgMTA0MTYKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAowMAowMAoxMAowMAoxMAowMAoxMApFTkRDSEFSClNUQVJUQ0hBUiB1bmkyOEIxCkVOQ09ESU5HIDEwNDE3ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKNDAKMDAKMTAKMDAKMTAKMDAKMTAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjhCMgpFTkNPRElORyAxMDQxOApTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjAwCjAwCjUwCjAwCjEwCjAwCjEwCkVORENIQVIKU1RBUlRDSEFSIHVuaTI4QjMKRU5DT0RJTkcgMTA0MTkKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAo0MAowMAo1MAowMAoxMAowMAoxMApFTkRDSEFSClNUQVJUQ0hBUiB1bmkyOEI0CkVOQ09ESU5HIDEwNDIwClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMDAKMDAKMTAKMDAKNTAKMDAKMTAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjhCNQpFTkNPRElORyAxMDQyMQpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjQwCjAwCjEwCjAwCjUwCjAwCjEwCkVORENIQVIKU1RBUlRDSEFSIHVuaTI4QjYKRU5DT0RJTkcgMTA0MjIKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAowMAowMAo1MAowMAo1MAowMAoxMApFTkRDSEFSClNUQVJUQ0hBUiB1bmkyOEI3CkVOQ09ESU5HIDEwNDIzClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKNDAKMDAKNTAKMDAKNTAKMDAKMTAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjhCOApFTkNPRElORyAxMDQyNApTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjEwCjAwCjEwCjAwCjEwCjAwCjEwCkVORENIQVIKU1RBUlRDSEFSIHVuaTI4QjkKRU5DT0RJTkcgMTA0MjUKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAo1MAowMAoxMAowMAoxMAowMAoxMApFTkRDSEFSClNUQVJUQ0hBUiB1bmkyOEJBCkVOQ09ESU5HIDEwNDI2ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMTAKMDAKNTAKMDAKMTAKMDAKMTAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjhCQgpFTkNPRElORyAxMDQyNwpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjUwCjAwCjUwCjAwCjEwCjAwCjEwCkVORENIQVIKU1RBUlRDSEFSIHVuaTI4QkMKRU5DT0RJTkcgMTA0MjgKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAoxMAowMAoxMAowMAo1MAowMAoxMApFTkRDSEFSClNUQVJUQ0hBUiB1bmkyOEJECkVOQ09ESU5HIDEwNDI5ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKNTAKMDAKMTAKMDAKNTAKMDAKMTAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjhCRQpFTkNPRElORyAxMDQzMApTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjEwCjAwCjUwCjAwCjUwCjAwCjEwCkVORENIQVIKU1RBUlRDSEFSIHVuaTI4QkYKRU5DT0RJTkcgMTA0MzEKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAo1MAowMAo1MAowMAo1MAowMAoxMApFTkRDSEFSClNUQVJUQ0hBUiB1bmkyOEMwCkVOQ09ESU5HIDEwNDMyClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMDAKMDAKMDAKMDAKMDAKMDAKNTAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjhDMQpFTkNPRElORyAxMDQzMwpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjQwCjAwCjAwCjAwCjAwCjAwCjUwCkVORENIQVIKU1RBUlRDSEFSIHVuaTI4QzIKRU5DT0RJTkcgMTA0MzQKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAowMAowMAo0MAowMAowMAowMAo1MApFTkRDSEFSClNUQVJUQ0hBUiB1bmkyOEMzCkVOQ09ESU5HIDEwNDM1ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKNDAKMDAKNDAKMDAKMDAKMDAKNTAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjhDNApFTkNPRElORyAxMDQzNgpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjAwCjAwCjAwCjAwCjQwCjAwCjUwCkVORENIQVIKU1RBUlRDSEFSIHVuaTI4QzUKRU5DT0RJTkcgMTA0MzcKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAo0MAowMAowMAowMAo0MAowMAo1MApFTkRDSEFSClNUQVJUQ0hBUiB1bmkyOEM2CkVOQ09ESU5HIDEwNDM4ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMDAKMDAKNDAKMDAKNDAKMDAKNTAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjhDNwpFTkNPRElORyAxMDQzOQpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjQwCjAwCjQwCjAwCjQwCjAwCjUwCkVORENIQVIKU1RBUlRDSEFSIHVuaTI4QzgKRU5DT0RJTkcgMTA0NDAKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAoxMAowMAowMAowMAowMAowMAo1MApFTkRDSEFSClNUQVJUQ0hBUiB1bmkyOEM5CkVOQ09ESU5HIDEwNDQxClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKNTAKMDAKMDAKMDAKMDAKMDAKNTAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjhDQQpFTkNPRElORyAxMDQ0MgpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjEwCjAwCjQwCjAwCjAwCjAwCjUwCkVORENIQVIKU1RBUlRDSEFSIHVuaTI4Q0IKRU5DT0RJTkcgMTA0NDMKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAo1MAowMAo0MAowMAowMAowMAo1MApFTkRDSEFSClNUQVJUQ0hBUiB1bmkyOENDCkVOQ09ESU5HIDEwNDQ0ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMTAKMDAKMDAKMDAKNDAKMDAKNTAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjhDRApFTkNPRElORyAxMDQ0NQpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjUwCjAwCjAwCjAwCjQwCjAwCjUwCkVORENIQVIKU1RBUlRDSEFSIHVuaTI4Q0UKRU5DT0RJTkcgMTA0NDYKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAoxMAowMAo0MAowMAo0MAowMAo1MApFTkRDSEFSClNUQVJUQ0hBUiB1bmkyOENGCkVOQ09ESU5HIDEwNDQ3ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKNTAKMDAKNDAKMDAKNDAKMDAKNTAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjhEMApFTkNPRElORyAxMDQ0OApTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjAwCjAwCjEwCjAwCjAwCjAwCjUwCkVORENIQVIKU1RBUlRDSEFSIHVuaTI4RDEKRU5DT0RJTkcgMTA0NDkKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAo0MAowMAoxMAowMAowMAowMAo1MApFTkRDSEFSClNUQVJUQ0hBUiB1bmkyOEQyCkVOQ09ESU5HIDEwNDUwClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMDAKMDAKNTAKMDAKMDAKMDAKNTAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjhEMwpFTkNPRElORyAxMDQ1MQpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjQwCjAwCjUwCjAwCjAwCjAwCjUwCkVORENIQVIKU1RBUlRDSEFSIHVuaTI4RDQKRU5DT0RJTkcgMTA0NTIKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAowMAowMAoxMAowMAo0MAowMAo1MApFTkRDSEFSClNUQVJUQ0hBUiB1bmkyOEQ1CkVOQ09ESU5HIDEwNDUzClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKNDAKMDAKMTAKMDAKNDAKMDAKNTAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjhENgpFTkNPRElORyAxMDQ1NApTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjAwCjAwCjUwCjAwCjQwCjAwCjUwCkVORENIQVIKU1RBUlRDSEFSIHVuaTI4RDcKRU5DT0RJTkcgMTA0NTUKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAo0MAowMAo1MAowMAo0MAowMAo1MApFTkRDSEFSClNUQVJUQ0hBUiB1bmkyOEQ4CkVOQ09ESU5HIDEwNDU2ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMTAKMDAKMTAKMDAKMDAKMDAKNTAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjhEOQpFTkNPRElORyAxMDQ1NwpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjUwCjAwCjEwCjAwCjAwCjAwCjUwCkVORENIQVIKU1RBUlRDSEFSIHVuaTI4REEKRU5DT0RJTkcgMTA0NTgKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAoxMAowMAo1MAowMAowMAowMAo1MApFTkRDSEFSClNUQVJUQ0hBUiB1bmkyOERCCkVOQ09ESU5HIDEwNDU5ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKNTAKMDAKNTAKMDAKMDAKMDAKNTAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjhEQwpFTkNPRElORyAxMDQ2MApTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjEwCjAwCjEwCjAwCjQwCjAwCjUwCkVORENIQVIKU1RBUlRDSEFSIHVuaTI4REQKRU5DT0RJTkcgMTA0NjEKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAo1MAowMAoxMAowMAo0MAowMAo1MApFTkRDSEFSClNUQVJUQ0hBUiB1bmkyOERFCkVOQ09ESU5HIDEwNDYyClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMTAKMDAKNTAKMDAKNDAKMDAKNTAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjhERgpFTkNPRElORyAxMDQ2MwpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjUwCjAwCjUwCjAwCjQwCjAwCjUwCkVORENIQVIKU1RBUlRDSEFSIHVuaTI4RTAKRU5DT0RJTkcgMTA0NjQKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAowMAowMAowMAowMAoxMAowMAo1MApFTkRDSEFSClNUQVJUQ0hBUiB1bmkyOEUxCkVOQ09ESU5HIDEwNDY1ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKNDAKMDAKMDAKMDAKMTAKMDAKNTAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjhFMgpFTkNPRElORyAxMDQ2NgpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjAwCjAwCjQwCjAwCjEwCjAwCjUwCkVORENIQVIKU1RBUlRDSEFSIHVuaTI4RTMKRU5DT0RJTkcgMTA0NjcKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAo0MAowMAo0MAowMAoxMAowMAo1MApFTkRDSEFSClNUQVJUQ0hBUiB1bmkyOEU0CkVOQ09ESU5HIDEwNDY4ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMDAKMDAKMDAKMDAKNTAKMDAKNTAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjhFNQpFTkNPRElORyAxMDQ2OQpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjQwCjAwCjAwCjAwCjUwCjAwCjUwCkVORENIQVIKU1RBUlRDSEFSIHVuaTI4RTYKRU5DT0RJTkcgMTA0NzAKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAowMAowMAo0MAowMAo1MAowMAo1MApFTkRDSEFSClNUQVJUQ0hBUiB1bmkyOEU3CkVOQ09ESU5HIDEwNDcxClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKNDAKMDAKNDAKMDAKNTAKMDAKNTAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjhFOApFTkNPRElORyAxMDQ3MgpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjEwCjAwCjAwCjAwCjEwCjAwCjUwCkVORENIQVIKU1RBUlRDSEFSIHVuaTI4RTkKRU5DT0RJTkcgMTA0NzMKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAo1MAowMAowMAowMAoxMAowMAo1MApFTkRDSEFSClNUQVJUQ0hBUiB1bmkyOEVBCkVOQ09ESU5HIDEwNDc0ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMTAKMDAKNDAKMDAKMTAKMDAKNTAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjhFQgpFTkNPRElORyAxMDQ3NQpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjUwCjAwCjQwCjAwCjEwCjAwCjUwCkVORENIQVIKU1RBUlRDSEFSIHVuaTI4RUMKRU5DT0RJTkcgMTA0NzYKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAoxMAowMAowMAowMAo1MAowMAo1MApFTkRDSEFSClNUQVJUQ0hBUiB1bmkyOEVECkVOQ09ESU5HIDEwNDc3ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKNTAKMDAKMDAKMDAKNTAKMDAKNTAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjhFRQpFTkNPRElORyAxMDQ3OApTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjEwCjAwCjQwCjAwCjUwCjAwCjUwCkVORENIQVIKU1RBUlRDSEFSIHVuaTI4RUYKRU5DT0RJTkcgMTA0NzkKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAo1MAowMAo0MAowMAo1MAowMAo1MApFTkRDSEFSClNUQVJUQ0hBUiB1bmkyOEYwCkVOQ09ESU5HIDEwNDgwClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMDAKMDAKMTAKMDAKMTAKMDAKNTAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjhGMQpFTkNPRElORyAxMDQ4MQpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjQwCjAwCjEwCjAwCjEwCjAwCjUwCkVORENIQVIKU1RBUlRDSEFSIHVuaTI4RjIKRU5DT0RJTkcgMTA0ODIKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAowMAowMAo1MAowMAoxMAowMAo1MApFTkRDSEFSClNUQVJUQ0hBUiB1bmkyOEYzCkVOQ09ESU5HIDEwNDgzClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKNDAKMDAKNTAKMDAKMTAKMDAKNTAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjhGNApFTkNPRElORyAxMDQ4NApTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjAwCjAwCjEwCjAwCjUwCjAwCjUwCkVORENIQVIKU1RBUlRDSEFSIHVuaTI4RjUKRU5DT0RJTkcgMTA0ODUKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAo0MAowMAoxMAowMAo1MAowMAo1MApFTkRDSEFSClNUQVJUQ0hBUiB1bmkyOEY2CkVOQ09ESU5HIDEwNDg2ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMDAKMDAKNTAKMDAKNTAKMDAKNTAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjhGNwpFTkNPRElORyAxMDQ4NwpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjQwCjAwCjUwCjAwCjUwCjAwCjUwCkVORENIQVIKU1RBUlRDSEFSIHVuaTI4RjgKRU5DT0RJTkcgMTA0ODgKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAoxMAowMAoxMAowMAoxMAowMAo1MApFTkRDSEFSClNUQVJUQ0hBUiB1bmkyOEY5CkVOQ09ESU5HIDEwNDg5ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKNTAKMDAKMTAKMDAKMTAKMDAKNTAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjhGQQpFTkNPRElORyAxMDQ5MApTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjEwCjAwCjUwCjAwCjEwCjAwCjUwCkVORENIQVIKU1RBUlRDSEFSIHVuaTI4RkIKRU5DT0RJTkcgMTA0OTEKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAo1MAowMAo1MAowMAoxMAowMAo1MApFTkRDSEFSClNUQVJUQ0hBUiB1bmkyOEZDCkVOQ09ESU5HIDEwNDkyClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMTAKMDAKMTAKMDAKNTAKMDAKNTAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMjhGRApFTkNPRElORyAxMDQ5MwpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjUwCjAwCjEwCjAwCjUwCjAwCjUwCkVORENIQVIKU1RBUlRDSEFSIHVuaTI4RkUKRU5DT0RJTkcgMTA0OTQKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAoxMAowMAo1MAowMAo1MAowMAo1MApFTkRDSEFSClNUQVJUQ0hBUiB1bmkyOEZGCkVOQ09ESU5HIDEwNDk1ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKNTAKMDAKNTAKMDAKNTAKMDAKNTAKRU5EQ0hBUgpTVEFSVENIQVIgZmYKRU5DT0RJTkcgNjQyNTYKU1dJRFRIIDQzNiAwCkRXSURUSCA1IDAKQkJYIDUgOCAwIC0xCkJJVE1BUAowMAo1MAphOAphMApmMAphMAphMAowMApFTkRDSEFSClNUQVJUQ0hBUiBmaQpFTkNPRElORyA2NDI1NwpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjAwCjIwCjUwCjQwCmYwCjUwCjUwCjAwCkVORENIQVIKU1RBUlRDSEFSIGZsCkVOQ09ESU5HIDY0MjU4ClNXSURUSCA0MzYgMApEV0lEVEggNSAwCkJCWCA1IDggMCAtMQpCSVRNQVAKMDAKMzAKNTAKNTAKZjAKNTAKNTAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pRkZGRApFTkNPRElORyA2NTUzMwpTV0lEVEggNDM2IDAKRFdJRFRIIDUgMApCQlggNSA4IDAgLTEKQklUTUFQCjcwCmQ4CmE4CmU4CmQ4CmY4CmQ4CjcwCkVORENIQVIKRU5ERk9OVAo=",
  "tom-thumb": "U1RBUlRGT05UIDIuMQpGT05UIC1SYWNjb29uLUZpeGVkNHg2LU1lZGl1bS1SLU5vcm1hbC0tNi02MC03NS03NS1QLTQwLUlTTzEwNjQ2LTEKU0laRSA2IDc1IDc1CkZPTlRCT1VORElOR0JPWCAzIDYgMCAtMQpTVEFSVFBST1BFUlRJRVMgMjUKRk9OVF9OQU1FICJGaXhlZDR4NiIKRk9OVF9BU0NFTlQgNQpGT05UX0RFU0NFTlQgMQpRVUFEX1dJRFRIIDYKWF9IRUlHSFQgMwpDQVBfSEVJR0hUIDQKRk9OVE5BTUVfUkVHSVNUUlkgIiIKRkFNSUxZX05BTUUgIkZpeGVkNHg2IgpGT1VORFJZICJSYWNjb29uIgpXRUlHSFRfTkFNRSAiTWVkaXVtIgpTRVRXSURUSF9OQU1FICJOb3JtYWwiClNMQU5UICJSIgpBRERfU1RZTEVfTkFNRSAiIgpQSVhFTF9TSVpFIDYKUE9JTlRfU0laRSA2MApSRVNPTFVUSU9OX1ggNzUKUkVTT0xVVElPTl9ZIDc1ClJFU09MVVRJT04gNzUKU1BBQ0lORyAiUCIKQVZFUkFHRV9XSURUSCA0MApDSEFSU0VUX1JFR0lTVFJZICJJU08xMDY0NiIKQ0hBUlNFVF9FTkNPRElORyAiMSIKQ0hBUlNFVF9DT0xMRUNUSU9OUyAiQVNDSUkgSVNPTGF0aW4xRW5jb2RpbmcgSVNPMTA2NDYtMSIKRlVMTF9OQU1FICJGaXhlZDR4NiIKQ09QWVJJR0hUICIiIiIiTUlUIiIiIiIKRU5EUFJPUEVSVElFUwpDSEFSUyAyMDMKU1RBUlRDSEFSIHNwYWNlCkVOQ09ESU5HIDMyClNXSURUSCAxMDAwIDAKRFdJRFRIIDQgMApCQlggMSAxIDMgNApCSVRNQVAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgZXhjbGFtCkVOQ09ESU5HIDMzClNXSURUSCAxMDAwIDAKRFdJRFRIIDQgMApCQlggMSA1IDEgMApCSVRNQVAKODAKODAKODAKMDAKODAKRU5EQ0hBUgpTVEFSVENIQVIgcXVvdGVkYmwKRU5DT0RJTkcgMzQKU1dJRFRIIDEwMDAgMApEV0lEVEggNCAwCkJCWCAzIDIgMCAzCkJJVE1BUApBMApBMApFTkRDSEFSClNUQVJUQ0hBUiBudW1iZXJzaWduCkVOQ09ESU5HIDM1ClNXSURUSCAxMDAwIDAKRFdJRFRIIDQgMApCQlggMyA1IDAgMApCSVRNQVAKQTAKRTAKQTAKRTAKQTAKRU5EQ0hBUgpTVEFSVENIQVIgZG9sbGFyCkVOQ09ESU5HIDM2ClNXSURUSCAxMDAwIDAKRFdJRFRIIDQgMApCQlggMyA1IDAgMApCSVRNQVAKNjAKQzAKNjAKQzAKNDAKRU5EQ0hBUgpTVEFSVENIQVIgcGVyY2VudApFTkNPRElORyAzNwpTV0lEVEggMTAwMCAwCkRXSURUSCA0IDAKQkJYIDMgNSAwIDAKQklUTUFQCjgwCjIwCjQwCjgwCjIwCkVORENIQVIKU1RBUlRDSEFSIGFtcGVyc2FuZApFTkNPRElORyAzOApTV0lEVEggMTAwMCAwCkRXSURUSCA0IDAKQkJYIDMgNSAwIDAKQklUTUFQCkMwCkMwCkUwCkEwCjYwCkVORENIQVIKU1RBUlRDSEFSIHF1b3Rlc2luZ2xlCkVOQ09ESU5HIDM5ClNXSURUSCAxMDAwIDAKRFdJRFRIIDQgMApCQlggMSAyIDEgMwpCSVRNQVAKODAKODAKRU5EQ0hBUgpTVEFSVENIQVIgcGFyZW5sZWZ0CkVOQ09ESU5HIDQwClNXSURUSCAxMDAwIDAKRFdJRFRIIDQgMApCQlggMiA1IDEgMApCSVRNQVAKNDAKODAKODAKODAKNDAKRU5EQ0hBUgpTVEFSVENIQVIgcGFyZW5yaWdodApFTkNPRElORyA0MQpTV0lEVEggMTAwMCAwCkRXSURUSCA0IDAKQkJYIDIgNSAwIDAKQklUTUFQCjgwCjQwCjQwCjQwCjgwCkVORENIQVIKU1RBUlRDSEFSIGFzdGVyaXNrCkVOQ09ESU5HIDQyClNXSURUSCAxMDAwIDAKRFdJRFRIIDQgMApCQlggMyAzIDAgMgpCSVRNQVAKQTAKNDAKQTAKRU5EQ0hBUgpTVEFSVENIQVIgcGx1cwpFTkNPRElORyA0MwpTV0lEVEggMTAwMCAwCkRXSURUSCA0IDAKQkJYIDMgMyAwIDEKQklUTUFQCjQwCkUwCjQwCkVORENIQVIKU1RBUlRDSEFSIGNvbW1hCkVOQ09ESU5HIDQ0ClNXSURUSCAxMDAwIDAKRFdJRFRIIDQgMApCQlggMiAyIDAgMApCSVRNQVAKNDAKODAKRU5EQ0hBUgpTVEFSVENIQVIgaHlwaGVuCkVOQ09ESU5HIDQ1ClNXSURUSCAxMDAwIDAKRFdJRFRIIDQgMApCQlggMyAxIDAgMgpCSVRNQVAKRTAKRU5EQ0hBUgpTVEFSVENIQVIgcGVyaW9kCkVOQ09ESU5HIDQ2ClNXSURUSCAxMDAwIDAKRFdJRFRIIDQgMApCQlggMSAxIDEgMApCSVRNQVAKODAKRU5EQ0hBUgpTVEFSVENIQVIgc2xhc2gKRU5DT0RJTkcgNDcKU1dJRFRIIDEwMDAgMApEV0lEVEggNCAwCkJCWCAzIDUgMCAwCkJJVE1BUAoyMAoyMAo0MAo4MAo4MApFTkRDSEFSClNUQVJUQ0hBUiB6ZXJvCkVOQ09ESU5HIDQ4ClNXSURUSCAxMDAwIDAKRFdJRFRIIDQgMApCQlggMyA1IDAgMApCSVRNQVAKNjAKQTAKQTAKQTAKQzAKRU5EQ0hBUgpTVEFSVENIQVIgb25lCkVOQ09ESU5HIDQ5ClNXSURUSCAxMDAwIDAKRFdJRFRIIDQgMApCQlggMiA1IDAgMApCSVRNQVAKNDAKQzAKNDAKNDAKNDAKRU5EQ0hBUgpTVEFSVENIQVIgdHdvCkVOQ09ESU5HIDUwClNXSURUSCAxMDAwIDAKRFdJRFRIIDQgMApCQlggMyA1IDAgMApCSVRNQVAKQzAKMjAKNDAKODAKRTAKRU5EQ0hBUgpTVEFSVENIQVIgdGhyZWUKRU5DT0RJTkcgNTEKU1dJRFRIIDEwMDAgMApEV0lEVEggNCAwCkJCWCAzIDUgMCAwCkJJVE1BUApDMAoyMAo0MAoyMApDMApFTkRDSEFSClNUQVJUQ0hBUiBmb3VyCkVOQ09ESU5HIDUyClNXSURUSCAxMDAwIDAKRFdJRFRIIDQgMApCQlggMyA1IDAgMApCSVRNQVAKQTAKQTAKRTAKMjAKMjAKRU5EQ0hBUgpTVEFSVENIQVIgZml2ZQpFTkNPRElORyA1MwpTV0lEVEggMTAwMCAwCkRXSURUSCA0IDAKQkJYIDMgNSAwIDAKQklUTUFQCkUwCjgwCkMwCjIwCkMwCkVORENIQVIKU1RBUlRDSEFSIHNpeApFTkNPRElORyA1NApTV0lEVEggMTAwMCAwCkRXSURUSCA0IDAKQkJYIDMgNSAwIDAKQklUTUFQCjYwCjgwCkUwCkEwCkUwCkVORENIQVIKU1RBUlRDSEFSIHNldmVuCkVOQ09ESU5HIDU1ClNXSURUSCAxMDAwIDAKRFdJRFRIIDQgMApCQlggMyA1IDAgMApCSVRNQVAKRTAKMjAKNDAKODAKODAKRU5EQ0hBUgpTVEFSVENIQVIgZWlnaHQKRU5DT0RJTkcgNTYKU1dJRFRIIDEwMDAgMApEV0lEVEggNCAwCkJCWCAzIDUgMCAwCkJJVE1BUApFMApBMApFMApBMApFMApFTkRDSEFSClNUQVJUQ0hBUiBuaW5lCkVOQ09ESU5HIDU3ClNXSURUSCAxMDAwIDAKRFdJRFRIIDQgMApCQlggMyA1IDAgMApCSVRNQVAKRTAKQTAKRTAKMjAKQzAKRU5EQ0hBUgpTVEFSVENIQVIgY29sb24KRU5DT0RJTkcgNTgKU1dJRFRIIDEwMDAgMApEV0lEVEggNCAwCkJCWCAxIDMgMSAxCkJJVE1BUAo4MAowMAo4MApFTkRDSEFSClNUQVJUQ0hBUiBzZW1pY29sb24KRU5DT0RJTkcgNTkKU1dJRFRIIDEwMDAgMApEV0lEVEggNCAwCkJCWCAyIDQgMCAwCkJJVE1BUAo0MAowMAo0MAo4MApFTkRDSEFSClNUQVJUQ0hBUiBsZXNzCkVOQ09ESU5HIDYwClNXSURUSCAxMDAwIDAKRFdJRFRIIDQgMApCQlggMyA1IDAgMApCSVRNQVAKMjAKNDAKODAKNDAKMjAKRU5EQ0hBUgpTVEFSVENIQVIgZXF1YWwKRU5DT0RJTkcgNjEKU1dJRFRIIDEwMDAgMApEV0lEVEggNCAwCkJCWCAzIDMgMCAxCkJJVE1BUApFMAowMApFMApFTkRDSEFSClNUQVJUQ0hBUiBncmVhdGVyCkVOQ09ESU5HIDYyClNXSURUSCAxMDAwIDAKRFdJRFRIIDQgMApCQlggMyA1IDAgMApCSVRNQVAKODAKNDAKMjAKNDAKODAKRU5EQ0hBUgpTVEFSVENIQVIgcXVlc3Rpb24KRU5DT0RJTkcgNjMKU1dJRFRIIDEwMDAgMApEV0lEVEggNCAwCkJCWCAzIDUgMCAwCkJJVE1BUApFMAoyMAo0MAowMAo0MApFTkRDSEFSClNUQVJUQ0hBUiBhdApFTkNPRElORyA2NApTV0lEVEggMTAwMCAwCkRXSURUSCA0IDAKQkJYIDMgNSAwIDAKQklUTUFQCjQwCkEwCkUwCjgwCjYwCkVORENIQVIKU1RBUlRDSEFSIEEKRU5DT0RJTkcgNjUKU1dJRFRIIDEwMDAgMApEV0lEVEggNCAwCkJCWCAzIDUgMCAwCkJJVE1BUAo0MApBMApFMApBMApBMApFTkRDSEFSClNUQVJUQ0hBUiBCCkVOQ09ESU5HIDY2ClNXSURUSCAxMDAwIDAKRFdJRFRIIDQgMApCQlggMyA1IDAgMApCSVRNQVAKQzAKQTAKQzAKQTAKQzAKRU5EQ0hBUgpTVEFSVENIQVIgQwpFTkNPRElORyA2NwpTV0lEVEggMTAwMCAwCkRXSURUSCA0IDAKQkJYIDMgNSAwIDAKQklUTUFQCjYwCjgwCjgwCjgwCjYwCkVORENIQVIKU1RBUlRDSEFSIEQKRU5DT0RJTkcgNjgKU1dJRFRIIDEwMDAgMApEV0lEVEggNCAwCkJCWCAzIDUgMCAwCkJJVE1BUApDMApBMApBMApBMApDMApFTkRDSEFSClNUQVJUQ0hBUiBFCkVOQ09ESU5HIDY5ClNXSURUSCAxMDAwIDAKRFdJRFRIIDQgMApCQlggMyA1IDAgMApCSVRNQVAKRTAKODAKRTAKODAKRTAKRU5EQ0hBUgpTVEFSVENIQVIgRgpFTkNPRElORyA3MApTV0lEVEggMTAwMCAwCkRXSURUSCA0IDAKQkJYIDMgNSAwIDAKQklUTUFQCkUwCjgwCkUwCjgwCjgwCkVORENIQVIKU1RBUlRDSEFSIEcKRU5DT0RJTkcgNzEKU1dJRFRIIDEwMDAgMApEV0lEVEggNCAwCkJCWCAzIDUgMCAwCkJJVE1BUAo2MAo4MApFMApBMAo2MApFTkRDSEFSClNUQVJUQ0hBUiBICkVOQ09ESU5HIDcyClNXSURUSCAxMDAwIDAKRFdJRFRIIDQgMApCQlggMyA1IDAgMApCSVRNQVAKQTAKQTAKRTAKQTAKQTAKRU5EQ0hBUgpTVEFSVENIQVIgSQpFTkNPRElORyA3MwpTV0lEVEggMTAwMCAwCkRXSURUSCA0IDAKQkJYIDMgNSAwIDAKQklUTUFQCkUwCjQwCjQwCjQwCkUwCkVORENIQVIKU1RBUlRDSEFSIEoKRU5DT0RJTkcgNzQKU1dJRFRIIDEwMDAgMApEV0lEVEggNCAwCkJCWCAzIDUgMCAwCkJJVE1BUAoyMAoyMAoyMApBMAo0MApFTkRDSEFSClNUQVJUQ0hBUiBLCkVOQ09ESU5HIDc1ClNXSURUSCAxMDAwIDAKRFdJRFRIIDQgMApCQlggMyA1IDAgMApCSVRNQVAKQTAKQTAKQzAKQTAKQTAKRU5EQ0hBUgpTVEFSVENIQVIgTApFTkNPRElORyA3NgpTV0lEVEggMTAwMCAwCkRXSURUSCA0IDAKQkJYIDMgNSAwIDAKQklUTUFQCjgwCjgwCjgwCjgwCkUwCkVORENIQVIKU1RBUlRDSEFSIE0KRU5DT0RJTkcgNzcKU1dJRFRIIDEwMDAgMApEV0lEVEggNCAwCkJCWCAzIDUgMCAwCkJJVE1BUApBMApFMApFMApBMApBMApFTkRDSEFSClNUQVJUQ0hBUiBOCkVOQ09ESU5HIDc4ClNXSURUSCAxMDAwIDAKRFdJRFRIIDQgMApCQlggMyA1IDAgMApCSVRNQVAKQTAKRTAKRTAKRTAKQTAKRU5EQ0hBUgpTVEFSVENIQVIgTwpFTkNPRElORyA3OQpTV0lEVEggMTAwMCAwCkRXSURUSCA0IDAKQkJYIDMgNSAwIDAKQklUTUFQCjQwCkEwCkEwCkEwCjQwCkVORENIQVIKU1RBUlRDSEFSIFAKRU5DT0RJTkcgODAKU1dJRFRIIDEwMDAgMApEV0lEVEggNCAwCkJCWCAzIDUgMCAwCkJJVE1BUApDMApBMApDMAo4MAo4MApFTkRDSEFSClNUQVJUQ0hBUiBRCkVOQ09ESU5HIDgxClNXSURUSCAxMDAwIDAKRFdJRFRIIDQgMApCQlggMyA1IDAgMApCSVRNQVAKNDAKQTAKQTAKRTAKNjAKRU5EQ0hBUgpTVEFSVENIQVIgUgpFTkNPRElORyA4MgpTV0lEVEggMTAwMCAwCkRXSURUSCA0IDAKQkJYIDMgNSAwIDAKQklUTUFQCkMwCkEwCkUwCkMwCkEwCkVORENIQVIKU1RBUlRDSEFSIFMKRU5DT0RJTkcgODMKU1dJRFRIIDEwMDAgMApEV0lEVEggNCAwCkJCWCAzIDUgMCAwCkJJVE1BUAo2MAo4MAo0MAoyMApDMApFTkRDSEFSClNUQVJUQ0hBUiBUCkVOQ09ESU5HIDg0ClNXSURUSCAxMDAwIDAKRFdJRFRIIDQgMApCQlggMyA1IDAgMApCSVRNQVAKRTAKNDAKNDAKNDAKNDAKRU5EQ0hBUgpTVEFSVENIQVIgVQpFTkNPRElORyA4NQpTV0lEVEggMTAwMCAwCkRXSURUSCA0IDAKQkJYIDMgNSAwIDAKQklUTUFQCkEwCkEwCkEwCkEwCjYwCkVORENIQVIKU1RBUlRDSEFSIFYKRU5DT0RJTkcgODYKU1dJRFRIIDEwMDAgMApEV0lEVEggNCAwCkJCWCAzIDUgMCAwCkJJVE1BUApBMApBMApBMAo0MAo0MApFTkRDSEFSClNUQVJUQ0hBUiBXCkVOQ09ESU5HIDg3ClNXSURUSCAxMDAwIDAKRFdJRFRIIDQgMApCQlggMyA1IDAgMApCSVRNQVAKQTAKQTAKRTAKRTAKQTAKRU5EQ0hBUgpTVEFSVENIQVIgWApFTkNPRElORyA4OApTV0lEVEggMTAwMCAwCkRXSURUSCA0IDAKQkJYIDMgNSAwIDAKQklUTUFQCkEwCkEwCjQwCkEwCkEwCkVORENIQVIKU1RBUlRDSEFSIFkKRU5DT0RJTkcgODkKU1dJRFRIIDEwMDAgMApEV0lEVEggNCAwCkJCWCAzIDUgMCAwCkJJVE1BUApBMApBMAo0MAo0MAo0MApFTkRDSEFSClNUQVJUQ0hBUiBaCkVOQ09ESU5HIDkwClNXSURUSCAxMDAwIDAKRFdJRFRIIDQgMApCQlggMyA1IDAgMApCSVRNQVAKRTAKMjAKNDAKODAKRTAKRU5EQ0hBUgpTVEFSVENIQVIgYnJhY2tldGxlZnQKRU5DT0RJTkcgOTEKU1dJRFRIIDEwMDAgMApEV0lEVEggNCAwCkJCWCAzIDUgMCAwCkJJVE1BUApFMAo4MAo4MAo4MApFMApFTkRDSEFSClNUQVJUQ0hBUiBiYWNrc2xhc2gKRU5DT0RJTkcgOTIKU1dJRFRIIDEwMDAgMApEV0lEVEggNCAwCkJCWCAzIDMgMCAxCkJJVE1BUAo4MAo0MAoyMApFTkRDSEFSClNUQVJUQ0hBUiBicmFja2V0cmlnaHQKRU5DT0RJTkcgOTMKU1dJRFRIIDEwMDAgMApEV0lEVEggNCAwCkJCWCAzIDUgMCAwCkJJVE1BUApFMAoyMAoyMAoyMApFMApFTkRDSEFSClNUQVJUQ0hBUiBhc2NpaWNpcmN1bQpFTkNPRElORyA5NApTV0lEVEggMTAwMCAwCkRXSURUSCA0IDAKQkJYIDMgMiAwIDMKQklUTUFQCjQwCkEwCkVORENIQVIKU1RBUlRDSEFSIHVuZGVyc2NvcmUKRU5DT0RJTkcgOTUKU1dJRFRIIDEwMDAgMApEV0lEVEggNCAwCkJCWCAzIDEgMCAwCkJJVE1BUApFMApFTkRDSEFSClNUQVJUQ0hBUiBncmF2ZQpFTkNPRElORyA5NgpTV0lEVEggMTAwMCAwCkRXSURUSCA0IDAKQkJYIDIgMiAwIDMKQklUTUFQCjgwCjQwCkVORENIQVIKU1RBUlRDSEFSIGEKRU5DT0RJTkcgOTcKU1dJRFRIIDEwMDAgMApEV0lEVEggNCAwCkJCWCAzIDQgMCAwCkJJVE1BUApDMAo2MApBMApFMApFTkRDSEFSClNUQVJUQ0hBUiBiCkVOQ09ESU5HIDk4ClNXSURUSCAxMDAwIDAKRFdJRFRIIDQgMApCQlggMyA1IDAgMApCSVRNQVAKODAKQzAKQTAKQTAKQzAKRU5EQ0hBUgpTVEFSVENIQVIgYwpFTkNPRElORyA5OQpTV0lEVEggMTAwMCAwCkRXSURUSCA0IDAKQkJYIDMgNCAwIDAKQklUTUFQCjYwCjgwCjgwCjYwCkVORENIQVIKU1RBUlRDSEFSIGQKRU5DT0RJTkcgMTAwClNXSURUSCAxMDAwIDAKRFdJRFRIIDQgMApCQlggMyA1IDAgMApCSVRNQVAKMjAKNjAKQTAKQTAKNjAKRU5EQ0hBUgpTVEFSVENIQVIgZQpFTkNPRElORyAxMDEKU1dJRFRIIDEwMDAgMApEV0lEVEggNCAwCkJCWCAzIDQgMCAwCkJJVE1BUAo2MApBMApDMAo2MApFTkRDSEFSClNUQVJUQ0hBUiBmCkVOQ09ESU5HIDEwMgpTV0lEVEggMTAwMCAwCkRXSURUSCA0IDAKQkJYIDMgNSAwIDAKQklUTUFQCjIwCjQwCkUwCjQwCjQwCkVORENIQVIKU1RBUlRDSEFSIGcKRU5DT0RJTkcgMTAzClNXSURUSCAxMDAwIDAKRFdJRFRIIDQgMApCQlggMyA1IDAgLTEKQklUTUFQCjYwCkEwCkUwCjIwCjQwCkVORENIQVIKU1RBUlRDSEFSIGgKRU5DT0RJTkcgMTA0ClNXSURUSCAxMDAwIDAKRFdJRFRIIDQgMApCQlggMyA1IDAgMApCSVRNQVAKODAKQzAKQTAKQTAKQTAKRU5EQ0hBUgpTVEFSVENIQVIgaQpFTkNPRElORyAxMDUKU1dJRFRIIDEwMDAgMApEV0lEVEggNCAwCkJCWCAxIDUgMSAwCkJJVE1BUAo4MAowMAo4MAo4MAo4MApFTkRDSEFSClNUQVJUQ0hBUiBqCkVOQ09ESU5HIDEwNgpTV0lEVEggMTAwMCAwCkRXSURUSCA0IDAKQkJYIDMgNiAwIC0xCkJJVE1BUAoyMAowMAoyMAoyMApBMAo0MApFTkRDSEFSClNUQVJUQ0hBUiBrCkVOQ09ESU5HIDEwNwpTV0lEVEggMTAwMCAwCkRXSURUSCA0IDAKQkJYIDMgNSAwIDAKQklUTUFQCjgwCkEwCkMwCkMwCkEwCkVORENIQVIKU1RBUlRDSEFSIGwKRU5DT0RJTkcgMTA4ClNXSURUSCAxMDAwIDAKRFdJRFRIIDQgMApCQlggMyA1IDAgMApCSVRNQVAKQzAKNDAKNDAKNDAKRTAKRU5EQ0hBUgpTVEFSVENIQVIgbQpFTkNPRElORyAxMDkKU1dJRFRIIDEwMDAgMApEV0lEVEggNCAwCkJCWCAzIDQgMCAwCkJJVE1BUApFMApFMApFMApBMApFTkRDSEFSClNUQVJUQ0hBUiBuCkVOQ09ESU5HIDExMApTV0lEVEggMTAwMCAwCkRXSURUSCA0IDAKQkJYIDMgNCAwIDAKQklUTUFQCkMwCkEwCkEwCkEwCkVORENIQVIKU1RBUlRDSEFSIG8KRU5DT0RJTkcgMTExClNXSURUSCAxMDAwIDAKRFdJRFRIIDQgMApCQlggMyA0IDAgMApCSVRNQVAKNDAKQTAKQTAKNDAKRU5EQ0hBUgpTVEFSVENIQVIgcApFTkNPRElORyAxMTIKU1dJRFRIIDEwMDAgMApEV0lEVEggNCAwCkJCWCAzIDUgMCAtMQpCSVRNQVAKQzAKQTAKQTAKQzAKODAKRU5EQ0hBUgpTVEFSVENIQVIgcQpFTkNPRElORyAxMTMKU1dJRFRIIDEwMDAgMApEV0lEVEggNCAwCkJCWCAzIDUgMCAtMQpCSVRNQVAKNjAKQTAKQTAKNjAKMjAKRU5EQ0hBUgpTVEFSVENIQVIgcgpFTkNPRElORyAxMTQKU1dJRFRIIDEwMDAgMApEV0lEVEggNCAwCkJCWCAzIDQgMCAwCkJJVE1BUAo2MAo4MAo4MAo4MApFTkRDSEFSClNUQVJUQ0hBUiBzCkVOQ09ESU5HIDExNQpTV0lEVEggMTAwMCAwCkRXSURUSCA0IDAKQkJYIDMgNCAwIDAKQklUTUFQCjYwCkMwCjYwCkMwCkVORENIQVIKU1RBUlRDSEFSIHQKRU5DT0RJTkcgMTE2ClNXSURUSCAxMDAwIDAKRFdJRFRIIDQgMApCQlggMyA1IDAgMApCSVRNQVAKNDAKRTAKNDAKNDAKNjAKRU5EQ0hBUgpTVEFSVENIQVIgdQpFTkNPRElORyAxMTcKU1dJRFRIIDEwMDAgMApEV0lEVEggNCAwCkJCWCAzIDQgMCAwCkJJVE1BUApBMApBMApBMAo2MApFTkRDSEFSClNUQVJUQ0hBUiB2CkVOQ09ESU5HIDExOApTV0lEVEggMTAwMCAwCkRXSURUSCA0IDAKQkJYIDMgNCAwIDAKQklUTUFQCkEwCkEwCkUwCjQwCkVORENIQVIKU1RBUlRDSEFSIHcKRU5DT0RJTkcgMTE5ClNXSURUSCAxMDAwIDAKRFdJRFRIIDQgMApCQlggMyA0IDAgMApCSVRNQVAKQTAKRTAKRTAKRTAKRU5EQ0hBUgpTVEFSVENIQVIgeApFTkNPRElORyAxMjAKU1dJRFRIIDEwMDAgMApEV0lEVEggNCAwCkJCWCAzIDQgMCAwCkJJVE1BUApBMAo0MAo0MApBMApFTkRDSEFSClNUQVJUQ0hBUiB5CkVOQ09ESU5HIDEyMQpTV0lEVEggMTAwMCAwCkRXSURUSCA0IDAKQkJYIDMgNSAwIC0xCkJJVE1BUApBMApBMAo2MAoyMAo0MApFTkRDSEFSClNUQVJUQ0hBUiB6CkVOQ09ESU5HIDEyMgpTV0lEVEggMTAwMCAwCkRXSURUSCA0IDAKQkJYIDMgNCAwIDAKQklUTUFQCkUwCjYwCkMwCkUwCkVORENIQVIKU1RBUlRDSEFSIGJyYWNlbGVmdApFTkNPRElORyAxMjMKU1dJRFRIIDEwMDAgMApEV0lEVEggNCAwCkJCWCAzIDUgMCAwCkJJVE1BUAo2MAo0MAo4MAo0MAo2MApFTkRDSEFSClNUQVJUQ0hBUiBiYXIKRU5DT0RJTkcgMTI0ClNXSURUSCAxMDAwIDAKRFdJRFRIIDQgMApCQlggMSA1IDEgMApCSVRNQVAKODAKODAKMDAKODAKODAKRU5EQ0hBUgpTVEFSVENIQVIgYnJhY2VyaWdodApFTkNPRElORyAxMjUKU1dJRFRIIDEwMDAgMApEV0lEVEggNCAwCkJCWCAzIDUgMCAwCkJJVE1BUApDMAo0MAoyMAo0MApDMApFTkRDSEFSClNUQVJUQ0hBUiBhc2NpaXRpbGRlCkVOQ09ESU5HIDEyNgpTV0lEVEggMTAwMCAwCkRXSURUSCA0IDAKQkJYIDMgMiAwIDMKQklUTUFQCjYwCkMwCkVORENIQVIKU1RBUlRDSEFSIGV4Y2xhbWRvd24KRU5DT0RJTkcgMTYxClNXSURUSCAxMDAwIDAKRFdJRFRIIDQgMApCQlggMSA1IDEgMApCSVRNQVAKODAKMDAKODAKODAKODAKRU5EQ0hBUgpTVEFSVENIQVIgY2VudApFTkNPRElORyAxNjIKU1dJRFRIIDEwMDAgMApEV0lEVEggNCAwCkJCWCAzIDUgMCAwCkJJVE1BUAo0MApFMAo4MApFMAo0MApFTkRDSEFSClNUQVJUQ0hBUiBzdGVybGluZwpFTkNPRElORyAxNjMKU1dJRFRIIDEwMDAgMApEV0lEVEggNCAwCkJCWCAzIDUgMCAwCkJJVE1BUAo2MAo0MApFMAo0MApFMApFTkRDSEFSClNUQVJUQ0hBUiBjdXJyZW5jeQpFTkNPRElORyAxNjQKU1dJRFRIIDEwMDAgMApEV0lEVEggNCAwCkJCWCAzIDUgMCAwCkJJVE1BUApBMAo0MApFMAo0MApBMApFTkRDSEFSClNUQVJUQ0hBUiB5ZW4KRU5DT0RJTkcgMTY1ClNXSURUSCAxMDAwIDAKRFdJRFRIIDQgMApCQlggMyA1IDAgMApCSVRNQVAKQTAKQTAKNDAKRTAKNDAKRU5EQ0hBUgpTVEFSVENIQVIgYnJva2VuYmFyCkVOQ09ESU5HIDE2NgpTV0lEVEggMTAwMCAwCkRXSURUSCA0IDAKQkJYIDEgNSAxIDAKQklUTUFQCjgwCjgwCjAwCjgwCjgwCkVORENIQVIKU1RBUlRDSEFSIHNlY3Rpb24KRU5DT0RJTkcgMTY3ClNXSURUSCAxMDAwIDAKRFdJRFRIIDQgMApCQlggMyA1IDAgMApCSVRNQVAKNjAKNDAKQTAKNDAKQzAKRU5EQ0hBUgpTVEFSVENIQVIgZGllcmVzaXMKRU5DT0RJTkcgMTY4ClNXSURUSCAxMDAwIDAKRFdJRFRIIDQgMApCQlggMyAxIDAgNApCSVRNQVAKQTAKRU5EQ0hBUgpTVEFSVENIQVIgY29weXJpZ2h0CkVOQ09ESU5HIDE2OQpTV0lEVEggMTAwMCAwCkRXSURUSCA0IDAKQkJYIDMgMyAwIDIKQklUTUFQCjYwCjgwCjYwCkVORENIQVIKU1RBUlRDSEFSIG9yZGZlbWluaW5lCkVOQ09ESU5HIDE3MApTV0lEVEggMTAwMCAwCkRXSURUSCA0IDAKQkJYIDMgNSAwIDAKQklUTUFQCjYwCkEwCkUwCjAwCkUwCkVORENIQVIKU1RBUlRDSEFSIGd1aWxsZW1vdGxlZnQKRU5DT0RJTkcgMTcxClNXSURUSCAxMDAwIDAKRFdJRFRIIDQgMApCQlggMiAzIDAgMgpCSVRNQVAKNDAKODAKNDAKRU5EQ0hBUgpTVEFSVENIQVIgbG9naWNhbG5vdApFTkNPRElORyAxNzIKU1dJRFRIIDEwMDAgMApEV0lEVEggNCAwCkJCWCAzIDIgMCAyCkJJVE1BUApFMAoyMApFTkRDSEFSClNUQVJUQ0hBUiBzb2Z0aHlwaGVuCkVOQ09ESU5HIDE3MwpTV0lEVEggMTAwMCAwCkRXSURUSCA0IDAKQkJYIDIgMSAwIDIKQklUTUFQCkMwCkVORENIQVIKU1RBUlRDSEFSIHJlZ2lzdGVyZWQKRU5DT0RJTkcgMTc0ClNXSURUSCAxMDAwIDAKRFdJRFRIIDQgMApCQlggMyAzIDAgMgpCSVRNQVAKQzAKQzAKQTAKRU5EQ0hBUgpTVEFSVENIQVIgbWFjcm9uCkVOQ09ESU5HIDE3NQpTV0lEVEggMTAwMCAwCkRXSURUSCA0IDAKQkJYIDMgMSAwIDQKQklUTUFQCkUwCkVORENIQVIKU1RBUlRDSEFSIGRlZ3JlZQpFTkNPRElORyAxNzYKU1dJRFRIIDEwMDAgMApEV0lEVEggNCAwCkJCWCAzIDMgMCAyCkJJVE1BUAo0MApBMAo0MApFTkRDSEFSClNUQVJUQ0hBUiBwbHVzbWludXMKRU5DT0RJTkcgMTc3ClNXSURUSCAxMDAwIDAKRFdJRFRIIDQgMApCQlggMyA1IDAgMApCSVRNQVAKNDAKRTAKNDAKMDAKRTAKRU5EQ0hBUgpTVEFSVENIQVIgdHdvc3VwZXJpb3IKRU5DT0RJTkcgMTc4ClNXSURUSCAxMDAwIDAKRFdJRFRIIDQgMApCQlggMyAzIDAgMgpCSVRNQVAKQzAKNDAKNjAKRU5EQ0hBUgpTVEFSVENIQVIgdGhyZWVzdXBlcmlvcgpFTkNPRElORyAxNzkKU1dJRFRIIDEwMDAgMApEV0lEVEggNCAwCkJCWCAzIDMgMCAyCkJJVE1BUApFMAo2MApFMApFTkRDSEFSClNUQVJUQ0hBUiBhY3V0ZQpFTkNPRElORyAxODAKU1dJRFRIIDEwMDAgMApEV0lEVEggNCAwCkJCWCAyIDIgMSAzCkJJVE1BUAo0MAo4MApFTkRDSEFSClNUQVJUQ0hBUiBtdQpFTkNPRElORyAxODEKU1dJRFRIIDEwMDAgMApEV0lEVEggNCAwCkJCWCAzIDUgMCAwCkJJVE1BUApBMApBMApBMApDMAo4MApFTkRDSEFSClNUQVJUQ0hBUiBwYXJhZ3JhcGgKRU5DT0RJTkcgMTgyClNXSURUSCAxMDAwIDAKRFdJRFRIIDQgMApCQlggMyA1IDAgMApCSVRNQVAKNjAKQTAKNjAKNjAKNjAKRU5EQ0hBUgpTVEFSVENIQVIgcGVyaW9kY2VudGVyZWQKRU5DT0RJTkcgMTgzClNXSURUSCAxMDAwIDAKRFdJRFRIIDQgMApCQlggMyAzIDAgMQpCSVRNQVAKRTAKRTAKRTAKRU5EQ0hBUgpTVEFSVENIQVIgY2VkaWxsYQpFTkNPRElORyAxODQKU1dJRFRIIDEwMDAgMApEV0lEVEggNCAwCkJCWCAzIDMgMCAwCkJJVE1BUAo0MAoyMApDMApFTkRDSEFSClNUQVJUQ0hBUiBvbmVzdXBlcmlvcgpFTkNPRElORyAxODUKU1dJRFRIIDEwMDAgMApEV0lEVEggNCAwCkJCWCAxIDMgMSAyCkJJVE1BUAo4MAo4MAo4MApFTkRDSEFSClNUQVJUQ0hBUiBvcmRtYXNjdWxpbmUKRU5DT0RJTkcgMTg2ClNXSURUSCAxMDAwIDAKRFdJRFRIIDQgMApCQlggMyA1IDAgMApCSVRNQVAKNDAKQTAKNDAKMDAKRTAKRU5EQ0hBUgpTVEFSVENIQVIgZ3VpbGxlbW90cmlnaHQKRU5DT0RJTkcgMTg3ClNXSURUSCAxMDAwIDAKRFdJRFRIIDQgMApCQlggMiAzIDEgMgpCSVRNQVAKODAKNDAKODAKRU5EQ0hBUgpTVEFSVENIQVIgb25lcXVhcnRlcgpFTkNPRElORyAxODgKU1dJRFRIIDEwMDAgMApEV0lEVEggNCAwCkJCWCAzIDUgMCAwCkJJVE1BUAo4MAo4MAowMAo2MAoyMApFTkRDSEFSClNUQVJUQ0hBUiBvbmVoYWxmCkVOQ09ESU5HIDE4OQpTV0lEVEggMTAwMCAwCkRXSURUSCA0IDAKQkJYIDMgNSAwIDAKQklUTUFQCjgwCjgwCjAwCkMwCjYwCkVORENIQVIKU1RBUlRDSEFSIHRocmVlcXVhcnRlcnMKRU5DT0RJTkcgMTkwClNXSURUSCAxMDAwIDAKRFdJRFRIIDQgMApCQlggMyA1IDAgMApCSVRNQVAKQzAKQzAKMDAKNjAKMjAKRU5EQ0hBUgpTVEFSVENIQVIgcXVlc3Rpb25kb3duCkVOQ09ESU5HIDE5MQpTV0lEVEggMTAwMCAwCkRXSURUSCA0IDAKQkJYIDMgNSAwIDAKQklUTUFQCjQwCjAwCjQwCjgwCkUwCkVORENIQVIKU1RBUlRDSEFSIEFncmF2ZQpFTkNPRElORyAxOTIKU1dJRFRIIDEwMDAgMApEV0lEVEggNCAwCkJCWCAzIDUgMCAwCkJJVE1BUAo0MAoyMAo0MApFMApBMApFTkRDSEFSClNUQVJUQ0hBUiBBYWN1dGUKRU5DT0RJTkcgMTkzClNXSURUSCAxMDAwIDAKRFdJRFRIIDQgMApCQlggMyA1IDAgMApCSVRNQVAKNDAKODAKNDAKRTAKQTAKRU5EQ0hBUgpTVEFSVENIQVIgQWNpcmN1bWZsZXgKRU5DT0RJTkcgMTk0ClNXSURUSCAxMDAwIDAKRFdJRFRIIDQgMApCQlggMyA1IDAgMApCSVRNQVAKRTAKMDAKNDAKRTAKQTAKRU5EQ0hBUgpTVEFSVENIQVIgQXRpbGRlCkVOQ09ESU5HIDE5NQpTV0lEVEggMTAwMCAwCkRXSURUSCA0IDAKQkJYIDMgNSAwIDAKQklUTUFQCjYwCkMwCjQwCkUwCkEwCkVORENIQVIKU1RBUlRDSEFSIEFkaWVyZXNpcwpFTkNPRElORyAxOTYKU1dJRFRIIDEwMDAgMApEV0lEVEggNCAwCkJCWCAzIDUgMCAwCkJJVE1BUApBMAo0MApBMApFMApBMApFTkRDSEFSClNUQVJUQ0hBUiBBcmluZwpFTkNPRElORyAxOTcKU1dJRFRIIDEwMDAgMApEV0lEVEggNCAwCkJCWCAzIDUgMCAwCkJJVE1BUApDMApDMApBMApFMApBMApFTkRDSEFSClNUQVJUQ0hBUiBBRQpFTkNPRElORyAxOTgKU1dJRFRIIDEwMDAgMApEV0lEVEggNCAwCkJCWCAzIDUgMCAwCkJJVE1BUAo2MApDMApFMApDMApFMApFTkRDSEFSClNUQVJUQ0hBUiBDY2VkaWxsYQpFTkNPRElORyAxOTkKU1dJRFRIIDEwMDAgMApEV0lEVEggNCAwCkJCWCAzIDYgMCAtMQpCSVRNQVAKNjAKODAKODAKNjAKMjAKNDAKRU5EQ0hBUgpTVEFSVENIQVIgRWdyYXZlCkVOQ09ESU5HIDIwMApTV0lEVEggMTAwMCAwCkRXSURUSCA0IDAKQkJYIDMgNSAwIDAKQklUTUFQCjQwCjIwCkUwCkMwCkUwCkVORENIQVIKU1RBUlRDSEFSIEVhY3V0ZQpFTkNPRElORyAyMDEKU1dJRFRIIDEwMDAgMApEV0lEVEggNCAwCkJCWCAzIDUgMCAwCkJJVE1BUAo0MAo4MApFMApDMApFMApFTkRDSEFSClNUQVJUQ0hBUiBFY2lyY3VtZmxleApFTkNPRElORyAyMDIKU1dJRFRIIDEwMDAgMApEV0lEVEggNCAwCkJCWCAzIDUgMCAwCkJJVE1BUApFMAowMApFMApDMApFMApFTkRDSEFSClNUQVJUQ0hBUiBFZGllcmVzaXMKRU5DT0RJTkcgMjAzClNXSURUSCAxMDAwIDAKRFdJRFRIIDQgMApCQlggMyA1IDAgMApCSVRNQVAKQTAKMDAKRTAKQzAKRTAKRU5EQ0hBUgpTVEFSVENIQVIgSWdyYXZlCkVOQ09ESU5HIDIwNApTV0lEVEggMTAwMCAwCkRXSURUSCA0IDAKQkJYIDMgNSAwIDAKQklUTUFQCjQwCjIwCkUwCjQwCkUwCkVORENIQVIKU1RBUlRDSEFSIElhY3V0ZQpFTkNPRElORyAyMDUKU1dJRFRIIDEwMDAgMApEV0lEVEggNCAwCkJCWCAzIDUgMCAwCkJJVE1BUAo0MAo4MApFMAo0MApFMApFTkRDSEFSClNUQVJUQ0hBUiBJY2lyY3VtZmxleApFTkNPRElORyAyMDYKU1dJRFRIIDEwMDAgMApEV0lEVEggNCAwCkJCWCAzIDUgMCAwCkJJVE1BUApFMAowMApFMAo0MApFMApFTkRDSEFSClNUQVJUQ0hBUiBJZGllcmVzaXMKRU5DT0RJTkcgMjA3ClNXSURUSCAxMDAwIDAKRFdJRFRIIDQgMApCQlggMyA1IDAgMApCSVRNQVAKQTAKMDAKRTAKNDAKRTAKRU5EQ0hBUgpTVEFSVENIQVIgRXRoCkVOQ09ESU5HIDIwOApTV0lEVEggMTAwMCAwCkRXSURUSCA0IDAKQkJYIDMgNSAwIDAKQklUTUFQCkMwCkEwCkUwCkEwCkMwCkVORENIQVIKU1RBUlRDSEFSIE50aWxkZQpFTkNPRElORyAyMDkKU1dJRFRIIDEwMDAgMApEV0lEVEggNCAwCkJCWCAzIDUgMCAwCkJJVE1BUApDMAo2MApBMApFMApBMApFTkRDSEFSClNUQVJUQ0hBUiBPZ3JhdmUKRU5DT0RJTkcgMjEwClNXSURUSCAxMDAwIDAKRFdJRFRIIDQgMApCQlggMyA1IDAgMApCSVRNQVAKNDAKMjAKRTAKQTAKRTAKRU5EQ0hBUgpTVEFSVENIQVIgT2FjdXRlCkVOQ09ESU5HIDIxMQpTV0lEVEggMTAwMCAwCkRXSURUSCA0IDAKQkJYIDMgNSAwIDAKQklUTUFQCjQwCjgwCkUwCkEwCkUwCkVORENIQVIKU1RBUlRDSEFSIE9jaXJjdW1mbGV4CkVOQ09ESU5HIDIxMgpTV0lEVEggMTAwMCAwCkRXSURUSCA0IDAKQkJYIDMgNSAwIDAKQklUTUFQCkUwCjAwCkUwCkEwCkUwCkVORENIQVIKU1RBUlRDSEFSIE90aWxkZQpFTkNPRElORyAyMTMKU1dJRFRIIDEwMDAgMApEV0lEVEggNCAwCkJCWCAzIDUgMCAwCkJJVE1BUApDMAo2MApFMApBMApFMApFTkRDSEFSClNUQVJUQ0hBUiBPZGllcmVzaXMKRU5DT0RJTkcgMjE0ClNXSURUSCAxMDAwIDAKRFdJRFRIIDQgMApCQlggMyA1IDAgMApCSVRNQVAKQTAKMDAKRTAKQTAKRTAKRU5EQ0hBUgpTVEFSVENIQVIgbXVsdGlwbHkKRU5DT0RJTkcgMjE1ClNXSURUSCAxMDAwIDAKRFdJRFRIIDQgMApCQlggMyAzIDAgMQpCSVRNQVAKQTAKNDAKQTAKRU5EQ0hBUgpTVEFSVENIQVIgT3NsYXNoCkVOQ09ESU5HIDIxNgpTV0lEVEggMTAwMCAwCkRXSURUSCA0IDAKQkJYIDMgNSAwIDAKQklUTUFQCjYwCkEwCkUwCkEwCkMwCkVORENIQVIKU1RBUlRDSEFSIFVncmF2ZQpFTkNPRElORyAyMTcKU1dJRFRIIDEwMDAgMApEV0lEVEggNCAwCkJCWCAzIDUgMCAwCkJJVE1BUAo4MAo0MApBMApBMApFMApFTkRDSEFSClNUQVJUQ0hBUiBVYWN1dGUKRU5DT0RJTkcgMjE4ClNXSURUSCAxMDAwIDAKRFdJRFRIIDQgMApCQlggMyA1IDAgMApCSVRNQVAKMjAKNDAKQTAKQTAKRTAKRU5EQ0hBUgpTVEFSVENIQVIgVWNpcmN1bWZsZXgKRU5DT0RJTkcgMjE5ClNXSURUSCAxMDAwIDAKRFdJRFRIIDQgMApCQlggMyA1IDAgMApCSVRNQVAKRTAKMDAKQTAKQTAKRTAKRU5EQ0hBUgpTVEFSVENIQVIgVWRpZXJlc2lzCkVOQ09ESU5HIDIyMApTV0lEVEggMTAwMCAwCkRXSURUSCA0IDAKQkJYIDMgNSAwIDAKQklUTUFQCkEwCjAwCkEwCkEwCkUwCkVORENIQVIKU1RBUlRDSEFSIFlhY3V0ZQpFTkNPRElORyAyMjEKU1dJRFRIIDEwMDAgMApEV0lEVEggNCAwCkJCWCAzIDUgMCAwCkJJVE1BUAoyMAo0MApBMApFMAo0MApFTkRDSEFSClNUQVJUQ0hBUiBUaG9ybgpFTkNPRElORyAyMjIKU1dJRFRIIDEwMDAgMApEV0lEVEggNCAwCkJCWCAzIDUgMCAwCkJJVE1BUAo4MApFMApBMApFMAo4MApFTkRDSEFSClNUQVJUQ0hBUiBnZXJtYW5kYmxzCkVOQ09ESU5HIDIyMwpTV0lEVEggMTAwMCAwCkRXSURUSCA0IDAKQkJYIDMgNiAwIC0xCkJJVE1BUAo2MApBMApDMApBMApDMAo4MApFTkRDSEFSClNUQVJUQ0hBUiBhZ3JhdmUKRU5DT0RJTkcgMjI0ClNXSURUSCAxMDAwIDAKRFdJRFRIIDQgMApCQlggMyA1IDAgMApCSVRNQVAKNDAKMjAKNjAKQTAKRTAKRU5EQ0hBUgpTVEFSVENIQVIgYWFjdXRlCkVOQ09ESU5HIDIyNQpTV0lEVEggMTAwMCAwCkRXSURUSCA0IDAKQkJYIDMgNSAwIDAKQklUTUFQCjQwCjgwCjYwCkEwCkUwCkVORENIQVIKU1RBUlRDSEFSIGFjaXJjdW1mbGV4CkVOQ09ESU5HIDIyNgpTV0lEVEggMTAwMCAwCkRXSURUSCA0IDAKQkJYIDMgNSAwIDAKQklUTUFQCkUwCjAwCjYwCkEwCkUwCkVORENIQVIKU1RBUlRDSEFSIGF0aWxkZQpFTkNPRElORyAyMjcKU1dJRFRIIDEwMDAgMApEV0lEVEggNCAwCkJCWCAzIDUgMCAwCkJJVE1BUAo2MApDMAo2MApBMApFMApFTkRDSEFSClNUQVJUQ0hBUiBhZGllcmVzaXMKRU5DT0RJTkcgMjI4ClNXSURUSCAxMDAwIDAKRFdJRFRIIDQgMApCQlggMyA1IDAgMApCSVRNQVAKQTAKMDAKNjAKQTAKRTAKRU5EQ0hBUgpTVEFSVENIQVIgYXJpbmcKRU5DT0RJTkcgMjI5ClNXSURUSCAxMDAwIDAKRFdJRFRIIDQgMApCQlggMyA1IDAgMApCSVRNQVAKNjAKNjAKNjAKQTAKRTAKRU5EQ0hBUgpTVEFSVENIQVIgYWUKRU5DT0RJTkcgMjMwClNXSURUSCAxMDAwIDAKRFdJRFRIIDQgMApCQlggMyA0IDAgMApCSVRNQVAKNjAKRTAKRTAKQzAKRU5EQ0hBUgpTVEFSVENIQVIgY2NlZGlsbGEKRU5DT0RJTkcgMjMxClNXSURUSCAxMDAwIDAKRFdJRFRIIDQgMApCQlggMyA1IDAgLTEKQklUTUFQCjYwCjgwCjYwCjIwCjQwCkVORENIQVIKU1RBUlRDSEFSIGVncmF2ZQpFTkNPRElORyAyMzIKU1dJRFRIIDEwMDAgMApEV0lEVEggNCAwCkJCWCAzIDUgMCAwCkJJVE1BUAo0MAoyMAo2MApFMAo2MApFTkRDSEFSClNUQVJUQ0hBUiBlYWN1dGUKRU5DT0RJTkcgMjMzClNXSURUSCAxMDAwIDAKRFdJRFRIIDQgMApCQlggMyA1IDAgMApCSVRNQVAKNDAKODAKNjAKRTAKNjAKRU5EQ0hBUgpTVEFSVENIQVIgZWNpcmN1bWZsZXgKRU5DT0RJTkcgMjM0ClNXSURUSCAxMDAwIDAKRFdJRFRIIDQgMApCQlggMyA1IDAgMApCSVRNQVAKRTAKMDAKNjAKRTAKNjAKRU5EQ0hBUgpTVEFSVENIQVIgZWRpZXJlc2lzCkVOQ09ESU5HIDIzNQpTV0lEVEggMTAwMCAwCkRXSURUSCA0IDAKQkJYIDMgNSAwIDAKQklUTUFQCkEwCjAwCjYwCkUwCjYwCkVORENIQVIKU1RBUlRDSEFSIGlncmF2ZQpFTkNPRElORyAyMzYKU1dJRFRIIDEwMDAgMApEV0lEVEggNCAwCkJCWCAyIDUgMSAwCkJJVE1BUAo4MAo0MAo4MAo4MAo4MApFTkRDSEFSClNUQVJUQ0hBUiBpYWN1dGUKRU5DT0RJTkcgMjM3ClNXSURUSCAxMDAwIDAKRFdJRFRIIDQgMApCQlggMiA1IDAgMApCSVRNQVAKNDAKODAKNDAKNDAKNDAKRU5EQ0hBUgpTVEFSVENIQVIgaWNpcmN1bWZsZXgKRU5DT0RJTkcgMjM4ClNXSURUSCAxMDAwIDAKRFdJRFRIIDQgMApCQlggMyA1IDAgMApCSVRNQVAKRTAKMDAKNDAKNDAKNDAKRU5EQ0hBUgpTVEFSVENIQVIgaWRpZXJlc2lzCkVOQ09ESU5HIDIzOQpTV0lEVEggMTAwMCAwCkRXSURUSCA0IDAKQkJYIDMgNSAwIDAKQklUTUFQCkEwCjAwCjQwCjQwCjQwCkVORENIQVIKU1RBUlRDSEFSIGV0aApFTkNPRElORyAyNDAKU1dJRFRIIDEwMDAgMApEV0lEVEggNCAwCkJCWCAzIDUgMCAwCkJJVE1BUAo2MApDMAo2MApBMAo2MApFTkRDSEFSClNUQVJUQ0hBUiBudGlsZGUKRU5DT0RJTkcgMjQxClNXSURUSCAxMDAwIDAKRFdJRFRIIDQgMApCQlggMyA1IDAgMApCSVRNQVAKQzAKNjAKQzAKQTAKQTAKRU5EQ0hBUgpTVEFSVENIQVIgb2dyYXZlCkVOQ09ESU5HIDI0MgpTV0lEVEggMTAwMCAwCkRXSURUSCA0IDAKQkJYIDMgNSAwIDAKQklUTUFQCjQwCjIwCjQwCkEwCjQwCkVORENIQVIKU1RBUlRDSEFSIG9hY3V0ZQpFTkNPRElORyAyNDMKU1dJRFRIIDEwMDAgMApEV0lEVEggNCAwCkJCWCAzIDUgMCAwCkJJVE1BUAo0MAo4MAo0MApBMAo0MApFTkRDSEFSClNUQVJUQ0hBUiBvY2lyY3VtZmxleApFTkNPRElORyAyNDQKU1dJRFRIIDEwMDAgMApEV0lEVEggNCAwCkJCWCAzIDUgMCAwCkJJVE1BUApFMAowMAo0MApBMAo0MApFTkRDSEFSClNUQVJUQ0hBUiBvdGlsZGUKRU5DT0RJTkcgMjQ1ClNXSURUSCAxMDAwIDAKRFdJRFRIIDQgMApCQlggMyA1IDAgMApCSVRNQVAKQzAKNjAKNDAKQTAKNDAKRU5EQ0hBUgpTVEFSVENIQVIgb2RpZXJlc2lzCkVOQ09ESU5HIDI0NgpTV0lEVEggMTAwMCAwCkRXSURUSCA0IDAKQkJYIDMgNSAwIDAKQklUTUFQCkEwCjAwCjQwCkEwCjQwCkVORENIQVIKU1RBUlRDSEFSIGRpdmlkZQpFTkNPRElORyAyNDcKU1dJRFRIIDEwMDAgMApEV0lEVEggNCAwCkJCWCAzIDUgMCAwCkJJVE1BUAo0MAowMApFMAowMAo0MApFTkRDSEFSClNUQVJUQ0hBUiBvc2xhc2gKRU5DT0RJTkcgMjQ4ClNXSURUSCAxMDAwIDAKRFdJRFRIIDQgMApCQlggMyA0IDAgMApCSVRNQVAKNjAKRTAKQTAKQzAKRU5EQ0hBUgpTVEFSVENIQVIgdWdyYXZlCkVOQ09ESU5HIDI0OQpTV0lEVEggMTAwMCAwCkRXSURUSCA0IDAKQkJYIDMgNSAwIDAKQklUTUFQCjgwCjQwCkEwCkEwCjYwCkVORENIQVIKU1RBUlRDSEFSIHVhY3V0ZQpFTkNPRElORyAyNTAKU1dJRFRIIDEwMDAgMApEV0lEVEggNCAwCkJCWCAzIDUgMCAwCkJJVE1BUAoyMAo0MApBMApBMAo2MApFTkRDSEFSClNUQVJUQ0hBUiB1Y2lyY3VtZmxleApFTkNPRElORyAyNTEKU1dJRFRIIDEwMDAgMApEV0lEVEggNCAwCkJCWCAzIDUgMCAwCkJJVE1BUApFMAowMApBMApBMAo2MApFTkRDSEFSClNUQVJUQ0hBUiB1ZGllcmVzaXMKRU5DT0RJTkcgMjUyClNXSURUSCAxMDAwIDAKRFdJRFRIIDQgMApCQlggMyA1IDAgMApCSVRNQVAKQTAKMDAKQTAKQTAKNjAKRU5EQ0hBUgpTVEFSVENIQVIgeWFjdXRlCkVOQ09ESU5HIDI1MwpTV0lEVEggMTAwMCAwCkRXSURUSCA0IDAKQkJYIDMgNiAwIC0xCkJJVE1BUAoyMAo0MApBMAo2MAoyMAo0MApFTkRDSEFSClNUQVJUQ0hBUiB0aG9ybgpFTkNPRElORyAyNTQKU1dJRFRIIDEwMDAgMApEV0lEVEggNCAwCkJCWCAzIDUgMCAtMQpCSVRNQVAKODAKQzAKQTAKQzAKODAKRU5EQ0hBUgpTVEFSVENIQVIgeWRpZXJlc2lzCkVOQ09ESU5HIDI1NQpTV0lEVEggMTAwMCAwCkRXSURUSCA0IDAKQkJYIDMgNiAwIC0xCkJJVE1BUApBMAowMApBMAo2MAoyMAo0MApFTkRDSEFSClNUQVJUQ0hBUiBnY2lyY3VtZmxleApFTkNPRElORyAyODUKU1dJRFRIIDEwMDAgMApEV0lEVEggNiAwCkJCWCAxIDEgMCAwCkJJVE1BUAowMApFTkRDSEFSClNUQVJUQ0hBUiBPRQpFTkNPRElORyAzMzgKU1dJRFRIIDY2NiAwCkRXSURUSCA0IDAKQkJYIDMgNSAwIDAKQklUTUFQCjYwCkMwCkUwCkMwCjYwCkVORENIQVIKU1RBUlRDSEFSIG9lCkVOQ09ESU5HIDMzOQpTV0lEVEggNjY2IDAKRFdJRFRIIDQgMApCQlggMyA0IDAgMApCSVRNQVAKNjAKRTAKQzAKRTAKRU5EQ0hBUgpTVEFSVENIQVIgU2Nhcm9uCkVOQ09ESU5HIDM1MgpTV0lEVEggNjY2IDAKRFdJRFRIIDQgMApCQlggMyA1IDAgMApCSVRNQVAKQTAKNjAKQzAKNjAKQzAKRU5EQ0hBUgpTVEFSVENIQVIgc2Nhcm9uCkVOQ09ESU5HIDM1MwpTV0lEVEggNjY2IDAKRFdJRFRIIDQgMApCQlggMyA1IDAgMApCSVRNQVAKQTAKNjAKQzAKNjAKQzAKRU5EQ0hBUgpTVEFSVENIQVIgWWRpZXJlc2lzCkVOQ09ESU5HIDM3NgpTV0lEVEggNjY2IDAKRFdJRFRIIDQgMApCQlggMyA1IDAgMApCSVRNQVAKQTAKMDAKQTAKNDAKNDAKRU5EQ0hBUgpTVEFSVENIQVIgWmNhcm9uCkVOQ09ESU5HIDM4MQpTV0lEVEggNjY2IDAKRFdJRFRIIDQgMApCQlggMyA1IDAgMApCSVRNQVAKQTAKRTAKNjAKQzAKRTAKRU5EQ0hBUgpTVEFSVENIQVIgemNhcm9uCkVOQ09ESU5HIDM4MgpTV0lEVEggNjY2IDAKRFdJRFRIIDQgMApCQlggMyA1IDAgMApCSVRNQVAKQTAKRTAKNjAKQzAKRTAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMEVBNApFTkNPRElORyAzNzQ4ClNXSURUSCAxMDAwIDAKRFdJRFRIIDYgMApCQlggMSAxIDAgMApCSVRNQVAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgdW5pMTNBMApFTkNPRElORyA1MDI0ClNXSURUSCAxMDAwIDAKRFdJRFRIIDYgMApCQlggMSAxIDAgMApCSVRNQVAKMDAKRU5EQ0hBUgpTVEFSVENIQVIgYnVsbGV0CkVOQ09ESU5HIDgyMjYKU1dJRFRIIDY2NiAwCkRXSURUSCA0IDAKQkJYIDEgMSAxIDIKQklUTUFQCjgwCkVORENIQVIKU1RBUlRDSEFSIGVsbGlwc2lzCkVOQ09ESU5HIDgyMzAKU1dJRFRIIDY2NiAwCkRXSURUSCA0IDAKQkJYIDMgMSAwIDAKQklUTUFQCkEwCkVORENIQVIKU1RBUlRDSEFSIEV1cm8KRU5DT0RJTkcgODM2NApTV0lEVEggNjY2IDAKRFdJRFRIIDQgMApCQlggMyA1IDAgMApCSVRNQVAKNjAKRTAKRTAKQzAKNjAKRU5EQ0hBUgpFTkRGT05UCg==",
 
@@ -0,0 +1,277 @@
+package render
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/binary"
+	"image"
+	"math/bits"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zachomedia/go-bdf"
+	"golang.org/x/image/font/gofont/goregular"
+)
+
+var testBDF = `STARTFONT 2.1
+FONT -test-tiny-medium-r-normal--4-40-75-75-c-40-iso10646-1
+SIZE 4 75 75
+FONTBOUNDINGBOX 3 4 0 -1
+STARTPROPERTIES 4
+FONT_ASCENT 3
+FONT_DESCENT 1
+CHARSET_REGISTRY "ISO10646"
+CHARSET_ENCODING "1"
+ENDPROPERTIES
+CHARS 2
+STARTCHAR x
+ENCODING 120
+SWIDTH 750 0
+DWIDTH 4 0
+BBX 3 3 0 0
+BITMAP
+A0
+40
+A0
+ENDCHAR
+STARTCHAR j
+ENCODING 106
+SWIDTH 500 0
+DWIDTH 2 0
+BBX 1 4 0 -1
+BITMAP
+80
+00
+80
+80
+ENDCHAR
+ENDFONT
+`
+
+func TestAddFont(t *testing.T) {
+	name, err := AddFont("fonts/tiny.bdf", []byte(testBDF))
+	require.NoError(t, err)
+	assert.Regexp(t, `^tiny\.bdf#[0-9a-f]{12}$`, name)
+	assert.NotContains(t, GetFontList(), name)
+
+	again, err := AddFont("tiny.bdf", []byte(testBDF))
+	require.NoError(t, err)
+	assert.Equal(t, name, again)
+
+	text := &Text{Content: "xjx", Font: name}
+	require.NoError(t, text.Init())
+	im := PaintWidget(text, image.Rect(0, 0, 0, 0), 0)
+	assert.Equal(t, nil, checkImage([]string{
+		"w.w." + "w." + "w.w.",
+		".w.." + ".." + ".w..",
+		"w.w." + "w." + "w.w.",
+		"...." + "w." + "....",
+	}, im))
+
+	_, err = AddFont("broken.bdf", []byte("not a font"))
+	assert.ErrorContains(t, err, "parsing font 'broken.bdf'")
+
+	_, err = AddFont("broken.pcf", []byte(pcfMagic+"\x10\x00\x00\x00"))
+	assert.ErrorContains(t, err, "PCF table of contents is truncated")
+}
+
+func TestReleaseFont(t *testing.T) {
+	name, err := AddFont("release.ttf", goregular.TTF)
+	require.NoError(t, err)
+	_, err = AddFont("release.ttf", goregular.TTF)
+	require.NoError(t, err)
+
+	_, err = GetFont(name + "@8")
+	require.NoError(t, err)
+	_, err = GetFont("tb-8," + name + "@8")
+	require.NoError(t, err)
+
+	// fonts are kept until they're released as often as they were added
+	ReleaseFont(name)
+	_, err = GetFont(name + "@8")
+	assert.NoError(t, err)
+
+	ReleaseFont(name)
+	_, err = GetFont(name + "@8")
+	assert.ErrorContains(t, err, "unknown font")
+	_, err = GetFont("tb-8," + name + "@8")
+	assert.ErrorContains(t, err, "unknown font")
+
+	fontMutex.Lock()
+	for key := range fontCache {
+		assert.False(t, usesFont(key, name), key)
+	}
+	for key := range faceCache.items {
+		assert.False(t, usesFont(key, name), key)
+	}
+	fontMutex.Unlock()
+
+	// built-in fonts aren't added, so they can't be released
+	ReleaseFont("tb-8")
+	_, err = GetFont("tb-8")
+	assert.NoError(t, err)
+}
+
+// encodePCF writes a BDF font in the PCF format, with the given format
+// for the metrics and bitmaps.
+func encodePCF(f *bdf.Font, format uint32) []byte {
+	var order binary.ByteOrder = binary.LittleEndian
+	if format&4 != 0 {
+		order = binary.BigEndian
+	}
+
+	table := func(format uint32) *bytes.Buffer {
+		b := &bytes.Buffer{}
+		binary.Write(b, binary.LittleEndian, format)
+		return b
+	}
+
+	// properties
+	strs := &bytes.Buffer{}
+	str := func(s string) int32 {
+		offset := strs.Len()
+		strs.WriteString(s + "\x00")
+		return int32(offset)
+	}
+	props := table(format &^ pcfFormatMask)
+	binary.Write(props, order, int32(3))
+	for _, p := range []struct {
+		name  string
+		value int32
+	}{{"FONT_ASCENT", int32(f.Ascent)}, {"FONT_DESCENT", int32(f.Descent)}} {
+		binary.Write(props, order, str(p.name))
+		props.WriteByte(0)
+		binary.Write(props, order, p.value)
+	}
+	binary.Write(props, order, str("CHARSET_REGISTRY"))
+	props.WriteByte(1)
+	binary.Write(props, order, str("ISO10646"))
+	props.Write([]byte{0})
+	binary.Write(props, order, int32(strs.Len()))
+	props.Write(strs.Bytes())
+
+	// metrics and bitmaps
+	metrics := table(format)
+	if format&pcfFormatMask == pcfCompressedMetrics {
+		binary.Write(metrics, order, int16(len(f.Characters)))
+	} else {
+		binary.Write(metrics, order, int32(len(f.Characters)))
+	}
+
+	pad := 1 << (format & 3)
+	unit := 1 << ((format >> 4) & 3)
+	glyphs := &bytes.Buffer{}
+	var offsets []int32
+
+	for _, c := range f.Characters {
+		w, h := c.Alpha.Rect.Dx(), c.Alpha.Rect.Dy()
+		m := []int{c.LowerPoint[0], c.LowerPoint[0] + w, c.Advance[0], h + c.LowerPoint[1], -c.LowerPoint[1]}
+		for _, v := range m {
+			if format&pcfFormatMask == pcfCompressedMetrics {
+				metrics.WriteByte(byte(v + 0x80))
+			} else {
+				binary.Write(metrics, order, int16(v))
+			}
+		}
+		if format&pcfFormatMask != pcfCompressedMetrics {
+			binary.Write(metrics, order, uint16(0))
+		}
+
+		offsets = append(offsets, int32(glyphs.Len()))
+		stride := ((w+7)/8 + pad - 1) / pad * pad
+		for y := 0; y < h; y++ {
+			row := make([]byte, stride)
+			for x := 0; x < w; x++ {
+				if c.Alpha.Pix[y*c.Alpha.Stride+x] != 0 {
+					row[x/8] |= 0x80 >> (x % 8)
+				}
+			}
+			if format&8 == 0 {
+				for i := range row {
+					row[i] = bits.Reverse8(row[i])
+				}
+			}
+			if (format&4 != 0) != (format&8 != 0) && unit > 1 {
+				for i := 0; i+unit <= len(row); i += unit {
+					for a, b := i, i+unit-1; a < b; a, b = a+1, b-1 {
+						row[a], row[b] = row[b], row[a]
+					}
+				}
+			}
+			glyphs.Write(row)
+		}
+	}
+
+	bitmaps := table(format &^ pcfFormatMask)
+	binary.Write(bitmaps, order, int32(len(offsets)))
+	binary.Write(bitmaps, order, offsets)
+	for i := 0; i < 4; i++ {
+		binary.Write(bitmaps, order, int32(glyphs.Len()))
+	}
+	bitmaps.Write(glyphs.Bytes())
+
+	// encodings, for the Basic Multilingual Plane
+	index := make([]uint16, 0x10000)
+	for i := range index {
+		index[i] = 0xffff
+	}
+	for i, c := range f.Characters {
+		if c.Encoding < 0x10000 {
+			index[c.Encoding] = uint16(i)
+		}
+	}
+	encodings := table(format &^ pcfFormatMask)
+	binary.Write(encodings, order, []int16{0, 0xff, 0, 0xff, int16(f.DefaultChar)})
+	binary.Write(encodings, order, index)
+
+	tables := []struct {
+		kind int
+		data []byte
+	}{
+		{pcfProperties, props.Bytes()},
+		{pcfMetrics, metrics.Bytes()},
+		{pcfBitmaps, bitmaps.Bytes()},
+		{pcfBDFEncodings, encodings.Bytes()},
+	}
+
+	out := &bytes.Buffer{}
+	out.WriteString(pcfMagic)
+	binary.Write(out, binary.LittleEndian, int32(len(tables)))
+	offset := 8 + 16*len(tables)
+	for _, t := range tables {
+		binary.Write(out, binary.LittleEndian, []int32{int32(t.kind), 0, int32(len(t.data)), int32(offset)})
+		offset += len(t.data)
+	}
+	for _, t := range tables {
+		out.Write(t.data)
+	}
+
+	return out.Bytes()
+}
+
+func TestParseFontPCF(t *testing.T) {
+	data, err := base64.StdEncoding.DecodeString(fontDataRaw["tom-thumb"])
+	require.NoError(t, err)
+	f, err := bdf.Parse(data)
+	require.NoError(t, err)
+
+	expected := &Text{Content: "Pixlet ÅÄÖ 123", Font: "tom-thumb"}
+	require.NoError(t, expected.Init())
+	expectedIm := PaintWidget(expected, image.Rect(0, 0, 100, 10), 0)
+
+	for _, format := range []uint32{
+		0x00,                        // LSB bytes and bits, byte pad
+		0x0c,                        // MSB bytes and bits
+		0x02 | 0x20 | 0x08,          // MSB bits, int pad, int units
+		0x01 | 0x10 | 0x04,          // MSB bytes, short pad and units
+		0x0e | pcfCompressedMetrics, // compressed metrics, int pad
+	} {
+		name, err := AddFont("tom-thumb.pcf", encodePCF(f, format))
+		require.NoError(t, err, format)
+
+		text := &Text{Content: expected.Content, Font: name}
+		require.NoError(t, text.Init(), format)
+		assert.Equal(t, expectedIm, PaintWidget(text, image.Rect(0, 0, 100, 10), 0), format)
+	}
+}
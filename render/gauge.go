@@ -0,0 +1,236 @@
+package render
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"math"
+
+	"github.com/tidbyt/gg"
+)
+
+// Progress is a value within a range, drawn by ProgressBar and Gauge.
+// The range can be split into bands by thresholds, each with its own
+// color. Max is NaN when it isn't set, and the range then ends at 100.
+type Progress struct {
+	Value      float64 `starlark:"value,required"`
+	Min        float64
+	Max        float64 `starlark:"max,nan"`
+	Thresholds []float64
+	Colors     []color.Color
+	Background color.Color
+}
+
+func (p Progress) Init() error {
+	for i := 1; i < len(p.Thresholds); i++ {
+		if p.Thresholds[i] < p.Thresholds[i-1] {
+			return fmt.Errorf("thresholds must be in ascending order")
+		}
+	}
+	return nil
+}
+
+// limits returns the range. An empty range, like that of the zero
+// value, is 100 wide.
+func (p Progress) limits() (float64, float64) {
+	lo, hi := p.Min, p.Max
+	if math.IsNaN(hi) {
+		hi = 100
+	}
+	if hi == lo {
+		hi = lo + 100
+	}
+	return lo, hi
+}
+
+// fraction returns how far v is along the range, from 0 to 1.
+func (p Progress) fraction(v float64) float64 {
+	lo, hi := p.limits()
+	return math.Max(0, math.Min(1, (v-lo)/(hi-lo)))
+}
+
+// at returns the value that's t along the range.
+func (p Progress) at(t float64) float64 {
+	lo, hi := p.limits()
+	return lo + t*(hi-lo)
+}
+
+// color returns the color of the band v is in.
+func (p Progress) color(v float64) color.Color {
+	if len(p.Colors) == 0 {
+		return DefaultPlotColor
+	}
+	band := 0
+	for _, threshold := range p.Thresholds {
+		if v >= threshold {
+			band++
+		}
+	}
+	return p.Colors[min(band, len(p.Colors)-1)]
+}
+
+// colorAt returns the color of the pixel that's t along the range.
+func (p Progress) colorAt(t float64) color.Color {
+	if t < p.fraction(p.Value) {
+		return p.color(p.Value)
+	}
+	if p.Background != nil {
+		return p.Background
+	}
+	return dampenColor(p.color(p.at(t)), FillDampFactor)
+}
+
+// ProgressBar draws a bar that's filled from the left in proportion to
+// a value, or from the bottom if `vertical` is set.
+//
+// The range is from `min` to `max`, which is 100 unless set. The
+// range can be split into bands by `thresholds`, in ascending order.
+// Values below the first threshold get the first of `colors`, values
+// between the first and second threshold get the second, and so on.
+// The part that's filled up to the value is drawn in the color of the
+// value's band, and the rest in the dimmed color of each band, or in
+// `background` if set.
+//
+// DOC(Value): Value to show
+// DOC(Width): Width of the bar
+// DOC(Height): Height of the bar
+// DOC(Min): Value of an empty bar, default is 0
+// DOC(Max): Value of a full bar, default is 100
+// DOC(Thresholds): Values where bands of color start
+// DOC(Colors): Color of each band, default is '#fff'
+// DOC(Background): Color of the unfilled part, default is a dimmed band color
+// DOC(Vertical): Fill from the bottom instead of the left
+//
+// EXAMPLE BEGIN
+// render.ProgressBar(
+//      value = 65,
+//      width = 60,
+//      height = 6,
+//      thresholds = [50, 80],
+//      colors = ["#0f0", "#ff0", "#f00"],
+// )
+// EXAMPLE END
+type ProgressBar struct {
+	Widget
+	Progress
+
+	Width    int `starlark:"width,required"`
+	Height   int `starlark:"height,required"`
+	Vertical bool
+}
+
+func (b ProgressBar) PaintBounds(bounds image.Rectangle, frameIdx int) image.Rectangle {
+	return image.Rect(0, 0, b.Width, b.Height)
+}
+
+func (b ProgressBar) Paint(dc *gg.Context, bounds image.Rectangle, frameIdx int) {
+	length := b.Width
+	if b.Vertical {
+		length = b.Height
+	}
+
+	for i := 0; i < length; i++ {
+		dc.SetColor(b.colorAt((float64(i) + 0.5) / float64(length)))
+		if b.Vertical {
+			dc.DrawRectangle(0, float64(length-i-1), float64(b.Width), 1)
+		} else {
+			dc.DrawRectangle(float64(i), 0, 1, float64(b.Height))
+		}
+		dc.Fill()
+	}
+}
+
+func (b ProgressBar) FrameCount() int {
+	return 1
+}
+
+// Gauge draws an arc that's filled clockwise in proportion to a value.
+//
+// The arc is `sweep` degrees of a ring, 270 by default, with a gap at
+// the bottom. It's `thickness` pixels wide, which is a quarter of the
+// diameter unless set. The range, `thresholds` and `colors` work the
+// same way as for ProgressBar.
+//
+// DOC(Value): Value to show
+// DOC(Diameter): Diameter of the gauge
+// DOC(Thickness): Width of the arc
+// DOC(Sweep): Angle of the arc in degrees, default is 270
+// DOC(Min): Value of an empty gauge, default is 0
+// DOC(Max): Value of a full gauge, default is 100
+// DOC(Thresholds): Values where bands of color start
+// DOC(Colors): Color of each band, default is '#fff'
+// DOC(Background): Color of the unfilled part, default is a dimmed band color
+//
+// EXAMPLE BEGIN
+// render.Gauge(
+//      value = 65,
+//      diameter = 30,
+//      thickness = 5,
+//      thresholds = [50, 80],
+//      colors = ["#0f0", "#ff0", "#f00"],
+// )
+// EXAMPLE END
+type Gauge struct {
+	Widget
+	Progress
+
+	Diameter  int `starlark:"diameter,required"`
+	Thickness int
+	Sweep     float64
+}
+
+func (g Gauge) Init() error {
+	if err := g.Progress.Init(); err != nil {
+		return err
+	}
+	if g.Thickness < 0 {
+		return fmt.Errorf("invalid thickness %d, must not be negative", g.Thickness)
+	}
+	if g.Sweep < 0 || g.Sweep > 360 {
+		return fmt.Errorf("invalid sweep %v, must be between 0 and 360", g.Sweep)
+	}
+	return nil
+}
+
+func (g Gauge) PaintBounds(bounds image.Rectangle, frameIdx int) image.Rectangle {
+	return image.Rect(0, 0, g.Diameter, g.Diameter)
+}
+
+func (g Gauge) Paint(dc *gg.Context, bounds image.Rectangle, frameIdx int) {
+	sweep := g.Sweep
+	if sweep == 0 {
+		sweep = 270
+	}
+	thickness := float64(g.Thickness)
+	if thickness == 0 {
+		thickness = float64(g.Diameter) / 4
+	}
+
+	// The arc starts at the bottom left and is centered at the top.
+	// Angles are clockwise from the right, since y points down.
+	start := 90 + (360-sweep)/2
+	r := float64(g.Diameter) / 2
+
+	for y := 0; y < g.Diameter; y++ {
+		for x := 0; x < g.Diameter; x++ {
+			dx, dy := float64(x)+0.5-r, float64(y)+0.5-r
+			if d := math.Hypot(dx, dy); d > r || d < r-thickness {
+				continue
+			}
+
+			angle := math.Atan2(dy, dx) * 180 / math.Pi
+			angle = math.Mod(angle-start+720, 360)
+			if angle > sweep {
+				continue
+			}
+
+			dc.SetColor(g.colorAt(angle / sweep))
+			tx, ty := dc.TransformPoint(float64(x), float64(y))
+			dc.SetPixel(int(tx), int(ty))
+		}
+	}
+}
+
+func (g Gauge) FrameCount() int {
+	return 1
+}
@@ -0,0 +1,112 @@
+package render
+
+import (
+	"image"
+	"image/color"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProgressBar(t *testing.T) {
+	ic := ImageChecker{
+		Palette: map[string]color.RGBA{
+			"r": {0xff, 0, 0, 0xff},
+			";": {0x55, 0, 0, 0xff},
+			"g": {0, 0xff, 0, 0xff},
+			",": {0, 0x55, 0, 0xff},
+			"b": {0, 0, 0xff, 0xff},
+			":": {0, 0, 0x55, 0xff},
+			"w": {0xff, 0xff, 0xff, 0xff},
+			".": {0, 0, 0, 0},
+		},
+	}
+
+	b := ProgressBar{
+		Progress: Progress{
+			Value:      50,
+			Thresholds: []float64{30, 70},
+			Colors:     []color.Color{barRed, barGreen, barBlue},
+		},
+		Width:  10,
+		Height: 2,
+	}
+
+	// the filled part is the color of the value's band, and the rest
+	// is the dimmed color of each band
+	assert.Equal(t, nil, ic.Check([]string{
+		"ggggg,,:::",
+		"ggggg,,:::",
+	}, PaintWidget(b, image.Rect(0, 0, 100, 100), 0)))
+
+	b.Background = color.RGBA{0xff, 0xff, 0xff, 0xff}
+	b.Value = 20
+	assert.Equal(t, nil, ic.Check([]string{
+		"rrwwwwwwww",
+		"rrwwwwwwww",
+	}, PaintWidget(b, image.Rect(0, 0, 100, 100), 0)))
+
+	// values outside the range are clamped, and thresholds are
+	// values rather than fractions of the range
+	b.Min, b.Max, b.Value = 10, 20, 25
+	assert.Equal(t, nil, ic.Check([]string{
+		"rrrrrrrrrr",
+		"rrrrrrrrrr",
+	}, PaintWidget(b, image.Rect(0, 0, 100, 100), 0)))
+
+	// without a max, the range ends at 100
+	b.Min, b.Max, b.Value = 50, math.NaN(), 75
+	b.Thresholds = nil
+	assert.Equal(t, nil, ic.Check([]string{
+		"rrrrrwwwww",
+		"rrrrrwwwww",
+	}, PaintWidget(b, image.Rect(0, 0, 100, 100), 0)))
+
+	b = ProgressBar{
+		Progress: Progress{Value: 25, Colors: []color.Color{barRed}},
+		Width:    1,
+		Height:   4,
+		Vertical: true,
+	}
+	assert.Equal(t, nil, ic.Check([]string{
+		";",
+		";",
+		";",
+		"r",
+	}, PaintWidget(b, image.Rect(0, 0, 100, 100), 0)))
+}
+
+func TestGauge(t *testing.T) {
+	g := Gauge{
+		Progress: Progress{
+			Value:      40,
+			Colors:     []color.Color{barRed},
+			Background: barBlue,
+		},
+		Diameter: 10,
+	}
+	assert.NoError(t, g.Init())
+
+	im := PaintWidget(g, image.Rect(0, 0, 100, 100), 0)
+	assert.Equal(t, image.Rect(0, 0, 10, 10), im.Bounds())
+
+	// the arc is filled clockwise from the bottom left, and there's
+	// a gap at the bottom and a hole in the middle
+	assert.Equal(t, color.RGBA{0xff, 0, 0, 0xff}, im.At(0, 5))
+	assert.Equal(t, color.RGBA{0, 0, 0xff, 0xff}, im.At(5, 0))
+	assert.Equal(t, color.RGBA{0, 0, 0xff, 0xff}, im.At(9, 5))
+	assert.Equal(t, color.RGBA{0, 0, 0, 0}, im.At(5, 9))
+	assert.Equal(t, color.RGBA{0, 0, 0, 0}, im.At(5, 5))
+
+	// a full sweep closes the gap
+	g.Sweep = 360
+	g.Value = 100
+	im = PaintWidget(g, image.Rect(0, 0, 100, 100), 0)
+	assert.Equal(t, color.RGBA{0xff, 0, 0, 0xff}, im.At(5, 9))
+	assert.Equal(t, color.RGBA{0xff, 0, 0, 0xff}, im.At(9, 5))
+
+	assert.Error(t, Gauge{Sweep: 400}.Init())
+	assert.Error(t, Gauge{Thickness: -1}.Init())
+	assert.Error(t, Gauge{Progress: Progress{Thresholds: []float64{2, 1}}}.Init())
+}
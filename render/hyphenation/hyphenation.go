@@ -0,0 +1,136 @@
+// Package hyphenation finds where English words can be broken across
+// lines, with the hyphenation patterns that TeX uses. See Frank Liang's
+// thesis, "Word Hy-phen-a-tion by Com-put-er", for how they work.
+package hyphenation
+
+import (
+	_ "embed"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+// Words aren't broken into parts shorter than these.
+const (
+	LeftMin  = 2
+	RightMin = 3
+)
+
+//go:embed hyph-en-us.pat.txt
+var patternsTxt string
+
+//go:embed hyph-en-us.hyp.txt
+var exceptionsTxt string
+
+var (
+	once sync.Once
+
+	// patterns maps the letters of each pattern to its values, which
+	// are between its letters and at either end
+	patterns   map[string][]int
+	maxPattern int
+
+	// exceptions maps words that the patterns get wrong to their
+	// hyphenation points
+	exceptions map[string][]int
+)
+
+func load() {
+	patterns = map[string][]int{}
+	for _, line := range strings.Split(patternsTxt, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "%") {
+			continue
+		}
+
+		letters := []byte{}
+		values := []int{0}
+		for i := 0; i < len(line); i++ {
+			if c := line[i]; c >= '0' && c <= '9' {
+				values[len(values)-1] = int(c - '0')
+			} else {
+				letters = append(letters, c)
+				values = append(values, 0)
+			}
+		}
+
+		patterns[string(letters)] = values
+		if len(letters) > maxPattern {
+			maxPattern = len(letters)
+		}
+	}
+
+	exceptions = map[string][]int{}
+	for _, line := range strings.Split(exceptionsTxt, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "%") {
+			continue
+		}
+
+		points := []int{}
+		for i, part := range strings.Split(line, "-") {
+			if i > 0 {
+				points = append(points, points[len(points)-1]+len(part))
+			} else {
+				points = append(points, len(part))
+			}
+		}
+		exceptions[strings.ReplaceAll(line, "-", "")] = points[:len(points)-1]
+	}
+}
+
+// Hyphenate returns the positions, in runes, where word can be broken
+// with a hyphen. Punctuation around the word is skipped. Words with
+// characters other than the letters a to z aren't hyphenated.
+func Hyphenate(word string) []int {
+	once.Do(load)
+
+	runes := []rune(word)
+	start, end := 0, len(runes)
+	for start < end && !unicode.IsLetter(runes[start]) {
+		start++
+	}
+	for end > start && !unicode.IsLetter(runes[end-1]) {
+		end--
+	}
+
+	core := strings.ToLower(string(runes[start:end]))
+	if len(core) < LeftMin+RightMin {
+		return nil
+	}
+	for _, c := range core {
+		if c < 'a' || c > 'z' {
+			return nil
+		}
+	}
+
+	var points []int
+	if exception, ok := exceptions[core]; ok {
+		for _, p := range exception {
+			points = append(points, start+p)
+		}
+		return points
+	}
+
+	// every pattern that matches the word sets the values between its
+	// letters, with higher values winning. odd values are hyphenation
+	// points.
+	w := "." + core + "."
+	values := make([]int, len(w)+1)
+	for i := 0; i < len(w); i++ {
+		for j := i + 1; j <= len(w) && j-i <= maxPattern; j++ {
+			for k, v := range patterns[w[i:j]] {
+				if v > values[i+k] {
+					values[i+k] = v
+				}
+			}
+		}
+	}
+
+	for p := LeftMin; p <= len(core)-RightMin; p++ {
+		if values[p+1]%2 == 1 {
+			points = append(points, start+p)
+		}
+	}
+	return points
+}
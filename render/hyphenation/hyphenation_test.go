@@ -0,0 +1,37 @@
+package hyphenation
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// hyphenated returns word with hyphens at its hyphenation points.
+func hyphenated(word string) string {
+	runes := []rune(word)
+	var b strings.Builder
+	last := 0
+	for _, p := range Hyphenate(word) {
+		b.WriteString(string(runes[last:p]) + "-")
+		last = p
+	}
+	b.WriteString(string(runes[last:]))
+	return b.String()
+}
+
+func TestHyphenate(t *testing.T) {
+	for word, expected := range map[string]string{
+		"hyphenation": "hy-phen-a-tion",
+		"computer":    "com-puter",
+		"Headline":    "Head-line",
+		"temperature": "tem-per-a-ture",
+		"associate":   "as-so-ciate",
+		"(forecast),": "(fore-cast),",
+		"cat":         "cat",
+		"naïve":       "naïve",
+		"":            "",
+	} {
+		assert.Equal(t, expected, hyphenated(word), word)
+	}
+}
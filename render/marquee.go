@@ -18,6 +18,10 @@ import (
 //
 // If the child's width fits fully, it will not scroll.
 //
+// Hebrew, Arabic and other right-to-left text scrolls the other way, from
+// left to right, in horizontal mode. Its `offset_start`, `offset_end` and
+// `align` are then measured from the right.
+//
 // The `offset_start` and `offset_end` parameters control the position
 // of the child in the beginning and the end of the animation.
 //
@@ -181,6 +185,9 @@ func (m Marquee) Paint(dc *gg.Context, bounds image.Rectangle, frameIdx int) {
 		dc.Pop()
 	} else {
 		offset -= int(align * float64(cb.Dx()))
+		if m.isRightToLeft() {
+			offset = size - cb.Dx() - offset
+		}
 		dc.Push()
 		dc.DrawRectangle(0, 0, float64(pb.Dx()), float64(pb.Dy()))
 		dc.Clip()
@@ -193,3 +200,10 @@ func (m Marquee) Paint(dc *gg.Context, bounds image.Rectangle, frameIdx int) {
 func (m Marquee) isVertical() bool {
 	return m.ScrollDirection == "vertical"
 }
+
+// isRightToLeft tells if the child is text that reads from right to left,
+// and so should scroll the other way.
+func (m Marquee) isRightToLeft() bool {
+	child, ok := m.Child.(interface{ rightToLeft() bool })
+	return ok && child.rightToLeft()
+}
@@ -0,0 +1,44 @@
+package render
+
+import (
+	"image"
+)
+
+// TextMetrics is the size of a line of text drawn by Text.
+type TextMetrics struct {
+	Width   int
+	Height  int
+	Ascent  int
+	Descent int
+}
+
+// MeasureText returns the size of content drawn by a Text in font, or
+// the default font if font is empty. It's measured the same way Text
+// measures it, so it matches the size of the widget.
+func MeasureText(content, font string) (TextMetrics, error) {
+	t := &Text{Content: content, Font: font}
+	if err := t.Init(); err != nil {
+		return TextMetrics{}, err
+	}
+
+	face, err := GetFontFor(t.Font, content)
+	if err != nil {
+		return TextMetrics{}, err
+	}
+	metrics := face.Metrics()
+
+	width, height := t.Size()
+	return TextMetrics{
+		Width:   width,
+		Height:  height,
+		Ascent:  metrics.Ascent.Floor(),
+		Descent: metrics.Descent.Floor(),
+	}, nil
+}
+
+// WidgetSize returns the size of the area w draws to in its first
+// frame, when it's given maxWidth by maxHeight pixels.
+func WidgetSize(w Widget, maxWidth, maxHeight int) (int, int) {
+	pb := w.PaintBounds(image.Rect(0, 0, maxWidth, maxHeight), 0)
+	return pb.Dx(), pb.Dy()
+}
@@ -0,0 +1,57 @@
+package render
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMeasureText(t *testing.T) {
+	metrics, err := MeasureText("Hello", "")
+	require.NoError(t, err)
+	assert.Equal(t, TextMetrics{Width: 23, Height: 8, Ascent: 7, Descent: 1}, metrics)
+
+	metrics, err = MeasureText("Hello", "tom-thumb")
+	require.NoError(t, err)
+	assert.Equal(t, 20, metrics.Width)
+	assert.Equal(t, metrics.Height, metrics.Ascent+metrics.Descent)
+
+	// the size matches the Text widget's
+	text := &Text{Content: "12:05", Font: "6x13"}
+	require.NoError(t, text.Init())
+	w, h := text.Size()
+	metrics, err = MeasureText("12:05", "6x13")
+	require.NoError(t, err)
+	assert.Equal(t, w, metrics.Width)
+	assert.Equal(t, h, metrics.Height)
+
+	_, err = MeasureText("Hello", "comic-sans")
+	assert.Error(t, err)
+}
+
+func TestWidgetSize(t *testing.T) {
+	row := &Row{
+		Children: []Widget{
+			&Box{Width: 10, Height: 4},
+			&Box{Width: 20, Height: 6},
+		},
+	}
+	w, h := WidgetSize(row, 64, 32)
+	assert.Equal(t, 30, w)
+	assert.Equal(t, 6, h)
+
+	// widgets that fill their space are limited by it
+	w, h = WidgetSize(&Box{}, 40, 10)
+	assert.Equal(t, 40, w)
+	assert.Equal(t, 10, h)
+
+	wrapped := &WrappedText{Content: "this is a long string of text"}
+	require.NoError(t, wrapped.Init())
+	w, h = WidgetSize(wrapped, 30, 32)
+	assert.LessOrEqual(t, w, 30)
+	assert.Greater(t, h, 8)
+	w, h = WidgetSize(wrapped, 30, 12)
+	assert.LessOrEqual(t, w, 30)
+	assert.Equal(t, 12, h)
+}
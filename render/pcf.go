@@ -0,0 +1,351 @@
+package render
+
+import (
+	"encoding/binary"
+	"fmt"
+	"image"
+	"math/bits"
+	"strings"
+
+	"github.com/zachomedia/go-bdf"
+	"golang.org/x/text/encoding/charmap"
+)
+
+// The PCF (Portable Compiled Format) tables we read. See
+// https://fontforge.org/docs/techref/pcf-format.html
+const (
+	pcfProperties      = 1 << 0
+	pcfAccelerators    = 1 << 1
+	pcfMetrics         = 1 << 2
+	pcfBitmaps         = 1 << 3
+	pcfBDFEncodings    = 1 << 5
+	pcfBDFAccelerators = 1 << 8
+
+	pcfFormatMask        = 0xffffff00
+	pcfCompressedMetrics = 0x00000100
+
+	pcfMagic = "\x01fcp"
+)
+
+// pcfCharmaps are the legacy encodings that PCF fonts may use besides
+// Unicode.
+var pcfCharmaps = map[string]*charmap.Charmap{
+	"iso8859-1":  charmap.ISO8859_1,
+	"iso8859-2":  charmap.ISO8859_2,
+	"iso8859-9":  charmap.ISO8859_9,
+	"iso8859-15": charmap.ISO8859_15,
+}
+
+type pcfMetric struct {
+	left, right, width, ascent, descent int
+}
+
+// pcfTable reads the fields of a single table, in the byte order given
+// by its format.
+type pcfTable struct {
+	data   []byte
+	pos    int
+	format uint32
+	order  binary.ByteOrder
+	err    error
+}
+
+func (t *pcfTable) next(n int) []byte {
+	if t.err != nil {
+		return make([]byte, n)
+	}
+	if n < 0 || t.pos+n > len(t.data) {
+		t.err = fmt.Errorf("table is truncated")
+		return make([]byte, n)
+	}
+	b := t.data[t.pos : t.pos+n]
+	t.pos += n
+	return b
+}
+
+func (t *pcfTable) u8() int    { return int(t.next(1)[0]) }
+func (t *pcfTable) i16() int   { return int(int16(t.order.Uint16(t.next(2)))) }
+func (t *pcfTable) u16() int   { return int(t.order.Uint16(t.next(2))) }
+func (t *pcfTable) i32() int   { return int(int32(t.order.Uint32(t.next(4)))) }
+func (t *pcfTable) skip(n int) { t.next(n) }
+
+// count checks that n items can fit in what remains of the table.
+func (t *pcfTable) count(n int) int {
+	if n < 0 || n > len(t.data)-t.pos {
+		if t.err == nil {
+			t.err = fmt.Errorf("table is truncated")
+		}
+		return 0
+	}
+	return n
+}
+
+func (t *pcfTable) metric() pcfMetric {
+	if t.format&pcfFormatMask == pcfCompressedMetrics {
+		return pcfMetric{
+			left:    t.u8() - 0x80,
+			right:   t.u8() - 0x80,
+			width:   t.u8() - 0x80,
+			ascent:  t.u8() - 0x80,
+			descent: t.u8() - 0x80,
+		}
+	}
+
+	m := pcfMetric{
+		left:    t.i16(),
+		right:   t.i16(),
+		width:   t.i16(),
+		ascent:  t.i16(),
+		descent: t.i16(),
+	}
+	t.skip(2) // attributes
+	return m
+}
+
+// parsePCF parses a font in the PCF format that X11 uses for compiled
+// BDF fonts.
+func parsePCF(data []byte) (*bdf.Font, error) {
+	if len(data) < 8 || string(data[:4]) != pcfMagic {
+		return nil, fmt.Errorf("not a PCF font")
+	}
+
+	tables := map[int]*pcfTable{}
+	count := int(binary.LittleEndian.Uint32(data[4:8]))
+	for i := 0; i < count; i++ {
+		entry := 8 + i*16
+		if entry+16 > len(data) {
+			return nil, fmt.Errorf("PCF table of contents is truncated")
+		}
+
+		kind := int(binary.LittleEndian.Uint32(data[entry:]))
+		size := int(binary.LittleEndian.Uint32(data[entry+8:]))
+		offset := int(binary.LittleEndian.Uint32(data[entry+12:]))
+		if offset < 0 || size < 4 || offset+size > len(data) {
+			return nil, fmt.Errorf("PCF table %d is out of bounds", kind)
+		}
+
+		t := &pcfTable{data: data[offset : offset+size], order: binary.LittleEndian}
+		t.format = uint32(t.i32())
+		if t.format&4 != 0 {
+			t.order = binary.BigEndian
+		}
+		tables[kind] = t
+	}
+
+	for _, kind := range []int{pcfMetrics, pcfBitmaps, pcfBDFEncodings} {
+		if tables[kind] == nil {
+			return nil, fmt.Errorf("PCF font has no table %d", kind)
+		}
+	}
+
+	f := &bdf.Font{
+		CharMap: map[rune]*bdf.Character{},
+		BPP:     1,
+	}
+
+	props := pcfReadProperties(tables[pcfProperties])
+	f.Name, _ = props["FONT"].(string)
+	if ascent, ok := props["FONT_ASCENT"].(int); ok {
+		f.Ascent = ascent
+	}
+	if descent, ok := props["FONT_DESCENT"].(int); ok {
+		f.Descent = descent
+	}
+	if capHeight, ok := props["CAP_HEIGHT"].(int); ok {
+		f.CapHeight = capHeight
+	}
+	if xHeight, ok := props["X_HEIGHT"].(int); ok {
+		f.XHeight = xHeight
+	}
+	registry, _ := props["CHARSET_REGISTRY"].(string)
+	encoding, _ := props["CHARSET_ENCODING"].(string)
+	f.Encoding = registry + "-" + encoding
+
+	// the accelerators have the ascent and descent if the properties
+	// don't
+	accel := tables[pcfBDFAccelerators]
+	if accel == nil {
+		accel = tables[pcfAccelerators]
+	}
+	if accel != nil && f.Ascent == 0 && f.Descent == 0 {
+		accel.skip(8)
+		f.Ascent = accel.i32()
+		f.Descent = accel.i32()
+	}
+
+	// metrics
+	t := tables[pcfMetrics]
+	var metrics []pcfMetric
+	if t.format&pcfFormatMask == pcfCompressedMetrics {
+		metrics = make([]pcfMetric, t.count(t.i16()))
+	} else {
+		metrics = make([]pcfMetric, t.count(t.i32()))
+	}
+	for i := range metrics {
+		metrics[i] = t.metric()
+	}
+	if t.err != nil {
+		return nil, fmt.Errorf("reading PCF metrics: %w", t.err)
+	}
+
+	// bitmaps
+	t = tables[pcfBitmaps]
+	glyphs := t.i32()
+	if glyphs != len(metrics) {
+		return nil, fmt.Errorf("PCF font has %d bitmaps for %d glyphs", glyphs, len(metrics))
+	}
+	offsets := make([]int, t.count(glyphs))
+	for i := range offsets {
+		offsets[i] = t.i32()
+	}
+	sizes := [4]int{t.i32(), t.i32(), t.i32(), t.i32()}
+	bitmaps := t.next(t.count(sizes[t.format&3]))
+	if t.err != nil {
+		return nil, fmt.Errorf("reading PCF bitmaps: %w", t.err)
+	}
+
+	f.Characters = make([]bdf.Character, glyphs)
+	for i, m := range metrics {
+		alpha, err := pcfGlyph(bitmaps, offsets[i], m, t.format)
+		if err != nil {
+			return nil, fmt.Errorf("reading PCF glyph %d: %w", i, err)
+		}
+
+		f.Characters[i] = bdf.Character{
+			Advance:    [2]int{m.width, 0},
+			Alpha:      alpha,
+			LowerPoint: [2]int{m.left, -m.descent},
+		}
+	}
+
+	// encodings
+	t = tables[pcfBDFEncodings]
+	minByte2, maxByte2 := t.i16(), t.i16()
+	minByte1, maxByte1 := t.i16(), t.i16()
+	defaultChar := t.i16()
+
+	cm := pcfCharmaps[strings.ToLower(f.Encoding)]
+	toRune := func(code int) rune {
+		if cm != nil && code < 256 {
+			return cm.DecodeByte(byte(code))
+		}
+		return rune(code)
+	}
+	f.DefaultChar = toRune(defaultChar)
+
+	for b1 := minByte1; b1 <= maxByte1; b1++ {
+		for b2 := minByte2; b2 <= maxByte2; b2++ {
+			index := t.u16()
+			if t.err != nil {
+				return nil, fmt.Errorf("reading PCF encodings: %w", t.err)
+			}
+			if index == 0xffff || index >= len(f.Characters) {
+				continue
+			}
+
+			r := toRune(b1<<8 | b2)
+			f.Characters[index].Encoding = r
+			f.CharMap[r] = &f.Characters[index]
+		}
+	}
+
+	return f, nil
+}
+
+// pcfReadProperties reads the font properties, which are either strings
+// or ints.
+func pcfReadProperties(t *pcfTable) map[string]interface{} {
+	props := map[string]interface{}{}
+	if t == nil {
+		return props
+	}
+
+	type prop struct {
+		name, value int
+		isString    bool
+	}
+
+	list := make([]prop, t.count(t.i32()))
+	for i := range list {
+		list[i] = prop{name: t.i32(), isString: t.u8() != 0, value: t.i32()}
+	}
+	if len(list)&3 != 0 {
+		t.skip(4 - len(list)&3)
+	}
+	strs := t.next(t.i32())
+	if t.err != nil {
+		return props
+	}
+
+	str := func(offset int) string {
+		if offset < 0 || offset >= len(strs) {
+			return ""
+		}
+		s := strs[offset:]
+		if end := strings.IndexByte(string(s), 0); end >= 0 {
+			s = s[:end]
+		}
+		return string(s)
+	}
+
+	for _, p := range list {
+		if p.isString {
+			props[str(p.name)] = str(p.value)
+		} else {
+			props[str(p.name)] = p.value
+		}
+	}
+
+	return props
+}
+
+// pcfGlyph decodes the bitmap of a glyph. Rows are padded to the glyph
+// pad of the format, and stored in scan units of the format's bit and
+// byte order.
+func pcfGlyph(bitmaps []byte, offset int, m pcfMetric, format uint32) (*image.Alpha, error) {
+	width := m.right - m.left
+	height := m.ascent + m.descent
+	if width < 0 || height < 0 {
+		return nil, fmt.Errorf("invalid metrics")
+	}
+
+	pad := 1 << (format & 3)
+	unit := 1 << ((format >> 4) & 3)
+	msbFirst := format&4 != 0
+	msbBitFirst := format&8 != 0
+
+	stride := (width + 7) / 8
+	stride = (stride + pad - 1) / pad * pad
+
+	if offset < 0 || offset+stride*height > len(bitmaps) {
+		return nil, fmt.Errorf("bitmap is out of bounds")
+	}
+	src := bitmaps[offset : offset+stride*height]
+
+	alpha := image.NewAlpha(image.Rect(0, 0, width, height))
+	row := make([]byte, stride)
+	for y := 0; y < height; y++ {
+		copy(row, src[y*stride:(y+1)*stride])
+
+		if msbFirst != msbBitFirst && unit > 1 {
+			for i := 0; i+unit <= len(row); i += unit {
+				for a, b := i, i+unit-1; a < b; a, b = a+1, b-1 {
+					row[a], row[b] = row[b], row[a]
+				}
+			}
+		}
+		if !msbBitFirst {
+			for i := range row {
+				row[i] = bits.Reverse8(row[i])
+			}
+		}
+
+		for x := 0; x < width; x++ {
+			if row[x/8]&(0x80>>(x%8)) != 0 {
+				alpha.Pix[y*alpha.Stride+x] = 0xff
+			}
+		}
+	}
+
+	return alpha, nil
+}
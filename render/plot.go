@@ -1,19 +1,49 @@
 package render
 
 import (
+	"fmt"
 	"image"
 	"image/color"
 	"math"
+	"strconv"
 
 	"github.com/tidbyt/gg"
 )
 
 var DefaultPlotColor = color.RGBA{0xff, 0xff, 0xff, 0xff}
 
+// reference lines, ticks and axis labels default to these
+var DefaultPlotLineColor = color.RGBA{0x55, 0x55, 0x55, 0xff}
+var DefaultPlotAxisColor = color.RGBA{0x88, 0x88, 0x88, 0xff}
+var DefaultPlotBandColor = color.RGBA{0x22, 0x22, 0x22, 0xff}
+
+// axis labels are drawn in this font unless set
+var DefaultPlotLabelFont = "CG-pixel-3x5-mono"
+
 // surface fill gets line color dampened by this factor
 var FillDampFactor uint8 = 0x55
 
-// Plot is a widget that draws a data series.
+// Plot is a widget that draws one or more data series.
+//
+// The first series is `data`, drawn in `color`. More can be added with
+// `series`, a list of `PlotSeries`, which are drawn on top of it. All
+// series share the same axes, which span all of their data unless
+// limited by `x_lim` and `y_lim`. With `independent_axes`, each series
+// is scaled to fit on its own instead, using its own limits, which
+// suits comparing series of different units, like a stock and an
+// index.
+//
+// `reference_lines` draws horizontal lines across the plot, like a
+// previous close, and `bands` shades ranges of Y-values. Both are
+// drawn behind the series, and use the axes of `data`.
+//
+// `x_ticks` and `y_ticks` draw that many tick marks, spread evenly
+// along the bottom and left edges, and can't be more than `width` and
+// `height`. With `x_labels` or `y_labels`, the
+// values at the ticks are written below or to the left of the plot,
+// in `label_font`. If there are fewer than two ticks, the ends of the
+// axis are labeled. The space for labels is taken from `width` and
+// `height`, so the plot itself gets smaller.
 //
 // DOC(Data): A list of 2-tuples of numbers
 // DOC(Width): Limits Plot width
@@ -26,6 +56,16 @@ var FillDampFactor uint8 = 0x55
 // DOC(FillColor): Fill color for Y-values above 0
 // DOC(FillColorInverted): Fill color for Y-values below 0
 // DOC(ChartType): Specifies the type of chart to render, "scatter" or "line", default is "line"
+// DOC(Series): More data series to draw
+// DOC(IndependentAxes): Scale each series to fit on its own
+// DOC(ReferenceLines): Horizontal lines across the plot
+// DOC(Bands): Shaded ranges of Y-values
+// DOC(XTicks): Number of tick marks along the X-axis
+// DOC(YTicks): Number of tick marks along the Y-axis
+// DOC(XLabels): Label the X-axis
+// DOC(YLabels): Label the Y-axis
+// DOC(LabelFont): Font for axis labels, default is 'CG-pixel-3x5-mono'
+// DOC(AxisColor): Color of ticks and labels, default is '#888'
 //
 // EXAMPLE BEGIN
 // render.Plot(
@@ -52,6 +92,27 @@ var FillDampFactor uint8 = 0x55
 //
 // ),
 // EXAMPLE END
+//
+// EXAMPLE BEGIN
+// render.Plot(
+//      data = [(0, 12), (1, 14), (2, 13), (3, 17), (4, 16), (5, 19)],
+//      series = [
+//           render.PlotSeries(
+//                data = [(0, 11), (1, 12), (2, 15), (3, 14), (4, 15), (5, 15)],
+//                color = "#07f",
+//           ),
+//      ],
+//      width = 64,
+//      height = 32,
+//      color = "#0f0",
+//      y_lim = (10, 20),
+//      reference_lines = [render.PlotLine(y = 15, dashed = True)],
+//      bands = [render.PlotBand(low = 10, high = 12, color = "#300")],
+//      x_ticks = 6,
+//      y_ticks = 3,
+//      y_labels = True,
+// )
+// EXAMPLE END
 type Plot struct {
 	Widget
 
@@ -84,27 +145,135 @@ type Plot struct {
 	// Optional fill color for Y-values below 0
 	FillColorInverted color.Color `starlark:"fill_color_inverted"`
 
+	// Optional series drawn on top of Data, and whether they're
+	// scaled on their own
+	Series          []PlotSeries `starlark:"series"`
+	IndependentAxes bool         `starlark:"independent_axes"`
+
+	// Optional lines and bands behind the series
+	ReferenceLines []PlotLine `starlark:"reference_lines"`
+	Bands          []PlotBand `starlark:"bands"`
+
+	// Optional tick marks and axis labels
+	XTicks    int         `starlark:"x_ticks"`
+	YTicks    int         `starlark:"y_ticks"`
+	XLabels   bool        `starlark:"x_labels"`
+	YLabels   bool        `starlark:"y_labels"`
+	LabelFont string      `starlark:"label_font"`
+	AxisColor color.Color `starlark:"axis_color"`
+
 	invThreshold int
 }
 
-// Computes X and Y limits
+// PlotSeries is a data series drawn by a Plot, in addition to its
+// `data`. Its limits are only used if the Plot has independent axes.
+//
+// DOC(Data): A list of 2-tuples of numbers
+// DOC(Color): Line color, default is '#fff'
+// DOC(ColorInverted): Line color for Y-values below 0
+// DOC(XLim): Limit X-axis to a range
+// DOC(YLim): Limit Y-axis to a range
+// DOC(Fill): Paint surface between line and X-axis
+// DOC(FillColor): Fill color for Y-values above 0
+// DOC(FillColorInverted): Fill color for Y-values below 0
+// DOC(ChartType): Specifies the type of chart to render, "scatter" or "line", default is "line"
+type PlotSeries struct {
+	Data              [][2]float64 `starlark:"data,required"`
+	Color             color.Color  `starlark:"color"`
+	ColorInverted     color.Color  `starlark:"color_inverted"`
+	XLim              [2]float64   `starlark:"x_lim"`
+	YLim              [2]float64   `starlark:"y_lim"`
+	Fill              bool         `starlark:"fill"`
+	FillColor         color.Color  `starlark:"fill_color"`
+	FillColorInverted color.Color  `starlark:"fill_color_inverted"`
+	ChartType         string       `starlark:"chart_type"`
+}
+
+// PlotLine is a horizontal reference line across a Plot, like a
+// previous close.
+//
+// DOC(Y): Y-value of the line
+// DOC(Color): Line color, default is '#555'
+// DOC(Dashed): Draw every other pixel
+type PlotLine struct {
+	Y      float64     `starlark:"y,required"`
+	Color  color.Color `starlark:"color"`
+	Dashed bool        `starlark:"dashed"`
+}
+
+// PlotBand shades a range of Y-values across a Plot, like a target
+// range.
+//
+// DOC(Low): Lowest Y-value of the band
+// DOC(High): Highest Y-value of the band
+// DOC(Color): Band color, default is '#222'
+type PlotBand struct {
+	Low   float64     `starlark:"low,required"`
+	High  float64     `starlark:"high,required"`
+	Color color.Color `starlark:"color"`
+}
+
+func (p *Plot) Init() error {
+	if p.XTicks < 0 || p.YTicks < 0 {
+		return fmt.Errorf("x_ticks and y_ticks must not be negative")
+	}
+	if p.XTicks > p.Width || p.YTicks > p.Height {
+		return fmt.Errorf("x_ticks and y_ticks must be at most the width and height of the plot")
+	}
+	if p.LabelFont != "" {
+		if _, err := GetFont(p.LabelFont); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// series returns all series of the plot, starting with Data.
+func (p Plot) series() []PlotSeries {
+	return append([]PlotSeries{{
+		Data:              p.Data,
+		Color:             p.Color,
+		ColorInverted:     p.ColorInverted,
+		XLim:              p.XLim,
+		YLim:              p.YLim,
+		Fill:              p.Fill,
+		FillColor:         p.FillColor,
+		FillColorInverted: p.FillColorInverted,
+		ChartType:         p.ChartType,
+	}}, p.Series...)
+}
+
+// Computes X and Y limits of Data, which are shared by all series
+// unless the axes are independent
 func (p *Plot) computeLimits() (float64, float64, float64, float64) {
+	data := p.Data
+	if !p.IndependentAxes {
+		for _, s := range p.Series {
+			data = append(data[:len(data):len(data)], s.Data...)
+		}
+	}
+	return plotLimits(data, p.XLim, p.YLim)
+}
+
+// Computes X and Y limits of data
+func plotLimits(data [][2]float64, xLim, yLim [2]float64) (float64, float64, float64, float64) {
 
 	// If all limits are set by user, no computation is required
-	if !math.IsNaN(p.XLim[0]) && !math.IsNaN(p.XLim[1]) &&
-		!math.IsNaN(p.YLim[0]) && !math.IsNaN(p.YLim[1]) {
-		return p.XLim[0], p.XLim[1], p.YLim[0], p.YLim[1]
+	if !math.IsNaN(xLim[0]) && !math.IsNaN(xLim[1]) &&
+		!math.IsNaN(yLim[0]) && !math.IsNaN(yLim[1]) &&
+		xLim[0] != xLim[1] && yLim[0] != yLim[1] {
+		return xLim[0], xLim[1], yLim[0], yLim[1]
 	}
 
 	// Otherwise we'll need min/max of X and Y
-	if len(p.Data) == 0 {
+	if len(data) == 0 {
 		return 0, 1, 0, 1
 	}
 
-	pt := p.Data[0]
+	pt := data[0]
 	minX, maxX, minY, maxY := pt[0], pt[0], pt[1], pt[1]
-	for i := 1; i < len(p.Data); i++ {
-		pt = p.Data[i]
+	for i := 1; i < len(data); i++ {
+		pt = data[i]
 		if pt[0] < minX {
 			minX = pt[0]
 		}
@@ -125,17 +294,17 @@ func (p *Plot) computeLimits() (float64, float64, float64, float64) {
 	xLimMax := maxX
 	yLimMin := minY
 	yLimMax := maxY
-	if !math.IsNaN(p.XLim[0]) {
-		xLimMin = p.XLim[0]
+	if !math.IsNaN(xLim[0]) {
+		xLimMin = xLim[0]
 	}
-	if !math.IsNaN(p.XLim[1]) {
-		xLimMax = p.XLim[1]
+	if !math.IsNaN(xLim[1]) {
+		xLimMax = xLim[1]
 	}
-	if !math.IsNaN(p.YLim[0]) {
-		yLimMin = p.YLim[0]
+	if !math.IsNaN(yLim[0]) {
+		yLimMin = yLim[0]
 	}
-	if !math.IsNaN(p.YLim[1]) {
-		yLimMax = p.YLim[1]
+	if !math.IsNaN(yLim[1]) {
+		yLimMax = yLim[1]
 	}
 
 	// The inferred limits can be non-sensical if user provides
@@ -143,14 +312,14 @@ func (p *Plot) computeLimits() (float64, float64, float64, float64) {
 	// provided limit and add an arbitraty +-0.5 to create limits
 	// that result in all points displayed "off-screen".
 	if xLimMax < xLimMin {
-		if math.IsNaN(p.XLim[0]) {
+		if math.IsNaN(xLim[0]) {
 			xLimMin = xLimMax - 0.5
 		} else {
 			xLimMax = xLimMin + 0.5
 		}
 	}
 	if yLimMax < yLimMin {
-		if math.IsNaN(p.YLim[0]) {
+		if math.IsNaN(yLim[0]) {
 			yLimMin = yLimMax - 0.5
 		} else {
 			yLimMax = yLimMin + 0.5
@@ -173,24 +342,134 @@ func (p *Plot) computeLimits() (float64, float64, float64, float64) {
 	return xLimMin, xLimMax, yLimMin, yLimMax
 }
 
+// plotScale maps data onto a width by height area of the canvas
+type plotScale struct {
+	xMin, xMax, yMin, yMax float64
+	width, height          int
+}
+
+func (s plotScale) x(v float64) int {
+	return int(math.Round((v - s.xMin) / (s.xMax - s.xMin) * float64(s.width-1)))
+}
+
+func (s plotScale) y(v float64) int {
+	return s.height - 1 - int(math.Round((v-s.yMin)/(s.yMax-s.yMin)*float64(s.height-1)))
+}
+
+func (s plotScale) translate(data [][2]float64) []PathPoint {
+	points := make([]PathPoint, len(data))
+	for i, pt := range data {
+		points[i] = PathPoint{X: s.x(pt[0]), Y: s.y(pt[1])}
+	}
+	return points
+}
+
+// scales returns the scale of each series, in a width by height area.
+func (p *Plot) scales(width, height int) []plotScale {
+	series := p.series()
+	scales := make([]plotScale, len(series))
+	for i, s := range series {
+		scale := plotScale{width: width, height: height}
+		if i == 0 || !p.IndependentAxes {
+			scale.xMin, scale.xMax, scale.yMin, scale.yMax = p.computeLimits()
+		} else {
+			scale.xMin, scale.xMax, scale.yMin, scale.yMax = plotLimits(s.Data, s.XLim, s.YLim)
+		}
+		scales[i] = scale
+	}
+	return scales
+}
+
 // Maps the points in X and Y to positions on the canvas
 func (p *Plot) translatePoints() []PathPoint {
-	xLimMin, xLimMax, yLimMin, yLimMax := p.computeLimits()
-
-	// Translate
-	points := make([]PathPoint, len(p.Data))
-	for i := 0; i < len(p.Data); i++ {
-		pt := p.Data[i]
-		nX := (pt[0] - xLimMin) / (xLimMax - xLimMin)
-		nY := (pt[1] - yLimMin) / (yLimMax - yLimMin)
-		points[i] = PathPoint{
-			X: int(math.Round(nX * float64(p.Width-1))),
-			Y: p.Height - 1 - int(math.Round(nY*float64(p.Height-1))),
+	area := p.area()
+	scale := p.scales(area.Dx(), area.Dy())[0]
+	p.invThreshold = scale.y(0)
+	return scale.translate(p.Data)
+}
+
+// ticks returns n values spread evenly from lo to hi.
+func ticks(n int, lo, hi float64) []float64 {
+	if n == 1 {
+		return []float64{lo}
+	}
+	values := make([]float64, n)
+	for i := range values {
+		values[i] = lo + float64(i)*(hi-lo)/float64(n-1)
+	}
+	return values
+}
+
+// formatTick formats v with as many decimals as ticks step apart need.
+func formatTick(v, step float64) string {
+	decimals := 0
+	if step = math.Abs(step); step > 0 && step < 1 {
+		decimals = int(math.Ceil(-math.Log10(step)))
+	}
+	scale := math.Pow(10, float64(decimals))
+	v = math.Round(v*scale) / scale
+	if v == 0 {
+		// avoids "-0"
+		v = 0
+	}
+	return strconv.FormatFloat(v, 'f', decimals, 64)
+}
+
+// labels returns labels for n ticks from lo to hi, or for the ends of
+// the axis if there are fewer than two ticks.
+func (p Plot) labels(n int, lo, hi float64) ([]float64, []*Text) {
+	if n < 2 {
+		n = 2
+	}
+	font := p.LabelFont
+	if font == "" {
+		font = DefaultPlotLabelFont
+	}
+	col := p.AxisColor
+	if col == nil {
+		col = DefaultPlotAxisColor
+	}
+
+	values := ticks(n, lo, hi)
+	texts := make([]*Text, 0, n)
+	for _, v := range values {
+		t := &Text{Content: formatTick(v, (hi-lo)/float64(n-1)), Font: font, Color: col}
+		if err := t.Init(); err != nil {
+			return nil, nil
 		}
+		texts = append(texts, t)
+	}
+	return values, texts
+}
+
+// area returns where the series are drawn, leaving room for axis
+// labels.
+func (p *Plot) area() image.Rectangle {
+	area := image.Rect(0, 0, p.Width, p.Height)
+	if !p.XLabels && !p.YLabels {
+		return area
 	}
-	p.invThreshold = p.Height - 1 - int(math.Round(((0-yLimMin)/(yLimMax-yLimMin))*float64(p.Height-1)))
 
-	return points
+	xMin, xMax, yMin, yMax := p.computeLimits()
+	if p.XLabels {
+		_, texts := p.labels(p.XTicks, xMin, xMax)
+		if len(texts) > 0 {
+			_, h := texts[0].Size()
+			area.Max.Y = max(area.Max.Y-h-1, area.Min.Y)
+		}
+	}
+	if p.YLabels {
+		_, texts := p.labels(p.YTicks, yMin, yMax)
+		width := 0
+		for _, t := range texts {
+			w, _ := t.Size()
+			width = max(width, w)
+		}
+		if width > 0 {
+			area.Min.X = min(width+1, area.Max.X)
+		}
+	}
+	return area
 }
 
 func dampenColor(c color.Color, a uint8) color.Color {
@@ -203,72 +482,196 @@ func (p Plot) PaintBounds(bounds image.Rectangle, frameIdx int) image.Rectangle
 }
 
 func (p Plot) Paint(dc *gg.Context, bounds image.Rectangle, frameIdx int) {
+	area := p.area()
+	if area.Empty() {
+		return
+	}
+	scales := p.scales(area.Dx(), area.Dy())
+
+	dc.Push()
+	dc.Translate(float64(area.Min.X), float64(area.Min.Y))
+	p.paintBackground(dc, scales[0])
+	for i, s := range p.series() {
+		s.paint(dc, scales[i])
+	}
+	dc.Pop()
+
+	p.paintLabels(dc, area, scales[0])
+}
+
+// paintBackground draws bands, reference lines and tick marks.
+func (p Plot) paintBackground(dc *gg.Context, scale plotScale) {
+	setPixel := func(x, y int) {
+		if x < 0 || x >= scale.width || y < 0 || y >= scale.height {
+			return
+		}
+		tx, ty := dc.TransformPoint(float64(x), float64(y))
+		dc.SetPixel(int(tx), int(ty))
+	}
+
+	for _, band := range p.Bands {
+		col := band.Color
+		if col == nil {
+			col = DefaultPlotBandColor
+		}
+		dc.SetColor(col)
+
+		// only the part of the band within the plot is drawn, so huge
+		// values don't take forever
+		low := math.Max(math.Min(band.Low, band.High), scale.yMin)
+		high := math.Min(math.Max(band.Low, band.High), scale.yMax)
+		if math.IsNaN(band.Low) || math.IsNaN(band.High) || low > high {
+			continue
+		}
+		top, bottom := max(scale.y(high), 0), min(scale.y(low), scale.height-1)
+		for y := top; y <= bottom; y++ {
+			for x := 0; x < scale.width; x++ {
+				setPixel(x, y)
+			}
+		}
+	}
+
+	for _, line := range p.ReferenceLines {
+		col := line.Color
+		if col == nil {
+			col = DefaultPlotLineColor
+		}
+		if !(line.Y >= scale.yMin && line.Y <= scale.yMax) {
+			// outside the plot, or NaN
+			continue
+		}
+		dc.SetColor(col)
+		y := scale.y(line.Y)
+		for x := 0; x < scale.width; x++ {
+			if !line.Dashed || x%2 == 0 {
+				setPixel(x, y)
+			}
+		}
+	}
+
+	col := p.AxisColor
+	if col == nil {
+		col = DefaultPlotAxisColor
+	}
+	dc.SetColor(col)
+	if p.XTicks > 0 {
+		for _, v := range ticks(p.XTicks, scale.xMin, scale.xMax) {
+			x := scale.x(v)
+			setPixel(x, scale.height-1)
+			setPixel(x, scale.height-2)
+		}
+	}
+	if p.YTicks > 0 {
+		for _, v := range ticks(p.YTicks, scale.yMin, scale.yMax) {
+			y := scale.y(v)
+			setPixel(0, y)
+			setPixel(1, y)
+		}
+	}
+}
+
+// paintLabels writes the axis labels around area.
+func (p Plot) paintLabels(dc *gg.Context, area image.Rectangle, scale plotScale) {
+	if p.XLabels {
+		values, texts := p.labels(p.XTicks, scale.xMin, scale.xMax)
+		for i, t := range texts {
+			w, _ := t.Size()
+			x := area.Min.X + scale.x(values[i]) - w/2
+			x = max(0, min(x, p.Width-w))
+			dc.Push()
+			dc.Translate(float64(x), float64(area.Max.Y+1))
+			t.Paint(dc, image.Rect(0, 0, w, p.Height), 0)
+			dc.Pop()
+		}
+	}
+
+	if p.YLabels {
+		values, texts := p.labels(p.YTicks, scale.yMin, scale.yMax)
+		for i, t := range texts {
+			w, h := t.Size()
+			y := area.Min.Y + scale.y(values[i]) - h/2
+			y = max(0, min(y, area.Max.Y-h))
+			dc.Push()
+			dc.Translate(float64(area.Min.X-1-w), float64(y))
+			t.Paint(dc, image.Rect(0, 0, w, h), 0)
+			dc.Pop()
+		}
+	}
+}
+
+// paint draws the series onto a width by height area of the canvas.
+func (s PlotSeries) paint(dc *gg.Context, scale plotScale) {
 	// Set line and fill colors
 	var col color.Color
 	col = color.RGBA{0xff, 0xff, 0xff, 0xff}
-	if p.Color != nil {
-		col = p.Color
+	if s.Color != nil {
+		col = s.Color
 	}
 	colInv := col
-	if p.ColorInverted != nil {
-		colInv = p.ColorInverted
+	if s.ColorInverted != nil {
+		colInv = s.ColorInverted
 	}
 
 	fillCol := dampenColor(col, FillDampFactor)
-	if p.FillColor != nil {
-		fillCol = p.FillColor
+	if s.FillColor != nil {
+		fillCol = s.FillColor
 	}
 
 	fillColInv := dampenColor(colInv, FillDampFactor)
-	if p.FillColorInverted != nil {
-		fillColInv = p.FillColorInverted
+	if s.FillColorInverted != nil {
+		fillColInv = s.FillColorInverted
+	}
+
+	setPixel := func(x, y int) {
+		if x < 0 || x >= scale.width || y < 0 || y >= scale.height {
+			return
+		}
+		tx, ty := dc.TransformPoint(float64(x), float64(y))
+		dc.SetPixel(int(tx), int(ty))
 	}
 
-	pl := &PolyLine{Vertices: p.translatePoints()}
+	points := scale.translate(s.Data)
+	invThreshold := scale.y(0)
+	pl := &PolyLine{Vertices: points}
 
 	// the optional surface fill
-	for i := 0; p.Fill && i < pl.Length(); i++ {
+	for i := 0; s.Fill && i < pl.Length(); i++ {
 		x, y := pl.Point(i)
-		if x < 0 || x >= p.Width || y < 0 || y >= p.Height {
+		if x < 0 || x >= scale.width || y < 0 || y >= scale.height {
 			continue
 		}
-		if y > p.invThreshold {
+		if y > invThreshold {
 			dc.SetColor(fillColInv)
-			for ; y != p.invThreshold && y >= 0; y-- {
-				tx, ty := dc.TransformPoint(float64(x), float64(y))
-				dc.SetPixel(int(tx), int(ty))
+			for ; y != invThreshold && y >= 0; y-- {
+				setPixel(x, y)
 			}
 		} else {
 			dc.SetColor(fillCol)
-			for ; y <= p.invThreshold && y <= p.Height; y++ {
-				tx, ty := dc.TransformPoint(float64(x), float64(y))
-				dc.SetPixel(int(tx), int(ty))
+			for ; y <= invThreshold && y <= scale.height; y++ {
+				setPixel(x, y)
 			}
 		}
 	}
 
-	if p.ChartType == "scatter" {
-		points := p.translatePoints()
-		for i := 0; i < len(points); i++ {
-			point := points[i]
-			if point.Y > p.invThreshold {
+	if s.ChartType == "scatter" {
+		for _, point := range points {
+			if point.Y > invThreshold {
 				dc.SetColor(colInv)
 			} else {
 				dc.SetColor(col)
 			}
-			dc.SetPixel(int(point.X), int(point.Y))
+			setPixel(point.X, point.Y)
 		}
 	} else {
 		// the line itself
 		for i := 0; i < pl.Length(); i++ {
 			x, y := pl.Point(i)
-			if y > p.invThreshold {
+			if y > invThreshold {
 				dc.SetColor(colInv)
 			} else {
 				dc.SetColor(col)
 			}
-			tx, ty := dc.TransformPoint(float64(x), float64(y))
-			dc.SetPixel(int(tx), int(ty))
+			setPixel(x, y)
 		}
 	}
 }
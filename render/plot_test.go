@@ -598,3 +598,156 @@ func TestPlotScatter(t *testing.T) {
 	}, PaintWidget(p, image.Rect(0, 0, 100, 100), 0)))
 
 }
+
+func TestPlotMultipleSeries(t *testing.T) {
+	ic := ImageChecker{
+		Palette: map[string]color.RGBA{
+			"1": {0xff, 0, 0, 0xff},
+			"2": {0, 0, 0xff, 0xff},
+			".": {0, 0, 0, 0},
+		},
+	}
+
+	p := Plot{
+		Width:  5,
+		Height: 5,
+		Data:   [][2]float64{{0, 0}, {4, 4}},
+		Color:  color.RGBA{0xff, 0, 0, 0xff},
+		XLim:   Empty,
+		YLim:   Empty,
+		Series: []PlotSeries{{
+			Data:  [][2]float64{{0, 1}, {4, 1}},
+			Color: color.RGBA{0, 0, 0xff, 0xff},
+			XLim:  Empty,
+			YLim:  Empty,
+		}},
+	}
+
+	// Series share the axes, and later series are drawn on top
+	assert.Equal(t, nil, ic.Check([]string{
+		"....1",
+		"...1.",
+		"..1..",
+		"22222",
+		"1....",
+	}, PaintWidget(p, image.Rect(0, 0, 100, 100), 0)))
+
+	// Independent axes scale each series on its own, so the flat
+	// series is centered vertically
+	p.IndependentAxes = true
+	assert.Equal(t, nil, ic.Check([]string{
+		"....1",
+		"...1.",
+		"22222",
+		".1...",
+		"1....",
+	}, PaintWidget(p, image.Rect(0, 0, 100, 100), 0)))
+
+	// Shared limits cover all series
+	p.IndependentAxes = false
+	p.Series[0].Data = [][2]float64{{0, 8}, {8, 8}}
+	xMin, xMax, yMin, yMax := p.computeLimits()
+	assert.Equal(t, []float64{0, 8, 0, 8}, []float64{xMin, xMax, yMin, yMax})
+}
+
+func TestPlotReferenceLinesAndBands(t *testing.T) {
+	ic := ImageChecker{
+		Palette: map[string]color.RGBA{
+			"1": {0xff, 0xff, 0xff, 0xff},
+			"-": {0x55, 0x55, 0x55, 0xff},
+			"b": {0, 0, 0xff, 0xff},
+			".": {0, 0, 0, 0},
+		},
+	}
+
+	p := Plot{
+		Width:  6,
+		Height: 5,
+		Data:   [][2]float64{{0, 0}, {5, 0}},
+		XLim:   Empty,
+		YLim:   [2]float64{0, 4},
+		ReferenceLines: []PlotLine{
+			{Y: 3},
+			{Y: 2, Dashed: true},
+		},
+		Bands: []PlotBand{
+			{Low: 0.6, High: 1.4, Color: color.RGBA{0, 0, 0xff, 0xff}},
+		},
+	}
+
+	// Bands and lines are drawn behind the series
+	assert.Equal(t, nil, ic.Check([]string{
+		"......",
+		"------",
+		"-.-.-.",
+		"bbbbbb",
+		"111111",
+	}, PaintWidget(p, image.Rect(0, 0, 100, 100), 0)))
+
+	// and cut off at its edges, however far they reach
+	p.ReferenceLines = []PlotLine{{Y: math.Inf(1)}, {Y: math.NaN()}, {Y: -1e300}}
+	p.Bands = []PlotBand{
+		{Low: 3.5, High: math.Inf(1), Color: color.RGBA{0, 0, 0xff, 0xff}},
+		{Low: math.NaN(), High: 1},
+		{Low: -1e300, High: -1e299},
+	}
+	assert.Equal(t, nil, ic.Check([]string{
+		"bbbbbb",
+		"......",
+		"......",
+		"......",
+		"111111",
+	}, PaintWidget(p, image.Rect(0, 0, 100, 100), 0)))
+}
+
+func TestPlotTicksAndLabels(t *testing.T) {
+	ic := ImageChecker{
+		Palette: map[string]color.RGBA{
+			"x": {0x88, 0x88, 0x88, 0xff},
+			".": {0, 0, 0, 0},
+		},
+	}
+
+	p := Plot{
+		Width:  9,
+		Height: 5,
+		Data:   [][2]float64{},
+		XLim:   [2]float64{0, 8},
+		YLim:   [2]float64{0, 4},
+		XTicks: 3,
+		YTicks: 2,
+	}
+	assert.Equal(t, nil, ic.Check([]string{
+		"xx.......",
+		".........",
+		".........",
+		"x...x...x",
+		"xx..x...x",
+	}, PaintWidget(p, image.Rect(0, 0, 100, 100), 0)))
+
+	// Labels take room from the plot, to the left and below
+	p = Plot{
+		Width:     12,
+		Height:    12,
+		Data:      [][2]float64{},
+		XLim:      [2]float64{0, 7},
+		YLim:      [2]float64{0, 1},
+		XLabels:   true,
+		YLabels:   true,
+		LabelFont: "CG-pixel-3x5-mono",
+	}
+	assert.Equal(t, image.Rect(5, 0, 12, 6), p.area())
+
+	assert.Equal(t, "0", formatTick(0.2, 5))
+	assert.Equal(t, "0.5", formatTick(0.5, 0.5))
+	assert.Equal(t, "0.25", formatTick(0.25, 0.05))
+	assert.Equal(t, "0.0", formatTick(-0.001, 0.1))
+
+	// there can't be more ticks than pixels
+	p = Plot{Width: 10, Height: 5, XTicks: 10, YTicks: 5}
+	assert.NoError(t, p.Init())
+	p.XTicks = 1e9
+	assert.ErrorContains(t, p.Init(), "at most the width and height")
+	p.XTicks, p.YTicks = 10, 6
+	assert.ErrorContains(t, p.Init(), "at most the width and height")
+}
@@ -0,0 +1,510 @@
+package render
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/tidbyt/gg"
+	"golang.org/x/image/font"
+)
+
+// Span is a run of text in a RichText, with its own style. Unset
+// attributes are inherited from the RichText.
+//
+// DOC(Content): The text string to draw
+// DOC(Font): Desired font face
+// DOC(Color): Desired font color
+// DOC(Background): Background color behind the text
+// DOC(Offset): Shifts the text up from the baseline
+// DOC(Bold): Draw the text in bold, by drawing every glyph twice
+type Span struct {
+	Content    string `starlark:"content,required"`
+	Font       string
+	Color      color.Color
+	Background color.Color
+	Offset     int
+	Bold       bool
+}
+
+// RichText draws text made of spans in different styles, wrapping it
+// across lines like WrappedText.
+//
+// Each span can have its own font, color, background and offset from
+// the baseline. Spans are given as a list of `Span` and strings, or
+// with `markup`, where tags style the text between them:
+// - `[b]bold[/b]`
+// - `[color=#f00]red[/color]`
+// - `[bg=#00f]on blue[/bg]`
+// - `[font=tom-thumb]small[/font]`
+// - `[offset=2]raised[/offset]`
+//
+// Tags can be nested, `[/]` closes the innermost one, and `[[` is a
+// literal `[`.
+//
+// The optional `width` and `height` parameters limit the drawing
+// area. If not set, RichText will use as much vertical and horizontal
+// space as possible to fit the text. Lines are aligned with `align`,
+// as in WrappedText.
+//
+// DOC(Spans): Spans of text to draw, as `Span` or strings
+// DOC(Markup): Text with markup tags, instead of spans
+// DOC(Font): Default font face of spans
+// DOC(Color): Default font color of spans
+// DOC(Width): Limits width of the area on which text may be drawn
+// DOC(Height): Limits height of the area on which text may be drawn
+// DOC(LineSpacing): Controls spacing between lines
+// DOC(Align): Text Alignment
+//
+// EXAMPLE BEGIN
+// render.RichText(markup="[color=#fa0]72°F[/color] [font=tom-thumb][color=#0f0]+3[/color][/font]")
+// EXAMPLE END
+type RichText struct {
+	Widget
+
+	Spans       []Span
+	Markup      string
+	Font        string
+	Color       color.Color
+	Width       int
+	Height      int
+	LineSpacing int
+	Align       string
+
+	styles []*spanStyle
+}
+
+// spanStyle is a span with its font and colors resolved.
+type spanStyle struct {
+	span    Span
+	face    font.Face
+	color   color.Color
+	ascent  int
+	descent int
+}
+
+// textRun is a word, or the space between words, in a single style.
+type textRun struct {
+	text  string
+	style *spanStyle
+	width int
+	space bool
+}
+
+// textLine is a line of runs laid out by RichText.
+type textLine struct {
+	runs    []textRun
+	width   int
+	ascent  int
+	descent int
+}
+
+func (rt *RichText) Init() error {
+	if rt.Font == "" {
+		rt.Font = DefaultFontFace
+	}
+	if rt.Color == nil {
+		rt.Color = DefaultFontColor
+	}
+
+	spans := rt.Spans
+	if rt.Markup != "" {
+		if len(rt.Spans) > 0 {
+			return fmt.Errorf("RichText can't have both spans and markup")
+		}
+
+		var err error
+		spans, err = ParseMarkup(rt.Markup)
+		if err != nil {
+			return err
+		}
+	}
+
+	rt.styles = nil
+	for _, span := range spans {
+		style, err := rt.resolve(span)
+		if err != nil {
+			return err
+		}
+		rt.styles = append(rt.styles, style)
+	}
+
+	return nil
+}
+
+func (rt *RichText) resolve(span Span) (*spanStyle, error) {
+	if span.Font == "" {
+		span.Font = rt.Font
+	}
+
+	face, err := GetFont(span.Font)
+	if err != nil {
+		return nil, err
+	}
+
+	style := &spanStyle{
+		span:    span,
+		face:    face,
+		color:   span.Color,
+		ascent:  face.Metrics().Ascent.Floor(),
+		descent: face.Metrics().Descent.Floor(),
+	}
+	if style.color == nil {
+		style.color = rt.Color
+	}
+
+	return style, nil
+}
+
+// measure returns the width of text drawn in style.
+func (s *spanStyle) measure(text string) int {
+	width := font.MeasureString(s.face, text).Round()
+	if s.span.Bold {
+		width += utf8.RuneCountInString(text)
+	}
+	return width
+}
+
+// draw draws text starting at x, with its baseline at y.
+func (s *spanStyle) draw(dc *gg.Context, text string, x, y int) {
+	dc.SetFontFace(s.face)
+	dc.SetColor(s.color)
+
+	baseline := float64(y - s.span.Offset)
+	if !s.span.Bold {
+		dc.DrawString(text, float64(x), baseline)
+		return
+	}
+
+	for _, r := range text {
+		glyph := string(r)
+		dc.DrawString(glyph, float64(x), baseline)
+		dc.DrawString(glyph, float64(x+1), baseline)
+		x += s.measure(glyph)
+	}
+}
+
+// runs splits the spans into words, spaces and line breaks. Line breaks
+// are runs with a nil style.
+func (rt *RichText) runs() []textRun {
+	var runs []textRun
+
+	for _, style := range rt.styles {
+		content := style.span.Content
+		for len(content) > 0 {
+			if content[0] == '\n' {
+				runs = append(runs, textRun{text: "\n"})
+				content = content[1:]
+				continue
+			}
+
+			space := content[0] == ' '
+			end := strings.IndexFunc(content, func(r rune) bool {
+				return r == '\n' || (r == ' ') != space
+			})
+			if end < 0 {
+				end = len(content)
+			}
+
+			text := content[:end]
+			runs = append(runs, textRun{
+				text:  text,
+				style: style,
+				width: style.measure(text),
+				space: space,
+			})
+			content = content[end:]
+		}
+	}
+
+	return runs
+}
+
+// layout breaks the text into lines no wider than width. Words that
+// are split across spans stay together, and words that are wider than
+// a line are broken between characters.
+func (rt *RichText) layout(width int) []textLine {
+	runs := rt.runs()
+
+	var lines []textLine
+	var line textLine
+	var pending []textRun // spaces before the next word
+
+	flush := func() {
+		lines = append(lines, line)
+		line = textLine{}
+		pending = nil
+	}
+
+	for i := 0; i < len(runs); {
+		run := runs[i]
+
+		if run.style == nil {
+			flush()
+			i++
+			continue
+		}
+
+		if run.space {
+			if len(line.runs) > 0 {
+				pending = append(pending, run)
+			}
+			i++
+			continue
+		}
+
+		// a word is made of all runs up to the next space or break
+		j := i
+		wordWidth := 0
+		for j < len(runs) && runs[j].style != nil && !runs[j].space {
+			wordWidth += runs[j].width
+			j++
+		}
+
+		spaceWidth := 0
+		for _, s := range pending {
+			spaceWidth += s.width
+		}
+
+		if len(line.runs) > 0 && line.width+spaceWidth+wordWidth > width {
+			flush()
+		}
+
+		for _, s := range pending {
+			line.add(s)
+		}
+		pending = nil
+
+		for _, r := range runs[i:j] {
+			if line.width+r.width <= width {
+				line.add(r)
+				continue
+			}
+
+			// break the word between characters
+			for _, c := range r.text {
+				piece := textRun{text: string(c), style: r.style, width: r.style.measure(string(c))}
+				if len(line.runs) > 0 && line.width+piece.width > width {
+					flush()
+				}
+				line.add(piece)
+			}
+		}
+
+		i = j
+	}
+
+	if len(line.runs) > 0 || len(lines) == 0 || len(runs) > 0 && runs[len(runs)-1].style == nil {
+		lines = append(lines, line)
+	}
+
+	// empty lines are as tall as the default font
+	for i := range lines {
+		if len(lines[i].runs) == 0 {
+			if face, err := GetFont(rt.Font); err == nil {
+				lines[i].ascent = face.Metrics().Ascent.Floor()
+				lines[i].descent = face.Metrics().Descent.Floor()
+			}
+		}
+	}
+
+	return lines
+}
+
+// add appends a run to the line, merging it with the previous run if
+// they have the same style.
+func (l *textLine) add(run textRun) {
+	if n := len(l.runs); n > 0 && l.runs[n-1].style == run.style {
+		l.runs[n-1].text += run.text
+		l.runs[n-1].width += run.width
+	} else {
+		l.runs = append(l.runs, run)
+	}
+	l.width += run.width
+
+	if a := run.style.ascent + run.style.span.Offset; a > l.ascent {
+		l.ascent = a
+	}
+	if d := run.style.descent - run.style.span.Offset; d > l.descent {
+		l.descent = d
+	}
+}
+
+func (rt *RichText) size(bounds image.Rectangle) (int, int, []textLine) {
+	width := rt.Width
+	if width == 0 {
+		width = bounds.Dx()
+	}
+
+	lines := rt.layout(width)
+
+	w, h := 0, 0
+	for i, line := range lines {
+		if line.width > w {
+			w = line.width
+		}
+		if i > 0 {
+			h += rt.LineSpacing
+		}
+		h += line.ascent + line.descent
+	}
+
+	if rt.Width != 0 {
+		w = rt.Width
+	} else if w > bounds.Dx() {
+		w = bounds.Dx()
+	}
+
+	if rt.Height != 0 {
+		h = rt.Height
+	} else if h > bounds.Dy() {
+		h = bounds.Dy()
+	}
+
+	return w, h, lines
+}
+
+func (rt *RichText) PaintBounds(bounds image.Rectangle, frameIdx int) image.Rectangle {
+	w, h, _ := rt.size(bounds)
+	return image.Rect(0, 0, w, h)
+}
+
+func (rt *RichText) Paint(dc *gg.Context, bounds image.Rectangle, frameIdx int) {
+	width, _, lines := rt.size(bounds)
+
+	y := 0
+	for _, line := range lines {
+		x := 0
+		switch rt.Align {
+		case "center":
+			x = (width - line.width) / 2
+		case "right":
+			x = width - line.width
+		}
+
+		height := line.ascent + line.descent
+		for _, run := range line.runs {
+			if run.style.span.Background != nil {
+				dc.SetColor(run.style.span.Background)
+				dc.DrawRectangle(float64(x), float64(y), float64(run.width), float64(height))
+				dc.Fill()
+			}
+			if !run.space {
+				run.style.draw(dc, run.text, x, y+line.ascent)
+			}
+			x += run.width
+		}
+
+		y += height + rt.LineSpacing
+	}
+}
+
+func (rt *RichText) FrameCount() int {
+	return 1
+}
+
+// ParseMarkup parses RichText markup into spans.
+func ParseMarkup(markup string) ([]Span, error) {
+	type tag struct {
+		name string
+		span Span
+	}
+
+	stack := []tag{{}}
+	var spans []Span
+	var text strings.Builder
+
+	emit := func() {
+		if text.Len() == 0 {
+			return
+		}
+		span := stack[len(stack)-1].span
+		span.Content = text.String()
+		spans = append(spans, span)
+		text.Reset()
+	}
+
+	for i := 0; i < len(markup); {
+		if strings.HasPrefix(markup[i:], "[[") {
+			text.WriteByte('[')
+			i += 2
+			continue
+		}
+		if markup[i] != '[' {
+			text.WriteByte(markup[i])
+			i++
+			continue
+		}
+
+		end := strings.IndexByte(markup[i:], ']')
+		if end < 0 {
+			return nil, fmt.Errorf("markup has unterminated tag at offset %d", i)
+		}
+		body := markup[i+1 : i+end]
+		i += end + 1
+
+		emit()
+
+		if strings.HasPrefix(body, "/") {
+			name := body[1:]
+			if len(stack) == 1 {
+				return nil, fmt.Errorf("markup closes [%s] which isn't open", body)
+			}
+			if name != "" && name != stack[len(stack)-1].name {
+				return nil, fmt.Errorf("markup closes [%s] but [%s] is open", body, stack[len(stack)-1].name)
+			}
+			stack = stack[:len(stack)-1]
+			continue
+		}
+
+		name, value, hasValue := strings.Cut(body, "=")
+		name = strings.TrimSpace(name)
+		value = strings.TrimSpace(value)
+		span := stack[len(stack)-1].span
+
+		switch name {
+		case "b":
+			span.Bold = true
+		case "color", "bg":
+			c, err := ParseColor(value)
+			if err != nil {
+				return nil, fmt.Errorf("markup [%s] has invalid color: %w", body, err)
+			}
+			if name == "color" {
+				span.Color = c
+			} else {
+				span.Background = c
+			}
+		case "font":
+			if _, err := GetFont(value); err != nil {
+				return nil, fmt.Errorf("markup [%s]: %w", body, err)
+			}
+			span.Font = value
+		case "offset":
+			n, err := strconv.Atoi(value)
+			if err != nil {
+				return nil, fmt.Errorf("markup [%s] has invalid offset", body)
+			}
+			span.Offset = n
+		default:
+			return nil, fmt.Errorf("markup has unknown tag [%s]", body)
+		}
+
+		if (name == "b") == hasValue {
+			return nil, fmt.Errorf("markup has invalid tag [%s]", body)
+		}
+
+		stack = append(stack, tag{name, span})
+	}
+
+	emit()
+
+	if len(stack) > 1 {
+		return nil, fmt.Errorf("markup doesn't close [%s]", stack[len(stack)-1].name)
+	}
+
+	return spans, nil
+}
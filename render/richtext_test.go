@@ -0,0 +1,182 @@
+package render
+
+import (
+	"image"
+	"image/color"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	red  = color.RGBA{0xff, 0, 0, 0xff}
+	blue = color.RGBA{0, 0, 0xff, 0xff}
+)
+
+func TestRichTextSpans(t *testing.T) {
+	text := &RichText{Spans: []Span{
+		{Content: "A", Color: red},
+		{Content: "B"},
+	}}
+	require.NoError(t, text.Init())
+
+	im := PaintWidget(text, image.Rect(0, 0, 40, 40), 0)
+	assert.Equal(t, nil, checkImage([]string{
+		"....." + ".....",
+		".rr.." + "www..",
+		"r..r." + "w..w.",
+		"r..r." + "www..",
+		"rrrr." + "w..w.",
+		"r..r." + "w..w.",
+		"r..r." + "www..",
+		"....." + ".....",
+	}, im))
+}
+
+func TestRichTextWrap(t *testing.T) {
+	// words stay together across spans
+	text := &RichText{Width: 12, Spans: []Span{
+		{Content: "AB "},
+		{Content: "C", Color: red},
+		{Content: "D", Color: blue},
+	}}
+	require.NoError(t, text.Init())
+
+	im := PaintWidget(text, image.Rect(0, 0, 40, 40), 0)
+	assert.Equal(t, nil, checkImage([]string{
+		"....." + ".......",
+		".ww.." + "www....",
+		"w..w." + "w..w...",
+		"w..w." + "www....",
+		"wwww." + "w..w...",
+		"w..w." + "w..w...",
+		"w..w." + "www....",
+		"....." + ".......",
+		"....." + ".......",
+		".rr.." + "bbb....",
+		"r..r." + "b..b...",
+		"r...." + "b..b...",
+		"r...." + "b..b...",
+		"r..r." + "b..b...",
+		".rr.." + "bbb....",
+		"....." + ".......",
+	}, im))
+
+	// explicit line breaks, and words that are too long for a line
+	text = &RichText{Spans: []Span{{Content: "A\nBCD"}}}
+	require.NoError(t, text.Init())
+
+	im = PaintWidget(text, image.Rect(0, 0, 10, 24), 0)
+	assert.Equal(t, nil, checkImage([]string{
+		"....." + ".....",
+		".ww.." + ".....",
+		"w..w." + ".....",
+		"w..w." + ".....",
+		"wwww." + ".....",
+		"w..w." + ".....",
+		"w..w." + ".....",
+		"....." + ".....",
+		"....." + ".....",
+		"www.." + ".ww..",
+		"w..w." + "w..w.",
+		"www.." + "w....",
+		"w..w." + "w....",
+		"w..w." + "w..w.",
+		"www.." + ".ww..",
+		"....." + ".....",
+		"....." + ".....",
+		"www.." + ".....",
+		"w..w." + ".....",
+		"w..w." + ".....",
+		"w..w." + ".....",
+		"w..w." + ".....",
+		"www.." + ".....",
+		"....." + ".....",
+	}, im))
+}
+
+func TestRichTextStyles(t *testing.T) {
+	text := &RichText{Spans: []Span{
+		{Content: "A", Background: blue},
+		{Content: "B", Offset: 1},
+	}}
+	require.NoError(t, text.Init())
+
+	// the raised span makes the line taller
+	im := PaintWidget(text, image.Rect(0, 0, 40, 40), 0)
+	assert.Equal(t, nil, checkImage([]string{
+		"bbbbb" + ".....",
+		"bbbbb" + "www..",
+		"bwwbb" + "w..w.",
+		"wbbwb" + "www..",
+		"wbbwb" + "w..w.",
+		"wwwwb" + "w..w.",
+		"wbbwb" + "www..",
+		"wbbwb" + ".....",
+		"bbbbb" + ".....",
+	}, im))
+
+	// bold glyphs are a pixel wider
+	text = &RichText{Spans: []Span{{Content: "I", Bold: true}}, Align: "right", Width: 8}
+	require.NoError(t, text.Init())
+
+	im = PaintWidget(text, image.Rect(0, 0, 40, 40), 0)
+	assert.Equal(t, nil, checkImage([]string{
+		"........",
+		"...wwww.",
+		"....ww..",
+		"....ww..",
+		"....ww..",
+		"....ww..",
+		"...wwww.",
+		"........",
+	}, im))
+}
+
+func TestRichTextMarkup(t *testing.T) {
+	spans, err := ParseMarkup("a [b]b [color=#f00]c[/color][/b] [[d] [bg=#00f][offset=-1]e[/][/bg] [font=tom-thumb]f[/font]")
+	require.NoError(t, err)
+	assert.Equal(t, []Span{
+		{Content: "a "},
+		{Content: "b ", Bold: true},
+		{Content: "c", Bold: true, Color: color.NRGBA{0xff, 0, 0, 0xff}},
+		{Content: " [d] "},
+		{Content: "e", Background: color.NRGBA{0, 0, 0xff, 0xff}, Offset: -1},
+		{Content: " "},
+		{Content: "f", Font: "tom-thumb"},
+	}, spans)
+
+	for _, markup := range []string{
+		"[b]a",
+		"a[/b]",
+		"[b]a[/color]",
+		"[color]a[/color]",
+		"[color=red]a[/color]",
+		"[b=1]a[/b]",
+		"[offset=up]a[/offset]",
+		"[font=missing]a[/font]",
+		"[i]a[/i]",
+		"[b",
+	} {
+		_, err := ParseMarkup(markup)
+		assert.Error(t, err, markup)
+	}
+
+	text := &RichText{Markup: "[color=#f00]A[/color]B"}
+	require.NoError(t, text.Init())
+	im := PaintWidget(text, image.Rect(0, 0, 40, 40), 0)
+	assert.Equal(t, nil, checkImage([]string{
+		"....." + ".....",
+		".rr.." + "www..",
+		"r..r." + "w..w.",
+		"r..r." + "www..",
+		"rrrr." + "w..w.",
+		"r..r." + "w..w.",
+		"r..r." + "www..",
+		"....." + ".....",
+	}, im))
+
+	text = &RichText{Markup: "A", Spans: []Span{{Content: "B"}}}
+	assert.Error(t, text.Init())
+}
@@ -28,10 +28,17 @@ import (
 // - `"end"`: place children at the bottom
 // - `"center"`: place children at the center
 //
+// `gap` leaves space between children. Children wrapped in `Flex`
+// share the space that's left over by the others, and children that
+// don't fit are cut off, unless `shrink` is set, which makes them
+// smaller to fit in the Row.
+//
 // DOC(Children): Child widgets to lay out
 // DOC(Expanded): Row should expand to fill all available horizontal space
 // DOC(MainAlign): Alignment along horizontal main axis
 // DOC(CrossAlign): Alignment along vertical cross axis
+// DOC(Gap): Space between children
+// DOC(Shrink): Shrink children that don't fit, instead of cutting them off
 //
 // EXAMPLE BEGIN
 // render.Row(
@@ -62,6 +69,8 @@ type Row struct {
 	MainAlign  string   `starlark:"main_align"`
 	CrossAlign string   `starlark:"cross_align"`
 	Expanded   bool
+	Gap        int
+	Shrink     bool
 }
 
 func (r Row) PaintBounds(bounds image.Rectangle, frameIdx int) image.Rectangle {
@@ -71,6 +80,8 @@ func (r Row) PaintBounds(bounds image.Rectangle, frameIdx int) image.Rectangle {
 		MainAlign:  r.MainAlign,
 		CrossAlign: r.CrossAlign,
 		Expanded:   r.Expanded,
+		Gap:        r.Gap,
+		Shrink:     r.Shrink,
 	}
 	return v.PaintBounds(bounds, frameIdx)
 }
@@ -82,6 +93,8 @@ func (r Row) Paint(dc *gg.Context, bounds image.Rectangle, frameIdx int) {
 		MainAlign:  r.MainAlign,
 		CrossAlign: r.CrossAlign,
 		Expanded:   r.Expanded,
+		Gap:        r.Gap,
+		Shrink:     r.Shrink,
 	}
 	v.Paint(dc, bounds, frameIdx)
 }
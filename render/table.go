@@ -0,0 +1,483 @@
+package render
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/tidbyt/gg"
+)
+
+// ColumnWidth is the width of a TableColumn: "auto", a number of
+// pixels, or a fraction of the width that's left, like "1fr".
+type ColumnWidth string
+
+// TableColumn sets how wide a column of a Table is, and how its cells
+// are laid out.
+//
+// The `width` of a column is one of:
+// - `"auto"`: as wide as its widest cell, which is the default
+// - a number of pixels, like `20`
+// - a fraction of the width left over by the other columns, like
+//   `"1fr"` or `"2fr"`
+//
+// Cells that are wider than their column are cut off with the `"clip"`
+// overflow, which is the default. With `"ellipsis"`, text is shortened
+// to fit and ends in an ellipsis, and with `"scroll"`, cells scroll
+// through the column like a Marquee.
+//
+// DOC(Width): Width of the column, 'auto', a number of pixels or a fraction like '1fr'
+// DOC(Align): Alignment of cells in the column, 'left', 'center' or 'right', default is left
+// DOC(Overflow): What to do with cells wider than the column, 'clip', 'ellipsis' or 'scroll', default is clip
+type TableColumn struct {
+	Width    ColumnWidth
+	Align    string
+	Overflow string
+
+	fixed    bool
+	pixels   int
+	fraction float64
+}
+
+func (c *TableColumn) Init() error {
+	c.fixed, c.pixels, c.fraction = false, 0, 0
+
+	switch width := strings.TrimSpace(string(c.Width)); {
+	case width == "" || width == "auto":
+	case strings.HasSuffix(width, "fr"):
+		fraction := 1.0
+		if width != "fr" {
+			f, err := strconv.ParseFloat(strings.TrimSuffix(width, "fr"), 64)
+			if err != nil || f <= 0 {
+				return fmt.Errorf("invalid column width '%s', fraction must be positive", c.Width)
+			}
+			fraction = f
+		}
+		c.fraction = fraction
+	default:
+		pixels, err := strconv.Atoi(width)
+		if err != nil || pixels < 0 {
+			return fmt.Errorf("invalid column width '%s', must be 'auto', a number of pixels or a fraction like '1fr'", c.Width)
+		}
+		c.fixed, c.pixels = true, pixels
+	}
+
+	switch c.Align {
+	case "", "left", "center", "right":
+	default:
+		return fmt.Errorf("invalid align '%s', must be 'left', 'center' or 'right'", c.Align)
+	}
+
+	switch c.Overflow {
+	case "", "clip", "ellipsis", "scroll":
+	default:
+		return fmt.Errorf("invalid overflow '%s', must be 'clip', 'ellipsis' or 'scroll'", c.Overflow)
+	}
+
+	return nil
+}
+
+func (c *TableColumn) isAuto() bool {
+	return !c.fixed && c.fraction == 0
+}
+
+// TableCell is a cell of a Table with its own alignment. Widgets in the
+// rows of a Table are cells aligned like their column.
+//
+// DOC(Child): Widget to draw in the cell
+// DOC(Align): Horizontal alignment, 'left', 'center' or 'right', default is that of the column
+// DOC(VerticalAlign): Vertical alignment, 'top', 'center' or 'bottom', default is top
+type TableCell struct {
+	Child         Widget `starlark:"child,required"`
+	Align         string
+	VerticalAlign string `starlark:"vertical_align"`
+}
+
+func (c *TableCell) Init() error {
+	switch c.Align {
+	case "", "left", "center", "right":
+	default:
+		return fmt.Errorf("invalid align '%s', must be 'left', 'center' or 'right'", c.Align)
+	}
+
+	switch c.VerticalAlign {
+	case "", "top", "center", "bottom":
+	default:
+		return fmt.Errorf("invalid vertical_align '%s', must be 'top', 'center' or 'bottom'", c.VerticalAlign)
+	}
+
+	return nil
+}
+
+// Table lays out widgets in rows and columns.
+//
+// Each row is a list of widgets, or of `TableCell` for cells with their
+// own alignment, and `None` leaves a cell empty. Columns are sized and
+// aligned by `columns`, a list of `TableColumn`. Columns without one
+// fit their widest cell and are aligned left.
+//
+// `column_gap` and `row_gap` leave space between columns and rows. With
+// `column_separator` or `row_separator`, a line is drawn in the middle
+// of that space, which is then at least one pixel wide. Rows can have
+// background colors with `row_colors`, which are used in turn, so two
+// colors make alternating rows.
+//
+// The table is as wide as its columns, or as wide as it can be if any
+// of them are fractions. Rows that don't fit in the height available
+// are cut off.
+//
+// DOC(Rows): Rows of cells, as lists of Widget or TableCell
+// DOC(Columns): Width, alignment and overflow of each column
+// DOC(ColumnGap): Pixels between columns
+// DOC(RowGap): Pixels between rows
+// DOC(ColumnSeparator): Color of lines between columns
+// DOC(RowSeparator): Color of lines between rows
+// DOC(RowColors): Background colors of rows, used in turn
+//
+// EXAMPLE BEGIN
+// render.Table(
+//      columns=[
+//           render.TableColumn(width="1fr", overflow="ellipsis"),
+//           render.TableColumn(width=14, align="right"),
+//      ],
+//      rows=[
+//           [render.Text("Arsenal"), render.Text("72", color="#fa0")],
+//           [render.Text("Manchester City"), render.Text("70", color="#fa0")],
+//           [render.Text("Liverpool"), render.Text("69", color="#fa0")],
+//      ],
+//      column_gap=2,
+//      row_colors=["#000", "#222"],
+// )
+// EXAMPLE END
+type Table struct {
+	Widget
+
+	Rows            [][]TableCell `starlark:"rows,required"`
+	Columns         []TableColumn
+	ColumnGap       int           `starlark:"column_gap"`
+	RowGap          int           `starlark:"row_gap"`
+	ColumnSeparator color.Color   `starlark:"column_separator"`
+	RowSeparator    color.Color   `starlark:"row_separator"`
+	RowColors       []color.Color `starlark:"row_colors"`
+}
+
+// tableLayout is the size of each column and of each row that fits.
+type tableLayout struct {
+	widths  []int
+	heights []int
+	width   int
+	height  int
+}
+
+func (t *Table) Init() error {
+	for i := range t.Columns {
+		if err := t.Columns[i].Init(); err != nil {
+			return err
+		}
+	}
+	for _, row := range t.Rows {
+		for i := range row {
+			if err := row[i].Init(); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (t *Table) numColumns() int {
+	n := len(t.Columns)
+	for _, row := range t.Rows {
+		if len(row) > n {
+			n = len(row)
+		}
+	}
+	return n
+}
+
+func (t *Table) column(i int) TableColumn {
+	if i < len(t.Columns) {
+		return t.Columns[i]
+	}
+	return TableColumn{}
+}
+
+func (t *Table) gaps() (int, int) {
+	columnGap, rowGap := t.ColumnGap, t.RowGap
+	if t.ColumnSeparator != nil && columnGap < 1 {
+		columnGap = 1
+	}
+	if t.RowSeparator != nil && rowGap < 1 {
+		rowGap = 1
+	}
+	return columnGap, rowGap
+}
+
+// layout works out the widths of the columns and the heights of the
+// rows that fit in bounds.
+func (t *Table) layout(bounds image.Rectangle, frameIdx int) tableLayout {
+	boundsW := bounds.Dx()
+	boundsH := bounds.Dy()
+	columnGap, rowGap := t.gaps()
+
+	n := t.numColumns()
+	l := tableLayout{widths: make([]int, n)}
+
+	// Fixed and auto columns come first, and fractions share what's
+	// left of the width
+	available := boundsW - columnGap*(n-1)
+	used := 0
+	fractions := 0.0
+	var autos []int
+	for i := range l.widths {
+		col := t.column(i)
+		switch {
+		case col.fraction > 0:
+			fractions += col.fraction
+			continue
+		case col.isAuto():
+			for _, row := range t.Rows {
+				if i < len(row) && row[i].Child != nil {
+					cb := row[i].Child.PaintBounds(image.Rect(0, 0, available, boundsH), frameIdx)
+					if cb.Dx() > l.widths[i] {
+						l.widths[i] = cb.Dx()
+					}
+				}
+			}
+			autos = append(autos, i)
+		default:
+			l.widths[i] = col.pixels
+		}
+		used += l.widths[i]
+	}
+
+	// If that's too wide, the widest auto columns give way
+	for ; used > available && len(autos) > 0; used-- {
+		widest := autos[0]
+		for _, i := range autos {
+			if l.widths[i] > l.widths[widest] {
+				widest = i
+			}
+		}
+		if l.widths[widest] == 0 {
+			break
+		}
+		l.widths[widest]--
+	}
+
+	remaining := available - used
+	if fractions > 0 && remaining > 0 {
+		shared := 0
+		for i := range l.widths {
+			if f := t.column(i).fraction; f > 0 {
+				l.widths[i] = int(float64(remaining) * f / fractions)
+				shared += l.widths[i]
+			}
+		}
+
+		// Residual space gets distributed 1 pixel at a time
+		for i := 0; shared < remaining; i = (i + 1) % n {
+			if t.column(i).fraction > 0 {
+				l.widths[i]++
+				shared++
+			}
+		}
+	}
+
+	for i, w := range l.widths {
+		if i > 0 {
+			l.width += columnGap
+		}
+		l.width += w
+	}
+
+	for r, row := range t.Rows {
+		if r > 0 {
+			l.height += rowGap
+		}
+		if l.height >= boundsH {
+			break
+		}
+
+		height := 0
+		for i := range row {
+			cell := t.cellWidget(row[i], t.column(i), l.widths[i], boundsH-l.height, frameIdx)
+			if cell == nil {
+				continue
+			}
+			cb := cell.PaintBounds(image.Rect(0, 0, l.widths[i], boundsH-l.height), frameIdx)
+			if cb.Dy() > height {
+				height = cb.Dy()
+			}
+		}
+
+		l.heights = append(l.heights, height)
+		l.height += height
+	}
+
+	if l.width > boundsW {
+		l.width = boundsW
+	}
+	if l.height > boundsH {
+		l.height = boundsH
+	}
+
+	return l
+}
+
+// cellWidget returns the widget to draw for a cell in a column that's
+// width pixels wide, which handles the column's overflow.
+func (t *Table) cellWidget(cell TableCell, col TableColumn, width, height, frameIdx int) Widget {
+	if cell.Child == nil || col.Overflow == "" || col.Overflow == "clip" {
+		return cell.Child
+	}
+
+	cb := cell.Child.PaintBounds(image.Rect(0, 0, width*10, height), frameIdx)
+	if cb.Dx() <= width {
+		return cell.Child
+	}
+
+	switch col.Overflow {
+	case "ellipsis":
+		if text, ok := cell.Child.(*Text); ok {
+			return ellipsizeText(text, width)
+		}
+	case "scroll":
+		return Marquee{Child: cell.Child, Width: width}
+	}
+
+	return cell.Child
+}
+
+// ellipsizeText returns a copy of text shortened to fit in width, with
+// an ellipsis at its end.
+func ellipsizeText(text *Text, width int) *Text {
+	ellipsis := "…"
+	if face, err := GetFont(text.Font); err != nil || !hasGlyph(face, '…') {
+		ellipsis = "..."
+	}
+
+	runes := []rune(text.Content)
+	shortened := *text
+	for n := len(runes) - 1; n >= 0; n-- {
+		shortened.Content = strings.TrimRightFunc(string(runes[:n]), unicode.IsSpace) + ellipsis
+		if err := shortened.Init(); err != nil {
+			return text
+		}
+		if w, _ := shortened.Size(); w <= width {
+			break
+		}
+	}
+	return &shortened
+}
+
+func (t *Table) PaintBounds(bounds image.Rectangle, frameIdx int) image.Rectangle {
+	l := t.layout(bounds, frameIdx)
+	return image.Rect(0, 0, l.width, l.height)
+}
+
+func (t *Table) Paint(dc *gg.Context, bounds image.Rectangle, frameIdx int) {
+	l := t.layout(bounds, frameIdx)
+	columnGap, rowGap := t.gaps()
+
+	y := 0
+	for r, height := range l.heights {
+		row := t.Rows[r]
+
+		if len(t.RowColors) > 0 {
+			dc.SetColor(t.RowColors[r%len(t.RowColors)])
+			dc.DrawRectangle(0, float64(y), float64(l.width), float64(height))
+			dc.Fill()
+		}
+
+		x := 0
+		for i, width := range l.widths {
+			if i < len(row) {
+				t.paintCell(dc, row[i], t.column(i), image.Rect(x, y, x+width, y+height), frameIdx)
+			}
+			x += width + columnGap
+		}
+
+		y += height
+		if t.RowSeparator != nil && r < len(l.heights)-1 {
+			dc.SetColor(t.RowSeparator)
+			dc.DrawRectangle(0, float64(y+(rowGap-1)/2), float64(l.width), 1)
+			dc.Fill()
+		}
+		y += rowGap
+	}
+
+	if t.ColumnSeparator != nil {
+		dc.SetColor(t.ColumnSeparator)
+		x := 0
+		for _, width := range l.widths[:max(len(l.widths)-1, 0)] {
+			x += width
+			dc.DrawRectangle(float64(x+(columnGap-1)/2), 0, 1, float64(l.height))
+			dc.Fill()
+			x += columnGap
+		}
+	}
+}
+
+// paintCell draws a cell in the rectangle r, aligned in it and clipped
+// to it.
+func (t *Table) paintCell(dc *gg.Context, cell TableCell, col TableColumn, r image.Rectangle, frameIdx int) {
+	child := t.cellWidget(cell, col, r.Dx(), r.Dy(), frameIdx)
+	if child == nil {
+		return
+	}
+	cb := child.PaintBounds(image.Rect(0, 0, r.Dx(), r.Dy()), frameIdx)
+
+	align := cell.Align
+	if align == "" {
+		align = col.Align
+	}
+	x := 0
+	switch align {
+	case "center":
+		x = (r.Dx() - cb.Dx()) / 2
+	case "right":
+		x = r.Dx() - cb.Dx()
+	}
+
+	y := 0
+	switch cell.VerticalAlign {
+	case "center":
+		y = (r.Dy() - cb.Dy()) / 2
+	case "bottom":
+		y = r.Dy() - cb.Dy()
+	}
+
+	dc.Push()
+	dc.DrawRectangle(float64(r.Min.X), float64(r.Min.Y), float64(r.Dx()), float64(r.Dy()))
+	dc.Clip()
+	dc.Translate(float64(r.Min.X+max(x, 0)), float64(r.Min.Y+max(y, 0)))
+	child.Paint(dc, image.Rect(0, 0, r.Dx(), r.Dy()), frameIdx)
+	dc.Pop()
+}
+
+func (t *Table) FrameCount() int {
+	count := 1
+	for _, row := range t.Rows {
+		for _, cell := range row {
+			if cell.Child != nil {
+				count = max(count, cell.Child.FrameCount())
+			}
+		}
+	}
+
+	// scrolling cells take as many frames as they need to scroll
+	l := t.layout(image.Rect(0, 0, FrameWidth, FrameHeight), 0)
+	for r := range l.heights {
+		for i, cell := range t.Rows[r] {
+			if child := t.cellWidget(cell, t.column(i), l.widths[i], l.heights[r], 0); child != nil {
+				count = max(count, child.FrameCount())
+			}
+		}
+	}
+
+	return count
+}
@@ -0,0 +1,237 @@
+package render
+
+import (
+	"image"
+	"image/color"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	tableRed   = color.RGBA{0xff, 0, 0, 0xff}
+	tableGreen = color.RGBA{0, 0xff, 0, 0xff}
+	tableBlue  = color.RGBA{0, 0, 0xff, 0xff}
+	tableWhite = color.RGBA{0xff, 0xff, 0xff, 0xff}
+)
+
+func TestTableAutoColumns(t *testing.T) {
+	table := &Table{
+		Rows: [][]TableCell{
+			{{Child: &Box{Width: 1, Height: 1, Color: tableRed}}, {Child: &Box{Width: 3, Height: 2, Color: tableGreen}}},
+			{{Child: &Box{Width: 2, Height: 1, Color: tableBlue}}, {}},
+			{{}, {Child: &Box{Width: 1, Height: 1, Color: tableWhite}}},
+		},
+		ColumnGap: 1,
+	}
+	require.NoError(t, table.Init())
+
+	// columns are as wide as their widest cell, and rows as tall as
+	// their tallest
+	im := PaintWidget(table, image.Rect(0, 0, 10, 10), 0)
+	assert.Equal(t, nil, checkImage([]string{
+		"r..ggg",
+		"...ggg",
+		"bb....",
+		"...w..",
+	}, im))
+}
+
+func TestTableColumnWidths(t *testing.T) {
+	table := &Table{
+		Columns: []TableColumn{
+			{Width: "2"},
+			{Width: "1fr"},
+			{Width: "2fr", Align: "right"},
+		},
+		Rows: [][]TableCell{
+			{
+				{Child: &Box{Width: 1, Height: 1, Color: tableRed}},
+				{Child: &Box{Width: 1, Height: 1, Color: tableGreen}},
+				{Child: &Box{Width: 1, Height: 1, Color: tableBlue}},
+			},
+		},
+	}
+	require.NoError(t, table.Init())
+
+	// fractions share the width left by the fixed column
+	im := PaintWidget(table, image.Rect(0, 0, 11, 10), 0)
+	assert.Equal(t, nil, checkImage([]string{
+		"r.g.......b",
+	}, im))
+
+	// fixed columns cut off cells that are too wide, and squeeze out
+	// auto columns that don't fit
+	table = &Table{
+		Columns: []TableColumn{{Width: "2"}},
+		Rows: [][]TableCell{
+			{
+				{Child: &Box{Width: 4, Height: 1, Color: tableRed}},
+				{Child: &Box{Width: 4, Height: 1, Color: tableGreen}},
+			},
+		},
+	}
+	require.NoError(t, table.Init())
+	im = PaintWidget(table, image.Rect(0, 0, 5, 10), 0)
+	assert.Equal(t, nil, checkImage([]string{
+		"rrggg",
+	}, im))
+}
+
+func TestTableAlignment(t *testing.T) {
+	table := &Table{
+		Columns: []TableColumn{{}, {Width: "3", Align: "center"}},
+		Rows: [][]TableCell{
+			{
+				{Child: &Box{Width: 1, Height: 3, Color: tableRed}},
+				{Child: &Box{Width: 1, Height: 1, Color: tableGreen}},
+			},
+			{
+				{Child: &Box{Width: 1, Height: 3, Color: tableRed}},
+				{Child: &Box{Width: 1, Height: 1, Color: tableGreen}, Align: "right", VerticalAlign: "bottom"},
+			},
+			{
+				{Child: &Box{Width: 1, Height: 3, Color: tableRed}},
+				{Child: &Box{Width: 1, Height: 1, Color: tableGreen}, Align: "left", VerticalAlign: "center"},
+			},
+		},
+	}
+	require.NoError(t, table.Init())
+
+	im := PaintWidget(table, image.Rect(0, 0, 10, 10), 0)
+	assert.Equal(t, nil, checkImage([]string{
+		"r.g.",
+		"r...",
+		"r...",
+		"r...",
+		"r...",
+		"r..g",
+		"r...",
+		"rg..",
+		"r...",
+	}, im))
+}
+
+func TestTableSeparatorsAndRowColors(t *testing.T) {
+	cell := func() TableCell {
+		return TableCell{Child: &Box{Width: 2, Height: 1, Color: tableWhite}}
+	}
+	table := &Table{
+		Rows: [][]TableCell{
+			{cell(), cell()},
+			{cell(), {}},
+			{cell(), cell()},
+		},
+		RowGap:          3,
+		ColumnSeparator: tableGreen,
+		RowSeparator:    tableBlue,
+		RowColors:       []color.Color{tableRed, color.Transparent},
+	}
+	require.NoError(t, table.Init())
+
+	// separators are in the middle of the gaps, and gaps are made wide
+	// enough for them. column separators are drawn over row ones.
+	im := PaintWidget(table, image.Rect(0, 0, 10, 20), 0)
+	assert.Equal(t, nil, checkImage([]string{
+		"wwgww",
+		"..g..",
+		"bbgbb",
+		"..g..",
+		"wwg..",
+		"..g..",
+		"bbgbb",
+		"..g..",
+		"wwgww",
+	}, im))
+
+	// row colors fill the rows, but not the gaps between them
+	table.ColumnSeparator = nil
+	table.RowSeparator = nil
+	table.Rows[0] = []TableCell{cell(), {}}
+	table.Rows[1] = []TableCell{{}, {}}
+	im = PaintWidget(table, image.Rect(0, 0, 10, 20), 0)
+	assert.Equal(t, nil, checkImage([]string{
+		"wwrr",
+		"....",
+		"....",
+		"....",
+		"....",
+		"....",
+		"....",
+		"wwww",
+	}, im))
+}
+
+func TestTableRowsCutOff(t *testing.T) {
+	table := &Table{
+		Rows: [][]TableCell{
+			{{Child: &Box{Width: 1, Height: 2, Color: tableRed}}},
+			{{Child: &Box{Width: 1, Height: 2, Color: tableGreen}}},
+			{{Child: &Box{Width: 1, Height: 2, Color: tableBlue}}},
+		},
+	}
+	require.NoError(t, table.Init())
+
+	assert.Equal(t, image.Rect(0, 0, 1, 6), table.PaintBounds(image.Rect(0, 0, 10, 10), 0))
+	assert.Equal(t, image.Rect(0, 0, 1, 3), table.PaintBounds(image.Rect(0, 0, 10, 3), 0))
+
+	im := PaintWidget(table, image.Rect(0, 0, 10, 3), 0)
+	assert.Equal(t, nil, checkImage([]string{
+		"r",
+		"r",
+		"g",
+	}, im))
+}
+
+func TestTableOverflow(t *testing.T) {
+	text := &Text{Content: "Breaking news"}
+	require.NoError(t, text.Init())
+
+	// ellipsis shortens text to fit
+	col := TableColumn{Width: "30", Overflow: "ellipsis"}
+	require.NoError(t, col.Init())
+	table := &Table{}
+	cell := table.cellWidget(TableCell{Child: text}, col, 30, 8, 0)
+	require.IsType(t, &Text{}, cell)
+	assert.Equal(t, "Break…", cell.(*Text).Content)
+	w, _ := cell.(*Text).Size()
+	assert.LessOrEqual(t, w, 30)
+	assert.Equal(t, "Breaking news", text.Content)
+
+	// text that fits is left alone
+	assert.Same(t, text, table.cellWidget(TableCell{Child: text}, col, 100, 8, 0))
+
+	// and scroll makes it a marquee
+	col = TableColumn{Width: "30", Overflow: "scroll"}
+	require.NoError(t, col.Init())
+	table = &Table{
+		Columns: []TableColumn{col},
+		Rows:    [][]TableCell{{{Child: text}}},
+	}
+	require.NoError(t, table.Init())
+	assert.Equal(t, Marquee{Child: text, Width: 30}, table.cellWidget(table.Rows[0][0], col, 30, 8, 0))
+	assert.Equal(t, Marquee{Child: text, Width: 30}.FrameCount(), table.FrameCount())
+	assert.Greater(t, table.FrameCount(), 1)
+
+	// clipping is the default
+	table.Columns[0].Overflow = ""
+	require.NoError(t, table.Init())
+	assert.Equal(t, 1, table.FrameCount())
+}
+
+func TestTableInvalid(t *testing.T) {
+	for _, col := range []TableColumn{
+		{Width: "wide"},
+		{Width: "-1"},
+		{Width: "0fr"},
+		{Align: "middle"},
+		{Overflow: "wrap"},
+	} {
+		table := &Table{Columns: []TableColumn{col}}
+		assert.Error(t, table.Init())
+	}
+
+	table := &Table{Rows: [][]TableCell{{{VerticalAlign: "middle"}}}}
+	assert.ErrorContains(t, table.Init(), "invalid vertical_align 'middle'")
+}
@@ -21,15 +21,35 @@ var (
 // string. Take a look at the [font documentation](fonts.md) for more
 // information.
 //
+// Hebrew, Arabic and other right-to-left text is laid out with the
+// Unicode bidirectional algorithm, and Arabic letters are joined if
+// the font has their contextual forms.
+//
+// The spacing between letters can be tightened or loosened with
+// `letter_spacing`, and `tabular_numbers` gives all digits the same
+// width, so that clocks and counters don't jitter as they change. Text
+// can have a one pixel outline, and a shadow offset by `shadow_offset`,
+// which is one pixel down and to the right by default. The outline and
+// shadow add to the size of the text.
+//
 // DOC(Content): The text string to draw
 // DOC(Font): Desired font face
 // DOC(Height): Limits height of the area on which text is drawn
 // DOC(Offset): Shifts position of text vertically.
 // DOC(Color): Desired font color
+// DOC(LetterSpacing): Pixels added between letters, or removed if negative
+// DOC(TabularNumbers): Give all digits the same width
+// DOC(OutlineColor): Color of an outline around the text
+// DOC(ShadowColor): Color of a shadow behind the text
+// DOC(ShadowOffset): Offset of the shadow, as x and y or a single number for both
 //
 // EXAMPLE BEGIN
 // render.Text(content="Tidbyt!", color="#099")
 // EXAMPLE END
+//
+// EXAMPLE BEGIN
+// render.Text(content="12:05", font="6x13", tabular_numbers=True, outline_color="#a00", shadow_color="#400")
+// EXAMPLE END
 type Text struct {
 	Widget
 	Content string `starlark:"content,required"`
@@ -37,8 +57,10 @@ type Text struct {
 	Height  int
 	Offset  int
 	Color   color.Color
+	TextStyle
 
 	img image.Image
+	rtl bool
 }
 
 func (t *Text) Size() (int, int) {
@@ -57,16 +79,21 @@ func (t *Text) Init() error {
 	if t.Font == "" {
 		t.Font = DefaultFontFace
 	}
-	face, err := GetFont(t.Font)
+	face, err := GetFontFor(t.Font, t.Content)
 	if err != nil {
 		return err
 	}
+	face = t.styledFace(face)
+
+	t.rtl = isRightToLeft(t.Content)
+	content := visualText(t.Content, face, t.rtl)
 
 	dc := gg.NewContext(0, 0)
 	dc.SetFontFace(face)
 
-	w, _ := dc.MeasureString(t.Content)
-	width := int(w)
+	w, _ := dc.MeasureString(content)
+	left, top, right, bottom := t.margins()
+	width := int(w) + left + right
 
 	// If the width of the text is longer then the max, cut off the size of the
 	// image so it's not unbounded.
@@ -78,26 +105,33 @@ func (t *Text) Init() error {
 	ascent := metrics.Ascent.Floor()
 	descent := metrics.Descent.Floor()
 
-	height := ascent + descent
+	height := ascent + descent + top + bottom
 	if t.Height != 0 {
 		height = t.Height
 	}
 
 	dc = gg.NewContext(width, height)
 	dc.SetFontFace(face)
+
+	var col color.Color = DefaultFontColor
 	if t.Color != nil {
-		dc.SetColor(t.Color)
-	} else {
-		dc.SetColor(DefaultFontColor)
+		col = t.Color
 	}
-
-	dc.DrawString(t.Content, 0, float64(height-descent-t.Offset))
+	baseline := height - bottom - descent - t.Offset
+	t.draw(dc, col, func(dx, dy float64) {
+		dc.DrawString(content, float64(left)+dx, float64(baseline)+dy)
+	})
 
 	t.img = dc.Image()
 
 	return nil
 }
 
+// rightToLeft tells if the text reads from right to left.
+func (t *Text) rightToLeft() bool {
+	return t.rtl
+}
+
 func (t Text) FrameCount() int {
 	return 1
 }
@@ -0,0 +1,231 @@
+package render
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"strconv"
+	"sync"
+
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/opentype"
+	"golang.org/x/image/font/sfnt"
+	"golang.org/x/image/math/fixed"
+)
+
+// Scalable fonts are drawn one bit per pixel. A pixel is on if the
+// glyph covers at least this much of it.
+const scalableFontThreshold = 0x80
+
+// MaxScalableFontSize is the largest pixel size that TrueType and
+// OpenType fonts can be drawn at.
+const MaxScalableFontSize = 256
+
+// scalableFonts are the TrueType and OpenType fonts added with AddFont.
+// They're drawn at the size given in the name of the font, such as
+// "noto.ttf#0123456789ab@12".
+var scalableFonts = map[string]*sfnt.Font{}
+
+// isScalableFont tells if data is a TrueType or OpenType font.
+func isScalableFont(data []byte) bool {
+	for _, magic := range []string{"\x00\x01\x00\x00", "OTTO", "true", "ttcf"} {
+		if bytes.HasPrefix(data, []byte(magic)) {
+			return true
+		}
+	}
+	return false
+}
+
+// parseScalableFont parses a TrueType or OpenType font, or the first
+// font of a collection.
+func parseScalableFont(data []byte) (*sfnt.Font, error) {
+	if !bytes.HasPrefix(data, []byte("ttcf")) {
+		return opentype.Parse(data)
+	}
+
+	c, err := opentype.ParseCollection(data)
+	if err != nil {
+		return nil, err
+	}
+	return c.Font(0)
+}
+
+// newScalableFace returns a face for a scalable font at a pixel size
+// given as a string. The caller must hold fontMutex.
+func newScalableFace(name, size string) (font.Face, error) {
+	f, ok := scalableFonts[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown font '%s'", name)
+	}
+
+	px, err := strconv.Atoi(size)
+	if err != nil || px < 1 || px > MaxScalableFontSize {
+		return nil, fmt.Errorf("font '%s' has invalid size '%s', must be 1 to %d pixels", name, size, MaxScalableFontSize)
+	}
+
+	face, err := opentype.NewFace(f, &opentype.FaceOptions{
+		Size:    float64(px),
+		DPI:     72,
+		Hinting: font.HintingFull,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("creating face for font '%s': %w", name, err)
+	}
+
+	return &pixelFace{face: face, glyphs: map[rune]*pixelGlyph{}}, nil
+}
+
+// pixelFace draws a scalable font for LED displays. Glyphs are drawn at
+// whole pixels, and are thresholded so that every pixel is either on or
+// off, which keeps them crisp at small sizes.
+type pixelFace struct {
+	face   font.Face
+	glyphs map[rune]*pixelGlyph
+
+	// faces from opentype aren't safe for concurrent use
+	mutex sync.Mutex
+}
+
+// pixelGlyph is a thresholded glyph, with bounds relative to the dot.
+type pixelGlyph struct {
+	bounds  image.Rectangle
+	mask    *image.Alpha
+	advance fixed.Int26_6
+	ok      bool
+}
+
+func (f *pixelFace) glyph(r rune) *pixelGlyph {
+	if g, ok := f.glyphs[r]; ok {
+		return g
+	}
+
+	advance, ok := f.face.GlyphAdvance(r)
+	g := &pixelGlyph{
+		mask:    image.NewAlpha(image.Rectangle{}),
+		advance: fixed.I(advance.Round()),
+		ok:      ok,
+	}
+
+	// outlines rarely line up with pixels, so strokes end up covering
+	// parts of two pixels, and thresholding them drops or doubles them.
+	// try nudging the glyph sideways by fractions of a pixel, and keep
+	// the position where pixels are the most clearly on or off.
+	best := -1
+	for _, dx := range []fixed.Int26_6{0, 16, 32, 48} {
+		dr, mask, maskp, _, ok := f.face.Glyph(fixed.Point26_6{X: dx}, r)
+		if !ok || mask == nil {
+			continue
+		}
+
+		coverage := make([]int, dr.Dx()*dr.Dy())
+		contrast := 0
+		for y := 0; y < dr.Dy(); y++ {
+			for x := 0; x < dr.Dx(); x++ {
+				_, _, _, a := mask.At(maskp.X+x, maskp.Y+y).RGBA()
+				coverage[y*dr.Dx()+x] = int(a >> 8)
+				d := int(a>>8) - scalableFontThreshold
+				contrast += d * d
+			}
+		}
+
+		if contrast > best {
+			best = contrast
+			g.bounds = dr
+			g.mask = threshold(coverage, dr.Dx(), dr.Dy())
+		}
+	}
+
+	f.glyphs[r] = g
+	return g
+}
+
+func (f *pixelFace) Close() error {
+	return nil
+}
+
+func (f *pixelFace) Glyph(dot fixed.Point26_6, r rune) (image.Rectangle, image.Image, image.Point, fixed.Int26_6, bool) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	g := f.glyph(r)
+	dr := g.bounds.Add(image.Pt(dot.X.Round(), dot.Y.Round()))
+	return dr, g.mask, image.Point{}, g.advance, g.ok
+}
+
+func (f *pixelFace) GlyphBounds(r rune) (fixed.Rectangle26_6, fixed.Int26_6, bool) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	g := f.glyph(r)
+	bounds := fixed.R(g.bounds.Min.X, g.bounds.Min.Y, g.bounds.Max.X, g.bounds.Max.Y)
+	return bounds, g.advance, g.ok
+}
+
+func (f *pixelFace) GlyphAdvance(r rune) (fixed.Int26_6, bool) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	g := f.glyph(r)
+	return g.advance, g.ok
+}
+
+func (f *pixelFace) Kern(r0, r1 rune) fixed.Int26_6 {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	return fixed.I(f.face.Kern(r0, r1).Round())
+}
+
+func (f *pixelFace) Metrics() font.Metrics {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	m := f.face.Metrics()
+	round := func(v fixed.Int26_6) fixed.Int26_6 {
+		return fixed.I(v.Round())
+	}
+
+	return font.Metrics{
+		Height:     round(m.Height),
+		Ascent:     round(m.Ascent),
+		Descent:    round(m.Descent),
+		XHeight:    round(m.XHeight),
+		CapHeight:  round(m.CapHeight),
+		CaretSlope: m.CaretSlope,
+	}
+}
+
+// threshold turns the coverage of the pixels of a glyph into a 1-bit
+// mask. Pixels that are mostly covered are on. So that thin strokes
+// don't disappear, a pixel is also on if it's the most covered one
+// across a stroke that covers half a pixel in total.
+func threshold(coverage []int, width, height int) *image.Alpha {
+	mask := image.NewAlpha(image.Rect(0, 0, width, height))
+
+	at := func(x, y int) int {
+		if x < 0 || y < 0 || x >= width || y >= height {
+			return 0
+		}
+		return coverage[y*width+x]
+	}
+
+	// stroke checks if the pixel at x, y is the peak of a stroke that
+	// runs across the direction dx, dy
+	stroke := func(x, y, dx, dy int) bool {
+		a, before, after := at(x, y), at(x-dx, y-dy), at(x+dx, y+dy)
+		if before >= scalableFontThreshold || after >= scalableFontThreshold {
+			return false
+		}
+		return a >= before && a > after && a+before+after >= scalableFontThreshold
+	}
+
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			if at(x, y) >= scalableFontThreshold || stroke(x, y, 1, 0) || stroke(x, y, 0, 1) {
+				mask.Pix[y*mask.Stride+x] = 0xff
+			}
+		}
+	}
+
+	return mask
+}
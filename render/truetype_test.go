@@ -0,0 +1,106 @@
+package render
+
+import (
+	"fmt"
+	"image"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/image/font/gofont/goregular"
+)
+
+func TestScalableFont(t *testing.T) {
+	name, err := AddFont("go.ttf", goregular.TTF)
+	require.NoError(t, err)
+
+	_, err = GetFont(name)
+	assert.ErrorContains(t, err, "needs a pixel size")
+	_, err = GetFont(name + "@0")
+	assert.ErrorContains(t, err, "invalid size '0'")
+	_, err = GetFont(name + "@big")
+	assert.ErrorContains(t, err, "invalid size 'big'")
+
+	// faces are cached by font and size
+	small, err := GetFont(name + "@8")
+	require.NoError(t, err)
+	again, err := GetFont(name + "@8")
+	require.NoError(t, err)
+	assert.Same(t, small, again)
+	large, err := GetFont(name + "@16")
+	require.NoError(t, err)
+	assert.NotSame(t, small, large)
+
+	// but only the most recently used are kept
+	for size := 1; size <= MaxCachedFaces; size++ {
+		_, err := GetFont(fmt.Sprintf("%s@%d", name, 16+size))
+		require.NoError(t, err)
+	}
+	again, err = GetFont(name + "@8")
+	require.NoError(t, err)
+	assert.NotSame(t, small, again)
+
+	fontMutex.Lock()
+	assert.Equal(t, MaxCachedFaces, faceCache.order.Len())
+	assert.Len(t, faceCache.items, MaxCachedFaces)
+	fontMutex.Unlock()
+
+	text := &Text{Content: "l", Font: name + "@12"}
+	require.NoError(t, text.Init())
+	im := PaintWidget(text, image.Rect(0, 0, 0, 0), 0)
+	assert.Equal(t, nil, checkImage([]string{
+		"...",
+		"...",
+		"...",
+		".w.",
+		".w.",
+		".w.",
+		".w.",
+		".w.",
+		".w.",
+		".w.",
+		".w.",
+		".ww",
+		"...",
+		"...",
+		"...",
+	}, im))
+
+	// glyphs are drawn without anti-aliasing
+	text = &Text{Content: "Pixlet ÅÄÖ", Font: name + "@10"}
+	require.NoError(t, text.Init())
+	im = PaintWidget(text, image.Rect(0, 0, 100, 20), 0)
+	on := 0
+	for y := im.Bounds().Min.Y; y < im.Bounds().Max.Y; y++ {
+		for x := im.Bounds().Min.X; x < im.Bounds().Max.X; x++ {
+			_, _, _, a := im.At(x, y).RGBA()
+			assert.True(t, a == 0 || a == 0xffff)
+			if a != 0 {
+				on++
+			}
+		}
+	}
+	assert.Greater(t, on, 50)
+
+	wrapped := &WrappedText{Content: "ll ll", Font: name + "@12", Width: 8}
+	require.NoError(t, wrapped.Init())
+	im = PaintWidget(wrapped, image.Rect(0, 0, 64, 32), 0)
+	assert.Equal(t, 8, im.Bounds().Dx())
+	assert.Equal(t, 28, im.Bounds().Dy())
+}
+
+func TestThreshold(t *testing.T) {
+	mask := threshold([]int{
+		0x00, 0x50, 0x40, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x20,
+		0xa0, 0x00, 0x00, 0x00, 0x20,
+	}, 5, 3)
+
+	// the thin stroke keeps its most covered pixel, but faint pixels
+	// stay off
+	assert.Equal(t, []uint8{
+		0x00, 0xff, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00,
+		0xff, 0x00, 0x00, 0x00, 0x00,
+	}, mask.Pix)
+}
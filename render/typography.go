@@ -0,0 +1,165 @@
+package render
+
+import (
+	"image"
+	"image/color"
+
+	"github.com/tidbyt/gg"
+	"golang.org/x/image/font"
+	"golang.org/x/image/math/fixed"
+)
+
+// spacedFace changes how far a face advances after each glyph. The
+// letter spacing is added after every glyph, and tabular digits all
+// advance as much as the widest digit, with their glyph centered in
+// that space, so that numbers don't move around as they change.
+type spacedFace struct {
+	font.Face
+	letterSpacing fixed.Int26_6
+	digitAdvance  fixed.Int26_6
+}
+
+// newSpacedFace returns face with letter spacing and tabular digits,
+// or face itself if it needs neither.
+func newSpacedFace(face font.Face, letterSpacing int, tabular bool) font.Face {
+	if letterSpacing == 0 && !tabular {
+		return face
+	}
+
+	f := &spacedFace{Face: face, letterSpacing: fixed.I(letterSpacing)}
+	if tabular {
+		for r := '0'; r <= '9'; r++ {
+			if advance, ok := face.GlyphAdvance(r); ok && advance > f.digitAdvance {
+				f.digitAdvance = advance
+			}
+		}
+	}
+	return f
+}
+
+func (f *spacedFace) isTabular(r rune) bool {
+	return f.digitAdvance > 0 && r >= '0' && r <= '9'
+}
+
+// shift is how far the glyph for r is moved right within its advance.
+func (f *spacedFace) shift(r rune, advance fixed.Int26_6) fixed.Int26_6 {
+	if !f.isTabular(r) {
+		return 0
+	}
+	return fixed.I((f.digitAdvance - advance).Round() / 2)
+}
+
+func (f *spacedFace) advance(r rune, advance fixed.Int26_6) fixed.Int26_6 {
+	if f.isTabular(r) {
+		advance = f.digitAdvance
+	}
+	return advance + f.letterSpacing
+}
+
+func (f *spacedFace) Glyph(dot fixed.Point26_6, r rune) (image.Rectangle, image.Image, image.Point, fixed.Int26_6, bool) {
+	advance, _ := f.Face.GlyphAdvance(r)
+	dot.X += f.shift(r, advance)
+	dr, mask, maskp, advance, ok := f.Face.Glyph(dot, r)
+	return dr, mask, maskp, f.advance(r, advance), ok
+}
+
+func (f *spacedFace) GlyphBounds(r rune) (fixed.Rectangle26_6, fixed.Int26_6, bool) {
+	bounds, advance, ok := f.Face.GlyphBounds(r)
+	shift := f.shift(r, advance)
+	bounds.Min.X += shift
+	bounds.Max.X += shift
+	return bounds, f.advance(r, advance), ok
+}
+
+func (f *spacedFace) GlyphAdvance(r rune) (fixed.Int26_6, bool) {
+	advance, ok := f.Face.GlyphAdvance(r)
+	return f.advance(r, advance), ok
+}
+
+func (f *spacedFace) Kern(r0, r1 rune) fixed.Int26_6 {
+	if f.isTabular(r0) && f.isTabular(r1) {
+		return 0
+	}
+	return f.Face.Kern(r0, r1)
+}
+
+// TextStyle has the typography options of Text and WrappedText. They
+// change how glyphs are placed and drawn, rather than the image of the
+// text.
+type TextStyle struct {
+	LetterSpacing  int         `starlark:"letter_spacing"`
+	TabularNumbers bool        `starlark:"tabular_numbers"`
+	OutlineColor   color.Color `starlark:"outline_color"`
+	ShadowColor    color.Color `starlark:"shadow_color"`
+	ShadowOffset   image.Point `starlark:"shadow_offset"`
+}
+
+// styledFace returns face with the letter spacing and tabular digits of the
+// style.
+func (s TextStyle) styledFace(face font.Face) font.Face {
+	return newSpacedFace(face, s.LetterSpacing, s.TabularNumbers)
+}
+
+// shadowOffset returns the offset of the shadow, which is one pixel
+// down and to the right unless set.
+func (s TextStyle) shadowOffset() image.Point {
+	if s.ShadowOffset == (image.Point{}) {
+		return image.Pt(1, 1)
+	}
+	return s.ShadowOffset
+}
+
+// margins returns how many pixels the outline and shadow reach past the
+// glyphs on each side.
+func (s TextStyle) margins() (left, top, right, bottom int) {
+	o := 0
+	if s.OutlineColor != nil {
+		o = 1
+	}
+	left, top, right, bottom = o, o, o, o
+
+	if s.ShadowColor != nil {
+		offset := s.shadowOffset()
+		left = max(left, o-offset.X)
+		top = max(top, o-offset.Y)
+		right = max(right, o+offset.X)
+		bottom = max(bottom, o+offset.Y)
+	}
+
+	return left, top, right, bottom
+}
+
+// draw draws glyphs in col, with the outline and shadow of the style.
+// drawGlyphs is called for every copy of the glyphs, offset by dx and
+// dy, after the color is set for it: first the shadow, then the outline
+// around the glyphs, and last the glyphs themselves.
+func (s TextStyle) draw(dc *gg.Context, col color.Color, drawGlyphs func(dx, dy float64)) {
+	around := []image.Point{{0, 0}}
+	if s.OutlineColor != nil {
+		around = []image.Point{
+			{-1, -1}, {0, -1}, {1, -1},
+			{-1, 0}, {0, 0}, {1, 0},
+			{-1, 1}, {0, 1}, {1, 1},
+		}
+	}
+
+	if s.ShadowColor != nil {
+		offset := s.shadowOffset()
+		dc.SetColor(s.ShadowColor)
+		for _, p := range around {
+			drawGlyphs(float64(offset.X+p.X), float64(offset.Y+p.Y))
+		}
+	}
+
+	if s.OutlineColor != nil {
+		dc.SetColor(s.OutlineColor)
+		for _, p := range around {
+			if p != (image.Point{}) {
+				drawGlyphs(float64(p.X), float64(p.Y))
+			}
+		}
+	}
+
+	dc.SetColor(col)
+	drawGlyphs(0, 0)
+}
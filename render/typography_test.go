@@ -0,0 +1,187 @@
+package render
+
+import (
+	"image"
+	"image/color"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/image/font"
+	"golang.org/x/image/math/fixed"
+)
+
+// narrowOneFace is a face where '1' is narrower than the other digits,
+// like it is in many proportional fonts.
+type narrowOneFace struct {
+	font.Face
+}
+
+func (f narrowOneFace) GlyphAdvance(r rune) (fixed.Int26_6, bool) {
+	advance, ok := f.Face.GlyphAdvance(r)
+	if r == '1' {
+		advance -= fixed.I(2)
+	}
+	return advance, ok
+}
+
+func (f narrowOneFace) GlyphBounds(r rune) (fixed.Rectangle26_6, fixed.Int26_6, bool) {
+	bounds, _, ok := f.Face.GlyphBounds(r)
+	advance, _ := f.GlyphAdvance(r)
+	return bounds, advance, ok
+}
+
+func TestTabularNumbers(t *testing.T) {
+	base, err := GetFont("tb-8")
+	require.NoError(t, err)
+	face := narrowOneFace{base}
+
+	assert.Less(t, font.MeasureString(face, "1111"), font.MeasureString(face, "0000"))
+
+	tabular := newSpacedFace(face, 0, true)
+	assert.Equal(t, font.MeasureString(tabular, "0000"), font.MeasureString(tabular, "1111"))
+	assert.Equal(t, font.MeasureString(face, "0000"), font.MeasureString(tabular, "1111"))
+
+	// narrow digits are centered in the advance of the widest one
+	bounds, _, _ := face.GlyphBounds('1')
+	shifted, _, _ := tabular.GlyphBounds('1')
+	assert.Equal(t, bounds.Min.X+fixed.I(1), shifted.Min.X)
+	bounds, _, _ = face.GlyphBounds('0')
+	shifted, _, _ = tabular.GlyphBounds('0')
+	assert.Equal(t, bounds, shifted)
+
+	// other characters keep their advance
+	assert.Equal(t, font.MeasureString(face, "a:b"), font.MeasureString(tabular, "a:b"))
+
+	// and faces without spacing are returned as is
+	assert.Equal(t, font.Face(face), newSpacedFace(face, 0, false))
+}
+
+func TestTextLetterSpacing(t *testing.T) {
+	text := &Text{
+		Content:   "--",
+		Font:      "CG-pixel-3x5-mono",
+		TextStyle: TextStyle{LetterSpacing: 1},
+	}
+	require.NoError(t, text.Init())
+	im := PaintWidget(text, image.Rect(0, 0, 0, 0), 0)
+	assert.Equal(t, nil, checkImage([]string{
+		"..........",
+		"..........",
+		"www..www..",
+		"..........",
+		"..........",
+	}, im))
+
+	// negative spacing pulls glyphs together
+	text.LetterSpacing = -1
+	require.NoError(t, text.Init())
+	im = PaintWidget(text, image.Rect(0, 0, 0, 0), 0)
+	assert.Equal(t, nil, checkImage([]string{
+		"......",
+		"......",
+		"wwwwww",
+		"......",
+		"......",
+	}, im))
+}
+
+func TestTextOutlineAndShadow(t *testing.T) {
+	red := color.RGBA{0xff, 0, 0, 0xff}
+	blue := color.RGBA{0, 0, 0xff, 0xff}
+
+	text := &Text{
+		Content:   "-",
+		Font:      "CG-pixel-3x5-mono",
+		TextStyle: TextStyle{OutlineColor: red},
+	}
+	require.NoError(t, text.Init())
+	im := PaintWidget(text, image.Rect(0, 0, 0, 0), 0)
+	assert.Equal(t, nil, checkImage([]string{
+		"......",
+		"......",
+		"rrrrr.",
+		"rwwwr.",
+		"rrrrr.",
+		"......",
+		"......",
+	}, im))
+
+	// the shadow is one pixel down and to the right by default
+	text = &Text{
+		Content:   "-",
+		Font:      "CG-pixel-3x5-mono",
+		TextStyle: TextStyle{ShadowColor: blue},
+	}
+	require.NoError(t, text.Init())
+	im = PaintWidget(text, image.Rect(0, 0, 0, 0), 0)
+	assert.Equal(t, nil, checkImage([]string{
+		".....",
+		".....",
+		"www..",
+		".bbb.",
+		".....",
+		".....",
+	}, im))
+
+	// shadows up or to the left make room on that side
+	text.ShadowOffset = image.Pt(-1, 2)
+	require.NoError(t, text.Init())
+	im = PaintWidget(text, image.Rect(0, 0, 0, 0), 0)
+	assert.Equal(t, nil, checkImage([]string{
+		".....",
+		".....",
+		".www.",
+		".....",
+		"bbb..",
+		".....",
+		".....",
+	}, im))
+
+	// the shadow of outlined text is outlined too
+	text = &Text{
+		Content:   "-",
+		Font:      "CG-pixel-3x5-mono",
+		TextStyle: TextStyle{OutlineColor: red, ShadowColor: blue},
+	}
+	require.NoError(t, text.Init())
+	im = PaintWidget(text, image.Rect(0, 0, 0, 0), 0)
+	assert.Equal(t, nil, checkImage([]string{
+		".......",
+		".......",
+		"rrrrr..",
+		"rwwwrb.",
+		"rrrrrb.",
+		".bbbbb.",
+		".......",
+		".......",
+	}, im))
+	w, h := text.Size()
+	assert.Equal(t, 7, w)
+	assert.Equal(t, 8, h)
+}
+
+func TestWrappedTextShadow(t *testing.T) {
+	text := &WrappedText{
+		Content:   "- -",
+		Font:      "CG-pixel-3x5-mono",
+		TextStyle: TextStyle{ShadowColor: color.RGBA{0, 0, 0xff, 0xff}},
+	}
+	require.NoError(t, text.Init())
+
+	// the shadow's margin is left out when wrapping
+	im := PaintWidget(text, image.Rect(0, 0, 6, 20), 0)
+	assert.Equal(t, nil, checkImage([]string{
+		".....",
+		".....",
+		"www..",
+		".bbb.",
+		".....",
+		".....",
+		".....",
+		"www..",
+		".bbb.",
+		".....",
+		".....",
+	}, im))
+}
@@ -13,6 +13,11 @@ import (
 // axis is either horizontal or vertical (i.e. a row or a
 // column). MainAlign controls how children are placed along this
 // axis. CrossAlign controls placement orthogonally to the main axis.
+//
+// Gap leaves space between children. Flex children share the space
+// left over by the others along the main axis. If Shrink is set,
+// children that overflow the main axis are made smaller to fit,
+// instead of being cut off.
 type Vector struct {
 	Widget
 
@@ -21,102 +26,178 @@ type Vector struct {
 	CrossAlign string `starlark: "cross_align"`
 	Expanded   bool
 	Vertical   bool
+	Gap        int
+	Shrink     bool
 }
 
-func (v Vector) PaintBounds(bounds image.Rectangle, frameIdx int) image.Rectangle {
-	// (dx, dy) determines the orientation of this Vector
-	dx, dy := 1, 0
-	if v.Vertical {
-		dx, dy = 0, 1
-	}
+// vectorChild is where a child of a vector is laid out.
+type vectorChild struct {
+	widget Widget
 
-	boundsW := bounds.Dx()
-	boundsH := bounds.Dy()
+	// main and cross are the size of the child along each axis
+	main, cross int
 
-	// Paint as many children as we can fit. Compute their max and
-	// total width and height as we go along.
-	maxW, maxH := 0, 0
-	sumW, sumH := 0, 0
-	for _, child := range v.Children {
-		cb := child.PaintBounds(image.Rect(0, 0, boundsW-dx*sumW, boundsH-dy*sumH), frameIdx)
+	// bounds are what the child is painted within, and sized is set
+	// for children that are clipped to their size along the main
+	// axis rather than their own bounds, like flex children and
+	// children that have been shrunk
+	bounds image.Rectangle
+	sized  bool
+}
 
-		imW := cb.Dx()
-		imH := cb.Dy()
+// rect returns a rectangle that's main by cross along the axes of the
+// vector.
+func (v Vector) rect(main, cross int) image.Rectangle {
+	if v.Vertical {
+		return image.Rect(0, 0, cross, main)
+	}
+	return image.Rect(0, 0, main, cross)
+}
+
+// axes returns the size of r along the main and cross axes of the
+// vector.
+func (v Vector) axes(r image.Rectangle) (int, int) {
+	if v.Vertical {
+		return r.Dy(), r.Dx()
+	}
+	return r.Dx(), r.Dy()
+}
 
-		sumW += imW
-		if imW > maxW {
-			maxW = imW
+// layout works out the size of each child that fits in bounds, and the
+// total size of the children and the gaps between them along the main
+// axis.
+func (v Vector) layout(bounds image.Rectangle, frameIdx int) ([]vectorChild, int) {
+	boundsMain, boundsCross := v.axes(bounds)
+
+	// Lay out as many children as we can fit. Flex children start at
+	// their minimum size.
+	children := make([]vectorChild, 0, len(v.Children))
+	sum := 0
+	for i, child := range v.Children {
+		if i > 0 {
+			sum += v.Gap
 		}
-		sumH += imH
-		if imH > maxH {
-			maxH = imH
+
+		if flex, ok := asFlex(child); ok {
+			children = append(children, vectorChild{widget: child, main: flex.MinSize, sized: true})
+			sum += flex.MinSize
+		} else {
+			// Children that can shrink are measured at their
+			// full size
+			available := boundsMain
+			if !v.Shrink {
+				available = max(boundsMain-sum, 0)
+			}
+			cb := child.PaintBounds(v.rect(available, boundsCross), frameIdx)
+			main, cross := v.axes(cb)
+			children = append(children, vectorChild{
+				widget: child,
+				main:   main,
+				cross:  cross,
+				bounds: v.rect(available, boundsCross),
+			})
+			sum += main
 		}
 
 		// This checks if we've overflowed the main axis
-		if sumW*dx >= boundsW || sumH*dy >= boundsH {
+		if !v.Shrink && sum >= boundsMain {
 			break
 		}
 	}
 
-	// Compute the final dimensions of the vector. If the vector
-	// is expanded, then it will span the full bounds along the
-	// main axis. Otherwise, it will be the size of its children.
-	// Along the cross axis, size will be the max of the
-	// children. However, in both cases, total size can never
-	// exceed the available bounds.
-	width := dx*sumW + dy*maxW
-	height := dx*maxH + dy*sumH
-	if v.Expanded {
-		width = dx*boundsW + dy*maxW
-		height = dx*maxH + dy*boundsH
+	sum += v.grow(children, boundsMain-sum)
+	if v.Shrink && sum > boundsMain {
+		sum -= v.shrink(children, sum-boundsMain)
 	}
-	if height > boundsH {
-		height = boundsH
-	}
-	if width > boundsW {
-		width = boundsW
-	}
-
-	return image.Rect(0, 0, width, height)
-}
 
-func (v Vector) Paint(dc *gg.Context, bounds image.Rectangle, frameIdx int) {
-	// (dx, dy) determines the orientation of this Vector
-	dx, dy := 1, 0
-	if v.Vertical {
-		dx, dy = 0, 1
+	// Children that are laid out to a size are measured along the
+	// cross axis at that size
+	for i := range children {
+		c := &children[i]
+		if !c.sized {
+			continue
+		}
+		widget := c.widget
+		if flex, ok := asFlex(widget); ok {
+			widget = flex.Child
+		}
+		c.bounds = v.rect(c.main, boundsCross)
+		_, c.cross = v.axes(widget.PaintBounds(c.bounds, frameIdx))
 	}
 
-	boundsW := bounds.Dx()
-	boundsH := bounds.Dy()
-
-	// Paint as many children as we can fit. Compute their max and
-	// total width and height as we go along.
-	maxW, maxH := 0, 0
-	sumW, sumH := 0, 0
-	childrenBounds := make([]image.Rectangle, 0, len(v.Children))
-	for _, child := range v.Children {
-		cb := child.PaintBounds(image.Rect(0, 0, boundsW-dx*sumW, boundsH-dy*sumH), frameIdx)
-
-		imW := cb.Dx()
-		imH := cb.Dy()
+	return children, sum
+}
 
-		sumW += imW
-		if imW > maxW {
-			maxW = imW
+// grow shares free space between flex children, by their weight and up
+// to their maximum size, and returns how much of it they took.
+func (v Vector) grow(children []vectorChild, free int) int {
+	grown := make([]int, len(children))
+	total := 0
+	for ; total < free; total++ {
+		// Each pixel goes to the flex child that has grown the
+		// least for its weight
+		next, least := -1, 0.0
+		for i, c := range children {
+			flex, ok := asFlex(c.widget)
+			if !ok || (flex.MaxSize > 0 && c.main >= flex.MaxSize) {
+				continue
+			}
+			if ratio := float64(grown[i]+1) / flex.weight(); next < 0 || ratio < least {
+				next, least = i, ratio
+			}
 		}
-		sumH += imH
-		if imH > maxH {
-			maxH = imH
+		if next < 0 {
+			break
 		}
+		grown[next]++
+		children[next].main++
+	}
+	return total
+}
 
-		childrenBounds = append(childrenBounds, cb)
+// shrink takes overflow pixels from children, in proportion to their
+// size, and returns how many it took. Flex children don't shrink below
+// their minimum size.
+func (v Vector) shrink(children []vectorChild, overflow int) int {
+	natural := make([]int, len(children))
+	for i, c := range children {
+		natural[i] = c.main
+	}
 
-		// This checks if we've overflowed the main axis
-		if sumW*dx >= boundsW || sumH*dy >= boundsH {
+	total := 0
+	for ; total < overflow; total++ {
+		// Each pixel comes from the child that has shrunk the
+		// least for its size
+		next := -1
+		for i, c := range children {
+			if c.main == 0 {
+				continue
+			}
+			if flex, ok := asFlex(c.widget); ok && c.main <= flex.MinSize {
+				continue
+			}
+			if next < 0 {
+				next = i
+				continue
+			}
+			// compare main/natural without dividing, and take
+			// from the larger child first when they're even
+			a, b := c.main*natural[next], children[next].main*natural[i]
+			if a > b || (a == b && natural[i] > natural[next]) {
+				next = i
+			}
+		}
+		if next < 0 {
 			break
 		}
+		children[next].main--
+		children[next].sized = true
 	}
+	return total
+}
+
+func (v Vector) size(children []vectorChild, sum int, bounds image.Rectangle) (int, int) {
+	boundsMain, boundsCross := v.axes(bounds)
 
 	// Compute the final dimensions of the vector. If the vector
 	// is expanded, then it will span the full bounds along the
@@ -124,26 +205,51 @@ func (v Vector) Paint(dc *gg.Context, bounds image.Rectangle, frameIdx int) {
 	// Along the cross axis, size will be the max of the
 	// children. However, in both cases, total size can never
 	// exceed the available bounds.
-	width := dx*sumW + dy*maxW
-	height := dx*maxH + dy*sumH
+	maxCross := 0
+	for _, c := range children {
+		if c.cross > maxCross {
+			maxCross = c.cross
+		}
+	}
+
+	main := sum
 	if v.Expanded {
-		width = dx*boundsW + dy*maxW
-		height = dx*maxH + dy*boundsH
+		main = boundsMain
 	}
-	if height > boundsH {
-		height = boundsH
+	if main > boundsMain {
+		main = boundsMain
 	}
-	if width > boundsW {
-		width = boundsW
+	if maxCross > boundsCross {
+		maxCross = boundsCross
 	}
 
+	return main, maxCross
+}
+
+func (v Vector) PaintBounds(bounds image.Rectangle, frameIdx int) image.Rectangle {
+	children, sum := v.layout(bounds, frameIdx)
+	main, cross := v.size(children, sum, bounds)
+	return v.rect(main, cross)
+}
+
+func (v Vector) Paint(dc *gg.Context, bounds image.Rectangle, frameIdx int) {
+	// (dx, dy) determines the orientation of this Vector
+	dx, dy := 1, 0
+	if v.Vertical {
+		dx, dy = 0, 1
+	}
+
+	boundsMain, _ := v.axes(bounds)
+	children, sum := v.layout(bounds, frameIdx)
+	main, cross := v.size(children, sum, bounds)
+
 	// These control position and spacing across main axis
 	offset := 0
 	spacing := 0
 	spacingResidual := 0
 
 	// The amount of space we have to play with
-	remaining := (dx*(width-sumW) + dy*(height-sumH))
+	remaining := main - sum
 	if remaining < 0 {
 		remaining = 0
 	}
@@ -152,22 +258,22 @@ func (v Vector) Paint(dc *gg.Context, bounds image.Rectangle, frameIdx int) {
 	case "start":
 		// all = 0
 	case "end":
-		offset = dx*(width-sumW) + dy*(height-sumH)
+		offset = main - sum
 		if offset < 0 {
 			offset = 0
 		}
 	case "space_evenly":
-		spacing = remaining / (len(childrenBounds) + 1)
-		spacingResidual = remaining % (len(childrenBounds) + 1)
+		spacing = remaining / (len(children) + 1)
+		spacingResidual = remaining % (len(children) + 1)
 		offset = spacing
 	case "space_around":
-		spacing = remaining / len(childrenBounds)
-		spacingResidual = remaining % len(childrenBounds)
+		spacing = remaining / len(children)
+		spacingResidual = remaining % len(children)
 		offset = spacing / 2
 	case "center":
 		offset = remaining / 2
 	case "space_between":
-		n := len(childrenBounds)
+		n := len(children)
 		if n > 1 {
 			spacing = remaining / (n - 1)
 			spacingResidual = remaining % (n - 1)
@@ -178,15 +284,8 @@ func (v Vector) Paint(dc *gg.Context, bounds image.Rectangle, frameIdx int) {
 		}
 	}
 
-	maxW, maxH = 0, 0
-	sumW, sumH = 0, 0
-
 	// Draw the children
-	for i, cb := range childrenBounds {
-		imW := cb.Dx()
-		imH := cb.Dy()
-		child := v.Children[i]
-
+	for _, c := range children {
 		// Residual space gets distributed 1 pixel at a time
 		if spacingResidual > 0 {
 			offset += 1
@@ -199,37 +298,29 @@ func (v Vector) Paint(dc *gg.Context, bounds image.Rectangle, frameIdx int) {
 		case "start":
 			// crossOffset = 0
 		case "center":
-			crossOffset = (dx*(height-imH) + dy*(width-imW)) / 2
+			crossOffset = (cross - c.cross) / 2
 		case "end":
-			crossOffset = dx*(height-imH) + dy*(width-imW)
+			crossOffset = cross - c.cross
 		}
 
 		dc.Push()
 		dc.Translate(float64(dx*offset+dy*crossOffset), float64(dx*crossOffset+dy*offset))
 
+		clip := v.rect(c.main, c.cross)
 		dc.DrawRectangle(
 			float64(0),
 			float64(0),
-			float64(cb.Dx()),
-			float64(cb.Dy()),
+			float64(clip.Dx()),
+			float64(clip.Dy()),
 		)
 		dc.Clip()
 
-		child.Paint(dc, image.Rect(0, 0, boundsW-dx*sumW, boundsH-dy*sumH), frameIdx)
+		c.widget.Paint(dc, c.bounds, frameIdx)
 		dc.Pop()
 
-		sumW += imW
-		if imW > maxW {
-			maxW = imW
-		}
-		sumH += imH
-		if imH > maxH {
-			maxH = imH
-		}
-
-		offset += dx*imW + dy*imH + spacing
+		offset += c.main + spacing + v.Gap
 
-		if offset >= dx*boundsW+dy*boundsH {
+		if offset >= boundsMain {
 			break
 		}
 	}
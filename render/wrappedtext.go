@@ -1,12 +1,24 @@
 package render
 
 import (
+	"fmt"
 	"image"
 	"image/color"
+	"strings"
+	"unicode"
 
 	"github.com/tidbyt/gg"
 
 	"golang.org/x/image/font"
+	"tidbyt.dev/pixlet/render/hyphenation"
+)
+
+const (
+	softHyphen = '\u00ad'
+
+	// wrappedTextFade is how many pixels text fades out over, when
+	// overflow is "fade"
+	wrappedTextFade = 8
 )
 
 // WrappedText draws multi-line text.
@@ -19,6 +31,31 @@ import (
 // - `"left"`: align text to the left
 // - `"center"`: align text in the center
 // - `"right"`: align text to the right
+// - `"justify"`: stretch the space between words so that lines fill
+// the width, except for the last line of a paragraph
+//
+// If `align` isn't set, each paragraph is aligned to the side it starts
+// on, which is the right for Hebrew, Arabic and other right-to-left
+// text. Lines of right-to-left text are broken and laid out with the
+// Unicode bidirectional algorithm.
+//
+// The number of lines can be limited with `max_lines`. What happens to
+// text that doesn't fit is controlled by passing one of the following
+// `overflow` values:
+// - `"clip"`: cut the text off at the edges, which is the default
+// - `"ellipsis"`: leave out lines that don't fit entirely, and end the
+// text with an ellipsis where it's cut
+// - `"fade"`: leave out lines that don't fit entirely, and fade the
+// text out where it's cut
+//
+// Words are broken across lines at soft hyphens (`"\u00ad"`). With
+// `hyphenate`, English words are also broken where a dictionary says
+// they can be. The read-only `truncated` attribute tells if some of the
+// text didn't fit, for the `width` and `height` of the WrappedText, or
+// the size of the display if they're not set.
+//
+// Letter spacing, tabular numbers, outline and shadow work as they do
+// for Text.
 //
 // DOC(Content): The text string to draw
 // DOC(Font): Desired font face
@@ -27,6 +64,15 @@ import (
 // DOC(LineSpacing): Controls spacing between lines
 // DOC(Color): Desired font color
 // DOC(Align): Text Alignment
+// DOC(MaxLines): Limits the number of lines drawn
+// DOC(Overflow): What to do with text that doesn't fit, 'clip', 'ellipsis' or 'fade', default is clip
+// DOC(Hyphenate): Break English words with hyphens to fit more text on each line
+// DOC(Truncated): (Read-only) Whether some of the text didn't fit
+// DOC(LetterSpacing): Pixels added between letters, or removed if negative
+// DOC(TabularNumbers): Give all digits the same width
+// DOC(OutlineColor): Color of an outline around the text
+// DOC(ShadowColor): Color of a shadow behind the text
+// DOC(ShadowOffset): Offset of the shadow, as x and y or a single number for both
 // EXAMPLE BEGIN
 // render.WrappedText(
 //
@@ -36,6 +82,16 @@ import (
 //
 // )
 // EXAMPLE END
+//
+// EXAMPLE BEGIN
+// render.WrappedText(
+//      content="Scientists announce breakthrough in superconductivity research",
+//      width=64,
+//      max_lines=3,
+//      overflow="ellipsis",
+//      hyphenate=True,
+// )
+// EXAMPLE END
 type WrappedText struct {
 	Widget
 
@@ -46,6 +102,11 @@ type WrappedText struct {
 	LineSpacing int
 	Color       color.Color
 	Align       string
+	MaxLines    int    `starlark:"max_lines"`
+	Overflow    string `starlark:"overflow"`
+	Hyphenate   bool   `starlark:"hyphenate"`
+	Truncated   bool   `starlark:"truncated,readonly"`
+	TextStyle
 
 	face font.Face
 }
@@ -55,12 +116,28 @@ func (tw *WrappedText) Init() error {
 		tw.Font = DefaultFontFace
 	}
 
-	face, err := GetFont(tw.Font)
+	switch tw.Overflow {
+	case "", "clip", "ellipsis", "fade":
+	default:
+		return fmt.Errorf("invalid overflow '%s', must be 'clip', 'ellipsis' or 'fade'", tw.Overflow)
+	}
+
+	face, err := GetFontFor(tw.Font, tw.Content)
 	if err != nil {
 		return err
 	}
 
-	tw.face = face
+	tw.face = tw.styledFace(face)
+
+	width, height := tw.Width, tw.Height
+	if width == 0 {
+		width = FrameWidth
+	}
+	if height == 0 {
+		height = FrameHeight
+	}
+	left, top, right, bottom := tw.margins()
+	_, tw.Truncated = tw.layout(width-left-right, height-top-bottom)
 
 	return nil
 }
@@ -87,13 +164,17 @@ func (tw *WrappedText) PaintBounds(bounds image.Rectangle, frameIdx int) image.R
 	dc.SetFontFace(tw.face)
 	w := 0.0
 	h := 0.0
-	for _, line := range dc.WordWrap(tw.Content, float64(width)) {
-		lw, lh := dc.MeasureString(line)
+	left, top, right, bottom := tw.margins()
+	lines, _ := tw.layout(width-left-right, height-top-bottom)
+	for _, line := range lines {
+		lw, lh := dc.MeasureString(line.text)
 		if lw > w {
 			w = lw
 		}
 		h += lh + linespace
 	}
+	w += float64(left + right)
+	h += float64(top + bottom)
 
 	// Size of drawing context
 	if tw.Width != 0 {
@@ -116,36 +197,325 @@ func (tw *WrappedText) PaintBounds(bounds image.Rectangle, frameIdx int) image.R
 }
 
 func (tw *WrappedText) Paint(dc *gg.Context, bounds image.Rectangle, frameIdx int) {
+	pb := tw.PaintBounds(bounds, frameIdx)
+	left, top, right, bottom := tw.margins()
+	width := pb.Dx() - left - right
+
+	metrics := tw.face.Metrics()
+	descent := metrics.Descent.Floor()
+
+	var col color.Color = DefaultFontColor
+	if tw.Color != nil {
+		col = tw.Color
+	}
+	dc.SetFontFace(tw.face)
+	dc.SetColor(col)
+
+	lines, _ := tw.layout(width, pb.Dy()-top-bottom)
+	y := float64(top - descent)
+	for _, line := range lines {
+		if line.fade {
+			// draw the line on its own, so it can be faded out
+			// without touching the lines around it
+			fc := gg.NewContext(pb.Dx(), pb.Dy())
+			fc.SetFontFace(tw.face)
+			var start, end int
+			tw.draw(fc, col, func(dx, dy float64) {
+				start, end = tw.drawLine(fc, line, width, float64(left)+dx, y+dy)
+			})
+			fadeOut(fc.Image().(*image.RGBA), start-left, end+right, line.rtl)
+			dc.DrawImage(fc.Image(), 0, 0)
+		} else {
+			tw.draw(dc, col, func(dx, dy float64) {
+				tw.drawLine(dc, line, width, float64(left)+dx, y+dy)
+			})
+		}
+		y += float64(tw.LineSpacing) + dc.FontHeight()
+	}
+}
+
+// drawLine draws a line of text at y, aligned in width starting at x0,
+// and returns where it starts and ends.
+func (tw *WrappedText) drawLine(dc *gg.Context, line wrappedLine, width int, x0, y float64) (int, int) {
+	// Justified lines have their words spread over the width
+	words := strings.Fields(line.text)
+	if tw.Align == "justify" && !line.last && len(words) > 1 {
+		extra := width
+		for _, word := range words {
+			extra -= tw.measure(word)
+		}
+		gaps := len(words) - 1
+
+		x := 0
+		for i, word := range words {
+			dc.DrawStringAnchored(word, x0+float64(x), y, 0, 1)
+			x += tw.measure(word)
+			if i < gaps {
+				x += extra / gaps
+				if i < extra%gaps {
+					x++
+				}
+			}
+		}
+		return int(x0), int(x0) + width
+	}
+
 	// Text alignment
-	align := gg.AlignLeft
+	x, ax := 0.0, 0.0
 	if tw.Align == "center" {
-		align = gg.AlignCenter
-	} else if tw.Align == "right" {
-		align = gg.AlignRight
+		x, ax = float64(width)/2, 0.5
+	} else if tw.Align == "right" || ((tw.Align == "" || tw.Align == "justify") && line.rtl) {
+		x, ax = float64(width), 1
 	}
 
-	width := tw.PaintBounds(bounds, frameIdx).Dx()
+	dc.DrawStringAnchored(line.text, x0+x, y, ax, 1)
 
-	metrics := tw.face.Metrics()
-	descent := metrics.Descent.Floor()
+	w := tw.measure(line.text)
+	left := int(x0 + x - ax*float64(w))
+	return left, left + w
+}
+
+// fadeOut fades the end of a line of text out, over the pixels from
+// left to right. Right-to-left text ends on the left.
+func fadeOut(im *image.RGBA, left, right int, rtl bool) {
+	left = max(left, im.Rect.Min.X)
+	right = min(right, im.Rect.Max.X)
+
+	fade := min(wrappedTextFade, (right-left)/2)
+	for i := 0; i < fade; i++ {
+		x := right - 1 - i
+		if rtl {
+			x = left + i
+		}
+
+		for y := im.Rect.Min.Y; y < im.Rect.Max.Y; y++ {
+			p := im.PixOffset(x, y)
+			for c := 0; c < 4; c++ {
+				im.Pix[p+c] = uint8(int(im.Pix[p+c]) * (i + 1) / (fade + 1))
+			}
+		}
+	}
+}
+
+// wrappedLine is a line of text in the order it's drawn in.
+type wrappedLine struct {
+	text string
+	rtl  bool
+
+	// last is set on the last line of a paragraph, which isn't
+	// justified
+	last bool
+
+	// hyphen is set if the line ends with a hyphen where a word was
+	// broken
+	hyphen bool
+
+	// fade is set if text after the line was cut, and it should fade
+	// out
+	fade bool
+}
+
+// layout breaks the text into the lines that are drawn in an area of
+// width and height, and tells if some of it didn't fit. Paragraphs are
+// wrapped in logical order, so that right-to-left text breaks after
+// the words that are read first, and each line is then reordered to be
+// drawn left to right.
+func (tw *WrappedText) layout(width, height int) ([]wrappedLine, bool) {
+	var lines []wrappedLine
+	for _, paragraph := range strings.Split(tw.Content, "\n") {
+		rtl := isRightToLeft(paragraph)
+		if hasRightToLeft(paragraph) {
+			paragraph = string(shapeArabic([]rune(paragraph), tw.face))
+		}
+
+		wrapped := tw.wrap(paragraph, width)
+		for i := range wrapped {
+			wrapped[i].rtl = rtl
+			wrapped[i].last = i == len(wrapped)-1
+		}
+		lines = append(lines, wrapped...)
+	}
+
+	// lines past max_lines are left out, and so are lines that don't
+	// fit entirely unless the text is clipped
+	count := len(lines)
+	if tw.MaxLines > 0 && tw.MaxLines < count {
+		count = tw.MaxLines
+	}
 
+	dc := gg.NewContext(0, 0)
 	dc.SetFontFace(tw.face)
-	if tw.Color != nil {
-		dc.SetColor(tw.Color)
-	} else {
-		dc.SetColor(DefaultFontColor)
-	}
-
-	dc.DrawStringWrapped(
-		tw.Content,
-		0,
-		float64(-descent),
-		0,
-		0,
-		float64(width),
-		(float64(tw.LineSpacing)+dc.FontHeight())/dc.FontHeight(),
-		align,
-	)
+	lineHeight := dc.FontHeight()
+	spacing := float64(tw.LineSpacing)
+	textHeight := func(n int) float64 {
+		return float64(n)*lineHeight + float64(n-1)*spacing
+	}
+
+	truncated := count < len(lines)
+	if tw.Overflow == "ellipsis" || tw.Overflow == "fade" {
+		for count > 1 && textHeight(count) > float64(height) {
+			count--
+			truncated = true
+		}
+	} else if count > 0 && textHeight(count) > float64(height) {
+		truncated = true
+	}
+
+	cut := count < len(lines)
+	lines = lines[:count]
+
+	for i := range lines {
+		line := &lines[i]
+		wide := tw.measure(line.text) > width
+		if wide {
+			truncated = true
+		}
+
+		if wide || (cut && i == len(lines)-1) {
+			switch tw.Overflow {
+			case "ellipsis":
+				line.text = tw.ellipsize(*line, width)
+				line.hyphen = false
+				line.last = true
+			case "fade":
+				line.fade = true
+			}
+		}
+
+		if hasRightToLeft(line.text) {
+			line.text = string(visualOrder([]rune(line.text), line.rtl))
+		}
+	}
+
+	return lines, truncated
+}
+
+// wrap breaks a paragraph into lines that fit in width, in logical
+// order. Like gg's word wrapping, words go on the next line if they
+// don't fit, and words longer than a line go on lines of their own.
+// Words are also broken with a hyphen at soft hyphens, which are put
+// where the hyphenator allows if Hyphenate is set, to fill lines better.
+func (tw *WrappedText) wrap(paragraph string, width int) []wrappedLine {
+	// words alternate with the space after them
+	var fields []string
+	start, wasSpace := 0, false
+	for i, c := range paragraph {
+		isSpace := unicode.IsSpace(c)
+		if isSpace != wasSpace && i > 0 {
+			fields = append(fields, paragraph[start:i])
+			start = i
+		}
+		wasSpace = isSpace
+	}
+	fields = append(fields, paragraph[start:])
+	if len(fields)%2 == 1 {
+		fields = append(fields, "")
+	}
+
+	var lines []wrappedLine
+	push := func(text string, hyphen bool) {
+		text = strings.TrimSpace(strings.ReplaceAll(text, string(softHyphen), ""))
+		lines = append(lines, wrappedLine{text: text, hyphen: hyphen})
+	}
+
+	x := ""
+	for i := 0; i < len(fields); i += 2 {
+		word, space := fields[i], fields[i+1]
+		if tw.Hyphenate {
+			word = hyphenate(word)
+		}
+
+		for tw.measure(x+word) > width {
+			if head, tail, ok := tw.breakWord(x, word, width); ok {
+				push(x+head+"-", true)
+				x, word = "", tail
+				continue
+			}
+			if x == "" {
+				break
+			}
+			push(x, false)
+			x = ""
+		}
+
+		if x == "" && tw.measure(word) > width {
+			push(word, false)
+			continue
+		}
+		x += word + space
+	}
+	if x != "" {
+		push(x, false)
+	}
+
+	return lines
+}
+
+// breakWord splits word at the last soft hyphen where the start of it
+// and a hyphen still fit on the line in width.
+func (tw *WrappedText) breakWord(line, word string, width int) (string, string, bool) {
+	runes := []rune(word)
+	for i := len(runes) - 2; i > 0; i-- {
+		if runes[i] != softHyphen {
+			continue
+		}
+		head := string(runes[:i])
+		if tw.measure(line+head+"-") <= width {
+			return head, string(runes[i+1:]), true
+		}
+	}
+	return "", "", false
+}
+
+// hyphenate puts soft hyphens in a word where it can be broken, unless
+// it already has some.
+func hyphenate(word string) string {
+	if strings.ContainsRune(word, softHyphen) {
+		return word
+	}
+
+	runes := []rune(word)
+	var b strings.Builder
+	last := 0
+	for _, p := range hyphenation.Hyphenate(word) {
+		b.WriteString(string(runes[last:p]))
+		b.WriteRune(softHyphen)
+		last = p
+	}
+	b.WriteString(string(runes[last:]))
+	return b.String()
+}
+
+// ellipsize shortens a line so that it fits in width with an ellipsis
+// at its end.
+func (tw *WrappedText) ellipsize(line wrappedLine, width int) string {
+	ellipsis := "…"
+	if !hasGlyph(tw.face, '…') {
+		ellipsis = "..."
+	}
+
+	runes := []rune(line.text)
+	if line.hyphen {
+		runes = runes[:len(runes)-1]
+	}
+	for n := len(runes); n > 0; n-- {
+		text := strings.TrimRightFunc(string(runes[:n]), unicode.IsSpace) + ellipsis
+		if tw.measure(text) <= width {
+			return text
+		}
+	}
+	return ellipsis
+}
+
+// measure returns the width of text in whole pixels, like gg does.
+func (tw *WrappedText) measure(text string) int {
+	text = strings.ReplaceAll(text, string(softHyphen), "")
+	return font.MeasureString(tw.face, text).Floor()
+}
+
+// rightToLeft tells if the text reads from right to left.
+func (tw *WrappedText) rightToLeft() bool {
+	return isRightToLeft(tw.Content)
 }
 
 func (tw *WrappedText) FrameCount() int {
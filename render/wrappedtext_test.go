@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestWrappedTextWithBounds(t *testing.T) {
@@ -239,3 +240,122 @@ func TestWrappedTextMissingFont(t *testing.T) {
 	text := &WrappedText{Content: "AB CD.", Font: "missing"}
 	assert.Error(t, text.Init())
 }
+
+func TestWrappedTextMaxLines(t *testing.T) {
+	text := &WrappedText{Content: "AB CD.", Width: 21, MaxLines: 1}
+	assert.NoError(t, text.Init())
+	assert.True(t, text.Truncated)
+
+	im := PaintWidget(text, image.Rect(0, 0, 40, 40), 0)
+	assert.Equal(t, nil, checkImage([]string{
+		"....." + "........" + "........",
+		".ww.." + "www....." + "........",
+		"w..w." + "w..w...." + "........",
+		"w..w." + "www....." + "........",
+		"wwww." + "w..w...." + "........",
+		"w..w." + "w..w...." + "........",
+		"w..w." + "www....." + "........",
+		"....." + "........" + "........",
+	}, im))
+
+	text = &WrappedText{Content: "AB CD.", MaxLines: 2}
+	assert.NoError(t, text.Init())
+	assert.False(t, text.Truncated)
+}
+
+func TestWrappedTextOverflow(t *testing.T) {
+	text := &WrappedText{Content: "AB CD.", Overflow: "scroll"}
+	assert.ErrorContains(t, text.Init(), "invalid overflow 'scroll'")
+
+	// lines that don't fit entirely are left out, and the last one
+	// ends with an ellipsis
+	text = &WrappedText{Content: "Breaking news from everywhere", Width: 40, Height: 12, Overflow: "ellipsis"}
+	assert.NoError(t, text.Init())
+	assert.True(t, text.Truncated)
+	lines, truncated := text.layout(40, 12)
+	assert.True(t, truncated)
+	require.Len(t, lines, 1)
+	assert.Equal(t, "Breakin…", lines[0].text)
+
+	// words too long for a line are cut too
+	text = &WrappedText{Content: "Supercalifragilistic", Width: 30, Overflow: "ellipsis"}
+	assert.NoError(t, text.Init())
+	assert.True(t, text.Truncated)
+	lines, _ = text.layout(30, 32)
+	assert.Equal(t, "Super…", lines[0].text)
+
+	// text fades out where it's cut
+	text = &WrappedText{Content: "Breaking news from everywhere", Width: 40, Height: 8, Overflow: "fade"}
+	assert.NoError(t, text.Init())
+	im := PaintWidget(text, image.Rect(0, 0, 64, 32), 0).(*image.RGBA)
+	end := text.measure("Breaking")
+	opaque, faded := 0, 0
+	for y := 0; y < 8; y++ {
+		for x := 0; x < 40; x++ {
+			switch a := im.RGBAAt(x, y).A; {
+			case a == 0xff:
+				opaque++
+			case a > 0:
+				faded++
+				assert.GreaterOrEqual(t, x, end-wrappedTextFade)
+			}
+		}
+	}
+	assert.Greater(t, opaque, 0)
+	assert.Greater(t, faded, 0)
+}
+
+func TestWrappedTextJustify(t *testing.T) {
+	text := &WrappedText{Content: "A B C D", Width: 15, Align: "justify"}
+	assert.NoError(t, text.Init())
+	im := PaintWidget(text, image.Rect(0, 0, 64, 32), 0)
+
+	// the space is spread between the words of the first line, but
+	// not the last
+	assert.Equal(t, nil, checkImage([]string{
+		"...............",
+		".ww.......www..",
+		"w..w......w..w.",
+		"w..w......www..",
+		"wwww......w..w.",
+		"w..w......w..w.",
+		"w..w......www..",
+		"...............",
+		"...............",
+		".ww.....www....",
+		"w..w....w..w...",
+		"w.......w..w...",
+		"w.......w..w...",
+		"w..w....w..w...",
+		".ww.....www....",
+		"...............",
+	}, im))
+}
+
+func TestWrappedTextHyphenation(t *testing.T) {
+	// soft hyphens are only drawn where words are broken
+	text := &WrappedText{Content: "Head­line news", Width: 24}
+	assert.NoError(t, text.Init())
+	lines, _ := text.layout(24, 32)
+	assert.Equal(t, []string{"Head-", "line", "news"}, lineTexts(lines))
+	lines, _ = text.layout(64, 32)
+	assert.Equal(t, []string{"Headline news"}, lineTexts(lines))
+
+	// with hyphenation, words are broken to fill lines
+	text = &WrappedText{Content: "The temperature is rising", Width: 40}
+	assert.NoError(t, text.Init())
+	lines, _ = text.layout(40, 32)
+	assert.Equal(t, []string{"The", "temperature", "is rising"}, lineTexts(lines))
+
+	text.Hyphenate = true
+	lines, _ = text.layout(40, 32)
+	assert.Equal(t, []string{"The tem-", "perature", "is rising"}, lineTexts(lines))
+}
+
+func lineTexts(lines []wrappedLine) []string {
+	var texts []string
+	for _, line := range lines {
+		texts = append(texts, line.text)
+	}
+	return texts
+}
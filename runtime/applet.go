@@ -8,6 +8,7 @@ import (
 	"path"
 	"runtime/debug"
 	"slices"
+	"sort"
 	"strings"
 	"testing"
 	"testing/fstest"
@@ -30,19 +31,25 @@ import (
 
 	"tidbyt.dev/pixlet/render"
 	"tidbyt.dev/pixlet/runtime/modules/animation_runtime"
+	"tidbyt.dev/pixlet/runtime/modules/barcode"
+	"tidbyt.dev/pixlet/runtime/modules/color"
 	"tidbyt.dev/pixlet/runtime/modules/file"
 	"tidbyt.dev/pixlet/runtime/modules/hmac"
 	"tidbyt.dev/pixlet/runtime/modules/humanize"
+	"tidbyt.dev/pixlet/runtime/modules/jsonpath"
 	"tidbyt.dev/pixlet/runtime/modules/qrcode"
 	"tidbyt.dev/pixlet/runtime/modules/random"
 	"tidbyt.dev/pixlet/runtime/modules/render_runtime"
 	"tidbyt.dev/pixlet/runtime/modules/starlarkhttp"
+	"tidbyt.dev/pixlet/runtime/modules/stats"
 	"tidbyt.dev/pixlet/runtime/modules/sunrise"
 	"tidbyt.dev/pixlet/runtime/modules/xpath"
 	"tidbyt.dev/pixlet/schema"
 	"tidbyt.dev/pixlet/starlarkutil"
 )
 
+const threadInstallationIDKey = "tidbyt.dev/pixlet/runtime/installation_id"
+
 type ModuleLoader func(*starlark.Thread, string) (starlark.StringDict, error)
 
 type PrintFunc func(thread *starlark.Thread, msg string)
@@ -59,9 +66,12 @@ type Applet struct {
 	Globals  map[string]starlark.StringDict
 	MainFile string
 
-	loader       ModuleLoader
-	initializers []ThreadInitializer
-	loadedPaths  map[string]bool
+	loader         ModuleLoader
+	initializers   []ThreadInitializer
+	loadedPaths    map[string]bool
+	allowedModules map[string]bool
+	loadedModules  map[string]bool
+	fonts          []string
 
 	mainFun    *starlark.Function
 	schemaFile string
@@ -77,6 +87,40 @@ func WithModuleLoader(loader ModuleLoader) AppletOption {
 	}
 }
 
+// WithAllowedModules restricts the modules that the applet can load to
+// the given names, such as "render.star". Loading any other module fails.
+// The restriction applies to modules resolved by a custom module loader
+// too, but not to files in the applet's filesystem.
+func WithAllowedModules(modules ...string) AppletOption {
+	return func(a *Applet) error {
+		a.allowedModules = make(map[string]bool, len(modules))
+		for _, m := range modules {
+			a.allowedModules[m] = true
+		}
+		return nil
+	}
+}
+
+// WithInstallationID sets the ID of the installation of the app that is
+// being run. State kept by the app, and its cache entries unless they're
+// explicitly shared, are scoped to the installation.
+func WithInstallationID(id string) AppletOption {
+	return func(a *Applet) error {
+		a.initializers = append(a.initializers, func(t *starlark.Thread) *starlark.Thread {
+			t.SetLocal(threadInstallationIDKey, id)
+			return t
+		})
+		return nil
+	}
+}
+
+// InstallationID returns the ID of the installation that a thread runs,
+// or an empty string if it wasn't set with WithInstallationID.
+func InstallationID(thread *starlark.Thread) string {
+	id, _ := thread.Local(threadInstallationIDKey).(string)
+	return id
+}
+
 func WithThreadInitializer(init ThreadInitializer) AppletOption {
 	return func(a *Applet) error {
 		a.initializers = append(a.initializers, init)
@@ -129,9 +173,10 @@ func NewApplet(id string, src []byte, opts ...AppletOption) (*Applet, error) {
 
 func NewAppletFromFS(id string, fsys fs.FS, opts ...AppletOption) (*Applet, error) {
 	a := &Applet{
-		ID:          id,
-		Globals:     make(map[string]starlark.StringDict),
-		loadedPaths: make(map[string]bool),
+		ID:            id,
+		Globals:       make(map[string]starlark.StringDict),
+		loadedPaths:   make(map[string]bool),
+		loadedModules: make(map[string]bool),
 	}
 
 	for _, opt := range opts {
@@ -141,12 +186,22 @@ func NewAppletFromFS(id string, fsys fs.FS, opts ...AppletOption) (*Applet, erro
 	}
 
 	if err := a.load(fsys); err != nil {
+		a.Close()
 		return nil, err
 	}
 
 	return a, nil
 }
 
+// Close releases the fonts that the applet bundles. Roots returned by the
+// applet can't be rendered once it's closed.
+func (a *Applet) Close() {
+	for _, name := range a.fonts {
+		render.ReleaseFont(name)
+	}
+	a.fonts = nil
+}
+
 // Run executes the applet's main function. It returns the render roots that are
 // returned by the applet.
 func (a *Applet) Run(ctx context.Context) (roots []render.Root, err error) {
@@ -330,6 +385,17 @@ func (a *Applet) PathsForBundle() []string {
 	return paths
 }
 
+// Modules returns the sorted names of the modules that the applet loads,
+// not including files in the applet's filesystem.
+func (a *Applet) Modules() []string {
+	modules := make([]string, 0, len(a.loadedModules))
+	for m := range a.loadedModules {
+		modules = append(modules, m)
+	}
+	sort.Strings(modules)
+	return modules
+}
+
 func (a *Applet) load(fsys fs.FS) (err error) {
 	// list files in the root directory of fsys
 	rootDir, err := fs.ReadDir(fsys, ".")
@@ -470,6 +536,23 @@ func (a *Applet) ensureLoaded(fsys fs.FS, pathToLoad string, currentlyLoading ..
 			}
 		}
 
+	case ".bdf", ".pcf", ".ttf", ".otf":
+		// fonts can be used as files too, but are mostly loaded to be
+		// passed to widgets by name
+		name, err := render.AddFont(pathToLoad, src)
+		if err != nil {
+			return fmt.Errorf("loading font %s: %w", pathToLoad, err)
+		}
+		a.fonts = append(a.fonts, name)
+
+		a.Globals[pathToLoad] = starlark.StringDict{
+			"file": &file.File{
+				FS:   fsys,
+				Path: pathToLoad,
+			},
+			"font": starlark.String(name),
+		}
+
 	default:
 		a.Globals[pathToLoad] = starlark.StringDict{
 			"file": &file.File{
@@ -502,41 +585,56 @@ func (a *Applet) newThread(ctx context.Context) *starlark.Thread {
 }
 
 func (a *Applet) loadModule(thread *starlark.Thread, module string) (starlark.StringDict, error) {
+	if a.allowedModules != nil && !a.allowedModules[module] {
+		return nil, fmt.Errorf("module %s is not allowed for %s", module, a.ID)
+	}
+
 	if a.loader != nil {
 		mod, err := a.loader(thread, module)
 		if err == nil {
+			a.loadedModules[module] = true
 			return mod, nil
 		}
 	}
 
-	switch module {
-	case "render.star":
-		return render_runtime.LoadRenderModule()
-
-	case "animation.star":
-		return animation_runtime.LoadAnimationModule()
-
-	case "schema.star":
-		return schema.LoadModule()
-
-	case "cache.star":
-		return LoadCacheModule()
-
-	case "secret.star":
-		return LoadSecretModule()
-
-	case "xpath.star":
-		return xpath.LoadXPathModule()
+	mod, err := LoadBuiltinModule(module)
+	if err != nil {
+		return nil, err
+	}
+	a.loadedModules[module] = true
+	return mod, nil
+}
 
-	case "bsoup.star":
-		return starlibbsoup.LoadModule()
+// PrivilegedModules are the built-in modules that reach outside of the
+// applet, to the network, to secrets or to archives of files. Hosts may
+// want to disallow them for untrusted applets.
+var PrivilegedModules = []string{
+	"compress/zipfile.star",
+	"http.star",
+	"secret.star",
+}
 
-	case "compress/gzip.star":
+// builtinModules maps the names of the modules that apps can load to the
+// functions that load them.
+var builtinModules = map[string]func() (starlark.StringDict, error){
+	"render.star":    render_runtime.LoadRenderModule,
+	"animation.star": animation_runtime.LoadAnimationModule,
+	"barcode.star":   barcode.LoadModule,
+	"schema.star":    schema.LoadModule,
+	"cache.star":     LoadCacheModule,
+	"color.star":     color.LoadModule,
+	"store.star":     LoadStoreModule,
+	"secret.star":    LoadSecretModule,
+	"xpath.star":     xpath.LoadXPathModule,
+	"bsoup.star":     starlibbsoup.LoadModule,
+
+	"compress/gzip.star": func() (starlark.StringDict, error) {
 		return starlark.StringDict{
 			starlibgzip.Module.Name: starlibgzip.Module,
 		}, nil
+	},
 
-	case "compress/zipfile.star":
+	"compress/zipfile.star": func() (starlark.StringDict, error) {
 		// Starlib expects you to load the ZipFile function directly, rather than having it be part of a namespace.
 		// Wraps this to be more consistent with other pixlet modules, as follows:
 		//   load("compress/zipfile.star", "zipfile")
@@ -548,59 +646,62 @@ func (a *Applet) loadModule(thread *starlark.Thread, module string) (starlark.St
 				Members: m,
 			},
 		}, nil
+	},
 
-	case "encoding/base64.star":
-		return starlibbase64.LoadModule()
-
-	case "encoding/csv.star":
-		return starlibcsv.LoadModule()
+	"encoding/base64.star": starlibbase64.LoadModule,
+	"encoding/csv.star":    starlibcsv.LoadModule,
 
-	case "encoding/json.star":
+	"encoding/json.star": func() (starlark.StringDict, error) {
 		return starlark.StringDict{
 			starlibjson.Module.Name: starlibjson.Module,
 		}, nil
+	},
 
-	case "hash.star":
-		return starlibhash.LoadModule()
-
-	case "hmac.star":
-		return hmac.LoadModule()
-
-	case "http.star":
-		return starlarkhttp.LoadModule()
-
-	case "html.star":
-		return starlibhtml.LoadModule()
+	"hash.star":     starlibhash.LoadModule,
+	"hmac.star":     hmac.LoadModule,
+	"http.star":     starlarkhttp.LoadModule,
+	"html.star":     starlibhtml.LoadModule,
+	"humanize.star": humanize.LoadModule,
+	"jsonpath.star": jsonpath.LoadModule,
 
-	case "humanize.star":
-		return humanize.LoadModule()
-
-	case "math.star":
+	"math.star": func() (starlark.StringDict, error) {
 		return starlark.StringDict{
 			starlibmath.Module.Name: starlibmath.Module,
 		}, nil
+	},
 
-	case "re.star":
-		return starlibre.LoadModule()
-
-	case "sunrise.star":
-		return sunrise.LoadModule()
+	"re.star":      starlibre.LoadModule,
+	"stats.star":   stats.LoadModule,
+	"sunrise.star": sunrise.LoadModule,
 
-	case "time.star":
+	"time.star": func() (starlark.StringDict, error) {
 		return starlark.StringDict{
 			starlibtime.Module.Name: starlibtime.Module,
 		}, nil
+	},
 
-	case "random.star":
-		return random.LoadModule()
-
-	case "qrcode.star":
-		return qrcode.LoadModule()
+	"random.star": random.LoadModule,
+	"qrcode.star": qrcode.LoadModule,
+	"assert.star": starlarktest.LoadAssertModule,
+}
 
-	case "assert.star":
-		return starlarktest.LoadAssertModule()
+// ModuleNames returns the sorted names of all built-in modules that apps
+// can load.
+func ModuleNames() []string {
+	names := make([]string, 0, len(builtinModules))
+	for name := range builtinModules {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
 
-	default:
-		return nil, fmt.Errorf("invalid module: %s", module)
+// LoadBuiltinModule loads the built-in module with the given name, such as
+// "render.star".
+func LoadBuiltinModule(name string) (starlark.StringDict, error) {
+	load, ok := builtinModules[name]
+	if !ok {
+		return nil, fmt.Errorf("invalid module: %s", name)
 	}
+	return load()
 }
@@ -6,6 +6,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"image"
 	"testing"
 	"testing/fstest"
 
@@ -13,7 +14,9 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.starlark.net/starlark"
+	"golang.org/x/image/font/gofont/goregular"
 
+	"tidbyt.dev/pixlet/render"
 	"tidbyt.dev/pixlet/schema"
 )
 
@@ -279,6 +282,74 @@ def main():
 	assert.Equal(t, 1, len(roots))
 }
 
+func TestAllowedModules(t *testing.T) {
+	src := `
+load("render.star", "render")
+load("http.star", "http")
+
+def main():
+    return render.Root(child=render.Box())
+`
+
+	// allowed modules load as usual
+	app, err := NewApplet("test.star", []byte(src), WithAllowedModules("render.star", "http.star"))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"http.star", "render.star"}, app.Modules())
+
+	// others fail to load
+	_, err = NewApplet("test.star", []byte(src), WithAllowedModules("render.star"))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "cannot load http.star: module http.star is not allowed for test.star")
+
+	// the restriction applies to custom loaders too
+	loader := func(thread *starlark.Thread, module string) (starlark.StringDict, error) {
+		if module == "hello.star" {
+			return starlibbase64.LoadModule()
+		}
+		return nil, fmt.Errorf("invalid module: %s", module)
+	}
+	src = `
+load("render.star", "render")
+load("hello.star", "base64")
+
+def main():
+    return render.Root(child=render.Box())
+`
+	_, err = NewApplet("test.star", []byte(src), WithModuleLoader(loader), WithAllowedModules("render.star"))
+	assert.ErrorContains(t, err, "module hello.star is not allowed")
+
+	// but files in the applet's filesystem can always be loaded
+	vfs := fstest.MapFS{
+		"main.star": {Data: []byte(`
+load("render.star", "render")
+load("lib.star", "greeting")
+
+def main():
+    return render.Root(child=render.Text(greeting))
+`)},
+		"lib.star": {Data: []byte(`greeting = "hi"`)},
+	}
+	app, err = NewAppletFromFS("test", vfs, WithAllowedModules("render.star"))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"render.star"}, app.Modules())
+
+	// modules that fail to load aren't recorded
+	_, err = app.loadModule(nil, "http.star")
+	assert.ErrorContains(t, err, "not allowed")
+	assert.Equal(t, []string{"render.star"}, app.Modules())
+
+	app, err = NewApplet("test.star", []byte(`
+load("render.star", "render")
+
+def main():
+    return render.Root(child=render.Box())
+`))
+	require.NoError(t, err)
+	_, err = app.loadModule(nil, "nope.star")
+	assert.ErrorContains(t, err, "invalid module: nope.star")
+	assert.Equal(t, []string{"render.star"}, app.Modules())
+}
+
 func TestDependency(t *testing.T) {
 	// src.star depends on hello.star
 	src := `
@@ -453,4 +524,93 @@ def main():
 	app.RunTests(t)
 }
 
+func TestLoadFont(t *testing.T) {
+	src := `
+load("render.star", "render")
+load("fonts/tiny.bdf", tiny = "font", tiny_file = "file")
+
+def main():
+	if not tiny_file.readall().startswith("STARTFONT"):
+		fail("font can't be read as a file")
+	return render.Root(child = render.Row(children = [
+		render.Text("x", font = tiny),
+		render.WrappedText("x", font = tiny),
+		render.Marquee(width = 4, child = render.Text("x", font = tiny)),
+	]))
+`
+
+	tinyBDF := `STARTFONT 2.1
+FONT tiny
+SIZE 4 75 75
+FONTBOUNDINGBOX 3 3 0 0
+STARTPROPERTIES 2
+FONT_ASCENT 3
+FONT_DESCENT 0
+ENDPROPERTIES
+CHARS 1
+STARTCHAR x
+ENCODING 120
+DWIDTH 4 0
+BBX 3 3 0 0
+BITMAP
+A0
+40
+A0
+ENDCHAR
+ENDFONT
+`
+
+	vfs := fstest.MapFS{
+		"main.star":      {Data: []byte(src)},
+		"fonts/tiny.bdf": {Data: []byte(tinyBDF)},
+		"fonts/big.bdf":  {Data: []byte("unused")},
+	}
+
+	app, err := NewAppletFromFS("test_load_font", vfs)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"main.star", "fonts/tiny.bdf"}, app.PathsForBundle())
+
+	roots, err := app.Run(context.Background())
+	require.NoError(t, err)
+	require.Len(t, roots, 1)
+
+	im := render.PaintWidget(roots[0].Child, image.Rect(0, 0, 12, 3), 0)
+	assert.Equal(t, nil, render.CheckImage([]string{
+		"w.w." + "w.w." + "w.w.",
+		".w.." + ".w.." + ".w..",
+		"w.w." + "w.w." + "w.w.",
+	}, im))
+
+	// scalable fonts are drawn at the size that follows their name
+	vfs["main.star"] = &fstest.MapFile{Data: []byte(`
+load("render.star", "render")
+load("go.ttf", go_font = "font")
+
+def main():
+	return render.Root(child = render.Text("Go", font = go_font + "@10"))
+`)}
+	vfs["go.ttf"] = &fstest.MapFile{Data: goregular.TTF}
+	app, err = NewAppletFromFS("test_load_font", vfs)
+	require.NoError(t, err)
+	assert.Contains(t, app.PathsForBundle(), "go.ttf")
+
+	roots, err = app.Run(context.Background())
+	require.NoError(t, err)
+	require.Len(t, roots, 1)
+	im = render.PaintWidget(roots[0].Child, image.Rect(0, 0, 64, 32), 0)
+	assert.Equal(t, 13, im.Bounds().Dy())
+
+	// and released along with the applet
+	name := string(app.Globals["go.ttf"]["font"].(starlark.String))
+	app.Close()
+	_, err = render.GetFont(name + "@10")
+	assert.ErrorContains(t, err, "unknown font")
+
+	// fonts that can't be parsed fail to load
+	vfs["main.star"] = &fstest.MapFile{Data: []byte(src)}
+	vfs["fonts/tiny.bdf"] = &fstest.MapFile{Data: []byte("STARTFONT 2.1\nCHARS x\n")}
+	_, err = NewAppletFromFS("test_load_font", vfs)
+	assert.ErrorContains(t, err, "loading font fonts/tiny.bdf")
+}
+
 // TODO: test Screens, especially Screens.Render()
@@ -3,6 +3,7 @@ package runtime
 import (
 	"fmt"
 	"log"
+	"net/url"
 	"sync"
 	"time"
 
@@ -86,22 +87,36 @@ func LoadCacheModule() (starlark.StringDict, error) {
 	return cacheModule, nil
 }
 
-func scopedCacheKey(thread *starlark.Thread, key starlark.String) string {
-	return fmt.Sprintf("pixlet:%s:%s", thread.Name, key.GoString())
+// scopedCacheKey namespaces a key to the installation that a thread runs,
+// so that installations of an app can't see each other's data. Shared
+// keys, and keys of threads without an installation ID, are namespaced
+// to the app only.
+func scopedCacheKey(thread *starlark.Thread, key starlark.String, shared bool) string {
+	id := InstallationID(thread)
+	if shared || id == "" {
+		return fmt.Sprintf("pixlet:%s:%s", thread.Name, key.GoString())
+	}
+
+	// the ID is escaped, so that it can't contain the separator
+	return fmt.Sprintf("pixlet:%s#%s:%s", thread.Name, url.QueryEscape(id), key.GoString())
 }
 
 func cacheGet(thread *starlark.Thread, _ *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
-	var key starlark.String
+	var (
+		key    starlark.String
+		shared bool
+	)
 
 	if err := starlark.UnpackArgs(
 		"get",
 		args, kwargs,
 		"key", &key,
+		"shared?", &shared,
 	); err != nil {
 		return nil, fmt.Errorf("unpacking arguments for cache.get: %v", err)
 	}
 
-	cacheKey := scopedCacheKey(thread, key)
+	cacheKey := scopedCacheKey(thread, key, shared)
 
 	if cache == nil {
 		// no cache configured
@@ -125,9 +140,10 @@ func cacheGet(thread *starlark.Thread, _ *starlark.Builtin, args starlark.Tuple,
 
 func cacheSet(thread *starlark.Thread, _ *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
 	var (
-		key starlark.String
-		val starlark.String
-		ttl starlark.Int
+		key    starlark.String
+		val    starlark.String
+		ttl    starlark.Int
+		shared bool
 	)
 
 	if err := starlark.UnpackArgs(
@@ -136,11 +152,12 @@ func cacheSet(thread *starlark.Thread, _ *starlark.Builtin, args starlark.Tuple,
 		"key", &key,
 		"value", &val,
 		"ttl_seconds?", &ttl,
+		"shared?", &shared,
 	); err != nil {
 		return nil, fmt.Errorf("unpacking arguments for cache.set: %v", err)
 	}
 
-	cacheKey := scopedCacheKey(thread, key)
+	cacheKey := scopedCacheKey(thread, key, shared)
 
 	ttl64, ok := ttl.Int64()
 	if !ok {
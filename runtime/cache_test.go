@@ -5,6 +5,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.starlark.net/starlark"
 )
 
 func TestCacheGetAndSet(t *testing.T) {
@@ -87,6 +89,46 @@ def main():
 
 }
 
+func TestCacheInstallationScope(t *testing.T) {
+	src := `
+load("render.star", "render")
+load("cache.star", "cache")
+
+def main(config):
+    if config.get("set"):
+        cache.set("secret", config["set"])
+        cache.set("everyone", config["set"], shared = True)
+    secret = cache.get("secret") or ""
+    everyone = cache.get("everyone", shared = True) or ""
+    return [render.Root(child=render.Box()) for _ in range(len(secret) + 10 * len(everyone))]
+`
+	InitCache(NewInMemoryCache())
+
+	run := func(id string, config map[string]string) int {
+		app, err := NewApplet("test.star", []byte(src), WithInstallationID(id))
+		require.NoError(t, err)
+		roots, err := app.RunWithConfig(context.Background(), config)
+		require.NoError(t, err)
+		return len(roots)
+	}
+
+	assert.Equal(t, 1+10, run("alice", map[string]string{"set": "a"}))
+
+	// installations don't see each other's keys, but do see shared ones
+	assert.Equal(t, 10, run("bob", nil))
+	assert.Equal(t, 2+20, run("bob", map[string]string{"set": "bb"}))
+	assert.Equal(t, 1+20, run("alice", nil))
+
+	// IDs are escaped, so that they can't be used to reach into other
+	// installations
+	key := func(id, key string) string {
+		thread := &starlark.Thread{Name: "test.star"}
+		thread.SetLocal(threadInstallationIDKey, id)
+		return scopedCacheKey(thread, starlark.String(key), false)
+	}
+	assert.NotEqual(t, key("a:b", "c"), key("a", "b:c"))
+}
+
 func TestCacheNoInit(t *testing.T) {
 	src := `
 load("render.star", "render")
@@ -13,8 +13,10 @@ import (
 	"go/format"
 	"go/parser"
 	"go/token"
+	"image"
 	"image/color"
 	"os"
+	"path/filepath"
 	"reflect"
 	"regexp"
 	"sort"
@@ -48,6 +50,7 @@ type Package struct {
 	CodePath       string
 	DocTemplate    string
 	DocPath        string
+	MetadataPath   string
 	GoRootName     string
 	GoWidgetName   string
 	Types          []reflect.Value
@@ -64,22 +67,35 @@ var Packages = []Package{
 		CodePath:       "./runtime/modules/render_runtime/generated.go",
 		DocTemplate:    "./runtime/gen/docs/render.tmpl",
 		DocPath:        "./docs/widgets.md",
+		MetadataPath:   "./runtime/modules/render_runtime/generated_metadata.go",
 		GoRootName:     "Root",
 		GoWidgetName:   "Widget",
 		Types: []reflect.Value{
 			reflect.ValueOf(new(render.Animation)),
+			reflect.ValueOf(new(render.BarChart)),
 			reflect.ValueOf(new(render.Box)),
 			reflect.ValueOf(new(render.Circle)),
 			reflect.ValueOf(new(render.Column)),
+			reflect.ValueOf(new(render.Flex)),
+			reflect.ValueOf(new(render.Gauge)),
 			reflect.ValueOf(new(render.Image)),
 			reflect.ValueOf(new(render.Marquee)),
 			reflect.ValueOf(new(render.Padding)),
 			reflect.ValueOf(new(render.PieChart)),
 			reflect.ValueOf(new(render.Plot)),
+			reflect.ValueOf(new(render.PlotBand)),
+			reflect.ValueOf(new(render.PlotLine)),
+			reflect.ValueOf(new(render.PlotSeries)),
+			reflect.ValueOf(new(render.ProgressBar)),
+			reflect.ValueOf(new(render.RichText)),
 			reflect.ValueOf(new(render.Root)),
 			reflect.ValueOf(new(render.Row)),
 			reflect.ValueOf(new(render.Sequence)),
+			reflect.ValueOf(new(render.Span)),
 			reflect.ValueOf(new(render.Stack)),
+			reflect.ValueOf(new(render.Table)),
+			reflect.ValueOf(new(render.TableCell)),
+			reflect.ValueOf(new(render.TableColumn)),
 			reflect.ValueOf(new(render.Text)),
 			reflect.ValueOf(new(render.WrappedText)),
 		},
@@ -93,6 +109,7 @@ var Packages = []Package{
 		CodePath:       "./runtime/modules/animation_runtime/generated.go",
 		DocTemplate:    "./runtime/gen/docs/animation.tmpl",
 		DocPath:        "./docs/animation.md",
+		MetadataPath:   "./runtime/modules/animation_runtime/generated_metadata.go",
 		GoRootName:     "render_runtime.Root",
 		GoWidgetName:   "render_runtime.Widget",
 		Types: []reflect.Value{
@@ -152,6 +169,11 @@ var TypeMap = map[reflect.Type]Type{
 		DocType:      "int / (int, int, int, int)",
 		TemplatePath: "./runtime/gen/attr/insets.tmpl",
 	},
+	toDecayedType(new(image.Point)): {
+		GoType:       "starlark.Value",
+		DocType:      "int / (int, int)",
+		TemplatePath: "./runtime/gen/attr/offset.tmpl",
+	},
 	toDecayedType(new(render.Widget)): {
 		GoType:       "starlark.Value",
 		DocType:      "Widget",
@@ -183,6 +205,14 @@ var TypeMap = map[reflect.Type]Type{
 		GenerateField: true,
 	},
 
+	// Render `BarChart` types
+	toDecayedType(new([][]float64)): {
+		GoType:        "*starlark.List",
+		DocType:       `[float] / [[float]]`,
+		TemplatePath:  "./runtime/gen/attr/weight_series.tmpl",
+		GenerateField: true,
+	},
+
 	// Render `Plot` types`
 	toDecayedType(new([2]float64)): {
 		GoType:       "starlark.Tuple",
@@ -194,6 +224,45 @@ var TypeMap = map[reflect.Type]Type{
 		DocType:      "[(float, float)]",
 		TemplatePath: "./runtime/gen/attr/dataseries.tmpl",
 	},
+	toDecayedType(new([]render.PlotSeries)): {
+		GoType:       "*starlark.List",
+		DocType:      "[PlotSeries]",
+		TemplatePath: "./runtime/gen/attr/plot_series.tmpl",
+	},
+	toDecayedType(new([]render.PlotLine)): {
+		GoType:       "*starlark.List",
+		DocType:      "[PlotLine]",
+		TemplatePath: "./runtime/gen/attr/plot_lines.tmpl",
+	},
+	toDecayedType(new([]render.PlotBand)): {
+		GoType:       "*starlark.List",
+		DocType:      "[PlotBand]",
+		TemplatePath: "./runtime/gen/attr/plot_bands.tmpl",
+	},
+
+	// Render `RichText` types
+	toDecayedType(new([]render.Span)): {
+		GoType:       "*starlark.List",
+		DocType:      "[Span / str]",
+		TemplatePath: "./runtime/gen/attr/spans.tmpl",
+	},
+
+	// Render `Table` types
+	toDecayedType(new(render.ColumnWidth)): {
+		GoType:       "starlark.Value",
+		DocType:      "int / str",
+		TemplatePath: "./runtime/gen/attr/column_width.tmpl",
+	},
+	toDecayedType(new([]render.TableColumn)): {
+		GoType:       "*starlark.List",
+		DocType:      "[TableColumn]",
+		TemplatePath: "./runtime/gen/attr/columns.tmpl",
+	},
+	toDecayedType(new([][]render.TableCell)): {
+		GoType:       "*starlark.List",
+		DocType:      "[[Widget / TableCell]]",
+		TemplatePath: "./runtime/gen/attr/rows.tmpl",
+	},
 
 	// Animation types
 	toDecayedType(new(animation.Origin)): {
@@ -251,6 +320,7 @@ type GeneratedAttr struct {
 	GenerateField bool
 	IsRequired    bool
 	IsReadOnly    bool
+	DefaultNaN    bool
 
 	// Template and generated code for handling this attribute.
 	Template *template.Template
@@ -312,6 +382,7 @@ func toGeneratedAttribute(typ reflect.Type, field reflect.StructField) (*Generat
 	// Additional supported flags:
 	//   * "required" - field is required on instantiation
 	//   * "readonly" - field is read-only, and not passed to constructor
+	//   * "nan" - float field is NaN unless given, to tell it apart from 0
 	//
 	if tag, ok := field.Tag.Lookup("starlark"); ok {
 		attrs := strings.Split(tag, ",")
@@ -327,6 +398,8 @@ func toGeneratedAttribute(typ reflect.Type, field reflect.StructField) (*Generat
 				result.IsRequired = true
 			} else if attr == "readonly" {
 				result.IsReadOnly = true
+			} else if attr == "nan" {
+				result.DefaultNaN = true
 			} else {
 				return nil, fmt.Errorf("%s.%s has unsupported tag attribute: '%s'", typ.Name(), field.Name, attr)
 			}
@@ -518,6 +591,29 @@ func generateDocs(pkg Package, types []*GeneratedType) {
 	renderTemplateToFile(template, types, pkg.DocPath)
 }
 
+func generateMetadata(pkg Package, types []*GeneratedType) {
+	tmpl := loadTemplate("metadata", "./runtime/gen/metadata.tmpl")
+
+	// Metadata types are declared in render_runtime, and referenced with
+	// the same qualifier as the widget interface.
+	data := struct {
+		Package string
+		Prefix  string
+		Types   []*GeneratedType
+	}{
+		Package: filepath.Base(filepath.Dir(pkg.MetadataPath)),
+		Prefix:  strings.TrimSuffix(pkg.GoWidgetName, "Widget"),
+		Types:   types,
+	}
+
+	var buf bytes.Buffer
+	renderTemplateToBuffer(tmpl, data, &buf)
+
+	source, err := format.Source(buf.Bytes())
+	nilOrPanic(err)
+	nilOrPanic(os.WriteFile(pkg.MetadataPath, source, 0644))
+}
+
 func main() {
 	// Generate code and documentation for each package.
 	for _, pkg := range Packages {
@@ -538,5 +634,6 @@ func main() {
 		attachDocs(pkg, types)
 		generateCode(pkg, types)
 		generateDocs(pkg, types)
+		generateMetadata(pkg, types)
 	}
 }
@@ -44,9 +44,34 @@ var cacheableStatusCodes = map[int]bool{
 	501: true,
 }
 
+// Values of the tidbyt-cache-status header, which tells apps where a
+// response came from.
+const (
+	// CacheStatusHit is a fresh response from the cache.
+	CacheStatusHit = "HIT"
+	// CacheStatusMiss is a response from the server.
+	CacheStatusMiss = "MISS"
+	// CacheStatusRevalidated is a response from the cache, after the server
+	// confirmed it's still current.
+	CacheStatusRevalidated = "REVALIDATED"
+	// CacheStatusStale is an expired response from the cache, served
+	// because the server couldn't be reached or failed.
+	CacheStatusStale = "STALE"
+)
+
+// StaleTTL is how long expired responses are kept around, to be
+// revalidated or served if the server fails, unless the response says
+// otherwise with stale-if-error.
+const StaleTTL = 1 * time.Hour
+
+// freshUntilHeader is added to responses in the cache to record when they
+// expire. It's removed before responses are handed to apps.
+const freshUntilHeader = "X-Tidbyt-Cache-Fresh-Until"
+
 type cacheClient struct {
 	cache     Cache
 	transport http.RoundTripper
+	now       func() time.Time
 }
 
 func InitHTTP(cache Cache) {
@@ -60,14 +85,29 @@ func InitHTTP(cache Cache) {
 		Timeout:   HTTPTimeout * 2,
 	}
 	starlarkhttp.StarlarkHTTPClient = httpClient
+	starlarkhttp.MaxTimeout = HTTPTimeout
 }
 
 // RoundTrip is an approximation of what our internal HTTP proxy does. It should
 // behave the same way, and any discrepancy should be considered a bug.
+//
+// Fresh responses are served from the cache. Expired responses to GET and
+// HEAD requests with an ETag or Last-Modified header are revalidated with
+// a conditional request, and served from the cache if the server responds
+// with 304 Not Modified.
+// If the server can't be reached or fails, expired responses are served
+// as long as stale-if-error allows it.
 func (c *cacheClient) RoundTrip(req *http.Request) (*http.Response, error) {
 	ctx := req.Context()
+	opts := starlarkhttp.TransportOptionsFrom(ctx)
 
-	ctx, cancel := context.WithTimeout(ctx, HTTPTimeout)
+	// requests can ask for a shorter timeout, but not a longer one
+	timeout := HTTPTimeout
+	if opts.Timeout > 0 && opts.Timeout < timeout {
+		timeout = opts.Timeout
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel() // need to do this to not leak a goroutine
 
 	key, err := cacheKey(req)
@@ -75,37 +115,232 @@ func (c *cacheClient) RoundTrip(req *http.Request) (*http.Response, error) {
 		return nil, fmt.Errorf("failed to generate cache key: %w", err)
 	}
 
-	if req.Method == "GET" || req.Method == "HEAD" || req.Method == "POST" {
+	cacheable := req.Method == "GET" || req.Method == "HEAD" || req.Method == "POST"
+	if _, noStore := parseCacheControl(req.Header.Get("Cache-Control"))["no-store"]; noStore {
+		cacheable = false
+	}
+
+	// retries are made because of an earlier response, which may well be
+	// the one in the cache
+	var stored *http.Response
+	if cacheable && !opts.Retry {
 		b, exists, err := c.cache.Get(nil, key)
 		if exists && err == nil {
 			if res, err := http.ReadResponse(bufio.NewReader(bytes.NewReader(b)), req); err == nil {
-				res.Header.Set("tidbyt-cache-status", "HIT")
-				return res, nil
+				if c.fresh(res) {
+					return cacheResponse(res, CacheStatusHit), nil
+				}
+				stored = res
 			}
 		}
 	}
 
-	resp, err := c.transport.RoundTrip(req.WithContext(ctx))
+	outReq := req
+	if stored != nil {
+		outReq = conditionalRequest(req, stored)
+	}
+
+	resp, err := c.transport.RoundTrip(outReq.WithContext(ctx))
+
+	if stored != nil {
+		if err == nil && resp.StatusCode == http.StatusNotModified && outReq != req {
+			resp.Body.Close()
+			return c.revalidate(key, req, stored, resp)
+		}
+
+		if (err != nil || resp.StatusCode >= 500) && c.canServeStale(stored) {
+			if resp != nil {
+				resp.Body.Close()
+			}
+			return cacheResponse(stored, CacheStatusStale), nil
+		}
+	}
+
 	if err == nil {
 		resp.Body = http.MaxBytesReader(nil, resp.Body, MaxResponseBytes)
 	}
 
-	if err == nil && (req.Method == "GET" || req.Method == "HEAD" || req.Method == "POST") {
-		ser, err := httputil.DumpResponse(resp, true)
-		if err != nil {
-			// if httputil.DumpResponse fails, it leaves the response body in an
-			// undefined state, so we cannot continue
-			return nil, fmt.Errorf("failed to serialize response for cache: %s", resp.Status)
+	if err == nil && cacheable {
+		if err := c.store(key, req, resp); err != nil {
+			return nil, err
 		}
-
-		ttl := DetermineTTL(req, resp)
-		c.cache.Set(nil, key, ser, int64(ttl.Seconds()))
-		resp.Header.Set("tidbyt-cache-status", "MISS")
+		resp.Header.Set("tidbyt-cache-status", CacheStatusMiss)
 	}
 
 	return resp, err
 }
 
+func (c *cacheClient) clock() time.Time {
+	if c.now != nil {
+		return c.now()
+	}
+	return time.Now()
+}
+
+// store writes a response to the cache, unless the response forbids it.
+// Responses that can be revalidated, or that stale-if-error allows to be
+// served when the server fails, are kept around for a while after they
+// expire.
+func (c *cacheClient) store(key string, req *http.Request, resp *http.Response) error {
+	cc := parseCacheControl(resp.Header.Get("Cache-Control"))
+	if _, ok := cc["no-store"]; ok {
+		return nil
+	}
+	if _, ok := cc["private"]; ok {
+		// responses are shared by every installation of an app
+		return nil
+	}
+
+	ttl := DetermineTTL(req, resp)
+	fresh := ttl
+	if _, ok := cc["no-cache"]; ok && determineDeveloperTTL(req) == 0 {
+		// may be stored, but must be revalidated before every use
+		fresh = 0
+	}
+
+	// expired responses are only worth keeping if they can be revalidated,
+	// or the server said they can be served when it fails
+	if cacheableStatusCodes[resp.StatusCode] {
+		secs, staleIfError := cc["stale-if-error"].(int)
+		if (staleIfError && secs > 0) || canRevalidate(req, resp) {
+			ttl = fresh + max(staleWindow(resp), StaleTTL)
+		}
+	}
+
+	resp.Header.Set(freshUntilHeader, strconv.FormatInt(c.clock().Add(fresh).Unix(), 10))
+	ser, err := httputil.DumpResponse(resp, true)
+	resp.Header.Del(freshUntilHeader)
+	if err != nil {
+		// if httputil.DumpResponse fails, it leaves the response body in an
+		// undefined state, so we cannot continue
+		return fmt.Errorf("failed to serialize response for cache: %s", resp.Status)
+	}
+
+	c.cache.Set(nil, key, ser, int64(ttl.Seconds()))
+	return nil
+}
+
+// revalidate refreshes a response in the cache with the headers of a
+// 304 Not Modified response, and serves it.
+func (c *cacheClient) revalidate(key string, req *http.Request, stored, notModified *http.Response) (*http.Response, error) {
+	for name, values := range notModified.Header {
+		switch name {
+		case "Content-Length", "Content-Encoding", "Transfer-Encoding", "Content-Range":
+			// these describe the body, which a 304 doesn't have
+			continue
+		}
+		stored.Header[name] = values
+	}
+	stored.Header.Del(freshUntilHeader)
+
+	if err := c.store(key, req, stored); err != nil {
+		return nil, err
+	}
+
+	return cacheResponse(stored, CacheStatusRevalidated), nil
+}
+
+// fresh reports whether a response from the cache can be served without
+// asking the server.
+func (c *cacheClient) fresh(res *http.Response) bool {
+	until, ok := freshUntil(res)
+	if !ok {
+		// stored without expiry, so it expires with the cache entry
+		return true
+	}
+
+	return c.clock().Before(until)
+}
+
+// canServeStale reports whether an expired response from the cache can be
+// served in place of an error.
+func (c *cacheClient) canServeStale(res *http.Response) bool {
+	if !cacheableStatusCodes[res.StatusCode] {
+		return false
+	}
+
+	until, ok := freshUntil(res)
+	if !ok {
+		return true
+	}
+
+	return c.clock().Before(until.Add(staleWindow(res)))
+}
+
+// staleWindow is how long after expiry a response from the cache can be
+// served if the server fails. It's given by stale-if-error, and otherwise
+// StaleTTL, unless the response must be revalidated. Responses are only
+// kept that long if store found them worth keeping.
+func staleWindow(res *http.Response) time.Duration {
+	cc := parseCacheControl(res.Header.Get("Cache-Control"))
+
+	if secs, ok := cc["stale-if-error"].(int); ok {
+		return time.Duration(secs) * time.Second
+	}
+
+	for _, directive := range []string{"must-revalidate", "proxy-revalidate", "no-cache"} {
+		if _, ok := cc[directive]; ok {
+			return 0
+		}
+	}
+
+	return StaleTTL
+}
+
+func freshUntil(res *http.Response) (time.Time, bool) {
+	value := res.Header.Get(freshUntilHeader)
+	if value == "" {
+		return time.Time{}, false
+	}
+
+	secs, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return time.Time{}, false
+	}
+
+	return time.Unix(secs, 0), true
+}
+
+// canRevalidate reports whether a response to a request can be
+// revalidated with a conditional request. Only GET and HEAD requests can,
+// since If-None-Match on a POST asks the server not to perform it.
+func canRevalidate(req *http.Request, res *http.Response) bool {
+	if req.Method != "GET" && req.Method != "HEAD" {
+		return false
+	}
+	return res.Header.Get("ETag") != "" || res.Header.Get("Last-Modified") != ""
+}
+
+// conditionalRequest returns a request that asks the server to only send
+// a response if it has changed since the stored one. If the stored
+// response can't be revalidated, or the app made a conditional request
+// itself, the request is returned as is.
+func conditionalRequest(req *http.Request, stored *http.Response) *http.Request {
+	if !canRevalidate(req, stored) {
+		return req
+	}
+	if req.Header.Get("If-None-Match") != "" || req.Header.Get("If-Modified-Since") != "" {
+		return req
+	}
+
+	cond := req.Clone(req.Context())
+	if etag := stored.Header.Get("ETag"); etag != "" {
+		cond.Header.Set("If-None-Match", etag)
+	}
+	if lastModified := stored.Header.Get("Last-Modified"); lastModified != "" {
+		cond.Header.Set("If-Modified-Since", lastModified)
+	}
+
+	return cond
+}
+
+// cacheResponse prepares a response from the cache to be served.
+func cacheResponse(res *http.Response, status string) *http.Response {
+	res.Header.Del(freshUntilHeader)
+	res.Header.Set("tidbyt-cache-status", status)
+	return res
+}
+
 func cacheKey(req *http.Request) (string, error) {
 	ttl := req.Header.Get(TTLHeader)
 	req.Header.Del(TTLHeader)
@@ -3,13 +3,17 @@ package runtime
 import (
 	"context"
 	"fmt"
+	"io"
 	"math/rand"
 	"net/http"
+	"net/http/httptest"
 	"os"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestInitHTTP(t *testing.T) {
@@ -28,6 +32,297 @@ func TestInitHTTP(t *testing.T) {
 	assert.NotNil(t, screens)
 }
 
+func TestHTTPRetryBypassesCache(t *testing.T) {
+	var calls int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		fmt.Fprint(w, "ok")
+	}))
+	defer ts.Close()
+
+	src := fmt.Sprintf(`
+load("render.star", "render")
+load("http.star", "http")
+
+def main():
+    res = http.get("%s", retries = 1, backoff = 0, timeout = 1)
+    if res.status_code != 200 or res.body() != "ok":
+        fail("retry was answered from the cache:", res.status_code)
+    return render.Root(child = render.Box())
+`, ts.URL)
+
+	InitHTTP(NewInMemoryCache())
+
+	app, err := NewApplet("retry.star", []byte(src))
+	require.NoError(t, err)
+	_, err = app.Run(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&calls))
+}
+
+// cacheTestServer serves "hello" with an ETag, answering conditional
+// requests with 304 Not Modified, until told otherwise.
+type cacheTestServer struct {
+	calls        int32
+	conditionals int32
+	status       int32
+	body         atomic.Value
+	cacheControl atomic.Value
+
+	// noValidators leaves out the ETag and Last-Modified headers
+	noValidators bool
+}
+
+func (s *cacheTestServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	atomic.AddInt32(&s.calls, 1)
+
+	if status := atomic.LoadInt32(&s.status); status != 0 {
+		w.WriteHeader(int(status))
+		return
+	}
+
+	body := s.body.Load().(string)
+	etag := fmt.Sprintf(`"%s"`, body)
+	w.Header().Set("Cache-Control", s.cacheControl.Load().(string))
+	if !s.noValidators {
+		w.Header().Set("ETag", etag)
+		w.Header().Set("Last-Modified", "Mon, 01 Jun 2000 00:00:00 GMT")
+	}
+
+	if r.Header.Get("If-None-Match") != "" {
+		atomic.AddInt32(&s.conditionals, 1)
+		if r.Header.Get("If-None-Match") == etag {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+	}
+
+	fmt.Fprint(w, body)
+}
+
+func newCacheTest(t *testing.T, cacheControl string) (*cacheTestServer, *httptest.Server, *http.Client, *time.Time) {
+	s := &cacheTestServer{}
+	s.body.Store("hello")
+	s.cacheControl.Store(cacheControl)
+	ts := httptest.NewServer(s)
+	t.Cleanup(ts.Close)
+
+	now := time.Now()
+	cc := &cacheClient{
+		cache:     NewInMemoryCache(),
+		transport: http.DefaultTransport,
+		now:       func() time.Time { return now },
+	}
+
+	return s, ts, &http.Client{Transport: cc}, &now
+}
+
+func get(t *testing.T, client *http.Client, url string) (string, string) {
+	resp, err := client.Get(url)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	assert.Empty(t, resp.Header.Get(freshUntilHeader))
+
+	return string(body), resp.Header.Get("tidbyt-cache-status")
+}
+
+func TestHTTPCacheRevalidation(t *testing.T) {
+	s, ts, client, now := newCacheTest(t, "max-age=60")
+
+	body, status := get(t, client, ts.URL)
+	assert.Equal(t, "hello", body)
+	assert.Equal(t, CacheStatusMiss, status)
+
+	body, status = get(t, client, ts.URL)
+	assert.Equal(t, "hello", body)
+	assert.Equal(t, CacheStatusHit, status)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&s.calls))
+
+	// once expired, the response is revalidated
+	*now = now.Add(2 * time.Minute)
+	body, status = get(t, client, ts.URL)
+	assert.Equal(t, "hello", body)
+	assert.Equal(t, CacheStatusRevalidated, status)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&s.conditionals))
+
+	// which makes it fresh again
+	body, status = get(t, client, ts.URL)
+	assert.Equal(t, "hello", body)
+	assert.Equal(t, CacheStatusHit, status)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&s.calls))
+
+	// until it changes
+	*now = now.Add(2 * time.Minute)
+	s.body.Store("world")
+	body, status = get(t, client, ts.URL)
+	assert.Equal(t, "world", body)
+	assert.Equal(t, CacheStatusMiss, status)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&s.conditionals))
+}
+
+func TestHTTPCacheNoCache(t *testing.T) {
+	s, ts, client, _ := newCacheTest(t, "no-cache")
+
+	_, status := get(t, client, ts.URL)
+	assert.Equal(t, CacheStatusMiss, status)
+
+	// stored, but revalidated every time
+	for i := 0; i < 2; i++ {
+		body, status := get(t, client, ts.URL)
+		assert.Equal(t, "hello", body)
+		assert.Equal(t, CacheStatusRevalidated, status)
+	}
+	assert.Equal(t, int32(3), atomic.LoadInt32(&s.calls))
+}
+
+func TestHTTPCacheNoStore(t *testing.T) {
+	for _, cacheControl := range []string{"no-store", "private, max-age=60"} {
+		s, ts, client, _ := newCacheTest(t, cacheControl)
+
+		for i := 0; i < 2; i++ {
+			body, status := get(t, client, ts.URL)
+			assert.Equal(t, "hello", body)
+			assert.Equal(t, CacheStatusMiss, status)
+		}
+		assert.Equal(t, int32(2), atomic.LoadInt32(&s.calls), cacheControl)
+		assert.Equal(t, int32(0), atomic.LoadInt32(&s.conditionals), cacheControl)
+	}
+
+	// requests can opt out of the cache too
+	s, ts, client, _ := newCacheTest(t, "max-age=60")
+	for i := 0; i < 2; i++ {
+		req, err := http.NewRequest("GET", ts.URL, nil)
+		require.NoError(t, err)
+		req.Header.Set("Cache-Control", "no-store")
+		resp, err := client.Do(req)
+		require.NoError(t, err)
+		resp.Body.Close()
+	}
+	assert.Equal(t, int32(2), atomic.LoadInt32(&s.calls))
+}
+
+func TestHTTPCacheStaleIfError(t *testing.T) {
+	s, ts, client, now := newCacheTest(t, "max-age=60")
+
+	_, status := get(t, client, ts.URL)
+	assert.Equal(t, CacheStatusMiss, status)
+
+	// the server fails after the response expires
+	*now = now.Add(2 * time.Minute)
+	atomic.StoreInt32(&s.status, http.StatusInternalServerError)
+	body, status := get(t, client, ts.URL)
+	assert.Equal(t, "hello", body)
+	assert.Equal(t, CacheStatusStale, status)
+
+	// or can't be reached at all
+	ts.Close()
+	body, status = get(t, client, ts.URL)
+	assert.Equal(t, "hello", body)
+	assert.Equal(t, CacheStatusStale, status)
+
+	// but not forever
+	*now = now.Add(StaleTTL)
+	_, err := client.Get(ts.URL)
+	assert.Error(t, err)
+}
+
+func TestHTTPCacheStaleIfErrorMustRevalidate(t *testing.T) {
+	s, ts, client, now := newCacheTest(t, "max-age=60, must-revalidate")
+
+	_, status := get(t, client, ts.URL)
+	assert.Equal(t, CacheStatusMiss, status)
+
+	*now = now.Add(2 * time.Minute)
+	atomic.StoreInt32(&s.status, http.StatusInternalServerError)
+	resp, err := client.Get(ts.URL)
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusInternalServerError, resp.StatusCode)
+
+	// unless stale-if-error says otherwise
+	s, ts, client, now = newCacheTest(t, "max-age=60, must-revalidate, stale-if-error=300")
+	get(t, client, ts.URL)
+	*now = now.Add(2 * time.Minute)
+	atomic.StoreInt32(&s.status, http.StatusBadGateway)
+	body, status := get(t, client, ts.URL)
+	assert.Equal(t, "hello", body)
+	assert.Equal(t, CacheStatusStale, status)
+}
+
+// cachedFor returns how long the only response in a test client's cache
+// is kept.
+func cachedFor(t *testing.T, client *http.Client) time.Duration {
+	c := client.Transport.(*cacheClient).cache.(*InMemoryCache)
+	require.Len(t, c.records, 1)
+	for _, r := range c.records {
+		return time.Until(r.expiration)
+	}
+	return 0
+}
+
+func TestHTTPCacheStaleCopies(t *testing.T) {
+	// responses that can't be revalidated are dropped once they expire
+	s, ts, client, _ := newCacheTest(t, "max-age=60")
+	s.noValidators = true
+	get(t, client, ts.URL)
+	assert.Less(t, cachedFor(t, client), 70*time.Second)
+
+	// unless stale-if-error asks for them
+	s, ts, client, _ = newCacheTest(t, "max-age=60, stale-if-error=300")
+	s.noValidators = true
+	get(t, client, ts.URL)
+	assert.Greater(t, cachedFor(t, client), StaleTTL)
+
+	s, ts, client, now := newCacheTest(t, "max-age=60, stale-if-error=0")
+	s.noValidators = true
+	get(t, client, ts.URL)
+	assert.Less(t, cachedFor(t, client), 70*time.Second)
+
+	*now = now.Add(2 * time.Minute)
+	atomic.StoreInt32(&s.status, http.StatusInternalServerError)
+	resp, err := client.Get(ts.URL)
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusInternalServerError, resp.StatusCode)
+
+	// responses that can be revalidated are kept
+	_, ts, client, _ = newCacheTest(t, "max-age=60")
+	get(t, client, ts.URL)
+	assert.Greater(t, cachedFor(t, client), StaleTTL)
+}
+
+func TestHTTPCachePostNotRevalidated(t *testing.T) {
+	s, ts, client, now := newCacheTest(t, "max-age=60")
+
+	post := func() string {
+		req, err := http.NewRequest("POST", ts.URL, nil)
+		require.NoError(t, err)
+		req.Header.Set(TTLHeader, "60")
+		resp, err := client.Do(req)
+		require.NoError(t, err)
+		resp.Body.Close()
+		return resp.Header.Get("tidbyt-cache-status")
+	}
+
+	assert.Equal(t, CacheStatusMiss, post())
+	assert.Equal(t, CacheStatusHit, post())
+
+	// If-None-Match would ask the server not to perform the request
+	*now = now.Add(2 * time.Minute)
+	assert.Equal(t, CacheStatusMiss, post())
+	assert.Equal(t, int32(2), atomic.LoadInt32(&s.calls))
+	assert.Equal(t, int32(0), atomic.LoadInt32(&s.conditionals))
+
+	// and without revalidation, there's no reason to keep it around
+	assert.Less(t, cachedFor(t, client), 70*time.Second)
+}
+
 // TestDetermineTTL tests the DetermineTTL function.
 func TestDetermineTTL(t *testing.T) {
 	type test struct {
@@ -430,7 +430,9 @@ func newRotate(
 	w := &Rotate{}
 
 	w.starlarkAngle = angle
-	if val, ok := starlark.AsFloat(w.starlarkAngle); ok {
+	if angle == nil {
+		w.starlarkAngle = starlark.None
+	} else if val, ok := starlark.AsFloat(w.starlarkAngle); ok {
 		w.Angle = val
 	} else {
 		return nil, fmt.Errorf("expected number, but got: %s", w.starlarkAngle.String())
@@ -499,14 +501,18 @@ func newScale(
 	w := &Scale{}
 
 	w.starlarkX = x
-	if val, ok := starlark.AsFloat(w.starlarkX); ok {
+	if x == nil {
+		w.starlarkX = starlark.None
+	} else if val, ok := starlark.AsFloat(w.starlarkX); ok {
 		w.X = val
 	} else {
 		return nil, fmt.Errorf("expected number, but got: %s", w.starlarkX.String())
 	}
 
 	w.starlarkY = y
-	if val, ok := starlark.AsFloat(w.starlarkY); ok {
+	if y == nil {
+		w.starlarkY = starlark.None
+	} else if val, ok := starlark.AsFloat(w.starlarkY); ok {
 		w.Y = val
 	} else {
 		return nil, fmt.Errorf("expected number, but got: %s", w.starlarkY.String())
@@ -820,14 +826,18 @@ func newTranslate(
 	w := &Translate{}
 
 	w.starlarkX = x
-	if val, ok := starlark.AsFloat(w.starlarkX); ok {
+	if x == nil {
+		w.starlarkX = starlark.None
+	} else if val, ok := starlark.AsFloat(w.starlarkX); ok {
 		w.X = val
 	} else {
 		return nil, fmt.Errorf("expected number, but got: %s", w.starlarkX.String())
 	}
 
 	w.starlarkY = y
-	if val, ok := starlark.AsFloat(w.starlarkY); ok {
+	if y == nil {
+		w.starlarkY = starlark.None
+	} else if val, ok := starlark.AsFloat(w.starlarkY); ok {
 		w.Y = val
 	} else {
 		return nil, fmt.Errorf("expected number, but got: %s", w.starlarkY.String())
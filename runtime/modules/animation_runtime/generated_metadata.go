@@ -0,0 +1,261 @@
+package animation_runtime
+
+// Code generated by runtime/gen. DO NOT EDIT.
+
+import "tidbyt.dev/pixlet/runtime/modules/render_runtime"
+
+// Metadata describes the types of this module and their attributes.
+var Metadata = []render_runtime.TypeMetadata{
+	{
+		Name:          "AnimatedPositioned",
+		Documentation: "Animate a widget from start to end coordinates.\n\n**DEPRECATED**: Please use `animation.Transformation` instead.",
+		Attributes: []render_runtime.AttrMetadata{
+			{
+				Name:          "child",
+				Type:          "Widget",
+				Documentation: "Widget to animate",
+				Required:      true,
+				ReadOnly:      false,
+			},
+			{
+				Name:          "duration",
+				Type:          "int",
+				Documentation: "Duration of animation in frames",
+				Required:      true,
+				ReadOnly:      false,
+			},
+			{
+				Name:          "curve",
+				Type:          "str / function",
+				Documentation: "Easing curve to use, default is 'linear'",
+				Required:      true,
+				ReadOnly:      false,
+			},
+			{
+				Name:          "x_start",
+				Type:          "int",
+				Documentation: "Horizontal start coordinate",
+				Required:      false,
+				ReadOnly:      false,
+			},
+			{
+				Name:          "x_end",
+				Type:          "int",
+				Documentation: "Horizontal end coordinate",
+				Required:      false,
+				ReadOnly:      false,
+			},
+			{
+				Name:          "y_start",
+				Type:          "int",
+				Documentation: "Vertical start coordinate",
+				Required:      false,
+				ReadOnly:      false,
+			},
+			{
+				Name:          "y_end",
+				Type:          "int",
+				Documentation: "Vertical end coordinate",
+				Required:      false,
+				ReadOnly:      false,
+			},
+			{
+				Name:          "delay",
+				Type:          "int",
+				Documentation: "Delay before animation in frames",
+				Required:      false,
+				ReadOnly:      false,
+			},
+			{
+				Name:          "hold",
+				Type:          "int",
+				Documentation: "Delay after animation in frames",
+				Required:      false,
+				ReadOnly:      false,
+			},
+		},
+	},
+	{
+		Name:          "Keyframe",
+		Documentation: "A keyframe defining specific point in time in the animation.\n\nThe keyframe _percentage_ can is expressed as a floating point value between `0.0` and `1.0`.",
+		Attributes: []render_runtime.AttrMetadata{
+			{
+				Name:          "percentage",
+				Type:          "float",
+				Documentation: "Percentage of the time at which this keyframe occurs through the animation.",
+				Required:      true,
+				ReadOnly:      false,
+			},
+			{
+				Name:          "transforms",
+				Type:          "[Transform]",
+				Documentation: "List of transforms at this keyframe to interpolate to or from.",
+				Required:      true,
+				ReadOnly:      false,
+			},
+			{
+				Name:          "curve",
+				Type:          "str / function",
+				Documentation: "Easing curve to use, default is 'linear'",
+				Required:      false,
+				ReadOnly:      false,
+			},
+		},
+	},
+	{
+		Name:          "Origin",
+		Documentation: "An relative anchor point to use for scaling and rotation transforms.",
+		Attributes: []render_runtime.AttrMetadata{
+			{
+				Name:          "x",
+				Type:          "float",
+				Documentation: "Horizontal anchor point",
+				Required:      true,
+				ReadOnly:      false,
+			},
+			{
+				Name:          "y",
+				Type:          "float",
+				Documentation: "Vertical anchor point",
+				Required:      true,
+				ReadOnly:      false,
+			},
+		},
+	},
+	{
+		Name:          "Rotate",
+		Documentation: "Transform by rotating by a given angle in degrees.",
+		Attributes: []render_runtime.AttrMetadata{
+			{
+				Name:          "angle",
+				Type:          "float / int",
+				Documentation: "Angle to rotate by in degrees",
+				Required:      true,
+				ReadOnly:      false,
+			},
+		},
+	},
+	{
+		Name:          "Scale",
+		Documentation: "Transform by scaling by a given factor.",
+		Attributes: []render_runtime.AttrMetadata{
+			{
+				Name:          "x",
+				Type:          "float / int",
+				Documentation: "Horizontal scale factor",
+				Required:      true,
+				ReadOnly:      false,
+			},
+			{
+				Name:          "y",
+				Type:          "float / int",
+				Documentation: "Vertical scale factor",
+				Required:      true,
+				ReadOnly:      false,
+			},
+		},
+	},
+	{
+		Name:          "Transformation",
+		Documentation: "Transformation makes it possible to animate a child widget by\ntransitioning between transforms which are applied to the child wiget.\n\nIt supports animating translation, scale and rotation of its child.\n\nIf you have used CSS transforms and animations before, some of the\nfollowing concepts will be familiar to you.\n\nKeyframes define a list of transforms to apply at a specific point in\ntime, which is given as a percentage of the total animation duration.\n\nA keyframe is created via `animation.Keyframe(percentage, transforms, curve)`.\n\nThe `percentage` specifies its point in time and can be expressed as\na floating point number in the range `0.0` to `1.0`.\n\nIn case a keyframe at percentage 0% or 100% is missing, a default\nkeyframe without transforms and with a \"linear\" easing curve is inserted.\n\nAs the animation progresses, transforms defined by the previous and\nnext keyframe will be interpolated to determine the transform to apply\nat the current frame.\n\nThe `duration` and `delay` of the animation are expressed as a number\nof frames.\n\nBy default a transform `origin` of `animation.Origin(0.5, 0.5)` is used,\nwhich defines the anchor point for scaling and rotation to be exactly the\ncenter of the child widget. A different `origin` can be specified by\nproviding a custom `animation.Origin`.\n\nThe animation `direction` defaults to `normal`, playing the animation\nforwards. Other possible values are `reverse` to play it backwards,\n`alternate` to play it forwards, then backwards or `alternate-reverse`\nto play it backwards, then forwards.\n\nThe animation `fill_mode` defaults to `forwards`, and controls which\ntransforms will be applied to the child widget after the animation\nfinishes. A value of `forwards` will retain the transforms of the last\nkeyframe, while a value of `backwards` will rever to the transforms\nof the first keyframe.\n\nWhen translating the child widget on the X- or Y-axis, it often is\ndesireable to round to even integers, which can be controlled via\n`rounding`, which defaults to `round`. Possible values are `round` to\nround to the nearest integer, `floor` to round down, `ceil` to round\nup or `none` to not perform any rounding. Rounding only is applied for\ntranslation transforms, but not to scaling or rotation transforms.\n\nIf `wait_for_child` is set to `True`, the animation will finish and\nthen wait for all child frames to play before restarting. If it is set\nto `False`, it will not wait.",
+		Attributes: []render_runtime.AttrMetadata{
+			{
+				Name:          "child",
+				Type:          "Widget",
+				Documentation: "Widget to animate",
+				Required:      true,
+				ReadOnly:      false,
+			},
+			{
+				Name:          "keyframes",
+				Type:          "[Keyframe]",
+				Documentation: "List of animation keyframes",
+				Required:      true,
+				ReadOnly:      false,
+			},
+			{
+				Name:          "duration",
+				Type:          "int",
+				Documentation: "Duration of animation (in frames)",
+				Required:      true,
+				ReadOnly:      false,
+			},
+			{
+				Name:          "delay",
+				Type:          "int",
+				Documentation: "Duration to wait before animation (in frames)",
+				Required:      false,
+				ReadOnly:      false,
+			},
+			{
+				Name:          "width",
+				Type:          "int",
+				Documentation: "Width of the animation canvas",
+				Required:      false,
+				ReadOnly:      false,
+			},
+			{
+				Name:          "height",
+				Type:          "int",
+				Documentation: "Height of the animation canvas",
+				Required:      false,
+				ReadOnly:      false,
+			},
+			{
+				Name:          "origin",
+				Type:          "Origin",
+				Documentation: "Origin for transforms, default is '50%, 50%'",
+				Required:      false,
+				ReadOnly:      false,
+			},
+			{
+				Name:          "direction",
+				Type:          "str",
+				Documentation: "Direction of the animation, default is 'normal'",
+				Required:      false,
+				ReadOnly:      false,
+			},
+			{
+				Name:          "fill_mode",
+				Type:          "str",
+				Documentation: "Fill mode of the animation, default is 'forwards'",
+				Required:      false,
+				ReadOnly:      false,
+			},
+			{
+				Name:          "rounding",
+				Type:          "str",
+				Documentation: "Rounding to use for interpolated translation coordinates (not used for scale and rotate), default is 'round'",
+				Required:      false,
+				ReadOnly:      false,
+			},
+			{
+				Name:          "wait_for_child",
+				Type:          "bool",
+				Documentation: "Wait for all child frames to play after finishing",
+				Required:      false,
+				ReadOnly:      false,
+			},
+		},
+	},
+	{
+		Name:          "Translate",
+		Documentation: "Transform by translating by a given offset.",
+		Attributes: []render_runtime.AttrMetadata{
+			{
+				Name:          "x",
+				Type:          "float / int",
+				Documentation: "Horizontal offset",
+				Required:      true,
+				ReadOnly:      false,
+			},
+			{
+				Name:          "y",
+				Type:          "float / int",
+				Documentation: "Vertical offset",
+				Required:      true,
+				ReadOnly:      false,
+			},
+		},
+	},
+}
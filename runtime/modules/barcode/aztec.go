@@ -0,0 +1,387 @@
+package barcode
+
+import (
+	"fmt"
+	"strings"
+)
+
+// bitBuffer collects bits, most significant first.
+type bitBuffer []bool
+
+func (b *bitBuffer) append(value, width int) {
+	for i := width - 1; i >= 0; i-- {
+		*b = append(*b, value&(1<<i) != 0)
+	}
+}
+
+const (
+	aztecUpper = iota
+	aztecLower
+	aztecDigit
+	aztecPunct
+	aztecBinary
+)
+
+// aztecPunctuation holds the single characters of the punctuation mode,
+// starting at code 6.
+const aztecPunctuation = `!"#$%&'()*+,-./:;<=>?[]{}`
+
+// aztecValue returns the code of c in mode, if the mode has one.
+func aztecValue(mode int, c byte) (int, bool) {
+	switch {
+	case c == ' ' && mode != aztecPunct:
+		return 1, true
+	case mode == aztecUpper && c >= 'A' && c <= 'Z':
+		return int(c-'A') + 2, true
+	case mode == aztecLower && c >= 'a' && c <= 'z':
+		return int(c-'a') + 2, true
+	case mode == aztecDigit && isDigit(c):
+		return int(c-'0') + 2, true
+	case mode == aztecDigit && c == ',':
+		return 12, true
+	case mode == aztecDigit && c == '.':
+		return 13, true
+	case mode == aztecPunct:
+		if i := strings.IndexByte(aztecPunctuation, c); i >= 0 {
+			return i + 6, true
+		}
+	}
+	return 0, false
+}
+
+// aztecMode returns the mode that c is best encoded in.
+func aztecMode(c byte) int {
+	for _, mode := range []int{aztecUpper, aztecLower, aztecDigit, aztecPunct} {
+		if _, ok := aztecValue(mode, c); ok {
+			return mode
+		}
+	}
+	return aztecBinary
+}
+
+func aztecWidth(mode int) int {
+	if mode == aztecDigit {
+		return 4
+	}
+	return 5
+}
+
+// aztecBits encodes data with the upper, lower and digit modes, shifting
+// to punctuation for single characters and to binary for everything else.
+func aztecBits(data string) bitBuffer {
+	var bits bitBuffer
+	mode := aztecUpper
+
+	latch := func(to int) {
+		switch {
+		case mode == aztecUpper && to == aztecLower:
+			bits.append(28, 5)
+		case mode == aztecLower && to == aztecUpper:
+			// through digit mode, since lower has no latch to upper
+			bits.append(30, 5)
+			bits.append(14, 4)
+		case mode == aztecDigit && to == aztecUpper:
+			bits.append(14, 4)
+		case mode == aztecDigit && to == aztecLower:
+			bits.append(14, 4)
+			bits.append(28, 5)
+		case to == aztecDigit:
+			bits.append(30, 5)
+		}
+		mode = to
+	}
+
+	for i := 0; i < len(data); {
+		c := data[i]
+
+		if v, ok := aztecValue(mode, c); ok {
+			bits.append(v, aztecWidth(mode))
+			i++
+			continue
+		}
+
+		switch target := aztecMode(c); target {
+		case aztecPunct:
+			bits.append(0, aztecWidth(mode))
+			v, _ := aztecValue(aztecPunct, c)
+			bits.append(v, 5)
+			i++
+
+		case aztecUpper:
+			if mode == aztecLower && (i+1 == len(data) || aztecMode(data[i+1]) != aztecUpper) {
+				// shift for a single capital letter
+				bits.append(28, 5)
+				v, _ := aztecValue(aztecUpper, c)
+				bits.append(v, 5)
+				i++
+				continue
+			}
+			latch(target)
+
+		case aztecLower, aztecDigit:
+			latch(target)
+
+		default:
+			if mode == aztecDigit {
+				latch(aztecUpper)
+			}
+
+			n := 0
+			for i+n < len(data) && n < 31+2047 && aztecMode(data[i+n]) == aztecBinary {
+				n++
+			}
+
+			bits.append(31, 5)
+			if n <= 31 {
+				bits.append(n, 5)
+			} else {
+				bits.append(0, 5)
+				bits.append(n-31, 11)
+			}
+			for j := 0; j < n; j++ {
+				bits.append(int(data[i+j]), 8)
+			}
+			i += n
+		}
+	}
+
+	return bits
+}
+
+// aztecStuff splits bits into words, inserting a bit into words that
+// would otherwise be all zeros or all ones. The last word is padded
+// with ones.
+func aztecStuff(bits bitBuffer, wordSize int) []int {
+	var words []int
+	mask := (1 << wordSize) - 2
+
+	for i := 0; i < len(bits); i += wordSize {
+		word := 0
+		for j := 0; j < wordSize; j++ {
+			if i+j >= len(bits) || bits[i+j] {
+				word |= 1 << (wordSize - 1 - j)
+			}
+		}
+
+		switch word & mask {
+		case mask:
+			words = append(words, word&mask)
+			i--
+		case 0:
+			words = append(words, word|1)
+			i--
+		default:
+			words = append(words, word)
+		}
+	}
+
+	return words
+}
+
+func aztecTotalBits(layers int, compact bool) int {
+	if compact {
+		return (88 + 16*layers) * layers
+	}
+	return (112 + 16*layers) * layers
+}
+
+func aztecWordSize(layers int) (int, *galoisField) {
+	switch {
+	case layers <= 2:
+		return 6, gf64
+	case layers <= 8:
+		return 8, gf256
+	case layers <= 22:
+		return 10, gf1024
+	}
+	return 12, gf4096
+}
+
+// aztecMessage appends error correction words to words, and returns all
+// of them as totalBits bits, padded with zeros at the start.
+func aztecMessage(words []int, totalBits, wordSize int, gf *galoisField) bitBuffer {
+	ecc := gf.ecc(words, totalBits/wordSize-len(words))
+
+	var bits bitBuffer
+	bits.append(0, totalBits%wordSize)
+	for _, w := range append(append([]int{}, words...), ecc...) {
+		bits.append(w, wordSize)
+	}
+	return bits
+}
+
+func encodeAztec(data string) (*symbol, error) {
+	if data == "" {
+		return nil, fmt.Errorf("aztec data cannot be empty")
+	}
+
+	bits := aztecBits(data)
+
+	// at least a third of the symbol is error correction
+	eccBits := len(bits)*33/100 + 11
+
+	var (
+		layers   int
+		compact  bool
+		wordSize int
+		gf       *galoisField
+		words    []int
+	)
+	for i := 0; ; i++ {
+		if i > 32 {
+			return nil, fmt.Errorf("aztec data is too long")
+		}
+
+		// compact symbols with 1 to 4 layers, then full size ones
+		compact = i <= 3
+		layers = i
+		if compact {
+			layers = i + 1
+		}
+
+		total := aztecTotalBits(layers, compact)
+		if len(bits)+eccBits > total {
+			continue
+		}
+
+		wordSize, gf = aztecWordSize(layers)
+		words = aztecStuff(bits, wordSize)
+		if compact && len(words) > 64 {
+			continue
+		}
+		if len(words)*wordSize+eccBits <= total-total%wordSize {
+			break
+		}
+	}
+
+	message := aztecMessage(words, aztecTotalBits(layers, compact), wordSize, gf)
+
+	// the mode message holds the number of layers and data words
+	var mode bitBuffer
+	var modeMessage bitBuffer
+	if compact {
+		mode.append(layers-1, 2)
+		mode.append(len(words)-1, 6)
+		modeMessage = aztecMessage(bitWords(mode, 4), 28, 4, gf16)
+	} else {
+		mode.append(layers-1, 5)
+		mode.append(len(words)-1, 11)
+		modeMessage = aztecMessage(bitWords(mode, 4), 40, 4, gf16)
+	}
+
+	baseSize := 14 + layers*4
+	if compact {
+		baseSize = 11 + layers*4
+	}
+
+	// full size symbols have a reference grid every 16 modules, which
+	// the layers skip
+	size := baseSize
+	align := make([]int, baseSize)
+	if compact {
+		for i := range align {
+			align[i] = i
+		}
+	} else {
+		size = baseSize + 1 + 2*((baseSize/2-1)/15)
+		origCenter, center := baseSize/2, size/2
+		for i := 0; i < origCenter; i++ {
+			offset := i + i/15
+			align[origCenter-i-1] = center - offset - 1
+			align[origCenter+i] = center + offset + 1
+		}
+	}
+
+	sym := newSymbol(size, size)
+
+	rowOffset := 0
+	for i := 0; i < layers; i++ {
+		rowSize := (layers-i)*4 + 12
+		if compact {
+			rowSize = (layers-i)*4 + 9
+		}
+
+		for j := 0; j < rowSize; j++ {
+			columnOffset := j * 2
+			for k := 0; k < 2; k++ {
+				if message[rowOffset+columnOffset+k] {
+					sym.set(align[i*2+k], align[i*2+j], true)
+				}
+				if message[rowOffset+rowSize*2+columnOffset+k] {
+					sym.set(align[i*2+j], align[baseSize-1-i*2-k], true)
+				}
+				if message[rowOffset+rowSize*4+columnOffset+k] {
+					sym.set(align[baseSize-1-i*2-k], align[baseSize-1-i*2-j], true)
+				}
+				if message[rowOffset+rowSize*6+columnOffset+k] {
+					sym.set(align[baseSize-1-i*2-j], align[i*2+k], true)
+				}
+			}
+		}
+
+		rowOffset += rowSize * 8
+	}
+
+	center := size / 2
+	if compact {
+		for i := 0; i < 7; i++ {
+			offset := center - 3 + i
+			sym.set(offset, center-5, modeMessage[i])
+			sym.set(center+5, offset, modeMessage[i+7])
+			sym.set(offset, center+5, modeMessage[20-i])
+			sym.set(center-5, offset, modeMessage[27-i])
+		}
+		drawAztecBullseye(sym, center, 5)
+	} else {
+		for i := 0; i < 10; i++ {
+			offset := center - 5 + i + i/5
+			sym.set(offset, center-7, modeMessage[i])
+			sym.set(center+7, offset, modeMessage[i+10])
+			sym.set(offset, center+7, modeMessage[29-i])
+			sym.set(center-7, offset, modeMessage[39-i])
+		}
+		drawAztecBullseye(sym, center, 7)
+
+		for i, j := 0, 0; i < baseSize/2-1; i, j = i+15, j+16 {
+			for k := center & 1; k < size; k += 2 {
+				sym.set(center-j, k, true)
+				sym.set(center+j, k, true)
+				sym.set(k, center-j, true)
+				sym.set(k, center+j, true)
+			}
+		}
+	}
+
+	return sym, nil
+}
+
+// bitWords splits bits into words of wordSize bits.
+func bitWords(bits bitBuffer, wordSize int) []int {
+	words := make([]int, len(bits)/wordSize)
+	for i, b := range bits {
+		if b {
+			words[i/wordSize] |= 1 << (wordSize - 1 - i%wordSize)
+		}
+	}
+	return words
+}
+
+// drawAztecBullseye draws the finder pattern of concentric squares, and
+// the orientation marks in its corners.
+func drawAztecBullseye(sym *symbol, center, size int) {
+	for i := 0; i < size; i += 2 {
+		for j := center - i; j <= center+i; j++ {
+			sym.set(j, center-i, true)
+			sym.set(j, center+i, true)
+			sym.set(center-i, j, true)
+			sym.set(center+i, j, true)
+		}
+	}
+
+	sym.set(center-size, center-size, true)
+	sym.set(center-size+1, center-size, true)
+	sym.set(center-size, center-size+1, true)
+	sym.set(center+size, center-size, true)
+	sym.set(center+size, center-size+1, true)
+	sym.set(center+size, center+size-1, true)
+}
@@ -0,0 +1,329 @@
+package barcode
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+	"sort"
+	"strings"
+	"sync"
+
+	"go.starlark.net/starlark"
+	"go.starlark.net/starlarkstruct"
+
+	"tidbyt.dev/pixlet/render"
+	"tidbyt.dev/pixlet/runtime/modules/render_runtime"
+)
+
+const (
+	ModuleName = "barcode"
+
+	// DefaultHeight is the height in pixels of linear barcodes, unless
+	// another one is given.
+	DefaultHeight = 16
+
+	// MaxScale, MaxHeight and MaxQuietZone keep images from getting much
+	// bigger than any display.
+	MaxScale     = 16
+	MaxHeight    = 256
+	MaxQuietZone = 32
+
+	DefaultCanvasWidth  = 64
+	DefaultCanvasHeight = 32
+)
+
+// format describes a symbology, and the quiet zone that it needs on each
+// side, in modules.
+type format struct {
+	encode    func(data string) (*symbol, error)
+	quietZone int
+}
+
+var formats = map[string]format{
+	"code128":    {encodeCode128, 10},
+	"ean13":      {encodeEAN13, 9},
+	"ean8":       {encodeEAN8, 7},
+	"upca":       {encodeUPCA, 9},
+	"datamatrix": {encodeDataMatrix, 1},
+	"aztec":      {encodeAztec, 0},
+}
+
+var (
+	once   sync.Once
+	module starlark.StringDict
+)
+
+func LoadModule() (starlark.StringDict, error) {
+	once.Do(func() {
+		module = starlark.StringDict{
+			ModuleName: &starlarkstruct.Module{
+				Name: ModuleName,
+				Members: starlark.StringDict{
+					"generate": starlark.NewBuiltin("generate", generate),
+					"widget":   starlark.NewBuiltin("widget", widget),
+					"measure":  starlark.NewBuiltin("measure", measure),
+				},
+			},
+		}
+	})
+
+	return module, nil
+}
+
+// symbol is a grid of modules. Linear symbols are a single row, which is
+// stretched to the height of the barcode.
+type symbol struct {
+	width, height int
+	modules       []bool
+	linear        bool
+}
+
+func newSymbol(width, height int) *symbol {
+	return &symbol{
+		width:   width,
+		height:  height,
+		modules: make([]bool, width*height),
+	}
+}
+
+func (s *symbol) set(x, y int, dark bool) {
+	s.modules[y*s.width+x] = dark
+}
+
+func (s *symbol) get(x, y int) bool {
+	return s.modules[y*s.width+x]
+}
+
+// moduleSymbol creates a linear symbol from a string of 1s and 0s.
+func moduleSymbol(bits string) *symbol {
+	s := &symbol{width: len(bits), height: 1, linear: true}
+	for _, b := range bits {
+		s.modules = append(s.modules, b == '1')
+	}
+	return s
+}
+
+// linearSymbol creates a linear symbol from the widths of alternating
+// bars and spaces, starting with a bar.
+func linearSymbol(widths string) *symbol {
+	var b strings.Builder
+	for i, w := range widths {
+		bit := "1"
+		if i%2 == 1 {
+			bit = "0"
+		}
+		b.WriteString(strings.Repeat(bit, int(w-'0')))
+	}
+	return moduleSymbol(b.String())
+}
+
+// options controls how a symbol is drawn.
+type options struct {
+	scale      int
+	height     int
+	quietZone  int
+	color      color.Color
+	background color.Color
+}
+
+// size returns the size of the image of s in pixels.
+func (s *symbol) size(opts options) (int, int) {
+	width := (s.width + 2*opts.quietZone) * opts.scale
+	if s.linear {
+		return width, opts.height
+	}
+	return width, (s.height + 2*opts.quietZone) * opts.scale
+}
+
+func (s *symbol) image(opts options) image.Image {
+	width, height := s.size(opts)
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			mx := x/opts.scale - opts.quietZone
+			my := y/opts.scale - opts.quietZone
+			if s.linear {
+				my = 0
+			}
+
+			c := opts.background
+			if mx >= 0 && mx < s.width && my >= 0 && my < s.height && s.get(mx, my) {
+				c = opts.color
+			}
+			img.Set(x, y, c)
+		}
+	}
+
+	return img
+}
+
+func (s *symbol) png(opts options) (string, error) {
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, s.image(opts)); err != nil {
+		return "", fmt.Errorf("encoding barcode: %w", err)
+	}
+	return buf.String(), nil
+}
+
+// formatNames returns the supported formats for error messages.
+func formatNames() string {
+	var names []string
+	for name := range formats {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return strings.Join(names, ", ")
+}
+
+// unpackSymbol unpacks the arguments shared by all functions, with extra
+// pairs of names and values for arguments that are specific to one.
+func unpackSymbol(fnname string, args starlark.Tuple, kwargs []starlark.Tuple, extra ...interface{}) (*symbol, options, error) {
+	var (
+		data       starlark.String
+		name       starlark.String
+		scale                     = starlark.MakeInt(1)
+		height     starlark.Value = starlark.None
+		quietZone  starlark.Value = starlark.None
+		fg                        = starlark.String("#000")
+		background                = starlark.String("#fff")
+	)
+
+	pairs := append([]interface{}{
+		"data", &data,
+		"format", &name,
+		"scale?", &scale,
+		"height?", &height,
+		"quiet_zone?", &quietZone,
+		"color?", &fg,
+		"background?", &background,
+	}, extra...)
+
+	if err := starlark.UnpackArgs(fnname, args, kwargs, pairs...); err != nil {
+		return nil, options{}, fmt.Errorf("unpacking arguments for %s: %s", fnname, err)
+	}
+
+	f, ok := formats[name.GoString()]
+	if !ok {
+		return nil, options{}, fmt.Errorf("%s: unsupported format %s (supported formats are %s)", fnname, name, formatNames())
+	}
+
+	sym, err := f.encode(data.GoString())
+	if err != nil {
+		return nil, options{}, fmt.Errorf("%s: %w", fnname, err)
+	}
+
+	opts := options{quietZone: f.quietZone}
+
+	s, ok := scale.Int64()
+	if !ok || s < 1 || s > MaxScale {
+		return nil, options{}, fmt.Errorf("%s: scale must be between 1 and %d", fnname, MaxScale)
+	}
+	opts.scale = int(s)
+
+	if height != starlark.None {
+		if !sym.linear {
+			return nil, options{}, fmt.Errorf("%s: height only applies to linear barcodes", fnname)
+		}
+		h, err := starlark.AsInt32(height)
+		if err != nil || h < 1 || h > MaxHeight {
+			return nil, options{}, fmt.Errorf("%s: height must be between 1 and %d", fnname, MaxHeight)
+		}
+		opts.height = h
+	} else {
+		opts.height = DefaultHeight * opts.scale
+	}
+
+	if quietZone != starlark.None {
+		q, err := starlark.AsInt32(quietZone)
+		if err != nil || q < 0 || q > MaxQuietZone {
+			return nil, options{}, fmt.Errorf("%s: quiet_zone must be between 0 and %d", fnname, MaxQuietZone)
+		}
+		opts.quietZone = q
+	}
+
+	if opts.color, err = render.ParseColor(fg.GoString()); err != nil {
+		return nil, options{}, fmt.Errorf("%s: color is not a valid hex string: %s", fnname, fg)
+	}
+	if opts.background, err = render.ParseColor(background.GoString()); err != nil {
+		return nil, options{}, fmt.Errorf("%s: background is not a valid hex string: %s", fnname, background)
+	}
+
+	return sym, opts, nil
+}
+
+func generate(thread *starlark.Thread, _ *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	sym, opts, err := unpackSymbol("generate", args, kwargs)
+	if err != nil {
+		return nil, err
+	}
+
+	img, err := sym.png(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return starlark.String(img), nil
+}
+
+func widget(thread *starlark.Thread, _ *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	sym, opts, err := unpackSymbol("widget", args, kwargs)
+	if err != nil {
+		return nil, err
+	}
+
+	img, err := sym.png(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	renderModule, err := render_runtime.LoadRenderModule()
+	if err != nil {
+		return nil, err
+	}
+
+	newImage, err := renderModule["render"].(*starlarkstruct.Module).Attr("Image")
+	if err != nil {
+		return nil, err
+	}
+
+	return starlark.Call(thread, newImage, nil, []starlark.Tuple{
+		{starlark.String("src"), starlark.String(img)},
+	})
+}
+
+func measure(thread *starlark.Thread, _ *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	var (
+		canvasWidth  = starlark.MakeInt(DefaultCanvasWidth)
+		canvasHeight = starlark.MakeInt(DefaultCanvasHeight)
+	)
+
+	sym, opts, err := unpackSymbol(
+		"measure", args, kwargs,
+		"canvas_width?", &canvasWidth,
+		"canvas_height?", &canvasHeight,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	cw, ok := canvasWidth.Int64()
+	if !ok {
+		return nil, fmt.Errorf("measure: canvas_width must be an integer")
+	}
+	ch, ok := canvasHeight.Int64()
+	if !ok {
+		return nil, fmt.Errorf("measure: canvas_height must be an integer")
+	}
+
+	width, height := sym.size(opts)
+
+	return starlarkstruct.FromStringDict(starlarkstruct.Default, starlark.StringDict{
+		"width":   starlark.MakeInt(width),
+		"height":  starlark.MakeInt(height),
+		"modules": starlark.Tuple{starlark.MakeInt(sym.width), starlark.MakeInt(sym.height)},
+		"fits":    starlark.Bool(int64(width) <= cw && int64(height) <= ch),
+	}), nil
+}
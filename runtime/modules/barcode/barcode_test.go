@@ -0,0 +1,103 @@
+package barcode_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"tidbyt.dev/pixlet/runtime"
+)
+
+var barcodeSource = `
+load("barcode.star", "barcode")
+load("render.star", "render")
+
+def assert(success, message=None):
+    if not success:
+        fail(message or "assertion failed")
+
+def test_measure():
+    # 11 modules for each of start, 4 values and check, 13 for stop, and
+    # a quiet zone of 10 on each side
+    m = barcode.measure("ABCD", "code128")
+    assert(m.width == 6 * 11 + 13 + 20)
+    assert(m.height == 16)
+    assert(m.modules == (6 * 11 + 13, 1))
+    assert(not m.fits)
+    assert(barcode.measure("ABCD", "code128", canvas_width = 128).fits)
+    assert(barcode.measure("1234", "code128", quiet_zone = 0).width == 4 * 11 + 13)
+
+    m = barcode.measure("978020137962", "ean13", scale = 2, height = 10)
+    assert(m.width == (95 + 18) * 2 and m.height == 10)
+
+    m = barcode.measure("https://tidbyt.com", "datamatrix")
+    assert(m.modules == (18, 18))
+    assert(m.width == 20 and m.height == 20 and m.fits)
+
+    m = barcode.measure("https://tidbyt.com", "aztec", scale = 2)
+    assert(m.modules == (19, 19))
+    assert(m.width == 38 and m.height == 38)
+    assert(not m.fits)
+    assert(barcode.measure("https://tidbyt.com", "aztec", scale = 2, canvas_width = 128, canvas_height = 64).fits)
+
+def test_generate():
+    for format, data in [
+        ("code128", "TIDBYT-42"),
+        ("ean13", "4006381333931"),
+        ("ean8", "9638507"),
+        ("upca", "03600029145"),
+        ("datamatrix", "Hello, World!"),
+        ("aztec", "Hello, World!"),
+    ]:
+        png = barcode.generate(data, format, color = "#0f0", background = "#000")
+        assert(png[1:4] == "PNG")
+
+        m = barcode.measure(data, format)
+        image = render.Image(src = png)
+        assert(image.size() == (m.width, m.height))
+
+        w = barcode.widget(data, format)
+        assert(w.size() == (m.width, m.height))
+
+test_measure()
+test_generate()
+
+def main():
+    return render.Root(child = barcode.widget("12345678", "code128", quiet_zone = 2, height = 8))
+`
+
+func TestBarcode(t *testing.T) {
+	app, err := runtime.NewApplet("barcode_test.star", []byte(barcodeSource))
+	require.NoError(t, err)
+
+	screens, err := app.Run(context.Background())
+	require.NoError(t, err)
+	assert.NotNil(t, screens)
+}
+
+func TestBarcodeErrors(t *testing.T) {
+	for _, expr := range []string{
+		`barcode.generate("abc", "qr")`,
+		`barcode.generate("", "code128")`,
+		`barcode.generate("abc", "ean13")`,
+		`barcode.generate("4006381333932", "ean13")`,
+		`barcode.generate("123", "ean8")`,
+		`barcode.generate("abc", "datamatrix", height = 10)`,
+		`barcode.generate("abc", "code128", scale = 0)`,
+		`barcode.generate("abc", "code128", quiet_zone = -1)`,
+		`barcode.generate("abc", "code128", scale = 17)`,
+		`barcode.generate("abc", "code128", height = 100000)`,
+		`barcode.generate("abc", "code128", quiet_zone = 1 << 20)`,
+		`barcode.generate("abc", "code128", color = "red")`,
+		`barcode.generate("x" * 2000, "datamatrix")`,
+		`barcode.measure("abc", "code128", canvas_width = "64")`,
+	} {
+		src := "load(\"barcode.star\", \"barcode\")\nx = " + expr + "\ndef main():\n    return []\n"
+		app, err := runtime.NewApplet("barcode_error.star", []byte(src))
+		if err == nil {
+			_, err = app.Run(context.Background())
+		}
+		assert.Error(t, err, expr)
+	}
+}
@@ -0,0 +1,137 @@
+package barcode
+
+import "fmt"
+
+// code128Patterns holds the bar and space widths of every Code 128 symbol
+// value, starting with a bar. 103 to 105 start code sets A, B and C, and
+// 106 is the stop pattern, which ends with an extra bar.
+var code128Patterns = []string{
+	"212222", "222122", "222221", "121223", "121322", "131222", "122213", "122312", "132212", "221213",
+	"221312", "231212", "112232", "122132", "122231", "113222", "123122", "123221", "223211", "221132",
+	"221231", "213212", "223112", "312131", "311222", "321122", "321221", "312212", "322112", "322211",
+	"212123", "212321", "232121", "111323", "131123", "131321", "112313", "132113", "132311", "211313",
+	"231113", "231311", "112133", "112331", "132131", "113123", "113321", "133121", "313121", "211331",
+	"231131", "213113", "213311", "213131", "311123", "311321", "331121", "312113", "312311", "332111",
+	"314111", "221411", "431111", "111224", "111422", "121124", "121421", "141122", "141221", "112214",
+	"112412", "122114", "122411", "142112", "142211", "241211", "221114", "413111", "241112", "134111",
+	"111242", "121142", "121241", "114212", "124112", "124211", "411212", "421112", "421211", "212141",
+	"214121", "412121", "111143", "111341", "131141", "114113", "114311", "411113", "411311", "113141",
+	"114131", "311141", "411131", "211412", "211214", "211232", "2331112",
+}
+
+const (
+	code128CodeC  = 99
+	code128CodeB  = 100
+	code128CodeA  = 101
+	code128StartA = 103
+	code128StartB = 104
+	code128StartC = 105
+	code128Stop   = 106
+)
+
+// code128Values encodes data as Code 128 symbol values, including the
+// start code and check value but not the stop pattern. Runs of digits
+// are packed in pairs with code set C, control characters use code set A
+// and everything else code set B.
+func code128Values(data string) ([]int, error) {
+	for i := 0; i < len(data); i++ {
+		if data[i] > 127 {
+			return nil, fmt.Errorf("code128 can only encode ASCII characters")
+		}
+	}
+
+	digitsAt := func(i int) int {
+		n := 0
+		for i+n < len(data) && data[i+n] >= '0' && data[i+n] <= '9' {
+			n++
+		}
+		return n
+	}
+
+	// the code set that a character should be encoded with, if the
+	// current one can't encode it
+	setFor := func(c byte) int {
+		if c < 32 {
+			return code128CodeA
+		}
+		return code128CodeB
+	}
+
+	var values []int
+	var set int
+
+	if n := digitsAt(0); n >= 4 || (n == len(data) && n >= 2 && n%2 == 0) {
+		set = code128CodeC
+		values = append(values, code128StartC)
+	} else if len(data) > 0 && setFor(data[0]) == code128CodeA {
+		set = code128CodeA
+		values = append(values, code128StartA)
+	} else {
+		set = code128CodeB
+		values = append(values, code128StartB)
+	}
+
+	for i := 0; i < len(data); {
+		c := data[i]
+
+		if set == code128CodeC {
+			if digitsAt(i) >= 2 {
+				values = append(values, int(c-'0')*10+int(data[i+1]-'0'))
+				i += 2
+				continue
+			}
+			set = setFor(c)
+			values = append(values, set)
+			continue
+		}
+
+		// switch to code set C for long runs of digits, leaving an odd
+		// digit out in the current code set
+		if n := digitsAt(i); n >= 6 || (n >= 4 && i+n == len(data)) {
+			if n%2 == 1 {
+				values = append(values, int(c)-32)
+				i++
+			}
+			set = code128CodeC
+			values = append(values, set)
+			continue
+		}
+
+		if set == code128CodeA && c >= 96 || set == code128CodeB && c < 32 {
+			set = setFor(c)
+			values = append(values, set)
+		}
+
+		if c < 32 {
+			values = append(values, int(c)+64)
+		} else {
+			values = append(values, int(c)-32)
+		}
+		i++
+	}
+
+	sum := values[0]
+	for i, v := range values[1:] {
+		sum += (i + 1) * v
+	}
+
+	return append(values, sum%103), nil
+}
+
+func encodeCode128(data string) (*symbol, error) {
+	if data == "" {
+		return nil, fmt.Errorf("code128 data cannot be empty")
+	}
+
+	values, err := code128Values(data)
+	if err != nil {
+		return nil, err
+	}
+
+	var widths string
+	for _, v := range append(values, code128Stop) {
+		widths += code128Patterns[v]
+	}
+
+	return linearSymbol(widths), nil
+}
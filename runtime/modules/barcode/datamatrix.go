@@ -0,0 +1,276 @@
+package barcode
+
+import "fmt"
+
+// dataMatrixSize describes a square ECC 200 Data Matrix symbol.
+type dataMatrixSize struct {
+	size       int // modules on each side
+	regionSize int // data modules on each side of a data region
+	dataWords  int
+	eccWords   int
+	blocks     int
+}
+
+var dataMatrixSizes = []dataMatrixSize{
+	{10, 8, 3, 5, 1},
+	{12, 10, 5, 7, 1},
+	{14, 12, 8, 10, 1},
+	{16, 14, 12, 12, 1},
+	{18, 16, 18, 14, 1},
+	{20, 18, 22, 18, 1},
+	{22, 20, 30, 20, 1},
+	{24, 22, 36, 24, 1},
+	{26, 24, 44, 28, 1},
+	{32, 14, 62, 36, 1},
+	{36, 16, 86, 42, 1},
+	{40, 18, 114, 48, 1},
+	{44, 20, 144, 56, 1},
+	{48, 22, 174, 68, 1},
+	{52, 24, 204, 84, 2},
+	{64, 14, 280, 112, 2},
+}
+
+const (
+	dataMatrixPad        = 129
+	dataMatrixUpperShift = 235
+)
+
+// dataMatrixCodewords encodes data in ASCII encodation, packing pairs of
+// digits into a single codeword.
+func dataMatrixCodewords(data string) []int {
+	var words []int
+
+	for i := 0; i < len(data); i++ {
+		c := data[i]
+
+		if isDigit(c) && i+1 < len(data) && isDigit(data[i+1]) {
+			words = append(words, 130+int(c-'0')*10+int(data[i+1]-'0'))
+			i++
+		} else if c > 127 {
+			words = append(words, dataMatrixUpperShift, int(c)-127)
+		} else {
+			words = append(words, int(c)+1)
+		}
+	}
+
+	return words
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+// dataMatrixSymbolWords picks the smallest symbol that fits data, and
+// returns the data and error correction codewords to fill it with.
+func dataMatrixSymbolWords(data string) (*dataMatrixSize, []int, error) {
+	words := dataMatrixCodewords(data)
+	n := len(words)
+
+	var size *dataMatrixSize
+	for i := range dataMatrixSizes {
+		if dataMatrixSizes[i].dataWords >= n {
+			size = &dataMatrixSizes[i]
+			break
+		}
+	}
+	if size == nil {
+		return nil, nil, fmt.Errorf("datamatrix data is too long (%d codewords, at most %d fit)", n, dataMatrixSizes[len(dataMatrixSizes)-1].dataWords)
+	}
+
+	// pad, randomizing all but the first pad codeword
+	for len(words) < size.dataWords {
+		if len(words) == n {
+			words = append(words, dataMatrixPad)
+			continue
+		}
+		pad := dataMatrixPad + (149*(len(words)+1))%253 + 1
+		if pad > 254 {
+			pad -= 254
+		}
+		words = append(words, pad)
+	}
+
+	// error correction is computed over interleaved blocks
+	ecc := make([]int, size.eccWords)
+	for b := 0; b < size.blocks; b++ {
+		var block []int
+		for i := b; i < len(words); i += size.blocks {
+			block = append(block, words[i])
+		}
+		for i, w := range gf256.ecc(block, size.eccWords/size.blocks) {
+			ecc[b+i*size.blocks] = w
+		}
+	}
+
+	return size, append(words, ecc...), nil
+}
+
+func encodeDataMatrix(data string) (*symbol, error) {
+	if data == "" {
+		return nil, fmt.Errorf("datamatrix data cannot be empty")
+	}
+
+	size, codewords, err := dataMatrixSymbolWords(data)
+	if err != nil {
+		return nil, err
+	}
+
+	regions := size.size / (size.regionSize + 2)
+	dim := regions * size.regionSize
+	placement := dataMatrixPlacement(dim, dim)
+
+	sym := newSymbol(size.size, size.size)
+	for row := 0; row < dim; row++ {
+		for col := 0; col < dim; col++ {
+			p := placement[row*dim+col]
+
+			var dark bool
+			switch {
+			case p.word < 0:
+				dark = p.bit == 1
+			default:
+				dark = codewords[p.word]&(1<<(7-p.bit)) != 0
+			}
+
+			y := row/size.regionSize*(size.regionSize+2) + row%size.regionSize + 1
+			x := col/size.regionSize*(size.regionSize+2) + col%size.regionSize + 1
+			sym.set(x, y, dark)
+		}
+	}
+
+	// finder patterns and clock tracks around every region
+	step := size.regionSize + 2
+	for y := 0; y < size.size; y++ {
+		for x := 0; x < size.size; x++ {
+			rx, ry := x%step, y%step
+			switch {
+			case rx == 0 || ry == step-1:
+				sym.set(x, y, true)
+			case ry == 0:
+				sym.set(x, y, rx%2 == 0)
+			case rx == step-1:
+				sym.set(x, y, ry%2 == 1)
+			}
+		}
+	}
+
+	return sym, nil
+}
+
+// dataMatrixModule is the codeword and bit, counting from the most
+// significant, that a module of the mapping matrix shows. Modules that
+// no codeword covers have a negative word, and bit set to 1 if they're
+// dark.
+type dataMatrixModule struct {
+	word int
+	bit  int
+}
+
+// dataMatrixPlacement places codewords in a mapping matrix of nrow by
+// ncol modules, following the algorithm in annex F of ISO/IEC 16022.
+func dataMatrixPlacement(nrow, ncol int) []dataMatrixModule {
+	grid := make([]dataMatrixModule, nrow*ncol)
+	placed := make([]bool, nrow*ncol)
+
+	module := func(row, col, word, bit int) {
+		if row < 0 {
+			row += nrow
+			col += 4 - ((nrow + 4) % 8)
+		}
+		if col < 0 {
+			col += ncol
+			row += 4 - ((ncol + 4) % 8)
+		}
+		grid[row*ncol+col] = dataMatrixModule{word, bit}
+		placed[row*ncol+col] = true
+	}
+
+	utah := func(row, col, word int) {
+		module(row-2, col-2, word, 0)
+		module(row-2, col-1, word, 1)
+		module(row-1, col-2, word, 2)
+		module(row-1, col-1, word, 3)
+		module(row-1, col, word, 4)
+		module(row, col-2, word, 5)
+		module(row, col-1, word, 6)
+		module(row, col, word, 7)
+	}
+
+	// the four special corner cases, as lists of row, col pairs
+	corners := [][8][2]int{
+		{{nrow - 1, 0}, {nrow - 1, 1}, {nrow - 1, 2}, {0, ncol - 2}, {0, ncol - 1}, {1, ncol - 1}, {2, ncol - 1}, {3, ncol - 1}},
+		{{nrow - 3, 0}, {nrow - 2, 0}, {nrow - 1, 0}, {0, ncol - 4}, {0, ncol - 3}, {0, ncol - 2}, {0, ncol - 1}, {1, ncol - 1}},
+		{{nrow - 3, 0}, {nrow - 2, 0}, {nrow - 1, 0}, {0, ncol - 2}, {0, ncol - 1}, {1, ncol - 1}, {2, ncol - 1}, {3, ncol - 1}},
+		{{nrow - 1, 0}, {nrow - 1, ncol - 1}, {0, ncol - 3}, {0, ncol - 2}, {0, ncol - 1}, {1, ncol - 3}, {1, ncol - 2}, {1, ncol - 1}},
+	}
+	corner := func(c, word int) {
+		for bit, rc := range corners[c] {
+			module(rc[0], rc[1], word, bit)
+		}
+	}
+
+	word := 0
+	row, col := 4, 0
+	for {
+		if row == nrow && col == 0 {
+			corner(0, word)
+			word++
+		}
+		if row == nrow-2 && col == 0 && ncol%4 != 0 {
+			corner(1, word)
+			word++
+		}
+		if row == nrow-2 && col == 0 && ncol%8 == 4 {
+			corner(2, word)
+			word++
+		}
+		if row == nrow+4 && col == 2 && ncol%8 == 0 {
+			corner(3, word)
+			word++
+		}
+
+		// sweep up and to the right
+		for {
+			if row < nrow && col >= 0 && !placed[row*ncol+col] {
+				utah(row, col, word)
+				word++
+			}
+			row -= 2
+			col += 2
+			if row < 0 || col >= ncol {
+				break
+			}
+		}
+		row++
+		col += 3
+
+		// then down and to the left
+		for {
+			if row >= 0 && col < ncol && !placed[row*ncol+col] {
+				utah(row, col, word)
+				word++
+			}
+			row += 2
+			col -= 2
+			if row >= nrow || col < 0 {
+				break
+			}
+		}
+		row += 3
+		col++
+
+		if row >= nrow && col >= ncol {
+			break
+		}
+	}
+
+	// a fixed pattern fills the bottom right corner if nothing covers it
+	if !placed[nrow*ncol-1] {
+		grid[nrow*ncol-1] = dataMatrixModule{-1, 1}
+		grid[(nrow-1)*ncol-2] = dataMatrixModule{-1, 1}
+		grid[nrow*ncol-2] = dataMatrixModule{-1, 0}
+		grid[(nrow-1)*ncol-1] = dataMatrixModule{-1, 0}
+	}
+
+	return grid
+}
@@ -0,0 +1,125 @@
+package barcode
+
+import (
+	"fmt"
+	"strings"
+)
+
+// eanLeft holds the odd parity patterns of digits, which the left half of
+// EAN and UPC codes use. Even parity patterns are the reverse of the
+// complement, and the right half uses the complement.
+var eanLeft = []string{
+	"0001101", "0011001", "0010011", "0111101", "0100011",
+	"0110001", "0101111", "0111011", "0110111", "0001011",
+}
+
+// ean13Parity is the parity of the left half digits of EAN-13 codes, set
+// by the implied first digit. G is even parity.
+var ean13Parity = []string{
+	"LLLLLL", "LLGLGG", "LLGGLG", "LLGGGL", "LGLLGG",
+	"LGGLLG", "LGGGLL", "LGLGLG", "LGLGGL", "LGGLGL",
+}
+
+// eanCheckDigit computes the check digit of the digits that precede it.
+func eanCheckDigit(digits string) byte {
+	sum := 0
+	for i := len(digits) - 1; i >= 0; i-- {
+		d := int(digits[i] - '0')
+		if (len(digits)-i)%2 == 1 {
+			d *= 3
+		}
+		sum += d
+	}
+	return byte('0' + (10-sum%10)%10)
+}
+
+// eanDigits validates data, which may leave out the check digit, and
+// returns it with the check digit.
+func eanDigits(format, data string, length int) (string, error) {
+	for _, c := range data {
+		if c < '0' || c > '9' {
+			return "", fmt.Errorf("%s data must be digits: %q", format, data)
+		}
+	}
+
+	switch len(data) {
+	case length - 1:
+		return data + string(eanCheckDigit(data)), nil
+
+	case length:
+		if check := eanCheckDigit(data[:length-1]); check != data[length-1] {
+			return "", fmt.Errorf("%s check digit of %s should be %c", format, data, check)
+		}
+		return data, nil
+	}
+
+	return "", fmt.Errorf("%s data must have %d or %d digits", format, length-1, length)
+}
+
+func complement(bits string) string {
+	return strings.Map(func(r rune) rune {
+		if r == '0' {
+			return '1'
+		}
+		return '0'
+	}, bits)
+}
+
+func reverse(bits string) string {
+	b := []byte(bits)
+	for i, j := 0, len(b)-1; i < j; i, j = i+1, j-1 {
+		b[i], b[j] = b[j], b[i]
+	}
+	return string(b)
+}
+
+// eanModules encodes the digits of both halves between guard patterns.
+func eanModules(left, right, parity string) string {
+	var b strings.Builder
+
+	b.WriteString("101")
+	for i := 0; i < len(left); i++ {
+		pattern := eanLeft[left[i]-'0']
+		if parity[i] == 'G' {
+			pattern = reverse(complement(pattern))
+		}
+		b.WriteString(pattern)
+	}
+	b.WriteString("01010")
+	for i := 0; i < len(right); i++ {
+		b.WriteString(complement(eanLeft[right[i]-'0']))
+	}
+	b.WriteString("101")
+
+	return b.String()
+}
+
+func encodeEAN13(data string) (*symbol, error) {
+	digits, err := eanDigits("ean13", data, 13)
+	if err != nil {
+		return nil, err
+	}
+
+	parity := ean13Parity[digits[0]-'0']
+	return moduleSymbol(eanModules(digits[1:7], digits[7:], parity)), nil
+}
+
+func encodeEAN8(data string) (*symbol, error) {
+	digits, err := eanDigits("ean8", data, 8)
+	if err != nil {
+		return nil, err
+	}
+
+	return moduleSymbol(eanModules(digits[:4], digits[4:], "LLLL")), nil
+}
+
+// encodeUPCA encodes a UPC-A code, which is an EAN-13 code that starts
+// with 0.
+func encodeUPCA(data string) (*symbol, error) {
+	digits, err := eanDigits("upca", data, 12)
+	if err != nil {
+		return nil, err
+	}
+
+	return encodeEAN13("0" + digits)
+}
@@ -0,0 +1,77 @@
+package barcode
+
+// galoisField is GF(2^m), built from a primitive polynomial.
+type galoisField struct {
+	exp  []int
+	log  []int
+	size int
+}
+
+func newGaloisField(poly, size int) *galoisField {
+	gf := &galoisField{
+		exp:  make([]int, size),
+		log:  make([]int, size),
+		size: size,
+	}
+
+	x := 1
+	for i := 0; i < size; i++ {
+		gf.exp[i] = x
+		x <<= 1
+		if x >= size {
+			x = (x ^ poly) & (size - 1)
+		}
+	}
+	for i := 0; i < size-1; i++ {
+		gf.log[gf.exp[i]] = i
+	}
+
+	return gf
+}
+
+func (gf *galoisField) mul(a, b int) int {
+	if a == 0 || b == 0 {
+		return 0
+	}
+	return gf.exp[(gf.log[a]+gf.log[b])%(gf.size-1)]
+}
+
+// generator returns the coefficients of (x - a^1)(x - a^2)...(x - a^n),
+// highest degree first.
+func (gf *galoisField) generator(n int) []int {
+	g := []int{1}
+	for i := 1; i <= n; i++ {
+		next := make([]int, len(g)+1)
+		for j, c := range g {
+			next[j] ^= c
+			next[j+1] ^= gf.mul(c, gf.exp[i%(gf.size-1)])
+		}
+		g = next
+	}
+	return g
+}
+
+// ecc returns n Reed-Solomon error correction words for data.
+func (gf *galoisField) ecc(data []int, n int) []int {
+	g := gf.generator(n)
+
+	rem := make([]int, n)
+	for _, d := range data {
+		factor := d ^ rem[0]
+		copy(rem, rem[1:])
+		rem[n-1] = 0
+		for i := 0; i < n; i++ {
+			rem[i] ^= gf.mul(g[i+1], factor)
+		}
+	}
+
+	return rem
+}
+
+var (
+	gf16   = newGaloisField(0x13, 16)
+	gf64   = newGaloisField(0x43, 64)
+	gf256  = newGaloisField(0x12d, 256)
+	gf1024 = newGaloisField(0x409, 1024)
+	gf4096 = newGaloisField(0x1069, 4096)
+)
@@ -0,0 +1,233 @@
+package barcode
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCode128Patterns(t *testing.T) {
+	require.Len(t, code128Patterns, 107)
+	for i, p := range code128Patterns {
+		sum := 0
+		for _, w := range p {
+			sum += int(w - '0')
+		}
+		if i == code128Stop {
+			assert.Equal(t, 13, sum, "stop")
+		} else {
+			assert.Equal(t, 11, sum, "value %d", i)
+		}
+	}
+}
+
+func TestCode128Values(t *testing.T) {
+	values, err := code128Values("Wikipedia")
+	require.NoError(t, err)
+	assert.Equal(t, []int{code128StartB, 55, 73, 75, 73, 80, 69, 68, 73, 65, 88}, values)
+
+	// digits are packed in pairs
+	values, err = code128Values("1234567890")
+	require.NoError(t, err)
+	assert.Equal(t, []int{code128StartC, 12, 34, 56, 78, 90, 85}, values)
+
+	// switching to code set C for a long run, with the odd digit in B
+	values, err = code128Values("AB1234567")
+	require.NoError(t, err)
+	assert.Equal(t, []int{code128StartB, 33, 34, 17, code128CodeC, 23, 45, 67}, values[:8])
+
+	// and to code set A for control characters
+	values, err = code128Values("A\tB")
+	require.NoError(t, err)
+	assert.Equal(t, []int{code128StartB, 33, code128CodeA, 73, 34}, values[:5])
+
+	values, err = code128Values("\r\n")
+	require.NoError(t, err)
+	assert.Equal(t, []int{code128StartA, 77, 74}, values[:3])
+
+	_, err = code128Values("é")
+	assert.Error(t, err)
+
+	sym, err := encodeCode128("Wikipedia")
+	require.NoError(t, err)
+	assert.Equal(t, 11*11+13, sym.width)
+	assert.True(t, sym.linear)
+}
+
+func TestEAN(t *testing.T) {
+	digits, err := eanDigits("ean13", "400638133393", 13)
+	require.NoError(t, err)
+	assert.Equal(t, "4006381333931", digits)
+
+	_, err = eanDigits("ean13", "4006381333932", 13)
+	assert.ErrorContains(t, err, "check digit of 4006381333932 should be 1")
+
+	sym, err := encodeEAN13("4006381333931")
+	require.NoError(t, err)
+	assert.Equal(t, 95, sym.width)
+
+	bits := modules(sym)
+	assert.Equal(t, "101", bits[:3])
+	// the first digit sets the parity of the left half to LGLLGG, so
+	// 0 has odd parity and the following 0 even parity
+	assert.Equal(t, "0001101", bits[3:10])
+	assert.Equal(t, "0100111", bits[10:17])
+	assert.Equal(t, "01010", bits[45:50])
+	// right half digits are the complement of odd parity
+	assert.Equal(t, "1000010", bits[50:57])
+	assert.Equal(t, "101", bits[92:])
+
+	sym, err = encodeEAN8("9638507")
+	require.NoError(t, err)
+	assert.Equal(t, 67, sym.width)
+
+	upc, err := encodeUPCA("03600029145")
+	require.NoError(t, err)
+	ean, err := encodeEAN13("0036000291452")
+	require.NoError(t, err)
+	assert.Equal(t, modules(ean), modules(upc))
+}
+
+func modules(s *symbol) string {
+	var b strings.Builder
+	for _, m := range s.modules {
+		if m {
+			b.WriteByte('1')
+		} else {
+			b.WriteByte('0')
+		}
+	}
+	return b.String()
+}
+
+func TestDataMatrixCodewords(t *testing.T) {
+	// the example in annex O of ISO/IEC 16022
+	size, words, err := dataMatrixSymbolWords("123456")
+	require.NoError(t, err)
+	assert.Equal(t, 10, size.size)
+	assert.Equal(t, []int{142, 164, 186, 114, 25, 5, 88, 102}, words)
+
+	// padding
+	_, words, err = dataMatrixSymbolWords("A")
+	require.NoError(t, err)
+	assert.Equal(t, []int{66, 129, 70}, words[:3])
+
+	_, _, err = dataMatrixSymbolWords(strings.Repeat("x", 300))
+	assert.Error(t, err)
+}
+
+func TestDataMatrixPlacement(t *testing.T) {
+	for _, size := range dataMatrixSizes {
+		dim := size.size / (size.regionSize + 2) * size.regionSize
+		placement := dataMatrixPlacement(dim, dim)
+
+		// every bit of every codeword is placed exactly once
+		seen := map[dataMatrixModule]bool{}
+		for _, m := range placement {
+			if m.word < 0 {
+				continue
+			}
+			assert.False(t, seen[m], "size %d places %v twice", size.size, m)
+			seen[m] = true
+		}
+		assert.Len(t, seen, (size.dataWords+size.eccWords)*8, "size %d", size.size)
+	}
+}
+
+func TestDataMatrixFinder(t *testing.T) {
+	sym, err := encodeDataMatrix("https://tidbyt.com")
+	require.NoError(t, err)
+	require.Equal(t, 18, sym.width)
+
+	for i := 0; i < sym.width; i++ {
+		assert.True(t, sym.get(0, i), "left edge is solid")
+		assert.True(t, sym.get(i, sym.height-1), "bottom edge is solid")
+		assert.Equal(t, i%2 == 0, sym.get(i, 0), "top edge alternates")
+		assert.Equal(t, i%2 == 1 || i == sym.height-1, sym.get(sym.width-1, i), "right edge alternates")
+	}
+}
+
+// syndromesZero checks that the codeword polynomial vanishes at the
+// roots of the generator.
+func syndromesZero(gf *galoisField, words []int, n int) bool {
+	for i := 1; i <= n; i++ {
+		s := 0
+		for _, w := range words {
+			s = gf.mul(s, gf.exp[i]) ^ w
+		}
+		if s != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+func TestReedSolomon(t *testing.T) {
+	for _, gf := range []*galoisField{gf16, gf64, gf256, gf1024, gf4096} {
+		data := []int{1, 2, 3, gf.size - 1, 0, 7}
+		ecc := gf.ecc(data, 5)
+		assert.True(t, syndromesZero(gf, append(data, ecc...), 5), "GF(%d)", gf.size)
+	}
+}
+
+func TestAztecBits(t *testing.T) {
+	var bits bitBuffer
+	bits.append(2, 5)  // A
+	bits.append(28, 5) // latch to lower
+	bits.append(3, 5)  // b
+	bits.append(30, 5) // latch to digit
+	bits.append(3, 4)  // 1
+	bits.append(0, 4)  // shift to punctuation
+	bits.append(6, 5)  // !
+	bits.append(14, 4) // latch to upper
+	bits.append(31, 5) // binary shift
+	bits.append(1, 5)
+	bits.append(0xe9, 8) // é in latin 1
+	assert.Equal(t, bits, aztecBits("Ab1!\xe9"))
+}
+
+func TestAztecStuff(t *testing.T) {
+	parse := func(s string) bitBuffer {
+		var bits bitBuffer
+		for _, c := range strings.ReplaceAll(s, " ", "") {
+			bits = append(bits, c == 'X')
+		}
+		return bits
+	}
+
+	assert.Equal(t, bitWords(parse(".X.X. X.X.X .X.X."), 5), aztecStuff(parse(".X.X. X.X.X .X.X."), 5))
+	assert.Equal(t, bitWords(parse(".X.X. ....X ..X.X"), 5), aztecStuff(parse(".X.X. ..... .X.X"), 5))
+	assert.Equal(t, bitWords(parse("XXXX. X...X"), 5), aztecStuff(parse("XXXXX ..."), 5))
+}
+
+func TestAztecSymbol(t *testing.T) {
+	for _, tc := range []struct {
+		data    string
+		size    int
+		compact bool
+	}{
+		{"Hello", 15, true},
+		{"https://tidbyt.com", 19, true},
+		{strings.Repeat("Pixlet 1234 ", 20), 49, false},
+	} {
+		sym, err := encodeAztec(tc.data)
+		require.NoError(t, err)
+		require.Equal(t, tc.size, sym.width, tc.data)
+
+		// the bullseye has alternating rings around the center
+		center := sym.width / 2
+		rings := 5
+		if !tc.compact {
+			rings = 7
+		}
+		for d := 0; d < rings; d++ {
+			assert.Equal(t, d%2 == 0, sym.get(center+d, center), "ring %d of %q", d, tc.data)
+			assert.Equal(t, d%2 == 0, sym.get(center, center-d), "ring %d of %q", d, tc.data)
+		}
+	}
+
+	_, err := encodeAztec(strings.Repeat("\x00", 4000))
+	assert.Error(t, err)
+}
@@ -0,0 +1,578 @@
+package color
+
+import (
+	"fmt"
+	gocolor "image/color"
+	"math"
+	"sync"
+
+	"go.starlark.net/starlark"
+	"go.starlark.net/starlarkstruct"
+
+	"tidbyt.dev/pixlet/render"
+)
+
+const (
+	ModuleName = "color"
+)
+
+var (
+	once   sync.Once
+	module starlark.StringDict
+)
+
+func LoadModule() (starlark.StringDict, error) {
+	once.Do(func() {
+		module = starlark.StringDict{
+			ModuleName: &starlarkstruct.Module{
+				Name: ModuleName,
+				Members: starlark.StringDict{
+					"rgb":      starlark.NewBuiltin("rgb", rgb),
+					"hsl":      starlark.NewBuiltin("hsl", fromSpace("hsl", "s", "l", hslToRGB)),
+					"hsv":      starlark.NewBuiltin("hsv", fromSpace("hsv", "s", "v", hsvToRGB)),
+					"oklch":    starlark.NewBuiltin("oklch", oklch),
+					"to_rgb":   starlark.NewBuiltin("to_rgb", toRGB),
+					"to_hsl":   starlark.NewBuiltin("to_hsl", toSpace("to_hsl", rgbToHSL)),
+					"to_hsv":   starlark.NewBuiltin("to_hsv", toSpace("to_hsv", rgbToHSV)),
+					"to_oklch": starlark.NewBuiltin("to_oklch", toSpace("to_oklch", rgbToOKLCH)),
+					"lerp":     starlark.NewBuiltin("lerp", lerpBuiltin),
+					"gradient": starlark.NewBuiltin("gradient", gradientBuiltin),
+					"lighten":  starlark.NewBuiltin("lighten", adjustLightness("lighten", 1)),
+					"darken":   starlark.NewBuiltin("darken", adjustLightness("darken", -1)),
+					"contrast": starlark.NewBuiltin("contrast", contrast),
+					"palette":  starlark.NewBuiltin("palette", palette),
+					"heatmap":  starlark.NewBuiltin("heatmap", heatmap),
+				},
+			},
+		}
+	})
+
+	return module, nil
+}
+
+// rgba is a colour with channels from 0 to 1, not premultiplied by alpha.
+type rgba struct {
+	r, g, b, a float64
+}
+
+func parse(fnname string, v starlark.Value) (rgba, error) {
+	s, ok := starlark.AsString(v)
+	if !ok {
+		return rgba{}, fmt.Errorf("%s: expected a color string, got %s", fnname, v.Type())
+	}
+
+	c, err := render.ParseColor(s)
+	if err != nil {
+		return rgba{}, fmt.Errorf("%s: %w", fnname, err)
+	}
+
+	n := gocolor.NRGBAModel.Convert(c).(gocolor.NRGBA)
+	return rgba{
+		r: float64(n.R) / 255,
+		g: float64(n.G) / 255,
+		b: float64(n.B) / 255,
+		a: float64(n.A) / 255,
+	}, nil
+}
+
+func channel(f float64) uint8 {
+	return uint8(math.Round(math.Max(0, math.Min(1, f)) * 255))
+}
+
+// hex formats a colour the way render.ParseColor reads it, with the
+// alpha channel only if the colour isn't opaque.
+func (c rgba) hex() starlark.String {
+	if a := channel(c.a); a != 255 {
+		return starlark.String(fmt.Sprintf("#%02x%02x%02x%02x", channel(c.r), channel(c.g), channel(c.b), a))
+	}
+	return starlark.String(fmt.Sprintf("#%02x%02x%02x", channel(c.r), channel(c.g), channel(c.b)))
+}
+
+func number(fnname, name string, v starlark.Value) (float64, error) {
+	f, ok := starlark.AsFloat(v)
+	if !ok {
+		return 0, fmt.Errorf("%s: %s must be a number, got %s", fnname, name, v.Type())
+	}
+	return f, nil
+}
+
+func rgb(thread *starlark.Thread, _ *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	var r, g, b int
+	a := 255
+
+	if err := starlark.UnpackArgs(
+		"rgb",
+		args, kwargs,
+		"r", &r,
+		"g", &g,
+		"b", &b,
+		"a?", &a,
+	); err != nil {
+		return nil, fmt.Errorf("unpacking arguments for rgb: %s", err)
+	}
+
+	for _, c := range []int{r, g, b, a} {
+		if c < 0 || c > 255 {
+			return nil, fmt.Errorf("rgb: channels must be between 0 and 255, got %d", c)
+		}
+	}
+
+	return rgba{float64(r) / 255, float64(g) / 255, float64(b) / 255, float64(a) / 255}.hex(), nil
+}
+
+// fromSpace is a factory function for builtins that make colours from a
+// hue in degrees and two components between 0 and 1.
+func fromSpace(name, second, third string, convert func(h, x, y float64) (r, g, b float64)) func(*starlark.Thread, *starlark.Builtin, starlark.Tuple, []starlark.Tuple) (starlark.Value, error) {
+	return func(thread *starlark.Thread, _ *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+		var h, x, y starlark.Value
+		var a starlark.Value = starlark.Float(1)
+
+		if err := starlark.UnpackArgs(
+			name,
+			args, kwargs,
+			"h", &h,
+			second, &x,
+			third, &y,
+			"a?", &a,
+		); err != nil {
+			return nil, fmt.Errorf("unpacking arguments for %s: %s", name, err)
+		}
+
+		values := make([]float64, 4)
+		for i, v := range []starlark.Value{h, x, y, a} {
+			f, err := number(name, []string{"h", second, third, "a"}[i], v)
+			if err != nil {
+				return nil, err
+			}
+			values[i] = f
+		}
+
+		r, g, b := convert(normalizeHue(values[0]), clamp(values[1]), clamp(values[2]))
+		return rgba{r, g, b, clamp(values[3])}.hex(), nil
+	}
+}
+
+func oklch(thread *starlark.Thread, _ *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	var l, c, h starlark.Value
+	var a starlark.Value = starlark.Float(1)
+
+	if err := starlark.UnpackArgs(
+		"oklch",
+		args, kwargs,
+		"l", &l,
+		"c", &c,
+		"h", &h,
+		"a?", &a,
+	); err != nil {
+		return nil, fmt.Errorf("unpacking arguments for oklch: %s", err)
+	}
+
+	values := make([]float64, 4)
+	for i, v := range []starlark.Value{l, c, h, a} {
+		f, err := number("oklch", []string{"l", "c", "h", "a"}[i], v)
+		if err != nil {
+			return nil, err
+		}
+		values[i] = f
+	}
+
+	r, g, b := oklchToRGB(clamp(values[0]), math.Max(0, values[1]), normalizeHue(values[2]))
+	return rgba{r, g, b, clamp(values[3])}.hex(), nil
+}
+
+func toRGB(thread *starlark.Thread, _ *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	var v starlark.Value
+
+	if err := starlark.UnpackArgs(
+		"to_rgb",
+		args, kwargs,
+		"color", &v,
+	); err != nil {
+		return nil, fmt.Errorf("unpacking arguments for to_rgb: %s", err)
+	}
+
+	c, err := parse("to_rgb", v)
+	if err != nil {
+		return nil, err
+	}
+
+	return starlark.Tuple{
+		starlark.MakeInt(int(channel(c.r))),
+		starlark.MakeInt(int(channel(c.g))),
+		starlark.MakeInt(int(channel(c.b))),
+		starlark.MakeInt(int(channel(c.a))),
+	}, nil
+}
+
+// toSpace is a factory function for builtins that convert colours to
+// another colour space, as a tuple of three components.
+func toSpace(name string, convert func(r, g, b float64) (x, y, z float64)) func(*starlark.Thread, *starlark.Builtin, starlark.Tuple, []starlark.Tuple) (starlark.Value, error) {
+	return func(thread *starlark.Thread, _ *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+		var v starlark.Value
+
+		if err := starlark.UnpackArgs(
+			name,
+			args, kwargs,
+			"color", &v,
+		); err != nil {
+			return nil, fmt.Errorf("unpacking arguments for %s: %s", name, err)
+		}
+
+		c, err := parse(name, v)
+		if err != nil {
+			return nil, err
+		}
+
+		x, y, z := convert(c.r, c.g, c.b)
+		return starlark.Tuple{starlark.Float(x), starlark.Float(y), starlark.Float(z)}, nil
+	}
+}
+
+// lerp interpolates between two colours in a colour space, taking the
+// shortest way around the hue circle in spaces with hues.
+func lerp(from, to rgba, t float64, space string) rgba {
+	mix := func(a, b float64) float64 { return a + (b-a)*t }
+	mixHue := func(a, b float64) float64 {
+		d := math.Mod(b-a+540, 360) - 180
+		return normalizeHue(a + d*t)
+	}
+
+	c := rgba{a: mix(from.a, to.a)}
+	switch space {
+	case "hsl":
+		h1, s1, l1 := rgbToHSL(from.r, from.g, from.b)
+		h2, s2, l2 := rgbToHSL(to.r, to.g, to.b)
+		h1, h2 = achromaticHue(h1, s1, h2), achromaticHue(h2, s2, h1)
+		c.r, c.g, c.b = hslToRGB(mixHue(h1, h2), mix(s1, s2), mix(l1, l2))
+	case "oklch":
+		l1, c1, h1 := rgbToOKLCH(from.r, from.g, from.b)
+		l2, c2, h2 := rgbToOKLCH(to.r, to.g, to.b)
+		h1, h2 = achromaticHue(h1, c1, h2), achromaticHue(h2, c2, h1)
+		c.r, c.g, c.b = oklchToRGB(mix(l1, l2), mix(c1, c2), mixHue(h1, h2))
+	default:
+		c.r, c.g, c.b = mix(from.r, to.r), mix(from.g, to.g), mix(from.b, to.b)
+	}
+
+	return c
+}
+
+// achromaticHue returns the hue of the other colour for greys, whose hue
+// is meaningless, so that interpolation doesn't swing through other hues.
+func achromaticHue(h, chroma, other float64) float64 {
+	if chroma < 1e-4 {
+		return other
+	}
+	return h
+}
+
+var spaces = map[string]bool{"rgb": true, "hsl": true, "oklch": true}
+
+func unpackSpace(fnname string, space string) error {
+	if !spaces[space] {
+		return fmt.Errorf("%s: space must be one of rgb, hsl or oklch, got %q", fnname, space)
+	}
+	return nil
+}
+
+func lerpBuiltin(thread *starlark.Thread, _ *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	var (
+		from, to, t starlark.Value
+		space       = "rgb"
+	)
+
+	if err := starlark.UnpackArgs(
+		"lerp",
+		args, kwargs,
+		"from", &from,
+		"to", &to,
+		"t", &t,
+		"space?", &space,
+	); err != nil {
+		return nil, fmt.Errorf("unpacking arguments for lerp: %s", err)
+	}
+
+	if err := unpackSpace("lerp", space); err != nil {
+		return nil, err
+	}
+
+	c1, err := parse("lerp", from)
+	if err != nil {
+		return nil, err
+	}
+	c2, err := parse("lerp", to)
+	if err != nil {
+		return nil, err
+	}
+	tf, err := number("lerp", "t", t)
+	if err != nil {
+		return nil, err
+	}
+
+	return lerp(c1, c2, clamp(tf), space).hex(), nil
+}
+
+// stop is a colour at a position of a gradient.
+type stop struct {
+	pos   float64
+	color rgba
+}
+
+// parseStops reads gradient stops, which are either a list of colours
+// spread evenly, or a list of (position, color) tuples.
+func parseStops(fnname string, v starlark.Value) ([]stop, error) {
+	list, ok := v.(starlark.Indexable)
+	if !ok || list.Len() < 2 {
+		return nil, fmt.Errorf("%s: expected a list of at least two colors or (position, color) tuples", fnname)
+	}
+
+	stops := make([]stop, list.Len())
+	for i := range stops {
+		item := list.Index(i)
+		stops[i].pos = float64(i) / float64(len(stops)-1)
+
+		if tuple, ok := item.(starlark.Tuple); ok {
+			if len(tuple) != 2 {
+				return nil, fmt.Errorf("%s: expected (position, color) tuple at index %d", fnname, i)
+			}
+			pos, err := number(fnname, "position", tuple[0])
+			if err != nil {
+				return nil, err
+			}
+			stops[i].pos = pos
+			item = tuple[1]
+		}
+
+		c, err := parse(fnname, item)
+		if err != nil {
+			return nil, err
+		}
+		stops[i].color = c
+
+		if i > 0 && stops[i].pos < stops[i-1].pos {
+			return nil, fmt.Errorf("%s: stop positions must be in increasing order", fnname)
+		}
+	}
+
+	return stops, nil
+}
+
+// gradient returns the colour at t along a gradient. Positions before the
+// first stop or after the last take the colour of that stop.
+func gradient(stops []stop, t float64, space string) rgba {
+	if t <= stops[0].pos {
+		return stops[0].color
+	}
+
+	for i := 1; i < len(stops); i++ {
+		if t <= stops[i].pos {
+			span := stops[i].pos - stops[i-1].pos
+			if span == 0 {
+				return stops[i].color
+			}
+			return lerp(stops[i-1].color, stops[i].color, (t-stops[i-1].pos)/span, space)
+		}
+	}
+
+	return stops[len(stops)-1].color
+}
+
+func gradientBuiltin(thread *starlark.Thread, _ *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	var (
+		stopsValue, t starlark.Value
+		space         = "rgb"
+	)
+
+	if err := starlark.UnpackArgs(
+		"gradient",
+		args, kwargs,
+		"stops", &stopsValue,
+		"t", &t,
+		"space?", &space,
+	); err != nil {
+		return nil, fmt.Errorf("unpacking arguments for gradient: %s", err)
+	}
+
+	if err := unpackSpace("gradient", space); err != nil {
+		return nil, err
+	}
+
+	stops, err := parseStops("gradient", stopsValue)
+	if err != nil {
+		return nil, err
+	}
+	tf, err := number("gradient", "t", t)
+	if err != nil {
+		return nil, err
+	}
+
+	return gradient(stops, tf, space).hex(), nil
+}
+
+// adjustLightness is a factory function for builtins that change the
+// lightness of a colour in HSL by an amount between 0 and 1.
+func adjustLightness(name string, sign float64) func(*starlark.Thread, *starlark.Builtin, starlark.Tuple, []starlark.Tuple) (starlark.Value, error) {
+	return func(thread *starlark.Thread, _ *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+		var v, amount starlark.Value
+
+		if err := starlark.UnpackArgs(
+			name,
+			args, kwargs,
+			"color", &v,
+			"amount", &amount,
+		); err != nil {
+			return nil, fmt.Errorf("unpacking arguments for %s: %s", name, err)
+		}
+
+		c, err := parse(name, v)
+		if err != nil {
+			return nil, err
+		}
+		af, err := number(name, "amount", amount)
+		if err != nil {
+			return nil, err
+		}
+
+		h, s, l := rgbToHSL(c.r, c.g, c.b)
+		c.r, c.g, c.b = hslToRGB(h, s, clamp(l+sign*af))
+		return c.hex(), nil
+	}
+}
+
+// luminance is the relative luminance of a colour as defined by WCAG.
+func luminance(c rgba) float64 {
+	return 0.2126*linear(c.r) + 0.7152*linear(c.g) + 0.0722*linear(c.b)
+}
+
+func contrast(thread *starlark.Thread, _ *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	var a, b starlark.Value
+
+	if err := starlark.UnpackArgs(
+		"contrast",
+		args, kwargs,
+		"a", &a,
+		"b", &b,
+	); err != nil {
+		return nil, fmt.Errorf("unpacking arguments for contrast: %s", err)
+	}
+
+	c1, err := parse("contrast", a)
+	if err != nil {
+		return nil, err
+	}
+	c2, err := parse("contrast", b)
+	if err != nil {
+		return nil, err
+	}
+
+	l1, l2 := luminance(c1), luminance(c2)
+	if l1 < l2 {
+		l1, l2 = l2, l1
+	}
+
+	return starlark.Float((l1 + 0.05) / (l2 + 0.05)), nil
+}
+
+func palette(thread *starlark.Thread, _ *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	var (
+		stopsValue starlark.Value
+		n          int
+		space      = "oklch"
+	)
+
+	if err := starlark.UnpackArgs(
+		"palette",
+		args, kwargs,
+		"stops", &stopsValue,
+		"n", &n,
+		"space?", &space,
+	); err != nil {
+		return nil, fmt.Errorf("unpacking arguments for palette: %s", err)
+	}
+
+	if err := unpackSpace("palette", space); err != nil {
+		return nil, err
+	}
+	if n < 1 {
+		return nil, fmt.Errorf("palette: n must be at least 1, got %d", n)
+	}
+
+	stops, err := parseStops("palette", stopsValue)
+	if err != nil {
+		return nil, err
+	}
+
+	first, last := stops[0].pos, stops[len(stops)-1].pos
+	colors := make([]starlark.Value, n)
+	for i := range colors {
+		t := first
+		if n > 1 {
+			t = first + (last-first)*float64(i)/float64(n-1)
+		}
+		colors[i] = gradient(stops, t, space).hex()
+	}
+
+	return starlark.NewList(colors), nil
+}
+
+// heatStops is the default scale of heatmap, from cold to hot.
+var heatStops = []string{"#00f", "#0ff", "#0f0", "#ff0", "#f00"}
+
+func heatmap(thread *starlark.Thread, _ *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	var (
+		value      starlark.Value
+		low        starlark.Value = starlark.Float(0)
+		high       starlark.Value = starlark.Float(1)
+		stopsValue starlark.Value = starlark.None
+		space                     = "oklch"
+	)
+
+	if err := starlark.UnpackArgs(
+		"heatmap",
+		args, kwargs,
+		"value", &value,
+		"min?", &low,
+		"max?", &high,
+		"colors?", &stopsValue,
+		"space?", &space,
+	); err != nil {
+		return nil, fmt.Errorf("unpacking arguments for heatmap: %s", err)
+	}
+
+	if err := unpackSpace("heatmap", space); err != nil {
+		return nil, err
+	}
+
+	if stopsValue == starlark.None {
+		colors := make([]starlark.Value, len(heatStops))
+		for i, s := range heatStops {
+			colors[i] = starlark.String(s)
+		}
+		stopsValue = starlark.NewList(colors)
+	}
+	stops, err := parseStops("heatmap", stopsValue)
+	if err != nil {
+		return nil, err
+	}
+
+	v, err := number("heatmap", "value", value)
+	if err != nil {
+		return nil, err
+	}
+	lo, err := number("heatmap", "min", low)
+	if err != nil {
+		return nil, err
+	}
+	hi, err := number("heatmap", "max", high)
+	if err != nil {
+		return nil, err
+	}
+
+	t := 0.0
+	if hi != lo {
+		t = (v - lo) / (hi - lo)
+	}
+
+	// the value is placed on the scale between its first and last stops
+	first, last := stops[0].pos, stops[len(stops)-1].pos
+	return gradient(stops, first+clamp(t)*(last-first), space).hex(), nil
+}
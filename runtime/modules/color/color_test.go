@@ -0,0 +1,139 @@
+package color_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"tidbyt.dev/pixlet/runtime"
+)
+
+var colorSource = `
+load("color.star", "color")
+
+def assert(success, message=None):
+    if not success:
+        fail(message or "assertion failed")
+
+def close(a, b, tolerance = 0.002):
+    for x, y in zip(a, b):
+        if abs(x - y) > tolerance:
+            return False
+    return True
+
+def test_conversions():
+    assert(color.rgb(255, 128, 0) == "#ff8000")
+    assert(color.rgb(255, 128, 0, 128) == "#ff800080")
+    assert(color.to_rgb("#f80") == (255, 136, 0, 255))
+    assert(color.to_rgb("#ff800080") == (255, 128, 0, 128))
+
+    assert(color.hsl(0, 1, 0.5) == "#ff0000")
+    assert(color.hsl(120, 1, 0.25) == "#008000")
+    assert(color.hsl(240, 1, 0.5, a = 0.5) == "#0000ff80")
+    assert(close(color.to_hsl("#008000"), (120, 1, 0.25)))
+    assert(close(color.to_hsl("#808080"), (0, 0, 0.50196)))
+
+    assert(color.hsv(60, 1, 1) == "#ffff00")
+    assert(color.hsv(300, 0.5, 0.5) == "#804080")
+    assert(close(color.to_hsv("#804080"), (300, 0.5, 0.50196)))
+
+    # reference values from the CSS Color 4 specification
+    assert(close(color.to_oklch("#ff0000"), (0.62796, 0.25768, 29.234)))
+    assert(close(color.to_oklch("#ffffff"), (1, 0, 0)))
+    assert(color.oklch(0.62796, 0.25768, 29.234) == "#ff0000")
+
+    # out of gamut colors keep their lightness and hue
+    vivid = color.oklch(0.7, 0.4, 150)
+    l, c, h = color.to_oklch(vivid)
+    assert(abs(l - 0.7) < 0.01 and abs(h - 150) < 1 and c < 0.4)
+
+def test_lerp():
+    assert(color.lerp("#000", "#fff", 0.5) == "#808080")
+    assert(color.lerp("#000", "#fff", 2) == "#ffffff")
+    assert(color.lerp("#ff000000", "#ff0000", 0.5) == "#ff000080")
+
+    # hue goes the short way around
+    assert(color.lerp("#ff0000", "#ff00ff", 0.5, space = "hsl") == "#ff0080")
+    assert(color.lerp("#ff0000", "#0000ff", 0, space = "oklch") == "#ff0000")
+
+    # greys take the hue of the other color
+    assert(color.lerp("#fff", "#f00", 0.5, space = "hsl") == "#df9f9f")
+
+def test_gradient():
+    stops = ["#000", "#f00", "#fff"]
+    assert(color.gradient(stops, 0.25) == "#800000")
+    assert(color.gradient(stops, 0.75) == "#ff8080")
+    assert(color.gradient(stops, -1) == "#000000")
+
+    stops = [(0, "#000"), (10, "#0f0"), (100, "#00f")]
+    assert(color.gradient(stops, 5) == "#008000")
+    assert(color.gradient(stops, 1000) == "#0000ff")
+
+def test_lightness():
+    assert(color.lighten("#800000", 0.25) == "#ff0000")
+    assert(color.darken("#ff0000", 0.25) == "#800000")
+    assert(color.darken("#ff0000", 2) == "#000000")
+
+def test_contrast():
+    assert(color.contrast("#000", "#fff") == 21.0)
+    assert(color.contrast("#fff", "#fff") == 1.0)
+
+    # WCAG AA requires 4.5:1 for normal text
+    assert(abs(color.contrast("#767676", "#fff") - 4.54) < 0.01)
+
+def test_palette():
+    steps = color.palette(["#000", "#fff"], 5, space = "rgb")
+    assert(steps == ["#000000", "#404040", "#808080", "#bfbfbf", "#ffffff"])
+    assert(color.palette(["#f00", "#00f"], 1) == ["#ff0000"])
+
+    steps = color.palette(["#f00", "#00f"], 7)
+    assert(len(steps) == 7 and steps[0] == "#ff0000" and steps[-1] == "#0000ff")
+
+def test_heatmap():
+    assert(color.heatmap(0) == "#0000ff")
+    assert(color.heatmap(1) == "#ff0000")
+    assert(color.heatmap(30, min = -10, max = 30) == "#ff0000")
+    assert(color.heatmap(100, max = 10) == "#ff0000")
+    assert(color.heatmap(5, min = 0, max = 10, colors = ["#000", "#fff"], space = "rgb") == "#808080")
+
+test_conversions()
+test_lerp()
+test_gradient()
+test_lightness()
+test_contrast()
+test_palette()
+test_heatmap()
+
+def main():
+    return []
+`
+
+func TestColor(t *testing.T) {
+	app, err := runtime.NewApplet("color_test.star", []byte(colorSource))
+	require.NoError(t, err)
+
+	screens, err := app.Run(context.Background())
+	require.NoError(t, err)
+	assert.NotNil(t, screens)
+}
+
+func TestColorErrors(t *testing.T) {
+	for _, expr := range []string{
+		`color.to_rgb("not a color")`,
+		`color.to_rgb(42)`,
+		`color.rgb(256, 0, 0)`,
+		`color.hsl("a", 1, 1)`,
+		`color.lerp("#000", "#fff", 0.5, space = "cmyk")`,
+		`color.gradient(["#000"], 0.5)`,
+		`color.gradient([(1, "#000"), (0, "#fff")], 0.5)`,
+		`color.palette(["#000", "#fff"], 0)`,
+	} {
+		src := "load(\"color.star\", \"color\")\nx = " + expr + "\ndef main():\n    return []\n"
+		app, err := runtime.NewApplet("color_error.star", []byte(src))
+		if err == nil {
+			_, err = app.Run(context.Background())
+		}
+		assert.Error(t, err, expr)
+	}
+}
@@ -0,0 +1,177 @@
+package color
+
+import (
+	"math"
+)
+
+func clamp(f float64) float64 {
+	return math.Max(0, math.Min(1, f))
+}
+
+// normalizeHue reduces a hue in degrees to [0, 360).
+func normalizeHue(h float64) float64 {
+	h = math.Mod(h, 360)
+	if h < 0 {
+		h += 360
+	}
+	return h
+}
+
+func rgbToHSL(r, g, b float64) (h, s, l float64) {
+	max := math.Max(r, math.Max(g, b))
+	min := math.Min(r, math.Min(g, b))
+	l = (max + min) / 2
+
+	d := max - min
+	if d == 0 {
+		return 0, 0, l
+	}
+
+	s = d / (1 - math.Abs(2*l-1))
+	return hue(r, g, b, max, d), s, l
+}
+
+func hslToRGB(h, s, l float64) (r, g, b float64) {
+	c := (1 - math.Abs(2*l-1)) * s
+	return fromChroma(h, c, l-c/2)
+}
+
+func rgbToHSV(r, g, b float64) (h, s, v float64) {
+	max := math.Max(r, math.Max(g, b))
+	min := math.Min(r, math.Min(g, b))
+
+	d := max - min
+	if d == 0 {
+		return 0, 0, max
+	}
+
+	return hue(r, g, b, max, d), d / max, max
+}
+
+func hsvToRGB(h, s, v float64) (r, g, b float64) {
+	c := v * s
+	return fromChroma(h, c, v-c)
+}
+
+// hue returns the hue of a colour in degrees, given its largest channel
+// and its chroma.
+func hue(r, g, b, max, chroma float64) float64 {
+	var h float64
+	switch max {
+	case r:
+		h = math.Mod((g-b)/chroma, 6)
+	case g:
+		h = (b-r)/chroma + 2
+	default:
+		h = (r-g)/chroma + 4
+	}
+	return normalizeHue(h * 60)
+}
+
+// fromChroma builds a colour from its hue, chroma and the amount added to
+// every channel, as shared by HSL and HSV.
+func fromChroma(h, c, m float64) (r, g, b float64) {
+	hp := h / 60
+	x := c * (1 - math.Abs(math.Mod(hp, 2)-1))
+
+	switch {
+	case hp < 1:
+		r, g, b = c, x, 0
+	case hp < 2:
+		r, g, b = x, c, 0
+	case hp < 3:
+		r, g, b = 0, c, x
+	case hp < 4:
+		r, g, b = 0, x, c
+	case hp < 5:
+		r, g, b = x, 0, c
+	default:
+		r, g, b = c, 0, x
+	}
+
+	return r + m, g + m, b + m
+}
+
+// linear converts an sRGB channel to linear light.
+func linear(c float64) float64 {
+	if c <= 0.04045 {
+		return c / 12.92
+	}
+	return math.Pow((c+0.055)/1.055, 2.4)
+}
+
+// gamma converts a channel in linear light to sRGB.
+func gamma(c float64) float64 {
+	if c <= 0.0031308 {
+		return c * 12.92
+	}
+	return 1.055*math.Pow(c, 1/2.4) - 0.055
+}
+
+// The conversions to and from OKLab are those of Björn Ottosson, who
+// designed it: https://bottosson.github.io/posts/oklab/
+
+func rgbToOKLab(r, g, b float64) (l, a, bb float64) {
+	r, g, b = linear(r), linear(g), linear(b)
+
+	lc := math.Cbrt(0.4122214708*r + 0.5363325363*g + 0.0514459929*b)
+	mc := math.Cbrt(0.2119034982*r + 0.6806995451*g + 0.1073969566*b)
+	sc := math.Cbrt(0.0883024619*r + 0.2817188376*g + 0.6299787005*b)
+
+	l = 0.2104542553*lc + 0.7936177850*mc - 0.0040720468*sc
+	a = 1.9779984951*lc - 2.4285922050*mc + 0.4505937099*sc
+	bb = 0.0259040371*lc + 0.7827717662*mc - 0.8086757660*sc
+	return l, a, bb
+}
+
+// okLabToLinear converts OKLab to linear sRGB, which may be out of gamut.
+func okLabToLinear(l, a, b float64) (r, g, bb float64) {
+	lc := l + 0.3963377774*a + 0.2158037573*b
+	mc := l - 0.1055613458*a - 0.0638541728*b
+	sc := l - 0.0894841775*a - 1.2914855480*b
+
+	lc, mc, sc = lc*lc*lc, mc*mc*mc, sc*sc*sc
+
+	r = 4.0767416621*lc - 3.3077115913*mc + 0.2309699292*sc
+	g = -1.2684380046*lc + 2.6097574011*mc - 0.3413193965*sc
+	bb = -0.0041960863*lc - 0.7034186147*mc + 1.7076147010*sc
+	return r, g, bb
+}
+
+func rgbToOKLCH(r, g, b float64) (l, c, h float64) {
+	l, a, bb := rgbToOKLab(r, g, b)
+	c = math.Hypot(a, bb)
+	if c < 1e-6 {
+		return l, 0, 0
+	}
+	return l, c, normalizeHue(math.Atan2(bb, a) * 180 / math.Pi)
+}
+
+// oklchToRGB converts OKLCH to sRGB. Colours that sRGB can't show have
+// their chroma reduced until it can, which keeps their lightness and hue.
+func oklchToRGB(l, c, h float64) (r, g, b float64) {
+	inGamut := func(c float64) (float64, float64, float64, bool) {
+		a := c * math.Cos(h*math.Pi/180)
+		bb := c * math.Sin(h*math.Pi/180)
+		r, g, b := okLabToLinear(l, a, bb)
+		const eps = 1e-6
+		ok := r >= -eps && r <= 1+eps && g >= -eps && g <= 1+eps && b >= -eps && b <= 1+eps
+		return r, g, b, ok
+	}
+
+	lr, lg, lb, ok := inGamut(c)
+	if !ok {
+		lo, hi := 0.0, c
+		for i := 0; i < 24; i++ {
+			mid := (lo + hi) / 2
+			if _, _, _, ok := inGamut(mid); ok {
+				lo = mid
+			} else {
+				hi = mid
+			}
+		}
+		lr, lg, lb, _ = inGamut(lo)
+	}
+
+	return gamma(clamp(lr)), gamma(clamp(lg)), gamma(clamp(lb))
+}
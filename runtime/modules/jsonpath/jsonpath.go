@@ -0,0 +1,181 @@
+package jsonpath
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"strings"
+	"sync"
+
+	"go.starlark.net/starlark"
+	"go.starlark.net/starlarkstruct"
+)
+
+const (
+	ModuleName = "jsonpath"
+)
+
+var (
+	once   sync.Once
+	module starlark.StringDict
+)
+
+func LoadModule() (starlark.StringDict, error) {
+	once.Do(func() {
+		module = starlark.StringDict{
+			ModuleName: &starlarkstruct.Module{
+				Name: ModuleName,
+				Members: starlark.StringDict{
+					"query":     starlark.NewBuiltin("query", query),
+					"query_one": starlark.NewBuiltin("query_one", queryOne),
+				},
+			},
+		}
+	})
+
+	return module, nil
+}
+
+func unpackQuery(fnname string, args starlark.Tuple, kwargs []starlark.Tuple) ([]starlark.Value, error) {
+	var (
+		doc  starlark.Value
+		expr starlark.String
+	)
+
+	if err := starlark.UnpackArgs(
+		fnname,
+		args, kwargs,
+		"doc", &doc,
+		"expr", &expr,
+	); err != nil {
+		return nil, fmt.Errorf("unpacking arguments for %s: %s", fnname, err)
+	}
+
+	path, err := Parse(expr.GoString())
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", fnname, err)
+	}
+
+	// strings are JSON documents, anything else has been decoded already
+	if s, ok := doc.(starlark.String); ok {
+		doc, err = Decode(s.GoString())
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", fnname, err)
+		}
+	}
+
+	matches, err := path.Query(doc)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", fnname, err)
+	}
+
+	return matches, nil
+}
+
+func query(thread *starlark.Thread, _ *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	matches, err := unpackQuery("query", args, kwargs)
+	if err != nil {
+		return nil, err
+	}
+
+	return starlark.NewList(matches), nil
+}
+
+func queryOne(thread *starlark.Thread, _ *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	matches, err := unpackQuery("query_one", args, kwargs)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(matches) == 0 {
+		return starlark.None, nil
+	}
+
+	return matches[0], nil
+}
+
+// Decode decodes a JSON document into Starlark values, keeping the order
+// of object members.
+func Decode(doc string) (starlark.Value, error) {
+	dec := json.NewDecoder(strings.NewReader(doc))
+	dec.UseNumber()
+
+	v, err := decodeValue(dec)
+	if err != nil {
+		return nil, fmt.Errorf("decoding JSON: %w", err)
+	}
+
+	if _, err := dec.Token(); err != io.EOF {
+		return nil, fmt.Errorf("decoding JSON: unexpected data after document")
+	}
+
+	return v, nil
+}
+
+func decodeValue(dec *json.Decoder) (starlark.Value, error) {
+	tok, err := dec.Token()
+	if err != nil {
+		return nil, err
+	}
+
+	switch tok := tok.(type) {
+	case json.Delim:
+		switch tok {
+		case '{':
+			dict := starlark.NewDict(0)
+			for dec.More() {
+				key, err := dec.Token()
+				if err != nil {
+					return nil, err
+				}
+				val, err := decodeValue(dec)
+				if err != nil {
+					return nil, err
+				}
+				if err := dict.SetKey(starlark.String(key.(string)), val); err != nil {
+					return nil, err
+				}
+			}
+			_, err := dec.Token()
+			return dict, err
+
+		case '[':
+			var elems []starlark.Value
+			for dec.More() {
+				val, err := decodeValue(dec)
+				if err != nil {
+					return nil, err
+				}
+				elems = append(elems, val)
+			}
+			_, err := dec.Token()
+			return starlark.NewList(elems), err
+		}
+
+	case string:
+		return starlark.String(tok), nil
+
+	case json.Number:
+		if i, err := tok.Int64(); err == nil {
+			return starlark.MakeInt64(i), nil
+		}
+		if i, ok := new(big.Int).SetString(tok.String(), 10); ok {
+			// an integer too big for 64 bits
+			return starlark.MakeBigInt(i), nil
+		}
+		f, err := tok.Float64()
+		if err != nil {
+			return nil, err
+		}
+		return starlark.Float(f), nil
+
+	case bool:
+		return starlark.Bool(tok), nil
+
+	case nil:
+		return starlark.None, nil
+	}
+
+	return nil, fmt.Errorf("unexpected token %v", tok)
+}
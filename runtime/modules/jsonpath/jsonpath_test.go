@@ -0,0 +1,150 @@
+package jsonpath_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"tidbyt.dev/pixlet/runtime"
+)
+
+var jsonpathSource = `
+load("encoding/json.star", "json")
+load("jsonpath.star", "jsonpath")
+
+def assert(success, message=None):
+    if not success:
+        fail(message or "assertion failed")
+
+store = """
+{
+    "store": {
+        "book": [
+            {"category": "reference", "author": "Nigel Rees", "title": "Sayings of the Century", "price": 8.95},
+            {"category": "fiction", "author": "Evelyn Waugh", "title": "Sword of Honour", "price": 12.99},
+            {"category": "fiction", "author": "Herman Melville", "title": "Moby Dick", "isbn": "0-553-21311-3", "price": 8.99},
+            {"category": "fiction", "author": "J. R. R. Tolkien", "title": "The Lord of the Rings", "isbn": "0-395-19395-8", "price": 22.99}
+        ],
+        "bicycle": {"color": "red", "price": 19.95}
+    },
+    "limit": 10
+}
+"""
+
+def test_paths():
+    for doc in [store, json.decode(store)]:
+        assert(jsonpath.query(doc, "$.store.book[0].author") == ["Nigel Rees"])
+        assert(jsonpath.query(doc, "$['store']['bicycle'].color") == ["red"])
+        assert(jsonpath.query(doc, "$.store.book[-1].title") == ["The Lord of the Rings"])
+        assert(len(jsonpath.query(doc, "$.store.book[*].author")) == 4)
+        assert(jsonpath.query(doc, "$..author")[3] == "J. R. R. Tolkien")
+        assert(jsonpath.query(doc, "$.store.*.color") == ["red"])
+        assert(jsonpath.query(doc, "$..book[0,2].price") == [8.95, 8.99])
+        assert(jsonpath.query(doc, "$.store.book[1:3].title") == ["Sword of Honour", "Moby Dick"])
+        assert(jsonpath.query(doc, "$.store.book[::-2].price") == [22.99, 12.99])
+        assert(jsonpath.query(doc, "$.limit") == [10])
+        assert(jsonpath.query(doc, "$.store.book[9]") == [])
+        assert(jsonpath.query(doc, "$.missing.path") == [])
+        assert(len(jsonpath.query(doc, "$..price")) == 5)
+
+def test_filters():
+    doc = json.decode(store)
+    q = lambda expr: [b["title"] for b in jsonpath.query(doc, expr)]
+
+    assert(q("$.store.book[?(@.price < 10)]") == ["Sayings of the Century", "Moby Dick"])
+    assert(q("$.store.book[?@.price < $.limit]") == ["Sayings of the Century", "Moby Dick"])
+    assert(q("$..book[?(@.isbn)]") == ["Moby Dick", "The Lord of the Rings"])
+    assert(q("$..book[?(!@.isbn)]") == ["Sayings of the Century", "Sword of Honour"])
+    assert(q("$..book[?(@.category == 'fiction' && @.price > 20)]") == ["The Lord of the Rings"])
+    assert(q("$..book[?(@.author == \"Nigel Rees\" || (@.price >= 12.99 && @.price <= 13))]") == ["Sayings of the Century", "Sword of Honour"])
+    assert(q("$..book[?(@.price != 8.95)]") == ["Sword of Honour", "Moby Dick", "The Lord of the Rings"])
+
+    # values of different types are never ordered
+    assert(jsonpath.query([1, "2", True, None, 3.5], "$[?(@ > 1)]") == [3.5])
+    assert(jsonpath.query([1, True, None], "$[?(@ == true)]") == [True])
+    assert(jsonpath.query([1, True, None], "$[?(@ == null)]") == [None])
+
+def test_query_one():
+    assert(jsonpath.query_one(store, "$..bicycle.color") == "red")
+    assert(jsonpath.query_one(store, "$..book[*].title") == "Sayings of the Century")
+    assert(jsonpath.query_one(store, "$..book[?(@.price > 100)]") == None)
+
+    # decoded values are returned as they are
+    doc = {"items": [{"id": 1}, {"id": 2}]}
+    assert(jsonpath.query_one(doc, "$.items[1]") == doc["items"][1])
+    assert(jsonpath.query(("a", "b"), "$[1]") == ["b"])
+
+test_paths()
+test_filters()
+test_query_one()
+
+def main():
+    return []
+`
+
+func TestJSONPath(t *testing.T) {
+	app, err := runtime.NewApplet("jsonpath_test.star", []byte(jsonpathSource))
+	require.NoError(t, err)
+
+	screens, err := app.Run(context.Background())
+	require.NoError(t, err)
+	assert.NotNil(t, screens)
+}
+
+func TestJSONPathErrors(t *testing.T) {
+	for _, expr := range []string{
+		`jsonpath.query({}, "store")`,
+		`jsonpath.query({}, "$.")`,
+		`jsonpath.query({}, "$[")`,
+		`jsonpath.query({}, "$['a")`,
+		`jsonpath.query({}, "$[?(@.a <)]")`,
+		`jsonpath.query({}, "$[?(@.a == 1]")`,
+		`jsonpath.query({}, "$[?(1)]")`,
+		`jsonpath.query({}, "$.a b")`,
+		`jsonpath.query("{", "$")`,
+		`jsonpath.query("{} []", "$")`,
+		`jsonpath.query_one({})`,
+	} {
+		src := "load(\"jsonpath.star\", \"jsonpath\")\nx = " + expr + "\ndef main():\n    return []\n"
+		app, err := runtime.NewApplet("jsonpath_error.star", []byte(src))
+		if err == nil {
+			_, err = app.Run(context.Background())
+		}
+		assert.Error(t, err, expr)
+	}
+}
+
+func TestJSONPathCycles(t *testing.T) {
+	for _, tc := range []struct {
+		src string
+		err string
+	}{
+		{"a = []\na.append(a)\nx = jsonpath.query(a, \"$..x\")", "query: document contains itself"},
+		{"d = {}\nd[\"d\"] = d\nx = jsonpath.query_one(d, \"$..x\")", "query_one: document contains itself"},
+		{"d = {\"a\": [1]}\nd[\"a\"].append(d)\nx = jsonpath.query(d, \"$..*\")", "query: document contains itself"},
+		{"def nest(a):\n    for i in range(2000):\n        a = [a]\n    return a\nx = jsonpath.query(nest([]), \"$..x\")", "nested deeper than 1000 levels"},
+	} {
+		src := "load(\"jsonpath.star\", \"jsonpath\")\n" + tc.src + "\ndef main():\n    return []\n"
+		_, err := runtime.NewApplet("jsonpath_cycle.star", []byte(src))
+		assert.ErrorContains(t, err, tc.err, tc.src)
+	}
+
+	// values that appear more than once aren't cycles, and paths that
+	// don't descend don't mind them
+	src := `
+load("jsonpath.star", "jsonpath")
+b = [1]
+shared = jsonpath.query([b, b], "$..*")
+a = [2]
+a.append(a)
+first = jsonpath.query_one(a, "$[0]")
+def main():
+    return []
+`
+	app, err := runtime.NewApplet("jsonpath_shared.star", []byte(src))
+	require.NoError(t, err)
+	globals := app.Globals["jsonpath_shared.star"]
+	assert.Equal(t, "[[1], [1], 1, 1]", globals["shared"].String())
+	assert.Equal(t, "2", globals["first"].String())
+}
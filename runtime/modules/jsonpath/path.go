@@ -0,0 +1,788 @@
+package jsonpath
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"go.starlark.net/starlark"
+	"go.starlark.net/syntax"
+)
+
+// Path is a parsed JSONPath expression, such as
+// `$.store.book[?(@.price < 10)].title`.
+type Path struct {
+	absolute bool
+	segments []segment
+}
+
+// segment applies its selectors to every node, or with descendant set, to
+// every node and everything below it.
+type segment struct {
+	descendant bool
+	selectors  []selector
+}
+
+type selector interface {
+	// selectFrom appends the children of node picked by the selector to
+	// out.
+	selectFrom(root, node starlark.Value, out []starlark.Value) []starlark.Value
+}
+
+// Parse parses a JSONPath expression.
+func Parse(expr string) (*Path, error) {
+	p := &parser{src: expr}
+
+	p.skipSpace()
+	if !p.consume("$") {
+		return nil, p.errorf("expression must start with $")
+	}
+
+	path, err := p.parsePath(true)
+	if err != nil {
+		return nil, err
+	}
+
+	p.skipSpace()
+	if !p.done() {
+		return nil, p.errorf("unexpected %q", p.rest())
+	}
+
+	return path, nil
+}
+
+// MaxDepth is how far below a node a recursive descent goes before it
+// gives up, so that deep or self-referential documents fail instead of
+// overflowing the stack.
+const MaxDepth = 1000
+
+// queryError is raised as a panic from deep within a query, and returned
+// by Query.
+type queryError struct {
+	error
+}
+
+// Query returns the nodes in doc matched by the path, in document order.
+// It fails if a recursive descent finds doc contains itself, or is nested
+// deeper than MaxDepth.
+func (p *Path) Query(doc starlark.Value) (nodes []starlark.Value, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			qe, ok := r.(queryError)
+			if !ok {
+				panic(r)
+			}
+			nodes, err = nil, qe.error
+		}
+	}()
+
+	return p.query(doc, doc), nil
+}
+
+func (p *Path) query(root, current starlark.Value) []starlark.Value {
+	nodes := []starlark.Value{current}
+	if p.absolute {
+		nodes = []starlark.Value{root}
+	}
+
+	for _, seg := range p.segments {
+		if seg.descendant {
+			var all []starlark.Value
+			for _, node := range nodes {
+				all = descendants(node, all, map[starlark.Value]bool{})
+			}
+			nodes = all
+		}
+
+		var next []starlark.Value
+		for _, node := range nodes {
+			for _, sel := range seg.selectors {
+				next = sel.selectFrom(root, node, next)
+			}
+		}
+		nodes = next
+	}
+
+	return nodes
+}
+
+// descendants appends node and everything below it to out. ancestors
+// holds the lists and dicts that node is within.
+func descendants(node starlark.Value, out []starlark.Value, ancestors map[starlark.Value]bool) []starlark.Value {
+	out = append(out, node)
+
+	switch node.(type) {
+	case *starlark.List, *starlark.Dict:
+		if ancestors[node] {
+			panic(queryError{fmt.Errorf("document contains itself")})
+		}
+		if len(ancestors) >= MaxDepth {
+			panic(queryError{fmt.Errorf("document is nested deeper than %d levels", MaxDepth)})
+		}
+		ancestors[node] = true
+		defer delete(ancestors, node)
+	}
+
+	for _, child := range children(node) {
+		out = descendants(child, out, ancestors)
+	}
+	return out
+}
+
+// children returns the values of a mapping, or the elements of a sequence.
+func children(node starlark.Value) []starlark.Value {
+	switch node := node.(type) {
+	case *starlark.Dict:
+		var values []starlark.Value
+		for _, item := range node.Items() {
+			values = append(values, item[1])
+		}
+		return values
+
+	case starlark.Indexable:
+		if _, ok := node.(starlark.String); ok {
+			return nil
+		}
+		values := make([]starlark.Value, node.Len())
+		for i := range values {
+			values[i] = node.Index(i)
+		}
+		return values
+	}
+
+	return nil
+}
+
+// sequence returns node as a sequence that can be indexed, as long as
+// it's not a string.
+func sequence(node starlark.Value) (starlark.Indexable, bool) {
+	if _, ok := node.(starlark.String); ok {
+		return nil, false
+	}
+	seq, ok := node.(starlark.Indexable)
+	return seq, ok
+}
+
+type wildcardSelector struct{}
+
+func (wildcardSelector) selectFrom(_, node starlark.Value, out []starlark.Value) []starlark.Value {
+	return append(out, children(node)...)
+}
+
+type nameSelector string
+
+func (s nameSelector) selectFrom(_, node starlark.Value, out []starlark.Value) []starlark.Value {
+	mapping, ok := node.(starlark.Mapping)
+	if !ok {
+		return out
+	}
+
+	val, found, err := mapping.Get(starlark.String(s))
+	if err != nil || !found {
+		return out
+	}
+
+	return append(out, val)
+}
+
+type indexSelector int
+
+func (s indexSelector) selectFrom(_, node starlark.Value, out []starlark.Value) []starlark.Value {
+	seq, ok := sequence(node)
+	if !ok {
+		return out
+	}
+
+	i := int(s)
+	if i < 0 {
+		i += seq.Len()
+	}
+	if i < 0 || i >= seq.Len() {
+		return out
+	}
+
+	return append(out, seq.Index(i))
+}
+
+// sliceSelector picks elements like a Python slice, with nil meaning the
+// bound was left out.
+type sliceSelector struct {
+	start, end *int
+	step       int
+}
+
+func (s sliceSelector) selectFrom(_, node starlark.Value, out []starlark.Value) []starlark.Value {
+	seq, ok := sequence(node)
+	if !ok || s.step == 0 {
+		return out
+	}
+
+	n := seq.Len()
+	bound := func(i *int, def int) int {
+		if i == nil {
+			return def
+		}
+		v := *i
+		if v < 0 {
+			v += n
+		}
+		if s.step > 0 {
+			return clamp(v, 0, n)
+		}
+		return clamp(v, -1, n-1)
+	}
+
+	if s.step > 0 {
+		for i := bound(s.start, 0); i < bound(s.end, n); i += s.step {
+			out = append(out, seq.Index(i))
+		}
+	} else {
+		for i := bound(s.start, n-1); i > bound(s.end, -1); i += s.step {
+			out = append(out, seq.Index(i))
+		}
+	}
+
+	return out
+}
+
+func clamp(v, lo, hi int) int {
+	if v < lo {
+		return lo
+	}
+	if v > hi {
+		return hi
+	}
+	return v
+}
+
+// filterSelector picks the children for which the expression holds.
+type filterSelector struct {
+	expr filterExpr
+}
+
+func (s filterSelector) selectFrom(root, node starlark.Value, out []starlark.Value) []starlark.Value {
+	for _, child := range children(node) {
+		if s.expr.test(root, child) {
+			out = append(out, child)
+		}
+	}
+	return out
+}
+
+type filterExpr interface {
+	test(root, current starlark.Value) bool
+}
+
+type orExpr struct{ left, right filterExpr }
+
+func (e orExpr) test(root, current starlark.Value) bool {
+	return e.left.test(root, current) || e.right.test(root, current)
+}
+
+type andExpr struct{ left, right filterExpr }
+
+func (e andExpr) test(root, current starlark.Value) bool {
+	return e.left.test(root, current) && e.right.test(root, current)
+}
+
+type notExpr struct{ expr filterExpr }
+
+func (e notExpr) test(root, current starlark.Value) bool {
+	return !e.expr.test(root, current)
+}
+
+// existsExpr holds when the path matches anything.
+type existsExpr struct{ path *Path }
+
+func (e existsExpr) test(root, current starlark.Value) bool {
+	return len(e.path.query(root, current)) > 0
+}
+
+// operand is one side of a comparison: a literal, or a path that must
+// match exactly one node.
+type operand struct {
+	path    *Path
+	literal starlark.Value
+}
+
+func (o operand) value(root, current starlark.Value) (starlark.Value, bool) {
+	if o.path == nil {
+		return o.literal, true
+	}
+
+	nodes := o.path.query(root, current)
+	if len(nodes) != 1 {
+		return nil, false
+	}
+	return nodes[0], true
+}
+
+type compareExpr struct {
+	op          syntax.Token
+	left, right operand
+}
+
+func (e compareExpr) test(root, current starlark.Value) bool {
+	x, xok := e.left.value(root, current)
+	y, yok := e.right.value(root, current)
+
+	if !xok || !yok {
+		// a missing value is only equal to another missing value
+		switch e.op {
+		case syntax.EQL:
+			return xok == yok
+		case syntax.NEQ:
+			return xok != yok
+		}
+		return false
+	}
+
+	if e.op == syntax.EQL || e.op == syntax.NEQ {
+		eq := equal(x, y)
+		return eq == (e.op == syntax.EQL)
+	}
+
+	// ordering is only defined between numbers and between strings
+	_, xnum := x.(starlark.Float)
+	_, ynum := y.(starlark.Float)
+	_, xint := x.(starlark.Int)
+	_, yint := y.(starlark.Int)
+	_, xstr := x.(starlark.String)
+	_, ystr := y.(starlark.String)
+	if !((xnum || xint) && (ynum || yint)) && !(xstr && ystr) {
+		return false
+	}
+
+	ok, err := starlark.Compare(e.op, x, y)
+	return err == nil && ok
+}
+
+// equal compares values without the errors Starlark raises for values
+// of different types, and without treating booleans as numbers.
+func equal(x, y starlark.Value) bool {
+	_, xbool := x.(starlark.Bool)
+	_, ybool := y.(starlark.Bool)
+	if xbool != ybool {
+		return false
+	}
+
+	eq, err := starlark.Equal(x, y)
+	return err == nil && eq
+}
+
+type parser struct {
+	src string
+	pos int
+}
+
+func (p *parser) errorf(format string, args ...interface{}) error {
+	return fmt.Errorf("invalid JSONPath %q at offset %d: %s", p.src, p.pos, fmt.Sprintf(format, args...))
+}
+
+func (p *parser) done() bool {
+	return p.pos >= len(p.src)
+}
+
+func (p *parser) rest() string {
+	return p.src[p.pos:]
+}
+
+func (p *parser) peek() byte {
+	if p.done() {
+		return 0
+	}
+	return p.src[p.pos]
+}
+
+func (p *parser) consume(s string) bool {
+	if strings.HasPrefix(p.rest(), s) {
+		p.pos += len(s)
+		return true
+	}
+	return false
+}
+
+func (p *parser) skipSpace() {
+	for !p.done() && strings.IndexByte(" \t\r\n", p.peek()) >= 0 {
+		p.pos++
+	}
+}
+
+// parsePath parses the segments that follow $ or @.
+func (p *parser) parsePath(absolute bool) (*Path, error) {
+	path := &Path{absolute: absolute}
+
+	for {
+		var seg segment
+
+		switch {
+		case p.consume(".."):
+			seg.descendant = true
+			if p.peek() == '[' {
+				sels, err := p.parseBracket()
+				if err != nil {
+					return nil, err
+				}
+				seg.selectors = sels
+			} else {
+				sel, err := p.parseDotSelector()
+				if err != nil {
+					return nil, err
+				}
+				seg.selectors = []selector{sel}
+			}
+
+		case p.consume("."):
+			sel, err := p.parseDotSelector()
+			if err != nil {
+				return nil, err
+			}
+			seg.selectors = []selector{sel}
+
+		case p.peek() == '[':
+			sels, err := p.parseBracket()
+			if err != nil {
+				return nil, err
+			}
+			seg.selectors = sels
+
+		default:
+			return path, nil
+		}
+
+		path.segments = append(path.segments, seg)
+	}
+}
+
+func (p *parser) parseDotSelector() (selector, error) {
+	if p.consume("*") {
+		return wildcardSelector{}, nil
+	}
+
+	start := p.pos
+	for !p.done() {
+		r, size := utf8.DecodeRuneInString(p.rest())
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '_' && r != '-' {
+			break
+		}
+		p.pos += size
+	}
+
+	if p.pos == start {
+		return nil, p.errorf("expected a member name")
+	}
+
+	return nameSelector(p.src[start:p.pos]), nil
+}
+
+// parseBracket parses a bracketed, comma separated list of selectors.
+func (p *parser) parseBracket() ([]selector, error) {
+	p.consume("[")
+
+	var sels []selector
+	for {
+		p.skipSpace()
+		sel, err := p.parseSelector()
+		if err != nil {
+			return nil, err
+		}
+		sels = append(sels, sel)
+
+		p.skipSpace()
+		if p.consume("]") {
+			return sels, nil
+		}
+		if !p.consume(",") {
+			return nil, p.errorf("expected , or ]")
+		}
+	}
+}
+
+func (p *parser) parseSelector() (selector, error) {
+	switch c := p.peek(); {
+	case c == '*':
+		p.pos++
+		return wildcardSelector{}, nil
+
+	case c == '\'' || c == '"':
+		s, err := p.parseString()
+		if err != nil {
+			return nil, err
+		}
+		return nameSelector(s), nil
+
+	case c == '?':
+		p.pos++
+		p.skipSpace()
+		expr, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		return filterSelector{expr}, nil
+
+	case c == '-' || c == ':' || (c >= '0' && c <= '9'):
+		return p.parseIndexOrSlice()
+	}
+
+	return nil, p.errorf("expected a selector")
+}
+
+func (p *parser) parseIndexOrSlice() (selector, error) {
+	var bounds [3]*int
+
+	for i := 0; i < 3; i++ {
+		p.skipSpace()
+		if c := p.peek(); c == '-' || (c >= '0' && c <= '9') {
+			n, err := p.parseInt()
+			if err != nil {
+				return nil, err
+			}
+			bounds[i] = &n
+		}
+
+		p.skipSpace()
+		if i == 0 && p.peek() != ':' {
+			if bounds[0] == nil {
+				return nil, p.errorf("expected an index")
+			}
+			return indexSelector(*bounds[0]), nil
+		}
+		if i == 2 || !p.consume(":") {
+			break
+		}
+	}
+
+	step := 1
+	if bounds[2] != nil {
+		step = *bounds[2]
+	}
+	return sliceSelector{start: bounds[0], end: bounds[1], step: step}, nil
+}
+
+func (p *parser) parseInt() (int, error) {
+	start := p.pos
+	p.consume("-")
+	for !p.done() && p.peek() >= '0' && p.peek() <= '9' {
+		p.pos++
+	}
+
+	n, err := strconv.Atoi(p.src[start:p.pos])
+	if err != nil {
+		p.pos = start
+		return 0, p.errorf("expected an integer")
+	}
+	return n, nil
+}
+
+// parseString parses a single or double quoted string, with backslash
+// escapes.
+func (p *parser) parseString() (string, error) {
+	quote := p.peek()
+	p.pos++
+
+	var b strings.Builder
+	for {
+		if p.done() {
+			return "", p.errorf("unterminated string")
+		}
+
+		c := p.peek()
+		p.pos++
+		switch {
+		case c == quote:
+			return b.String(), nil
+
+		case c == '\\':
+			if p.done() {
+				return "", p.errorf("unterminated string")
+			}
+			esc := p.peek()
+			p.pos++
+			switch esc {
+			case 'n':
+				b.WriteByte('\n')
+			case 't':
+				b.WriteByte('\t')
+			case 'r':
+				b.WriteByte('\r')
+			case 'u':
+				if len(p.rest()) < 4 {
+					return "", p.errorf("invalid unicode escape")
+				}
+				r, err := strconv.ParseUint(p.src[p.pos:p.pos+4], 16, 32)
+				if err != nil {
+					return "", p.errorf("invalid unicode escape")
+				}
+				b.WriteRune(rune(r))
+				p.pos += 4
+			default:
+				b.WriteByte(esc)
+			}
+
+		default:
+			b.WriteByte(c)
+		}
+	}
+}
+
+func (p *parser) parseOr() (filterExpr, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		p.skipSpace()
+		if !p.consume("||") {
+			return left, nil
+		}
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = orExpr{left, right}
+	}
+}
+
+func (p *parser) parseAnd() (filterExpr, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		p.skipSpace()
+		if !p.consume("&&") {
+			return left, nil
+		}
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		left = andExpr{left, right}
+	}
+}
+
+func (p *parser) parseUnary() (filterExpr, error) {
+	p.skipSpace()
+
+	if p.peek() == '!' && !strings.HasPrefix(p.rest(), "!=") {
+		p.pos++
+		expr, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return notExpr{expr}, nil
+	}
+
+	if p.consume("(") {
+		expr, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		p.skipSpace()
+		if !p.consume(")") {
+			return nil, p.errorf("expected )")
+		}
+		return expr, nil
+	}
+
+	return p.parseComparison()
+}
+
+var comparisonOps = []struct {
+	text string
+	op   syntax.Token
+}{
+	// longer operators first, so that <= isn't read as <
+	{"==", syntax.EQL},
+	{"!=", syntax.NEQ},
+	{"<=", syntax.LE},
+	{">=", syntax.GE},
+	{"<", syntax.LT},
+	{">", syntax.GT},
+}
+
+func (p *parser) parseComparison() (filterExpr, error) {
+	left, err := p.parseOperand()
+	if err != nil {
+		return nil, err
+	}
+
+	p.skipSpace()
+	for _, c := range comparisonOps {
+		if !p.consume(c.text) {
+			continue
+		}
+
+		p.skipSpace()
+		right, err := p.parseOperand()
+		if err != nil {
+			return nil, err
+		}
+		return compareExpr{op: c.op, left: left, right: right}, nil
+	}
+
+	if left.path == nil {
+		return nil, p.errorf("expected a comparison")
+	}
+	return existsExpr{left.path}, nil
+}
+
+func (p *parser) parseOperand() (operand, error) {
+	switch c := p.peek(); {
+	case c == '@' || c == '$':
+		p.pos++
+		path, err := p.parsePath(c == '$')
+		if err != nil {
+			return operand{}, err
+		}
+		return operand{path: path}, nil
+
+	case c == '\'' || c == '"':
+		s, err := p.parseString()
+		if err != nil {
+			return operand{}, err
+		}
+		return operand{literal: starlark.String(s)}, nil
+
+	case c == '-' || (c >= '0' && c <= '9'):
+		return p.parseNumber()
+	}
+
+	for _, lit := range []struct {
+		text string
+		val  starlark.Value
+	}{
+		{"true", starlark.True},
+		{"false", starlark.False},
+		{"null", starlark.None},
+	} {
+		if p.consume(lit.text) {
+			return operand{literal: lit.val}, nil
+		}
+	}
+
+	return operand{}, p.errorf("expected a path or a literal")
+}
+
+func (p *parser) parseNumber() (operand, error) {
+	start := p.pos
+	p.consume("-")
+	for !p.done() && strings.IndexByte("0123456789.eE+-", p.peek()) >= 0 {
+		p.pos++
+	}
+	text := p.src[start:p.pos]
+
+	if i, err := strconv.ParseInt(text, 10, 64); err == nil {
+		return operand{literal: starlark.MakeInt64(i)}, nil
+	}
+	if f, err := strconv.ParseFloat(text, 64); err == nil {
+		return operand{literal: starlark.Float(f)}, nil
+	}
+
+	p.pos = start
+	return operand{}, p.errorf("invalid number %q", text)
+}
@@ -71,6 +71,37 @@ func WeightsFromStarlark(list *starlark.List) ([]float64, error) {
 	return result, nil
 }
 
+// WeightSeriesFromStarlark converts a list of numbers, or a list of
+// lists of numbers, to a list of series.
+func WeightSeriesFromStarlark(list *starlark.List) ([][]float64, error) {
+	if list.Len() == 0 {
+		return [][]float64{}, nil
+	}
+
+	if _, isList := list.Index(0).(*starlark.List); !isList {
+		series, err := WeightsFromStarlark(list)
+		if err != nil {
+			return nil, err
+		}
+		return [][]float64{series}, nil
+	}
+
+	result := make([][]float64, 0, list.Len())
+	for i := 0; i < list.Len(); i++ {
+		series, isList := list.Index(i).(*starlark.List)
+		if !isList {
+			return nil, fmt.Errorf("invalid type for data series: %s (expected a list)", list.Index(i).Type())
+		}
+		if val, err := WeightsFromStarlark(series); err == nil {
+			result = append(result, val)
+		} else {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
 func ColorSeriesFromStarlark(list *starlark.List) ([]color.Color, error) {
 	result := make([]color.Color, 0)
 
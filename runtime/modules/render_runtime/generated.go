@@ -4,6 +4,7 @@ package render_runtime
 
 import (
 	"fmt"
+	"math"
 	"sync"
 
 	"github.com/mitchellh/hashstructure/v2"
@@ -35,14 +36,24 @@ func LoadRenderModule() (starlark.StringDict, error) {
 				Members: starlark.StringDict{
 					"fonts": fnt,
 
+					"measure_text": starlark.NewBuiltin("measure_text", measureText),
+
+					"size": starlark.NewBuiltin("size", widgetSize),
+
 					"Animation": starlark.NewBuiltin("Animation", newAnimation),
 
+					"BarChart": starlark.NewBuiltin("BarChart", newBarChart),
+
 					"Box": starlark.NewBuiltin("Box", newBox),
 
 					"Circle": starlark.NewBuiltin("Circle", newCircle),
 
 					"Column": starlark.NewBuiltin("Column", newColumn),
 
+					"Flex": starlark.NewBuiltin("Flex", newFlex),
+
+					"Gauge": starlark.NewBuiltin("Gauge", newGauge),
+
 					"Image": starlark.NewBuiltin("Image", newImage),
 
 					"Marquee": starlark.NewBuiltin("Marquee", newMarquee),
@@ -53,14 +64,32 @@ func LoadRenderModule() (starlark.StringDict, error) {
 
 					"Plot": starlark.NewBuiltin("Plot", newPlot),
 
+					"PlotBand": starlark.NewBuiltin("PlotBand", newPlotBand),
+
+					"PlotLine": starlark.NewBuiltin("PlotLine", newPlotLine),
+
+					"PlotSeries": starlark.NewBuiltin("PlotSeries", newPlotSeries),
+
+					"ProgressBar": starlark.NewBuiltin("ProgressBar", newProgressBar),
+
+					"RichText": starlark.NewBuiltin("RichText", newRichText),
+
 					"Root": starlark.NewBuiltin("Root", newRoot),
 
 					"Row": starlark.NewBuiltin("Row", newRow),
 
 					"Sequence": starlark.NewBuiltin("Sequence", newSequence),
 
+					"Span": starlark.NewBuiltin("Span", newSpan),
+
 					"Stack": starlark.NewBuiltin("Stack", newStack),
 
+					"Table": starlark.NewBuiltin("Table", newTable),
+
+					"TableCell": starlark.NewBuiltin("TableCell", newTableCell),
+
+					"TableColumn": starlark.NewBuiltin("TableColumn", newTableColumn),
+
 					"Text": starlark.NewBuiltin("Text", newText),
 
 					"WrappedText": starlark.NewBuiltin("WrappedText", newWrappedText),
@@ -183,6 +212,185 @@ func animationFrameCount(
 	return starlark.MakeInt(count), nil
 }
 
+type BarChart struct {
+	Widget
+
+	render.BarChart
+
+	starlarkData *starlark.List
+
+	starlarkColors *starlark.List
+
+	starlarkColorInverted starlark.String
+
+	starlarkValueLim starlark.Tuple
+
+	frame_count *starlark.Builtin
+}
+
+func newBarChart(
+	thread *starlark.Thread,
+	_ *starlark.Builtin,
+	args starlark.Tuple,
+	kwargs []starlark.Tuple,
+) (starlark.Value, error) {
+
+	var (
+		data           *starlark.List
+		width          starlark.Int
+		height         starlark.Int
+		colors         *starlark.List
+		color_inverted starlark.String
+		horizontal     starlark.Bool
+		stacked        starlark.Bool
+		gap            starlark.Int
+		value_lim      starlark.Tuple
+	)
+
+	if err := starlark.UnpackArgs(
+		"BarChart",
+		args, kwargs,
+		"data", &data,
+		"width", &width,
+		"height", &height,
+		"colors?", &colors,
+		"color_inverted?", &color_inverted,
+		"horizontal?", &horizontal,
+		"stacked?", &stacked,
+		"gap?", &gap,
+		"value_lim?", &value_lim,
+	); err != nil {
+		return nil, fmt.Errorf("unpacking arguments for BarChart: %s", err)
+	}
+
+	w := &BarChart{}
+
+	w.starlarkData = data
+	if val, err := WeightSeriesFromStarlark(data); err == nil {
+		w.Data = val
+	} else {
+		return nil, err
+	}
+
+	w.Width = int(width.BigInt().Int64())
+
+	w.Height = int(height.BigInt().Int64())
+
+	w.starlarkColors = colors
+	if colors != nil {
+		if val, err := ColorSeriesFromStarlark(colors); err == nil {
+			w.Colors = val
+		} else {
+			return nil, err
+		}
+	}
+
+	w.starlarkColorInverted = color_inverted
+	if color_inverted.Len() > 0 {
+		c, err := render.ParseColor(color_inverted.GoString())
+		if err != nil {
+			return nil, fmt.Errorf("color_inverted is not a valid hex string: %s", color_inverted.String())
+		}
+		w.ColorInverted = c
+	}
+
+	w.Horizontal = bool(horizontal)
+
+	w.Stacked = bool(stacked)
+
+	w.Gap = int(gap.BigInt().Int64())
+
+	w.starlarkValueLim = value_lim
+	if val, err := DataPointFromStarlark(value_lim); err == nil {
+		w.ValueLim = val
+	} else {
+		return nil, err
+	}
+
+	w.frame_count = starlark.NewBuiltin("frame_count", barchartFrameCount)
+
+	return w, nil
+}
+
+func (w *BarChart) AsRenderWidget() render.Widget {
+	return &w.BarChart
+}
+
+func (w *BarChart) AttrNames() []string {
+	return []string{
+		"data", "width", "height", "colors", "color_inverted", "horizontal", "stacked", "gap", "value_lim",
+	}
+}
+
+func (w *BarChart) Attr(name string) (starlark.Value, error) {
+	switch name {
+
+	case "data":
+
+		return w.starlarkData, nil
+
+	case "width":
+
+		return starlark.MakeInt(int(w.Width)), nil
+
+	case "height":
+
+		return starlark.MakeInt(int(w.Height)), nil
+
+	case "colors":
+
+		return w.starlarkColors, nil
+
+	case "color_inverted":
+
+		return w.starlarkColorInverted, nil
+
+	case "horizontal":
+
+		return starlark.Bool(w.Horizontal), nil
+
+	case "stacked":
+
+		return starlark.Bool(w.Stacked), nil
+
+	case "gap":
+
+		return starlark.MakeInt(int(w.Gap)), nil
+
+	case "value_lim":
+
+		return w.starlarkValueLim, nil
+
+	case "frame_count":
+		return w.frame_count.BindReceiver(w), nil
+
+	default:
+		return nil, nil
+	}
+}
+
+func (w *BarChart) String() string       { return "BarChart(...)" }
+func (w *BarChart) Type() string         { return "BarChart" }
+func (w *BarChart) Freeze()              {}
+func (w *BarChart) Truth() starlark.Bool { return true }
+
+func (w *BarChart) Hash() (uint32, error) {
+	sum, err := hashstructure.Hash(w, hashstructure.FormatV2, nil)
+	return uint32(sum), err
+}
+
+func barchartFrameCount(
+	thread *starlark.Thread,
+	b *starlark.Builtin,
+	args starlark.Tuple,
+	kwargs []starlark.Tuple) (starlark.Value, error) {
+
+	w := b.Receiver().(*BarChart)
+	count := w.FrameCount()
+
+	return starlark.MakeInt(count), nil
+}
+
 type Box struct {
 	Widget
 
@@ -461,6 +669,8 @@ func newColumn(
 		main_align  starlark.String
 		cross_align starlark.String
 		expanded    starlark.Bool
+		gap         starlark.Int
+		shrink      starlark.Bool
 	)
 
 	if err := starlark.UnpackArgs(
@@ -470,6 +680,8 @@ func newColumn(
 		"main_align?", &main_align,
 		"cross_align?", &cross_align,
 		"expanded?", &expanded,
+		"gap?", &gap,
+		"shrink?", &shrink,
 	); err != nil {
 		return nil, fmt.Errorf("unpacking arguments for Column: %s", err)
 	}
@@ -503,6 +715,10 @@ func newColumn(
 
 	w.Expanded = bool(expanded)
 
+	w.Gap = int(gap.BigInt().Int64())
+
+	w.Shrink = bool(shrink)
+
 	w.frame_count = starlark.NewBuiltin("frame_count", columnFrameCount)
 
 	return w, nil
@@ -514,7 +730,7 @@ func (w *Column) AsRenderWidget() render.Widget {
 
 func (w *Column) AttrNames() []string {
 	return []string{
-		"children", "main_align", "cross_align", "expanded",
+		"children", "main_align", "cross_align", "expanded", "gap", "shrink",
 	}
 }
 
@@ -537,6 +753,14 @@ func (w *Column) Attr(name string) (starlark.Value, error) {
 
 		return starlark.Bool(w.Expanded), nil
 
+	case "gap":
+
+		return starlark.MakeInt(int(w.Gap)), nil
+
+	case "shrink":
+
+		return starlark.Bool(w.Shrink), nil
+
 	case "frame_count":
 		return w.frame_count.BindReceiver(w), nil
 
@@ -567,17 +791,19 @@ func columnFrameCount(
 	return starlark.MakeInt(count), nil
 }
 
-type Image struct {
+type Flex struct {
 	Widget
 
-	render.Image
+	render.Flex
 
-	size *starlark.Builtin
+	starlarkChild starlark.Value
+
+	starlarkWeight starlark.Value
 
 	frame_count *starlark.Builtin
 }
 
-func newImage(
+func newFlex(
 	thread *starlark.Thread,
 	_ *starlark.Builtin,
 	args starlark.Tuple,
@@ -585,32 +811,51 @@ func newImage(
 ) (starlark.Value, error) {
 
 	var (
-		src    starlark.String
-		width  starlark.Int
-		height starlark.Int
+		child    starlark.Value
+		weight   starlark.Value
+		min_size starlark.Int
+		max_size starlark.Int
 	)
 
 	if err := starlark.UnpackArgs(
-		"Image",
+		"Flex",
 		args, kwargs,
-		"src", &src,
-		"width?", &width,
-		"height?", &height,
+		"child", &child,
+		"weight?", &weight,
+		"min_size?", &min_size,
+		"max_size?", &max_size,
 	); err != nil {
-		return nil, fmt.Errorf("unpacking arguments for Image: %s", err)
+		return nil, fmt.Errorf("unpacking arguments for Flex: %s", err)
 	}
 
-	w := &Image{}
+	w := &Flex{}
 
-	w.Src = src.GoString()
+	if child != nil {
+		childWidget, ok := child.(Widget)
+		if !ok {
+			return nil, fmt.Errorf(
+				"invalid type for child: %s (expected Widget)",
+				child.Type(),
+			)
+		}
+		w.Child = childWidget.AsRenderWidget()
+		w.starlarkChild = child
+	}
 
-	w.Width = int(width.BigInt().Int64())
+	w.starlarkWeight = weight
+	if weight == nil {
+		w.starlarkWeight = starlark.None
+	} else if val, ok := starlark.AsFloat(w.starlarkWeight); ok {
+		w.Weight = val
+	} else {
+		return nil, fmt.Errorf("expected number, but got: %s", w.starlarkWeight.String())
+	}
 
-	w.Height = int(height.BigInt().Int64())
+	w.MinSize = int(min_size.BigInt().Int64())
 
-	w.size = starlark.NewBuiltin("size", imageSize)
+	w.MaxSize = int(max_size.BigInt().Int64())
 
-	w.frame_count = starlark.NewBuiltin("frame_count", imageFrameCount)
+	w.frame_count = starlark.NewBuiltin("frame_count", flexFrameCount)
 
 	if err := w.Init(); err != nil {
 		return nil, err
@@ -619,37 +864,34 @@ func newImage(
 	return w, nil
 }
 
-func (w *Image) AsRenderWidget() render.Widget {
-	return &w.Image
+func (w *Flex) AsRenderWidget() render.Widget {
+	return &w.Flex
 }
 
-func (w *Image) AttrNames() []string {
+func (w *Flex) AttrNames() []string {
 	return []string{
-		"src", "width", "height", "delay",
+		"child", "weight", "min_size", "max_size",
 	}
 }
 
-func (w *Image) Attr(name string) (starlark.Value, error) {
+func (w *Flex) Attr(name string) (starlark.Value, error) {
 	switch name {
 
-	case "src":
-
-		return starlark.String(w.Src), nil
+	case "child":
 
-	case "width":
+		return w.starlarkChild, nil
 
-		return starlark.MakeInt(int(w.Width)), nil
+	case "weight":
 
-	case "height":
+		return w.starlarkWeight, nil
 
-		return starlark.MakeInt(int(w.Height)), nil
+	case "min_size":
 
-	case "delay":
+		return starlark.MakeInt(int(w.MinSize)), nil
 
-		return starlark.MakeInt(int(w.Delay)), nil
+	case "max_size":
 
-	case "size":
-		return w.size.BindReceiver(w), nil
+		return starlark.MakeInt(int(w.MaxSize)), nil
 
 	case "frame_count":
 		return w.frame_count.BindReceiver(w), nil
@@ -659,54 +901,51 @@ func (w *Image) Attr(name string) (starlark.Value, error) {
 	}
 }
 
-func (w *Image) String() string       { return "Image(...)" }
-func (w *Image) Type() string         { return "Image" }
-func (w *Image) Freeze()              {}
-func (w *Image) Truth() starlark.Bool { return true }
+func (w *Flex) String() string       { return "Flex(...)" }
+func (w *Flex) Type() string         { return "Flex" }
+func (w *Flex) Freeze()              {}
+func (w *Flex) Truth() starlark.Bool { return true }
 
-func (w *Image) Hash() (uint32, error) {
+func (w *Flex) Hash() (uint32, error) {
 	sum, err := hashstructure.Hash(w, hashstructure.FormatV2, nil)
 	return uint32(sum), err
 }
 
-func imageSize(
-	thread *starlark.Thread,
-	b *starlark.Builtin,
-	args starlark.Tuple,
-	kwargs []starlark.Tuple) (starlark.Value, error) {
-
-	w := b.Receiver().(*Image)
-	width, height := w.Size()
-
-	return starlark.Tuple([]starlark.Value{
-		starlark.MakeInt(width),
-		starlark.MakeInt(height),
-	}), nil
-}
-
-func imageFrameCount(
+func flexFrameCount(
 	thread *starlark.Thread,
 	b *starlark.Builtin,
 	args starlark.Tuple,
 	kwargs []starlark.Tuple) (starlark.Value, error) {
 
-	w := b.Receiver().(*Image)
+	w := b.Receiver().(*Flex)
 	count := w.FrameCount()
 
 	return starlark.MakeInt(count), nil
 }
 
-type Marquee struct {
+type Gauge struct {
 	Widget
 
-	render.Marquee
+	render.Gauge
 
-	starlarkChild starlark.Value
+	starlarkValue starlark.Value
+
+	starlarkMin starlark.Value
+
+	starlarkMax starlark.Value
+
+	starlarkThresholds *starlark.List
+
+	starlarkColors *starlark.List
+
+	starlarkBackground starlark.String
+
+	starlarkSweep starlark.Value
 
 	frame_count *starlark.Builtin
 }
 
-func newMarquee(
+func newGauge(
 	thread *starlark.Thread,
 	_ *starlark.Builtin,
 	args starlark.Tuple,
@@ -714,108 +953,160 @@ func newMarquee(
 ) (starlark.Value, error) {
 
 	var (
-		child            starlark.Value
-		width            starlark.Int
-		height           starlark.Int
-		offset_start     starlark.Int
-		offset_end       starlark.Int
-		scroll_direction starlark.String
-		align            starlark.String
-		delay            starlark.Int
+		value      starlark.Value
+		diameter   starlark.Int
+		min        starlark.Value
+		max        starlark.Value
+		thresholds *starlark.List
+		colors     *starlark.List
+		background starlark.String
+		thickness  starlark.Int
+		sweep      starlark.Value
 	)
 
 	if err := starlark.UnpackArgs(
-		"Marquee",
+		"Gauge",
 		args, kwargs,
-		"child", &child,
-		"width?", &width,
-		"height?", &height,
-		"offset_start?", &offset_start,
-		"offset_end?", &offset_end,
-		"scroll_direction?", &scroll_direction,
-		"align?", &align,
-		"delay?", &delay,
+		"value", &value,
+		"diameter", &diameter,
+		"min?", &min,
+		"max?", &max,
+		"thresholds?", &thresholds,
+		"colors?", &colors,
+		"background?", &background,
+		"thickness?", &thickness,
+		"sweep?", &sweep,
 	); err != nil {
-		return nil, fmt.Errorf("unpacking arguments for Marquee: %s", err)
+		return nil, fmt.Errorf("unpacking arguments for Gauge: %s", err)
 	}
 
-	w := &Marquee{}
+	w := &Gauge{}
 
-	if child != nil {
-		childWidget, ok := child.(Widget)
-		if !ok {
-			return nil, fmt.Errorf(
-				"invalid type for child: %s (expected Widget)",
-				child.Type(),
-			)
-		}
-		w.Child = childWidget.AsRenderWidget()
-		w.starlarkChild = child
+	w.starlarkValue = value
+	if value == nil {
+		w.starlarkValue = starlark.None
+	} else if val, ok := starlark.AsFloat(w.starlarkValue); ok {
+		w.Value = val
+	} else {
+		return nil, fmt.Errorf("expected number, but got: %s", w.starlarkValue.String())
 	}
 
-	w.Width = int(width.BigInt().Int64())
+	w.Diameter = int(diameter.BigInt().Int64())
 
-	w.Height = int(height.BigInt().Int64())
+	w.starlarkMin = min
+	if min == nil {
+		w.starlarkMin = starlark.None
+	} else if val, ok := starlark.AsFloat(w.starlarkMin); ok {
+		w.Min = val
+	} else {
+		return nil, fmt.Errorf("expected number, but got: %s", w.starlarkMin.String())
+	}
 
-	w.OffsetStart = int(offset_start.BigInt().Int64())
+	w.starlarkMax = max
+	if max == nil {
+		w.starlarkMax = starlark.None
+		w.Max = math.NaN()
+	} else if val, ok := starlark.AsFloat(w.starlarkMax); ok {
+		w.Max = val
+	} else {
+		return nil, fmt.Errorf("expected number, but got: %s", w.starlarkMax.String())
+	}
 
-	w.OffsetEnd = int(offset_end.BigInt().Int64())
+	w.starlarkThresholds = thresholds
+	if thresholds != nil {
+		if val, err := WeightsFromStarlark(thresholds); err == nil {
+			w.Thresholds = val
+		} else {
+			return nil, err
+		}
+	}
 
-	w.ScrollDirection = scroll_direction.GoString()
+	w.starlarkColors = colors
+	if colors != nil {
+		if val, err := ColorSeriesFromStarlark(colors); err == nil {
+			w.Colors = val
+		} else {
+			return nil, err
+		}
+	}
 
-	w.Align = align.GoString()
+	w.starlarkBackground = background
+	if background.Len() > 0 {
+		c, err := render.ParseColor(background.GoString())
+		if err != nil {
+			return nil, fmt.Errorf("background is not a valid hex string: %s", background.String())
+		}
+		w.Background = c
+	}
 
-	w.Delay = int(delay.BigInt().Int64())
+	w.Thickness = int(thickness.BigInt().Int64())
 
-	w.frame_count = starlark.NewBuiltin("frame_count", marqueeFrameCount)
+	w.starlarkSweep = sweep
+	if sweep == nil {
+		w.starlarkSweep = starlark.None
+	} else if val, ok := starlark.AsFloat(w.starlarkSweep); ok {
+		w.Sweep = val
+	} else {
+		return nil, fmt.Errorf("expected number, but got: %s", w.starlarkSweep.String())
+	}
+
+	w.frame_count = starlark.NewBuiltin("frame_count", gaugeFrameCount)
+
+	if err := w.Init(); err != nil {
+		return nil, err
+	}
 
 	return w, nil
 }
 
-func (w *Marquee) AsRenderWidget() render.Widget {
-	return &w.Marquee
+func (w *Gauge) AsRenderWidget() render.Widget {
+	return &w.Gauge
 }
 
-func (w *Marquee) AttrNames() []string {
+func (w *Gauge) AttrNames() []string {
 	return []string{
-		"child", "width", "height", "offset_start", "offset_end", "scroll_direction", "align", "delay",
+		"value", "diameter", "min", "max", "thresholds", "colors", "background", "thickness", "sweep",
 	}
 }
 
-func (w *Marquee) Attr(name string) (starlark.Value, error) {
+func (w *Gauge) Attr(name string) (starlark.Value, error) {
 	switch name {
 
-	case "child":
+	case "value":
 
-		return w.starlarkChild, nil
+		return w.starlarkValue, nil
 
-	case "width":
+	case "diameter":
 
-		return starlark.MakeInt(int(w.Width)), nil
+		return starlark.MakeInt(int(w.Diameter)), nil
 
-	case "height":
+	case "min":
 
-		return starlark.MakeInt(int(w.Height)), nil
+		return w.starlarkMin, nil
 
-	case "offset_start":
+	case "max":
 
-		return starlark.MakeInt(int(w.OffsetStart)), nil
+		return w.starlarkMax, nil
 
-	case "offset_end":
+	case "thresholds":
 
-		return starlark.MakeInt(int(w.OffsetEnd)), nil
+		return w.starlarkThresholds, nil
 
-	case "scroll_direction":
+	case "colors":
 
-		return starlark.String(w.ScrollDirection), nil
+		return w.starlarkColors, nil
 
-	case "align":
+	case "background":
 
-		return starlark.String(w.Align), nil
+		return w.starlarkBackground, nil
 
-	case "delay":
+	case "thickness":
 
-		return starlark.MakeInt(int(w.Delay)), nil
+		return starlark.MakeInt(int(w.Thickness)), nil
+
+	case "sweep":
+
+		return w.starlarkSweep, nil
 
 	case "frame_count":
 		return w.frame_count.BindReceiver(w), nil
@@ -825,43 +1116,39 @@ func (w *Marquee) Attr(name string) (starlark.Value, error) {
 	}
 }
 
-func (w *Marquee) String() string       { return "Marquee(...)" }
-func (w *Marquee) Type() string         { return "Marquee" }
-func (w *Marquee) Freeze()              {}
-func (w *Marquee) Truth() starlark.Bool { return true }
+func (w *Gauge) String() string       { return "Gauge(...)" }
+func (w *Gauge) Type() string         { return "Gauge" }
+func (w *Gauge) Freeze()              {}
+func (w *Gauge) Truth() starlark.Bool { return true }
 
-func (w *Marquee) Hash() (uint32, error) {
+func (w *Gauge) Hash() (uint32, error) {
 	sum, err := hashstructure.Hash(w, hashstructure.FormatV2, nil)
 	return uint32(sum), err
 }
 
-func marqueeFrameCount(
+func gaugeFrameCount(
 	thread *starlark.Thread,
 	b *starlark.Builtin,
 	args starlark.Tuple,
 	kwargs []starlark.Tuple) (starlark.Value, error) {
 
-	w := b.Receiver().(*Marquee)
+	w := b.Receiver().(*Gauge)
 	count := w.FrameCount()
 
 	return starlark.MakeInt(count), nil
 }
 
-type Padding struct {
+type Image struct {
 	Widget
 
-	render.Padding
-
-	starlarkChild starlark.Value
-
-	starlarkPad starlark.Value
+	render.Image
 
-	starlarkColor starlark.String
+	size *starlark.Builtin
 
 	frame_count *starlark.Builtin
 }
 
-func newPadding(
+func newImage(
 	thread *starlark.Thread,
 	_ *starlark.Builtin,
 	args starlark.Tuple,
@@ -869,113 +1156,71 @@ func newPadding(
 ) (starlark.Value, error) {
 
 	var (
-		child    starlark.Value
-		pad      starlark.Value
-		expanded starlark.Bool
-		color    starlark.String
+		src    starlark.String
+		width  starlark.Int
+		height starlark.Int
 	)
 
 	if err := starlark.UnpackArgs(
-		"Padding",
+		"Image",
 		args, kwargs,
-		"child", &child,
-		"pad?", &pad,
-		"expanded?", &expanded,
-		"color?", &color,
+		"src", &src,
+		"width?", &width,
+		"height?", &height,
 	); err != nil {
-		return nil, fmt.Errorf("unpacking arguments for Padding: %s", err)
+		return nil, fmt.Errorf("unpacking arguments for Image: %s", err)
 	}
 
-	w := &Padding{}
+	w := &Image{}
 
-	if child != nil {
-		childWidget, ok := child.(Widget)
-		if !ok {
-			return nil, fmt.Errorf(
-				"invalid type for child: %s (expected Widget)",
-				child.Type(),
-			)
-		}
-		w.Child = childWidget.AsRenderWidget()
-		w.starlarkChild = child
-	}
+	w.Src = src.GoString()
 
-	w.starlarkPad = pad
-	switch padVal := pad.(type) {
-	case starlark.Int:
-		padInt := int(padVal.BigInt().Int64())
-		w.Pad.Left = padInt
-		w.Pad.Top = padInt
-		w.Pad.Right = padInt
-		w.Pad.Bottom = padInt
-	case starlark.Tuple:
-		padList := []starlark.Value(padVal)
-		if len(padList) != 4 {
-			return nil, fmt.Errorf(
-				"pad tuple must hold 4 elements (left, top, right, bottom), found %d",
-				len(padList),
-			)
-		}
-		padListInt := make([]starlark.Int, 4)
-		for i := 0; i < 4; i++ {
-			pi, ok := padList[i].(starlark.Int)
-			if !ok {
-				return nil, fmt.Errorf("pad element %d is not int", i)
-			}
-			padListInt[i] = pi
-		}
-		w.Pad.Left = int(padListInt[0].BigInt().Int64())
-		w.Pad.Top = int(padListInt[1].BigInt().Int64())
-		w.Pad.Right = int(padListInt[2].BigInt().Int64())
-		w.Pad.Bottom = int(padListInt[3].BigInt().Int64())
-	default:
-		return nil, fmt.Errorf("pad must be int or 4-tuple of int")
-	}
+	w.Width = int(width.BigInt().Int64())
 
-	w.Expanded = bool(expanded)
+	w.Height = int(height.BigInt().Int64())
 
-	w.starlarkColor = color
-	if color.Len() > 0 {
-		c, err := render.ParseColor(color.GoString())
-		if err != nil {
-			return nil, fmt.Errorf("color is not a valid hex string: %s", color.String())
-		}
-		w.Color = c
-	}
+	w.size = starlark.NewBuiltin("size", imageSize)
 
-	w.frame_count = starlark.NewBuiltin("frame_count", paddingFrameCount)
+	w.frame_count = starlark.NewBuiltin("frame_count", imageFrameCount)
+
+	if err := w.Init(); err != nil {
+		return nil, err
+	}
 
 	return w, nil
 }
 
-func (w *Padding) AsRenderWidget() render.Widget {
-	return &w.Padding
+func (w *Image) AsRenderWidget() render.Widget {
+	return &w.Image
 }
 
-func (w *Padding) AttrNames() []string {
+func (w *Image) AttrNames() []string {
 	return []string{
-		"child", "pad", "expanded", "color",
+		"src", "width", "height", "delay",
 	}
 }
 
-func (w *Padding) Attr(name string) (starlark.Value, error) {
+func (w *Image) Attr(name string) (starlark.Value, error) {
 	switch name {
 
-	case "child":
+	case "src":
 
-		return w.starlarkChild, nil
+		return starlark.String(w.Src), nil
 
-	case "pad":
+	case "width":
 
-		return w.starlarkPad, nil
+		return starlark.MakeInt(int(w.Width)), nil
 
-	case "expanded":
+	case "height":
 
-		return starlark.Bool(w.Expanded), nil
+		return starlark.MakeInt(int(w.Height)), nil
 
-	case "color":
+	case "delay":
 
-		return w.starlarkColor, nil
+		return starlark.MakeInt(int(w.Delay)), nil
+
+	case "size":
+		return w.size.BindReceiver(w), nil
 
 	case "frame_count":
 		return w.frame_count.BindReceiver(w), nil
@@ -985,41 +1230,54 @@ func (w *Padding) Attr(name string) (starlark.Value, error) {
 	}
 }
 
-func (w *Padding) String() string       { return "Padding(...)" }
-func (w *Padding) Type() string         { return "Padding" }
-func (w *Padding) Freeze()              {}
-func (w *Padding) Truth() starlark.Bool { return true }
+func (w *Image) String() string       { return "Image(...)" }
+func (w *Image) Type() string         { return "Image" }
+func (w *Image) Freeze()              {}
+func (w *Image) Truth() starlark.Bool { return true }
 
-func (w *Padding) Hash() (uint32, error) {
+func (w *Image) Hash() (uint32, error) {
 	sum, err := hashstructure.Hash(w, hashstructure.FormatV2, nil)
 	return uint32(sum), err
 }
 
-func paddingFrameCount(
+func imageSize(
 	thread *starlark.Thread,
 	b *starlark.Builtin,
 	args starlark.Tuple,
 	kwargs []starlark.Tuple) (starlark.Value, error) {
 
-	w := b.Receiver().(*Padding)
+	w := b.Receiver().(*Image)
+	width, height := w.Size()
+
+	return starlark.Tuple([]starlark.Value{
+		starlark.MakeInt(width),
+		starlark.MakeInt(height),
+	}), nil
+}
+
+func imageFrameCount(
+	thread *starlark.Thread,
+	b *starlark.Builtin,
+	args starlark.Tuple,
+	kwargs []starlark.Tuple) (starlark.Value, error) {
+
+	w := b.Receiver().(*Image)
 	count := w.FrameCount()
 
 	return starlark.MakeInt(count), nil
 }
 
-type PieChart struct {
+type Marquee struct {
 	Widget
 
-	render.PieChart
-
-	starlarkColors *starlark.List
+	render.Marquee
 
-	starlarkWeights *starlark.List
+	starlarkChild starlark.Value
 
 	frame_count *starlark.Builtin
 }
 
-func newPieChart(
+func newMarquee(
 	thread *starlark.Thread,
 	_ *starlark.Builtin,
 	args starlark.Tuple,
@@ -1027,68 +1285,108 @@ func newPieChart(
 ) (starlark.Value, error) {
 
 	var (
-		colors   *starlark.List
-		weights  *starlark.List
-		diameter starlark.Int
+		child            starlark.Value
+		width            starlark.Int
+		height           starlark.Int
+		offset_start     starlark.Int
+		offset_end       starlark.Int
+		scroll_direction starlark.String
+		align            starlark.String
+		delay            starlark.Int
 	)
 
 	if err := starlark.UnpackArgs(
-		"PieChart",
+		"Marquee",
 		args, kwargs,
-		"colors", &colors,
-		"weights", &weights,
-		"diameter", &diameter,
+		"child", &child,
+		"width?", &width,
+		"height?", &height,
+		"offset_start?", &offset_start,
+		"offset_end?", &offset_end,
+		"scroll_direction?", &scroll_direction,
+		"align?", &align,
+		"delay?", &delay,
 	); err != nil {
-		return nil, fmt.Errorf("unpacking arguments for PieChart: %s", err)
+		return nil, fmt.Errorf("unpacking arguments for Marquee: %s", err)
 	}
 
-	w := &PieChart{}
+	w := &Marquee{}
 
-	w.starlarkColors = colors
-	if val, err := ColorSeriesFromStarlark(colors); err == nil {
-		w.Colors = val
-	} else {
-		return nil, err
+	if child != nil {
+		childWidget, ok := child.(Widget)
+		if !ok {
+			return nil, fmt.Errorf(
+				"invalid type for child: %s (expected Widget)",
+				child.Type(),
+			)
+		}
+		w.Child = childWidget.AsRenderWidget()
+		w.starlarkChild = child
 	}
 
-	w.starlarkWeights = weights
-	if val, err := WeightsFromStarlark(weights); err == nil {
-		w.Weights = val
-	} else {
-		return nil, err
-	}
+	w.Width = int(width.BigInt().Int64())
 
-	w.Diameter = int(diameter.BigInt().Int64())
+	w.Height = int(height.BigInt().Int64())
 
-	w.frame_count = starlark.NewBuiltin("frame_count", piechartFrameCount)
+	w.OffsetStart = int(offset_start.BigInt().Int64())
+
+	w.OffsetEnd = int(offset_end.BigInt().Int64())
+
+	w.ScrollDirection = scroll_direction.GoString()
+
+	w.Align = align.GoString()
+
+	w.Delay = int(delay.BigInt().Int64())
+
+	w.frame_count = starlark.NewBuiltin("frame_count", marqueeFrameCount)
 
 	return w, nil
 }
 
-func (w *PieChart) AsRenderWidget() render.Widget {
-	return &w.PieChart
+func (w *Marquee) AsRenderWidget() render.Widget {
+	return &w.Marquee
 }
 
-func (w *PieChart) AttrNames() []string {
+func (w *Marquee) AttrNames() []string {
 	return []string{
-		"colors", "weights", "diameter",
+		"child", "width", "height", "offset_start", "offset_end", "scroll_direction", "align", "delay",
 	}
 }
 
-func (w *PieChart) Attr(name string) (starlark.Value, error) {
+func (w *Marquee) Attr(name string) (starlark.Value, error) {
 	switch name {
 
-	case "colors":
+	case "child":
 
-		return w.starlarkColors, nil
+		return w.starlarkChild, nil
 
-	case "weights":
+	case "width":
 
-		return w.starlarkWeights, nil
+		return starlark.MakeInt(int(w.Width)), nil
 
-	case "diameter":
+	case "height":
 
-		return starlark.MakeInt(int(w.Diameter)), nil
+		return starlark.MakeInt(int(w.Height)), nil
+
+	case "offset_start":
+
+		return starlark.MakeInt(int(w.OffsetStart)), nil
+
+	case "offset_end":
+
+		return starlark.MakeInt(int(w.OffsetEnd)), nil
+
+	case "scroll_direction":
+
+		return starlark.String(w.ScrollDirection), nil
+
+	case "align":
+
+		return starlark.String(w.Align), nil
+
+	case "delay":
+
+		return starlark.MakeInt(int(w.Delay)), nil
 
 	case "frame_count":
 		return w.frame_count.BindReceiver(w), nil
@@ -1098,51 +1396,43 @@ func (w *PieChart) Attr(name string) (starlark.Value, error) {
 	}
 }
 
-func (w *PieChart) String() string       { return "PieChart(...)" }
-func (w *PieChart) Type() string         { return "PieChart" }
-func (w *PieChart) Freeze()              {}
-func (w *PieChart) Truth() starlark.Bool { return true }
+func (w *Marquee) String() string       { return "Marquee(...)" }
+func (w *Marquee) Type() string         { return "Marquee" }
+func (w *Marquee) Freeze()              {}
+func (w *Marquee) Truth() starlark.Bool { return true }
 
-func (w *PieChart) Hash() (uint32, error) {
+func (w *Marquee) Hash() (uint32, error) {
 	sum, err := hashstructure.Hash(w, hashstructure.FormatV2, nil)
 	return uint32(sum), err
 }
 
-func piechartFrameCount(
+func marqueeFrameCount(
 	thread *starlark.Thread,
 	b *starlark.Builtin,
 	args starlark.Tuple,
 	kwargs []starlark.Tuple) (starlark.Value, error) {
 
-	w := b.Receiver().(*PieChart)
+	w := b.Receiver().(*Marquee)
 	count := w.FrameCount()
 
 	return starlark.MakeInt(count), nil
 }
 
-type Plot struct {
+type Padding struct {
 	Widget
 
-	render.Plot
-
-	starlarkData *starlark.List
-
-	starlarkColor starlark.String
-
-	starlarkColorInverted starlark.String
-
-	starlarkXLim starlark.Tuple
+	render.Padding
 
-	starlarkYLim starlark.Tuple
+	starlarkChild starlark.Value
 
-	starlarkFillColor starlark.String
+	starlarkPad starlark.Value
 
-	starlarkFillColorInverted starlark.String
+	starlarkColor starlark.String
 
 	frame_count *starlark.Builtin
 }
 
-func newPlot(
+func newPadding(
 	thread *starlark.Thread,
 	_ *starlark.Builtin,
 	args starlark.Tuple,
@@ -1150,49 +1440,70 @@ func newPlot(
 ) (starlark.Value, error) {
 
 	var (
-		data                *starlark.List
-		width               starlark.Int
-		height              starlark.Int
-		color               starlark.String
-		color_inverted      starlark.String
-		x_lim               starlark.Tuple
-		y_lim               starlark.Tuple
-		fill                starlark.Bool
-		chart_type          starlark.String
-		fill_color          starlark.String
-		fill_color_inverted starlark.String
+		child    starlark.Value
+		pad      starlark.Value
+		expanded starlark.Bool
+		color    starlark.String
 	)
 
 	if err := starlark.UnpackArgs(
-		"Plot",
+		"Padding",
 		args, kwargs,
-		"data", &data,
-		"width", &width,
-		"height", &height,
+		"child", &child,
+		"pad?", &pad,
+		"expanded?", &expanded,
 		"color?", &color,
-		"color_inverted?", &color_inverted,
-		"x_lim?", &x_lim,
-		"y_lim?", &y_lim,
-		"fill?", &fill,
-		"chart_type?", &chart_type,
-		"fill_color?", &fill_color,
-		"fill_color_inverted?", &fill_color_inverted,
 	); err != nil {
-		return nil, fmt.Errorf("unpacking arguments for Plot: %s", err)
+		return nil, fmt.Errorf("unpacking arguments for Padding: %s", err)
 	}
 
-	w := &Plot{}
+	w := &Padding{}
 
-	w.starlarkData = data
-	if val, err := DataSeriesFromStarlark(data); err == nil {
-		w.Data = val
-	} else {
-		return nil, err
+	if child != nil {
+		childWidget, ok := child.(Widget)
+		if !ok {
+			return nil, fmt.Errorf(
+				"invalid type for child: %s (expected Widget)",
+				child.Type(),
+			)
+		}
+		w.Child = childWidget.AsRenderWidget()
+		w.starlarkChild = child
 	}
 
-	w.Width = int(width.BigInt().Int64())
+	w.starlarkPad = pad
+	switch padVal := pad.(type) {
+	case starlark.Int:
+		padInt := int(padVal.BigInt().Int64())
+		w.Pad.Left = padInt
+		w.Pad.Top = padInt
+		w.Pad.Right = padInt
+		w.Pad.Bottom = padInt
+	case starlark.Tuple:
+		padList := []starlark.Value(padVal)
+		if len(padList) != 4 {
+			return nil, fmt.Errorf(
+				"pad tuple must hold 4 elements (left, top, right, bottom), found %d",
+				len(padList),
+			)
+		}
+		padListInt := make([]starlark.Int, 4)
+		for i := 0; i < 4; i++ {
+			pi, ok := padList[i].(starlark.Int)
+			if !ok {
+				return nil, fmt.Errorf("pad element %d is not int", i)
+			}
+			padListInt[i] = pi
+		}
+		w.Pad.Left = int(padListInt[0].BigInt().Int64())
+		w.Pad.Top = int(padListInt[1].BigInt().Int64())
+		w.Pad.Right = int(padListInt[2].BigInt().Int64())
+		w.Pad.Bottom = int(padListInt[3].BigInt().Int64())
+	default:
+		return nil, fmt.Errorf("pad must be int or 4-tuple of int")
+	}
 
-	w.Height = int(height.BigInt().Int64())
+	w.Expanded = bool(expanded)
 
 	w.starlarkColor = color
 	if color.Len() > 0 {
@@ -1203,112 +1514,1616 @@ func newPlot(
 		w.Color = c
 	}
 
-	w.starlarkColorInverted = color_inverted
-	if color_inverted.Len() > 0 {
-		c, err := render.ParseColor(color_inverted.GoString())
-		if err != nil {
-			return nil, fmt.Errorf("color_inverted is not a valid hex string: %s", color_inverted.String())
-		}
-		w.ColorInverted = c
-	}
-
-	w.starlarkXLim = x_lim
-	if val, err := DataPointFromStarlark(x_lim); err == nil {
-		w.XLim = val
-	} else {
-		return nil, err
-	}
-
-	w.starlarkYLim = y_lim
-	if val, err := DataPointFromStarlark(y_lim); err == nil {
-		w.YLim = val
-	} else {
-		return nil, err
-	}
-
-	w.Fill = bool(fill)
-
-	w.ChartType = chart_type.GoString()
-
-	w.starlarkFillColor = fill_color
-	if fill_color.Len() > 0 {
-		c, err := render.ParseColor(fill_color.GoString())
-		if err != nil {
-			return nil, fmt.Errorf("fill_color is not a valid hex string: %s", fill_color.String())
-		}
-		w.FillColor = c
-	}
-
-	w.starlarkFillColorInverted = fill_color_inverted
-	if fill_color_inverted.Len() > 0 {
-		c, err := render.ParseColor(fill_color_inverted.GoString())
-		if err != nil {
-			return nil, fmt.Errorf("fill_color_inverted is not a valid hex string: %s", fill_color_inverted.String())
-		}
-		w.FillColorInverted = c
-	}
-
-	w.frame_count = starlark.NewBuiltin("frame_count", plotFrameCount)
+	w.frame_count = starlark.NewBuiltin("frame_count", paddingFrameCount)
 
 	return w, nil
 }
 
-func (w *Plot) AsRenderWidget() render.Widget {
-	return &w.Plot
+func (w *Padding) AsRenderWidget() render.Widget {
+	return &w.Padding
 }
 
-func (w *Plot) AttrNames() []string {
+func (w *Padding) AttrNames() []string {
 	return []string{
-		"data", "width", "height", "color", "color_inverted", "x_lim", "y_lim", "fill", "chart_type", "fill_color", "fill_color_inverted",
+		"child", "pad", "expanded", "color",
 	}
 }
 
-func (w *Plot) Attr(name string) (starlark.Value, error) {
+func (w *Padding) Attr(name string) (starlark.Value, error) {
 	switch name {
 
-	case "data":
+	case "child":
 
-		return w.starlarkData, nil
+		return w.starlarkChild, nil
 
-	case "width":
+	case "pad":
 
-		return starlark.MakeInt(int(w.Width)), nil
+		return w.starlarkPad, nil
 
-	case "height":
+	case "expanded":
 
-		return starlark.MakeInt(int(w.Height)), nil
+		return starlark.Bool(w.Expanded), nil
 
 	case "color":
 
 		return w.starlarkColor, nil
 
-	case "color_inverted":
+	case "frame_count":
+		return w.frame_count.BindReceiver(w), nil
 
-		return w.starlarkColorInverted, nil
+	default:
+		return nil, nil
+	}
+}
 
-	case "x_lim":
+func (w *Padding) String() string       { return "Padding(...)" }
+func (w *Padding) Type() string         { return "Padding" }
+func (w *Padding) Freeze()              {}
+func (w *Padding) Truth() starlark.Bool { return true }
 
-		return w.starlarkXLim, nil
+func (w *Padding) Hash() (uint32, error) {
+	sum, err := hashstructure.Hash(w, hashstructure.FormatV2, nil)
+	return uint32(sum), err
+}
 
-	case "y_lim":
+func paddingFrameCount(
+	thread *starlark.Thread,
+	b *starlark.Builtin,
+	args starlark.Tuple,
+	kwargs []starlark.Tuple) (starlark.Value, error) {
 
-		return w.starlarkYLim, nil
+	w := b.Receiver().(*Padding)
+	count := w.FrameCount()
 
-	case "fill":
+	return starlark.MakeInt(count), nil
+}
 
-		return starlark.Bool(w.Fill), nil
+type PieChart struct {
+	Widget
+
+	render.PieChart
+
+	starlarkColors *starlark.List
+
+	starlarkWeights *starlark.List
+
+	frame_count *starlark.Builtin
+}
+
+func newPieChart(
+	thread *starlark.Thread,
+	_ *starlark.Builtin,
+	args starlark.Tuple,
+	kwargs []starlark.Tuple,
+) (starlark.Value, error) {
+
+	var (
+		colors   *starlark.List
+		weights  *starlark.List
+		diameter starlark.Int
+	)
+
+	if err := starlark.UnpackArgs(
+		"PieChart",
+		args, kwargs,
+		"colors", &colors,
+		"weights", &weights,
+		"diameter", &diameter,
+	); err != nil {
+		return nil, fmt.Errorf("unpacking arguments for PieChart: %s", err)
+	}
+
+	w := &PieChart{}
+
+	w.starlarkColors = colors
+	if colors != nil {
+		if val, err := ColorSeriesFromStarlark(colors); err == nil {
+			w.Colors = val
+		} else {
+			return nil, err
+		}
+	}
+
+	w.starlarkWeights = weights
+	if weights != nil {
+		if val, err := WeightsFromStarlark(weights); err == nil {
+			w.Weights = val
+		} else {
+			return nil, err
+		}
+	}
+
+	w.Diameter = int(diameter.BigInt().Int64())
+
+	w.frame_count = starlark.NewBuiltin("frame_count", piechartFrameCount)
+
+	return w, nil
+}
+
+func (w *PieChart) AsRenderWidget() render.Widget {
+	return &w.PieChart
+}
+
+func (w *PieChart) AttrNames() []string {
+	return []string{
+		"colors", "weights", "diameter",
+	}
+}
+
+func (w *PieChart) Attr(name string) (starlark.Value, error) {
+	switch name {
+
+	case "colors":
+
+		return w.starlarkColors, nil
+
+	case "weights":
+
+		return w.starlarkWeights, nil
+
+	case "diameter":
+
+		return starlark.MakeInt(int(w.Diameter)), nil
+
+	case "frame_count":
+		return w.frame_count.BindReceiver(w), nil
+
+	default:
+		return nil, nil
+	}
+}
+
+func (w *PieChart) String() string       { return "PieChart(...)" }
+func (w *PieChart) Type() string         { return "PieChart" }
+func (w *PieChart) Freeze()              {}
+func (w *PieChart) Truth() starlark.Bool { return true }
+
+func (w *PieChart) Hash() (uint32, error) {
+	sum, err := hashstructure.Hash(w, hashstructure.FormatV2, nil)
+	return uint32(sum), err
+}
+
+func piechartFrameCount(
+	thread *starlark.Thread,
+	b *starlark.Builtin,
+	args starlark.Tuple,
+	kwargs []starlark.Tuple) (starlark.Value, error) {
+
+	w := b.Receiver().(*PieChart)
+	count := w.FrameCount()
+
+	return starlark.MakeInt(count), nil
+}
+
+type Plot struct {
+	Widget
+
+	render.Plot
+
+	starlarkData *starlark.List
+
+	starlarkColor starlark.String
+
+	starlarkColorInverted starlark.String
+
+	starlarkXLim starlark.Tuple
+
+	starlarkYLim starlark.Tuple
+
+	starlarkFillColor starlark.String
+
+	starlarkFillColorInverted starlark.String
+
+	starlarkSeries *starlark.List
+
+	starlarkReferenceLines *starlark.List
+
+	starlarkBands *starlark.List
+
+	starlarkAxisColor starlark.String
+
+	frame_count *starlark.Builtin
+}
+
+func newPlot(
+	thread *starlark.Thread,
+	_ *starlark.Builtin,
+	args starlark.Tuple,
+	kwargs []starlark.Tuple,
+) (starlark.Value, error) {
+
+	var (
+		data                *starlark.List
+		width               starlark.Int
+		height              starlark.Int
+		color               starlark.String
+		color_inverted      starlark.String
+		x_lim               starlark.Tuple
+		y_lim               starlark.Tuple
+		fill                starlark.Bool
+		chart_type          starlark.String
+		fill_color          starlark.String
+		fill_color_inverted starlark.String
+		series              *starlark.List
+		independent_axes    starlark.Bool
+		reference_lines     *starlark.List
+		bands               *starlark.List
+		x_ticks             starlark.Int
+		y_ticks             starlark.Int
+		x_labels            starlark.Bool
+		y_labels            starlark.Bool
+		label_font          starlark.String
+		axis_color          starlark.String
+	)
+
+	if err := starlark.UnpackArgs(
+		"Plot",
+		args, kwargs,
+		"data", &data,
+		"width", &width,
+		"height", &height,
+		"color?", &color,
+		"color_inverted?", &color_inverted,
+		"x_lim?", &x_lim,
+		"y_lim?", &y_lim,
+		"fill?", &fill,
+		"chart_type?", &chart_type,
+		"fill_color?", &fill_color,
+		"fill_color_inverted?", &fill_color_inverted,
+		"series?", &series,
+		"independent_axes?", &independent_axes,
+		"reference_lines?", &reference_lines,
+		"bands?", &bands,
+		"x_ticks?", &x_ticks,
+		"y_ticks?", &y_ticks,
+		"x_labels?", &x_labels,
+		"y_labels?", &y_labels,
+		"label_font?", &label_font,
+		"axis_color?", &axis_color,
+	); err != nil {
+		return nil, fmt.Errorf("unpacking arguments for Plot: %s", err)
+	}
+
+	w := &Plot{}
+
+	w.starlarkData = data
+	if val, err := DataSeriesFromStarlark(data); err == nil {
+		w.Data = val
+	} else {
+		return nil, err
+	}
+
+	w.Width = int(width.BigInt().Int64())
+
+	w.Height = int(height.BigInt().Int64())
+
+	w.starlarkColor = color
+	if color.Len() > 0 {
+		c, err := render.ParseColor(color.GoString())
+		if err != nil {
+			return nil, fmt.Errorf("color is not a valid hex string: %s", color.String())
+		}
+		w.Color = c
+	}
+
+	w.starlarkColorInverted = color_inverted
+	if color_inverted.Len() > 0 {
+		c, err := render.ParseColor(color_inverted.GoString())
+		if err != nil {
+			return nil, fmt.Errorf("color_inverted is not a valid hex string: %s", color_inverted.String())
+		}
+		w.ColorInverted = c
+	}
+
+	w.starlarkXLim = x_lim
+	if val, err := DataPointFromStarlark(x_lim); err == nil {
+		w.XLim = val
+	} else {
+		return nil, err
+	}
+
+	w.starlarkYLim = y_lim
+	if val, err := DataPointFromStarlark(y_lim); err == nil {
+		w.YLim = val
+	} else {
+		return nil, err
+	}
+
+	w.Fill = bool(fill)
+
+	w.ChartType = chart_type.GoString()
+
+	w.starlarkFillColor = fill_color
+	if fill_color.Len() > 0 {
+		c, err := render.ParseColor(fill_color.GoString())
+		if err != nil {
+			return nil, fmt.Errorf("fill_color is not a valid hex string: %s", fill_color.String())
+		}
+		w.FillColor = c
+	}
+
+	w.starlarkFillColorInverted = fill_color_inverted
+	if fill_color_inverted.Len() > 0 {
+		c, err := render.ParseColor(fill_color_inverted.GoString())
+		if err != nil {
+			return nil, fmt.Errorf("fill_color_inverted is not a valid hex string: %s", fill_color_inverted.String())
+		}
+		w.FillColorInverted = c
+	}
+
+	w.starlarkSeries = series
+	if series != nil {
+		for i := 0; i < series.Len(); i++ {
+			seriesVal, ok := series.Index(i).(*PlotSeries)
+			if !ok {
+				return nil, fmt.Errorf(
+					"expected series to be a list of PlotSeries but found: %s (at index %d)",
+					series.Index(i).Type(),
+					i,
+				)
+			}
+			w.Series = append(w.Series, seriesVal.PlotSeries)
+		}
+	}
+
+	w.IndependentAxes = bool(independent_axes)
+
+	w.starlarkReferenceLines = reference_lines
+	if reference_lines != nil {
+		for i := 0; i < reference_lines.Len(); i++ {
+			reference_linesVal, ok := reference_lines.Index(i).(*PlotLine)
+			if !ok {
+				return nil, fmt.Errorf(
+					"expected reference_lines to be a list of PlotLine but found: %s (at index %d)",
+					reference_lines.Index(i).Type(),
+					i,
+				)
+			}
+			w.ReferenceLines = append(w.ReferenceLines, reference_linesVal.PlotLine)
+		}
+	}
+
+	w.starlarkBands = bands
+	if bands != nil {
+		for i := 0; i < bands.Len(); i++ {
+			bandsVal, ok := bands.Index(i).(*PlotBand)
+			if !ok {
+				return nil, fmt.Errorf(
+					"expected bands to be a list of PlotBand but found: %s (at index %d)",
+					bands.Index(i).Type(),
+					i,
+				)
+			}
+			w.Bands = append(w.Bands, bandsVal.PlotBand)
+		}
+	}
+
+	w.XTicks = int(x_ticks.BigInt().Int64())
+
+	w.YTicks = int(y_ticks.BigInt().Int64())
+
+	w.XLabels = bool(x_labels)
+
+	w.YLabels = bool(y_labels)
+
+	w.LabelFont = label_font.GoString()
+
+	w.starlarkAxisColor = axis_color
+	if axis_color.Len() > 0 {
+		c, err := render.ParseColor(axis_color.GoString())
+		if err != nil {
+			return nil, fmt.Errorf("axis_color is not a valid hex string: %s", axis_color.String())
+		}
+		w.AxisColor = c
+	}
+
+	w.frame_count = starlark.NewBuiltin("frame_count", plotFrameCount)
+
+	if err := w.Init(); err != nil {
+		return nil, err
+	}
+
+	return w, nil
+}
+
+func (w *Plot) AsRenderWidget() render.Widget {
+	return &w.Plot
+}
+
+func (w *Plot) AttrNames() []string {
+	return []string{
+		"data", "width", "height", "color", "color_inverted", "x_lim", "y_lim", "fill", "chart_type", "fill_color", "fill_color_inverted", "series", "independent_axes", "reference_lines", "bands", "x_ticks", "y_ticks", "x_labels", "y_labels", "label_font", "axis_color",
+	}
+}
+
+func (w *Plot) Attr(name string) (starlark.Value, error) {
+	switch name {
+
+	case "data":
+
+		return w.starlarkData, nil
+
+	case "width":
+
+		return starlark.MakeInt(int(w.Width)), nil
+
+	case "height":
+
+		return starlark.MakeInt(int(w.Height)), nil
+
+	case "color":
+
+		return w.starlarkColor, nil
+
+	case "color_inverted":
+
+		return w.starlarkColorInverted, nil
+
+	case "x_lim":
+
+		return w.starlarkXLim, nil
+
+	case "y_lim":
+
+		return w.starlarkYLim, nil
+
+	case "fill":
+
+		return starlark.Bool(w.Fill), nil
+
+	case "chart_type":
+
+		return starlark.String(w.ChartType), nil
+
+	case "fill_color":
+
+		return w.starlarkFillColor, nil
+
+	case "fill_color_inverted":
+
+		return w.starlarkFillColorInverted, nil
+
+	case "series":
+
+		return w.starlarkSeries, nil
+
+	case "independent_axes":
+
+		return starlark.Bool(w.IndependentAxes), nil
+
+	case "reference_lines":
+
+		return w.starlarkReferenceLines, nil
+
+	case "bands":
+
+		return w.starlarkBands, nil
+
+	case "x_ticks":
+
+		return starlark.MakeInt(int(w.XTicks)), nil
+
+	case "y_ticks":
+
+		return starlark.MakeInt(int(w.YTicks)), nil
+
+	case "x_labels":
+
+		return starlark.Bool(w.XLabels), nil
+
+	case "y_labels":
+
+		return starlark.Bool(w.YLabels), nil
+
+	case "label_font":
+
+		return starlark.String(w.LabelFont), nil
+
+	case "axis_color":
+
+		return w.starlarkAxisColor, nil
+
+	case "frame_count":
+		return w.frame_count.BindReceiver(w), nil
+
+	default:
+		return nil, nil
+	}
+}
+
+func (w *Plot) String() string       { return "Plot(...)" }
+func (w *Plot) Type() string         { return "Plot" }
+func (w *Plot) Freeze()              {}
+func (w *Plot) Truth() starlark.Bool { return true }
+
+func (w *Plot) Hash() (uint32, error) {
+	sum, err := hashstructure.Hash(w, hashstructure.FormatV2, nil)
+	return uint32(sum), err
+}
+
+func plotFrameCount(
+	thread *starlark.Thread,
+	b *starlark.Builtin,
+	args starlark.Tuple,
+	kwargs []starlark.Tuple) (starlark.Value, error) {
+
+	w := b.Receiver().(*Plot)
+	count := w.FrameCount()
+
+	return starlark.MakeInt(count), nil
+}
+
+type PlotBand struct {
+	render.PlotBand
+
+	starlarkLow starlark.Value
+
+	starlarkHigh starlark.Value
+
+	starlarkColor starlark.String
+}
+
+func newPlotBand(
+	thread *starlark.Thread,
+	_ *starlark.Builtin,
+	args starlark.Tuple,
+	kwargs []starlark.Tuple,
+) (starlark.Value, error) {
+
+	var (
+		low   starlark.Value
+		high  starlark.Value
+		color starlark.String
+	)
+
+	if err := starlark.UnpackArgs(
+		"PlotBand",
+		args, kwargs,
+		"low", &low,
+		"high", &high,
+		"color?", &color,
+	); err != nil {
+		return nil, fmt.Errorf("unpacking arguments for PlotBand: %s", err)
+	}
+
+	w := &PlotBand{}
+
+	w.starlarkLow = low
+	if low == nil {
+		w.starlarkLow = starlark.None
+	} else if val, ok := starlark.AsFloat(w.starlarkLow); ok {
+		w.Low = val
+	} else {
+		return nil, fmt.Errorf("expected number, but got: %s", w.starlarkLow.String())
+	}
+
+	w.starlarkHigh = high
+	if high == nil {
+		w.starlarkHigh = starlark.None
+	} else if val, ok := starlark.AsFloat(w.starlarkHigh); ok {
+		w.High = val
+	} else {
+		return nil, fmt.Errorf("expected number, but got: %s", w.starlarkHigh.String())
+	}
+
+	w.starlarkColor = color
+	if color.Len() > 0 {
+		c, err := render.ParseColor(color.GoString())
+		if err != nil {
+			return nil, fmt.Errorf("color is not a valid hex string: %s", color.String())
+		}
+		w.Color = c
+	}
+
+	return w, nil
+}
+
+func (w *PlotBand) AttrNames() []string {
+	return []string{
+		"low", "high", "color",
+	}
+}
+
+func (w *PlotBand) Attr(name string) (starlark.Value, error) {
+	switch name {
+
+	case "low":
+
+		return w.starlarkLow, nil
+
+	case "high":
+
+		return w.starlarkHigh, nil
+
+	case "color":
+
+		return w.starlarkColor, nil
+
+	default:
+		return nil, nil
+	}
+}
+
+func (w *PlotBand) String() string       { return "PlotBand(...)" }
+func (w *PlotBand) Type() string         { return "PlotBand" }
+func (w *PlotBand) Freeze()              {}
+func (w *PlotBand) Truth() starlark.Bool { return true }
+
+func (w *PlotBand) Hash() (uint32, error) {
+	sum, err := hashstructure.Hash(w, hashstructure.FormatV2, nil)
+	return uint32(sum), err
+}
+
+type PlotLine struct {
+	render.PlotLine
+
+	starlarkY starlark.Value
+
+	starlarkColor starlark.String
+}
+
+func newPlotLine(
+	thread *starlark.Thread,
+	_ *starlark.Builtin,
+	args starlark.Tuple,
+	kwargs []starlark.Tuple,
+) (starlark.Value, error) {
+
+	var (
+		y      starlark.Value
+		color  starlark.String
+		dashed starlark.Bool
+	)
+
+	if err := starlark.UnpackArgs(
+		"PlotLine",
+		args, kwargs,
+		"y", &y,
+		"color?", &color,
+		"dashed?", &dashed,
+	); err != nil {
+		return nil, fmt.Errorf("unpacking arguments for PlotLine: %s", err)
+	}
+
+	w := &PlotLine{}
+
+	w.starlarkY = y
+	if y == nil {
+		w.starlarkY = starlark.None
+	} else if val, ok := starlark.AsFloat(w.starlarkY); ok {
+		w.Y = val
+	} else {
+		return nil, fmt.Errorf("expected number, but got: %s", w.starlarkY.String())
+	}
+
+	w.starlarkColor = color
+	if color.Len() > 0 {
+		c, err := render.ParseColor(color.GoString())
+		if err != nil {
+			return nil, fmt.Errorf("color is not a valid hex string: %s", color.String())
+		}
+		w.Color = c
+	}
+
+	w.Dashed = bool(dashed)
+
+	return w, nil
+}
+
+func (w *PlotLine) AttrNames() []string {
+	return []string{
+		"y", "color", "dashed",
+	}
+}
+
+func (w *PlotLine) Attr(name string) (starlark.Value, error) {
+	switch name {
+
+	case "y":
+
+		return w.starlarkY, nil
+
+	case "color":
+
+		return w.starlarkColor, nil
+
+	case "dashed":
+
+		return starlark.Bool(w.Dashed), nil
+
+	default:
+		return nil, nil
+	}
+}
+
+func (w *PlotLine) String() string       { return "PlotLine(...)" }
+func (w *PlotLine) Type() string         { return "PlotLine" }
+func (w *PlotLine) Freeze()              {}
+func (w *PlotLine) Truth() starlark.Bool { return true }
+
+func (w *PlotLine) Hash() (uint32, error) {
+	sum, err := hashstructure.Hash(w, hashstructure.FormatV2, nil)
+	return uint32(sum), err
+}
+
+type PlotSeries struct {
+	render.PlotSeries
+
+	starlarkData *starlark.List
+
+	starlarkColor starlark.String
+
+	starlarkColorInverted starlark.String
+
+	starlarkXLim starlark.Tuple
+
+	starlarkYLim starlark.Tuple
+
+	starlarkFillColor starlark.String
+
+	starlarkFillColorInverted starlark.String
+}
+
+func newPlotSeries(
+	thread *starlark.Thread,
+	_ *starlark.Builtin,
+	args starlark.Tuple,
+	kwargs []starlark.Tuple,
+) (starlark.Value, error) {
+
+	var (
+		data                *starlark.List
+		color               starlark.String
+		color_inverted      starlark.String
+		x_lim               starlark.Tuple
+		y_lim               starlark.Tuple
+		fill                starlark.Bool
+		fill_color          starlark.String
+		fill_color_inverted starlark.String
+		chart_type          starlark.String
+	)
+
+	if err := starlark.UnpackArgs(
+		"PlotSeries",
+		args, kwargs,
+		"data", &data,
+		"color?", &color,
+		"color_inverted?", &color_inverted,
+		"x_lim?", &x_lim,
+		"y_lim?", &y_lim,
+		"fill?", &fill,
+		"fill_color?", &fill_color,
+		"fill_color_inverted?", &fill_color_inverted,
+		"chart_type?", &chart_type,
+	); err != nil {
+		return nil, fmt.Errorf("unpacking arguments for PlotSeries: %s", err)
+	}
+
+	w := &PlotSeries{}
+
+	w.starlarkData = data
+	if val, err := DataSeriesFromStarlark(data); err == nil {
+		w.Data = val
+	} else {
+		return nil, err
+	}
+
+	w.starlarkColor = color
+	if color.Len() > 0 {
+		c, err := render.ParseColor(color.GoString())
+		if err != nil {
+			return nil, fmt.Errorf("color is not a valid hex string: %s", color.String())
+		}
+		w.Color = c
+	}
+
+	w.starlarkColorInverted = color_inverted
+	if color_inverted.Len() > 0 {
+		c, err := render.ParseColor(color_inverted.GoString())
+		if err != nil {
+			return nil, fmt.Errorf("color_inverted is not a valid hex string: %s", color_inverted.String())
+		}
+		w.ColorInverted = c
+	}
+
+	w.starlarkXLim = x_lim
+	if val, err := DataPointFromStarlark(x_lim); err == nil {
+		w.XLim = val
+	} else {
+		return nil, err
+	}
+
+	w.starlarkYLim = y_lim
+	if val, err := DataPointFromStarlark(y_lim); err == nil {
+		w.YLim = val
+	} else {
+		return nil, err
+	}
+
+	w.Fill = bool(fill)
+
+	w.starlarkFillColor = fill_color
+	if fill_color.Len() > 0 {
+		c, err := render.ParseColor(fill_color.GoString())
+		if err != nil {
+			return nil, fmt.Errorf("fill_color is not a valid hex string: %s", fill_color.String())
+		}
+		w.FillColor = c
+	}
+
+	w.starlarkFillColorInverted = fill_color_inverted
+	if fill_color_inverted.Len() > 0 {
+		c, err := render.ParseColor(fill_color_inverted.GoString())
+		if err != nil {
+			return nil, fmt.Errorf("fill_color_inverted is not a valid hex string: %s", fill_color_inverted.String())
+		}
+		w.FillColorInverted = c
+	}
+
+	w.ChartType = chart_type.GoString()
+
+	return w, nil
+}
+
+func (w *PlotSeries) AttrNames() []string {
+	return []string{
+		"data", "color", "color_inverted", "x_lim", "y_lim", "fill", "fill_color", "fill_color_inverted", "chart_type",
+	}
+}
+
+func (w *PlotSeries) Attr(name string) (starlark.Value, error) {
+	switch name {
+
+	case "data":
+
+		return w.starlarkData, nil
+
+	case "color":
+
+		return w.starlarkColor, nil
+
+	case "color_inverted":
+
+		return w.starlarkColorInverted, nil
+
+	case "x_lim":
+
+		return w.starlarkXLim, nil
+
+	case "y_lim":
+
+		return w.starlarkYLim, nil
+
+	case "fill":
+
+		return starlark.Bool(w.Fill), nil
+
+	case "fill_color":
+
+		return w.starlarkFillColor, nil
+
+	case "fill_color_inverted":
+
+		return w.starlarkFillColorInverted, nil
+
+	case "chart_type":
+
+		return starlark.String(w.ChartType), nil
+
+	default:
+		return nil, nil
+	}
+}
+
+func (w *PlotSeries) String() string       { return "PlotSeries(...)" }
+func (w *PlotSeries) Type() string         { return "PlotSeries" }
+func (w *PlotSeries) Freeze()              {}
+func (w *PlotSeries) Truth() starlark.Bool { return true }
+
+func (w *PlotSeries) Hash() (uint32, error) {
+	sum, err := hashstructure.Hash(w, hashstructure.FormatV2, nil)
+	return uint32(sum), err
+}
+
+type ProgressBar struct {
+	Widget
+
+	render.ProgressBar
+
+	starlarkValue starlark.Value
+
+	starlarkMin starlark.Value
+
+	starlarkMax starlark.Value
+
+	starlarkThresholds *starlark.List
+
+	starlarkColors *starlark.List
+
+	starlarkBackground starlark.String
+
+	frame_count *starlark.Builtin
+}
+
+func newProgressBar(
+	thread *starlark.Thread,
+	_ *starlark.Builtin,
+	args starlark.Tuple,
+	kwargs []starlark.Tuple,
+) (starlark.Value, error) {
+
+	var (
+		value      starlark.Value
+		width      starlark.Int
+		height     starlark.Int
+		min        starlark.Value
+		max        starlark.Value
+		thresholds *starlark.List
+		colors     *starlark.List
+		background starlark.String
+		vertical   starlark.Bool
+	)
+
+	if err := starlark.UnpackArgs(
+		"ProgressBar",
+		args, kwargs,
+		"value", &value,
+		"width", &width,
+		"height", &height,
+		"min?", &min,
+		"max?", &max,
+		"thresholds?", &thresholds,
+		"colors?", &colors,
+		"background?", &background,
+		"vertical?", &vertical,
+	); err != nil {
+		return nil, fmt.Errorf("unpacking arguments for ProgressBar: %s", err)
+	}
+
+	w := &ProgressBar{}
+
+	w.starlarkValue = value
+	if value == nil {
+		w.starlarkValue = starlark.None
+	} else if val, ok := starlark.AsFloat(w.starlarkValue); ok {
+		w.Value = val
+	} else {
+		return nil, fmt.Errorf("expected number, but got: %s", w.starlarkValue.String())
+	}
+
+	w.Width = int(width.BigInt().Int64())
+
+	w.Height = int(height.BigInt().Int64())
+
+	w.starlarkMin = min
+	if min == nil {
+		w.starlarkMin = starlark.None
+	} else if val, ok := starlark.AsFloat(w.starlarkMin); ok {
+		w.Min = val
+	} else {
+		return nil, fmt.Errorf("expected number, but got: %s", w.starlarkMin.String())
+	}
+
+	w.starlarkMax = max
+	if max == nil {
+		w.starlarkMax = starlark.None
+		w.Max = math.NaN()
+	} else if val, ok := starlark.AsFloat(w.starlarkMax); ok {
+		w.Max = val
+	} else {
+		return nil, fmt.Errorf("expected number, but got: %s", w.starlarkMax.String())
+	}
+
+	w.starlarkThresholds = thresholds
+	if thresholds != nil {
+		if val, err := WeightsFromStarlark(thresholds); err == nil {
+			w.Thresholds = val
+		} else {
+			return nil, err
+		}
+	}
+
+	w.starlarkColors = colors
+	if colors != nil {
+		if val, err := ColorSeriesFromStarlark(colors); err == nil {
+			w.Colors = val
+		} else {
+			return nil, err
+		}
+	}
+
+	w.starlarkBackground = background
+	if background.Len() > 0 {
+		c, err := render.ParseColor(background.GoString())
+		if err != nil {
+			return nil, fmt.Errorf("background is not a valid hex string: %s", background.String())
+		}
+		w.Background = c
+	}
+
+	w.Vertical = bool(vertical)
+
+	w.frame_count = starlark.NewBuiltin("frame_count", progressbarFrameCount)
+
+	if err := w.Init(); err != nil {
+		return nil, err
+	}
+
+	return w, nil
+}
+
+func (w *ProgressBar) AsRenderWidget() render.Widget {
+	return &w.ProgressBar
+}
+
+func (w *ProgressBar) AttrNames() []string {
+	return []string{
+		"value", "width", "height", "min", "max", "thresholds", "colors", "background", "vertical",
+	}
+}
+
+func (w *ProgressBar) Attr(name string) (starlark.Value, error) {
+	switch name {
+
+	case "value":
+
+		return w.starlarkValue, nil
+
+	case "width":
+
+		return starlark.MakeInt(int(w.Width)), nil
+
+	case "height":
+
+		return starlark.MakeInt(int(w.Height)), nil
+
+	case "min":
+
+		return w.starlarkMin, nil
+
+	case "max":
+
+		return w.starlarkMax, nil
+
+	case "thresholds":
+
+		return w.starlarkThresholds, nil
+
+	case "colors":
+
+		return w.starlarkColors, nil
+
+	case "background":
+
+		return w.starlarkBackground, nil
+
+	case "vertical":
+
+		return starlark.Bool(w.Vertical), nil
+
+	case "frame_count":
+		return w.frame_count.BindReceiver(w), nil
+
+	default:
+		return nil, nil
+	}
+}
+
+func (w *ProgressBar) String() string       { return "ProgressBar(...)" }
+func (w *ProgressBar) Type() string         { return "ProgressBar" }
+func (w *ProgressBar) Freeze()              {}
+func (w *ProgressBar) Truth() starlark.Bool { return true }
+
+func (w *ProgressBar) Hash() (uint32, error) {
+	sum, err := hashstructure.Hash(w, hashstructure.FormatV2, nil)
+	return uint32(sum), err
+}
+
+func progressbarFrameCount(
+	thread *starlark.Thread,
+	b *starlark.Builtin,
+	args starlark.Tuple,
+	kwargs []starlark.Tuple) (starlark.Value, error) {
+
+	w := b.Receiver().(*ProgressBar)
+	count := w.FrameCount()
+
+	return starlark.MakeInt(count), nil
+}
+
+type RichText struct {
+	Widget
+
+	render.RichText
+
+	starlarkSpans *starlark.List
+
+	starlarkColor starlark.String
+
+	frame_count *starlark.Builtin
+}
+
+func newRichText(
+	thread *starlark.Thread,
+	_ *starlark.Builtin,
+	args starlark.Tuple,
+	kwargs []starlark.Tuple,
+) (starlark.Value, error) {
+
+	var (
+		spans       *starlark.List
+		markup      starlark.String
+		font        starlark.String
+		color       starlark.String
+		width       starlark.Int
+		height      starlark.Int
+		linespacing starlark.Int
+		align       starlark.String
+	)
+
+	if err := starlark.UnpackArgs(
+		"RichText",
+		args, kwargs,
+		"spans?", &spans,
+		"markup?", &markup,
+		"font?", &font,
+		"color?", &color,
+		"width?", &width,
+		"height?", &height,
+		"linespacing?", &linespacing,
+		"align?", &align,
+	); err != nil {
+		return nil, fmt.Errorf("unpacking arguments for RichText: %s", err)
+	}
+
+	w := &RichText{}
+
+	w.starlarkSpans = spans
+	if spans != nil {
+		for i := 0; i < spans.Len(); i++ {
+			switch spansVal := spans.Index(i).(type) {
+			case *Span:
+				w.Spans = append(w.Spans, spansVal.Span)
+			case starlark.String:
+				w.Spans = append(w.Spans, render.Span{Content: spansVal.GoString()})
+			default:
+				return nil, fmt.Errorf(
+					"expected spans to be a list of Span or str but found: %s (at index %d)",
+					spansVal.Type(),
+					i,
+				)
+			}
+		}
+	}
+
+	w.Markup = markup.GoString()
+
+	w.Font = font.GoString()
+
+	w.starlarkColor = color
+	if color.Len() > 0 {
+		c, err := render.ParseColor(color.GoString())
+		if err != nil {
+			return nil, fmt.Errorf("color is not a valid hex string: %s", color.String())
+		}
+		w.Color = c
+	}
+
+	w.Width = int(width.BigInt().Int64())
+
+	w.Height = int(height.BigInt().Int64())
+
+	w.LineSpacing = int(linespacing.BigInt().Int64())
+
+	w.Align = align.GoString()
+
+	w.frame_count = starlark.NewBuiltin("frame_count", richtextFrameCount)
+
+	if err := w.Init(); err != nil {
+		return nil, err
+	}
+
+	return w, nil
+}
+
+func (w *RichText) AsRenderWidget() render.Widget {
+	return &w.RichText
+}
+
+func (w *RichText) AttrNames() []string {
+	return []string{
+		"spans", "markup", "font", "color", "width", "height", "linespacing", "align",
+	}
+}
+
+func (w *RichText) Attr(name string) (starlark.Value, error) {
+	switch name {
+
+	case "spans":
+
+		return w.starlarkSpans, nil
+
+	case "markup":
+
+		return starlark.String(w.Markup), nil
+
+	case "font":
+
+		return starlark.String(w.Font), nil
+
+	case "color":
+
+		return w.starlarkColor, nil
+
+	case "width":
+
+		return starlark.MakeInt(int(w.Width)), nil
+
+	case "height":
+
+		return starlark.MakeInt(int(w.Height)), nil
+
+	case "linespacing":
+
+		return starlark.MakeInt(int(w.LineSpacing)), nil
+
+	case "align":
+
+		return starlark.String(w.Align), nil
+
+	case "frame_count":
+		return w.frame_count.BindReceiver(w), nil
+
+	default:
+		return nil, nil
+	}
+}
+
+func (w *RichText) String() string       { return "RichText(...)" }
+func (w *RichText) Type() string         { return "RichText" }
+func (w *RichText) Freeze()              {}
+func (w *RichText) Truth() starlark.Bool { return true }
+
+func (w *RichText) Hash() (uint32, error) {
+	sum, err := hashstructure.Hash(w, hashstructure.FormatV2, nil)
+	return uint32(sum), err
+}
+
+func richtextFrameCount(
+	thread *starlark.Thread,
+	b *starlark.Builtin,
+	args starlark.Tuple,
+	kwargs []starlark.Tuple) (starlark.Value, error) {
+
+	w := b.Receiver().(*RichText)
+	count := w.FrameCount()
+
+	return starlark.MakeInt(count), nil
+}
+
+type Root struct {
+	render.Root
+
+	starlarkChild starlark.Value
+}
+
+func newRoot(
+	thread *starlark.Thread,
+	_ *starlark.Builtin,
+	args starlark.Tuple,
+	kwargs []starlark.Tuple,
+) (starlark.Value, error) {
+
+	var (
+		child               starlark.Value
+		delay               starlark.Int
+		max_age             starlark.Int
+		show_full_animation starlark.Bool
+	)
+
+	if err := starlark.UnpackArgs(
+		"Root",
+		args, kwargs,
+		"child", &child,
+		"delay?", &delay,
+		"max_age?", &max_age,
+		"show_full_animation?", &show_full_animation,
+	); err != nil {
+		return nil, fmt.Errorf("unpacking arguments for Root: %s", err)
+	}
+
+	w := &Root{}
+
+	if child != nil {
+		childWidget, ok := child.(Widget)
+		if !ok {
+			return nil, fmt.Errorf(
+				"invalid type for child: %s (expected Widget)",
+				child.Type(),
+			)
+		}
+		w.Child = childWidget.AsRenderWidget()
+		w.starlarkChild = child
+	}
+
+	if val, err := starlark.AsInt32(delay); err == nil {
+		w.Delay = int32(val)
+	} else {
+		return nil, err
+	}
+
+	if val, err := starlark.AsInt32(max_age); err == nil {
+		w.MaxAge = int32(val)
+	} else {
+		return nil, err
+	}
+
+	w.ShowFullAnimation = bool(show_full_animation)
+
+	return w, nil
+}
+
+func (w *Root) AsRenderRoot() render.Root {
+	return w.Root
+}
+
+func (w *Root) AttrNames() []string {
+	return []string{
+		"child", "delay", "max_age", "show_full_animation",
+	}
+}
+
+func (w *Root) Attr(name string) (starlark.Value, error) {
+	switch name {
+
+	case "child":
+
+		return w.starlarkChild, nil
+
+	case "delay":
+
+		return starlark.MakeInt(int(w.Delay)), nil
+
+	case "max_age":
+
+		return starlark.MakeInt(int(w.MaxAge)), nil
+
+	case "show_full_animation":
+
+		return starlark.Bool(w.ShowFullAnimation), nil
+
+	default:
+		return nil, nil
+	}
+}
+
+func (w *Root) String() string       { return "Root(...)" }
+func (w *Root) Type() string         { return "Root" }
+func (w *Root) Freeze()              {}
+func (w *Root) Truth() starlark.Bool { return true }
+
+func (w *Root) Hash() (uint32, error) {
+	sum, err := hashstructure.Hash(w, hashstructure.FormatV2, nil)
+	return uint32(sum), err
+}
+
+type Row struct {
+	Widget
+
+	render.Row
+
+	starlarkChildren *starlark.List
+
+	frame_count *starlark.Builtin
+}
+
+func newRow(
+	thread *starlark.Thread,
+	_ *starlark.Builtin,
+	args starlark.Tuple,
+	kwargs []starlark.Tuple,
+) (starlark.Value, error) {
+
+	var (
+		children    *starlark.List
+		main_align  starlark.String
+		cross_align starlark.String
+		expanded    starlark.Bool
+		gap         starlark.Int
+		shrink      starlark.Bool
+	)
+
+	if err := starlark.UnpackArgs(
+		"Row",
+		args, kwargs,
+		"children", &children,
+		"main_align?", &main_align,
+		"cross_align?", &cross_align,
+		"expanded?", &expanded,
+		"gap?", &gap,
+		"shrink?", &shrink,
+	); err != nil {
+		return nil, fmt.Errorf("unpacking arguments for Row: %s", err)
+	}
+
+	w := &Row{}
+
+	var childrenVal starlark.Value
+	childrenIter := children.Iterate()
+	defer childrenIter.Done()
+	for i := 0; childrenIter.Next(&childrenVal); {
+		if _, isNone := childrenVal.(starlark.NoneType); isNone {
+			continue
+		}
+
+		childrenChild, ok := childrenVal.(Widget)
+		if !ok {
+			return nil, fmt.Errorf(
+				"expected children to be a list of Widget but found: %s (at index %d)",
+				childrenVal.Type(),
+				i,
+			)
+		}
+
+		w.Children = append(w.Children, childrenChild.AsRenderWidget())
+	}
+	w.starlarkChildren = children
+
+	w.MainAlign = main_align.GoString()
+
+	w.CrossAlign = cross_align.GoString()
+
+	w.Expanded = bool(expanded)
+
+	w.Gap = int(gap.BigInt().Int64())
+
+	w.Shrink = bool(shrink)
+
+	w.frame_count = starlark.NewBuiltin("frame_count", rowFrameCount)
+
+	return w, nil
+}
+
+func (w *Row) AsRenderWidget() render.Widget {
+	return &w.Row
+}
+
+func (w *Row) AttrNames() []string {
+	return []string{
+		"children", "main_align", "cross_align", "expanded", "gap", "shrink",
+	}
+}
+
+func (w *Row) Attr(name string) (starlark.Value, error) {
+	switch name {
+
+	case "children":
+
+		return w.starlarkChildren, nil
+
+	case "main_align":
+
+		return starlark.String(w.MainAlign), nil
+
+	case "cross_align":
+
+		return starlark.String(w.CrossAlign), nil
+
+	case "expanded":
+
+		return starlark.Bool(w.Expanded), nil
+
+	case "gap":
+
+		return starlark.MakeInt(int(w.Gap)), nil
+
+	case "shrink":
+
+		return starlark.Bool(w.Shrink), nil
+
+	case "frame_count":
+		return w.frame_count.BindReceiver(w), nil
+
+	default:
+		return nil, nil
+	}
+}
+
+func (w *Row) String() string       { return "Row(...)" }
+func (w *Row) Type() string         { return "Row" }
+func (w *Row) Freeze()              {}
+func (w *Row) Truth() starlark.Bool { return true }
+
+func (w *Row) Hash() (uint32, error) {
+	sum, err := hashstructure.Hash(w, hashstructure.FormatV2, nil)
+	return uint32(sum), err
+}
+
+func rowFrameCount(
+	thread *starlark.Thread,
+	b *starlark.Builtin,
+	args starlark.Tuple,
+	kwargs []starlark.Tuple) (starlark.Value, error) {
+
+	w := b.Receiver().(*Row)
+	count := w.FrameCount()
+
+	return starlark.MakeInt(count), nil
+}
+
+type Sequence struct {
+	Widget
+
+	render.Sequence
+
+	starlarkChildren *starlark.List
+
+	frame_count *starlark.Builtin
+}
+
+func newSequence(
+	thread *starlark.Thread,
+	_ *starlark.Builtin,
+	args starlark.Tuple,
+	kwargs []starlark.Tuple,
+) (starlark.Value, error) {
+
+	var (
+		children *starlark.List
+	)
+
+	if err := starlark.UnpackArgs(
+		"Sequence",
+		args, kwargs,
+		"children", &children,
+	); err != nil {
+		return nil, fmt.Errorf("unpacking arguments for Sequence: %s", err)
+	}
+
+	w := &Sequence{}
+
+	var childrenVal starlark.Value
+	childrenIter := children.Iterate()
+	defer childrenIter.Done()
+	for i := 0; childrenIter.Next(&childrenVal); {
+		if _, isNone := childrenVal.(starlark.NoneType); isNone {
+			continue
+		}
+
+		childrenChild, ok := childrenVal.(Widget)
+		if !ok {
+			return nil, fmt.Errorf(
+				"expected children to be a list of Widget but found: %s (at index %d)",
+				childrenVal.Type(),
+				i,
+			)
+		}
+
+		w.Children = append(w.Children, childrenChild.AsRenderWidget())
+	}
+	w.starlarkChildren = children
+
+	w.frame_count = starlark.NewBuiltin("frame_count", sequenceFrameCount)
 
-	case "chart_type":
+	return w, nil
+}
 
-		return starlark.String(w.ChartType), nil
+func (w *Sequence) AsRenderWidget() render.Widget {
+	return &w.Sequence
+}
 
-	case "fill_color":
+func (w *Sequence) AttrNames() []string {
+	return []string{
+		"children",
+	}
+}
 
-		return w.starlarkFillColor, nil
+func (w *Sequence) Attr(name string) (starlark.Value, error) {
+	switch name {
 
-	case "fill_color_inverted":
+	case "children":
 
-		return w.starlarkFillColorInverted, nil
+		return w.starlarkChildren, nil
 
 	case "frame_count":
 		return w.frame_count.BindReceiver(w), nil
@@ -1318,35 +3133,37 @@ func (w *Plot) Attr(name string) (starlark.Value, error) {
 	}
 }
 
-func (w *Plot) String() string       { return "Plot(...)" }
-func (w *Plot) Type() string         { return "Plot" }
-func (w *Plot) Freeze()              {}
-func (w *Plot) Truth() starlark.Bool { return true }
+func (w *Sequence) String() string       { return "Sequence(...)" }
+func (w *Sequence) Type() string         { return "Sequence" }
+func (w *Sequence) Freeze()              {}
+func (w *Sequence) Truth() starlark.Bool { return true }
 
-func (w *Plot) Hash() (uint32, error) {
+func (w *Sequence) Hash() (uint32, error) {
 	sum, err := hashstructure.Hash(w, hashstructure.FormatV2, nil)
 	return uint32(sum), err
 }
 
-func plotFrameCount(
+func sequenceFrameCount(
 	thread *starlark.Thread,
 	b *starlark.Builtin,
 	args starlark.Tuple,
 	kwargs []starlark.Tuple) (starlark.Value, error) {
 
-	w := b.Receiver().(*Plot)
+	w := b.Receiver().(*Sequence)
 	count := w.FrameCount()
 
 	return starlark.MakeInt(count), nil
 }
 
-type Root struct {
-	render.Root
+type Span struct {
+	render.Span
 
-	starlarkChild starlark.Value
+	starlarkColor starlark.String
+
+	starlarkBackground starlark.String
 }
 
-func newRoot(
+func newSpan(
 	thread *starlark.Thread,
 	_ *starlark.Builtin,
 	args starlark.Tuple,
@@ -1354,109 +3171,117 @@ func newRoot(
 ) (starlark.Value, error) {
 
 	var (
-		child               starlark.Value
-		delay               starlark.Int
-		max_age             starlark.Int
-		show_full_animation starlark.Bool
+		content    starlark.String
+		font       starlark.String
+		color      starlark.String
+		background starlark.String
+		offset     starlark.Int
+		bold       starlark.Bool
 	)
 
 	if err := starlark.UnpackArgs(
-		"Root",
+		"Span",
 		args, kwargs,
-		"child", &child,
-		"delay?", &delay,
-		"max_age?", &max_age,
-		"show_full_animation?", &show_full_animation,
+		"content", &content,
+		"font?", &font,
+		"color?", &color,
+		"background?", &background,
+		"offset?", &offset,
+		"bold?", &bold,
 	); err != nil {
-		return nil, fmt.Errorf("unpacking arguments for Root: %s", err)
+		return nil, fmt.Errorf("unpacking arguments for Span: %s", err)
 	}
 
-	w := &Root{}
+	w := &Span{}
 
-	if child != nil {
-		childWidget, ok := child.(Widget)
-		if !ok {
-			return nil, fmt.Errorf(
-				"invalid type for child: %s (expected Widget)",
-				child.Type(),
-			)
+	w.Content = content.GoString()
+
+	w.Font = font.GoString()
+
+	w.starlarkColor = color
+	if color.Len() > 0 {
+		c, err := render.ParseColor(color.GoString())
+		if err != nil {
+			return nil, fmt.Errorf("color is not a valid hex string: %s", color.String())
 		}
-		w.Child = childWidget.AsRenderWidget()
-		w.starlarkChild = child
+		w.Color = c
 	}
 
-	if val, err := starlark.AsInt32(delay); err == nil {
-		w.Delay = int32(val)
-	} else {
-		return nil, err
+	w.starlarkBackground = background
+	if background.Len() > 0 {
+		c, err := render.ParseColor(background.GoString())
+		if err != nil {
+			return nil, fmt.Errorf("background is not a valid hex string: %s", background.String())
+		}
+		w.Background = c
 	}
 
-	if val, err := starlark.AsInt32(max_age); err == nil {
-		w.MaxAge = int32(val)
-	} else {
-		return nil, err
-	}
+	w.Offset = int(offset.BigInt().Int64())
 
-	w.ShowFullAnimation = bool(show_full_animation)
+	w.Bold = bool(bold)
 
 	return w, nil
 }
 
-func (w *Root) AsRenderRoot() render.Root {
-	return w.Root
-}
-
-func (w *Root) AttrNames() []string {
+func (w *Span) AttrNames() []string {
 	return []string{
-		"child", "delay", "max_age", "show_full_animation",
+		"content", "font", "color", "background", "offset", "bold",
 	}
 }
 
-func (w *Root) Attr(name string) (starlark.Value, error) {
+func (w *Span) Attr(name string) (starlark.Value, error) {
 	switch name {
 
-	case "child":
+	case "content":
 
-		return w.starlarkChild, nil
+		return starlark.String(w.Content), nil
 
-	case "delay":
+	case "font":
 
-		return starlark.MakeInt(int(w.Delay)), nil
+		return starlark.String(w.Font), nil
 
-	case "max_age":
+	case "color":
 
-		return starlark.MakeInt(int(w.MaxAge)), nil
+		return w.starlarkColor, nil
 
-	case "show_full_animation":
+	case "background":
 
-		return starlark.Bool(w.ShowFullAnimation), nil
+		return w.starlarkBackground, nil
+
+	case "offset":
+
+		return starlark.MakeInt(int(w.Offset)), nil
+
+	case "bold":
+
+		return starlark.Bool(w.Bold), nil
 
 	default:
 		return nil, nil
 	}
 }
 
-func (w *Root) String() string       { return "Root(...)" }
-func (w *Root) Type() string         { return "Root" }
-func (w *Root) Freeze()              {}
-func (w *Root) Truth() starlark.Bool { return true }
+func (w *Span) String() string       { return "Span(...)" }
+func (w *Span) Type() string         { return "Span" }
+func (w *Span) Freeze()              {}
+func (w *Span) Truth() starlark.Bool { return true }
 
-func (w *Root) Hash() (uint32, error) {
+func (w *Span) Hash() (uint32, error) {
 	sum, err := hashstructure.Hash(w, hashstructure.FormatV2, nil)
 	return uint32(sum), err
 }
 
-type Row struct {
+type Stack struct {
 	Widget
 
-	render.Row
+	render.Stack
 
 	starlarkChildren *starlark.List
 
 	frame_count *starlark.Builtin
 }
 
-func newRow(
+func newStack(
 	thread *starlark.Thread,
 	_ *starlark.Builtin,
 	args starlark.Tuple,
@@ -1464,24 +3289,18 @@ func newRow(
 ) (starlark.Value, error) {
 
 	var (
-		children    *starlark.List
-		main_align  starlark.String
-		cross_align starlark.String
-		expanded    starlark.Bool
+		children *starlark.List
 	)
 
 	if err := starlark.UnpackArgs(
-		"Row",
+		"Stack",
 		args, kwargs,
 		"children", &children,
-		"main_align?", &main_align,
-		"cross_align?", &cross_align,
-		"expanded?", &expanded,
 	); err != nil {
-		return nil, fmt.Errorf("unpacking arguments for Row: %s", err)
+		return nil, fmt.Errorf("unpacking arguments for Stack: %s", err)
 	}
 
-	w := &Row{}
+	w := &Stack{}
 
 	var childrenVal starlark.Value
 	childrenIter := children.Iterate()
@@ -1504,45 +3323,241 @@ func newRow(
 	}
 	w.starlarkChildren = children
 
-	w.MainAlign = main_align.GoString()
+	w.frame_count = starlark.NewBuiltin("frame_count", stackFrameCount)
 
-	w.CrossAlign = cross_align.GoString()
+	return w, nil
+}
 
-	w.Expanded = bool(expanded)
+func (w *Stack) AsRenderWidget() render.Widget {
+	return &w.Stack
+}
 
-	w.frame_count = starlark.NewBuiltin("frame_count", rowFrameCount)
+func (w *Stack) AttrNames() []string {
+	return []string{
+		"children",
+	}
+}
+
+func (w *Stack) Attr(name string) (starlark.Value, error) {
+	switch name {
+
+	case "children":
+
+		return w.starlarkChildren, nil
+
+	case "frame_count":
+		return w.frame_count.BindReceiver(w), nil
+
+	default:
+		return nil, nil
+	}
+}
+
+func (w *Stack) String() string       { return "Stack(...)" }
+func (w *Stack) Type() string         { return "Stack" }
+func (w *Stack) Freeze()              {}
+func (w *Stack) Truth() starlark.Bool { return true }
+
+func (w *Stack) Hash() (uint32, error) {
+	sum, err := hashstructure.Hash(w, hashstructure.FormatV2, nil)
+	return uint32(sum), err
+}
+
+func stackFrameCount(
+	thread *starlark.Thread,
+	b *starlark.Builtin,
+	args starlark.Tuple,
+	kwargs []starlark.Tuple) (starlark.Value, error) {
+
+	w := b.Receiver().(*Stack)
+	count := w.FrameCount()
+
+	return starlark.MakeInt(count), nil
+}
+
+type Table struct {
+	Widget
+
+	render.Table
+
+	starlarkRows *starlark.List
+
+	starlarkColumns *starlark.List
+
+	starlarkColumnSeparator starlark.String
+
+	starlarkRowSeparator starlark.String
+
+	starlarkRowColors *starlark.List
+
+	frame_count *starlark.Builtin
+}
+
+func newTable(
+	thread *starlark.Thread,
+	_ *starlark.Builtin,
+	args starlark.Tuple,
+	kwargs []starlark.Tuple,
+) (starlark.Value, error) {
+
+	var (
+		rows             *starlark.List
+		columns          *starlark.List
+		column_gap       starlark.Int
+		row_gap          starlark.Int
+		column_separator starlark.String
+		row_separator    starlark.String
+		row_colors       *starlark.List
+	)
+
+	if err := starlark.UnpackArgs(
+		"Table",
+		args, kwargs,
+		"rows", &rows,
+		"columns?", &columns,
+		"column_gap?", &column_gap,
+		"row_gap?", &row_gap,
+		"column_separator?", &column_separator,
+		"row_separator?", &row_separator,
+		"row_colors?", &row_colors,
+	); err != nil {
+		return nil, fmt.Errorf("unpacking arguments for Table: %s", err)
+	}
+
+	w := &Table{}
+
+	w.starlarkRows = rows
+	for r := 0; r < rows.Len(); r++ {
+		var rowsRow starlark.Indexable
+		switch rowsVal := rows.Index(r).(type) {
+		case *starlark.List:
+			rowsRow = rowsVal
+		case starlark.Tuple:
+			rowsRow = rowsVal
+		default:
+			return nil, fmt.Errorf(
+				"expected rows to be a list of lists but found: %s (at index %d)",
+				rowsVal.Type(),
+				r,
+			)
+		}
+
+		cells := make([]render.TableCell, 0, rowsRow.Len())
+		for i := 0; i < rowsRow.Len(); i++ {
+			switch rowsVal := rowsRow.Index(i).(type) {
+			case starlark.NoneType:
+				cells = append(cells, render.TableCell{})
+			case *TableCell:
+				cells = append(cells, rowsVal.TableCell)
+			case Widget:
+				cells = append(cells, render.TableCell{Child: rowsVal.AsRenderWidget()})
+			default:
+				return nil, fmt.Errorf(
+					"expected rows to hold Widget, TableCell or None but found: %s (at row %d, column %d)",
+					rowsVal.Type(),
+					r,
+					i,
+				)
+			}
+		}
+		w.Rows = append(w.Rows, cells)
+	}
+
+	w.starlarkColumns = columns
+	if columns != nil {
+		for i := 0; i < columns.Len(); i++ {
+			columnsVal, ok := columns.Index(i).(*TableColumn)
+			if !ok {
+				return nil, fmt.Errorf(
+					"expected columns to be a list of TableColumn but found: %s (at index %d)",
+					columns.Index(i).Type(),
+					i,
+				)
+			}
+			w.Columns = append(w.Columns, columnsVal.TableColumn)
+		}
+	}
+
+	w.ColumnGap = int(column_gap.BigInt().Int64())
+
+	w.RowGap = int(row_gap.BigInt().Int64())
+
+	w.starlarkColumnSeparator = column_separator
+	if column_separator.Len() > 0 {
+		c, err := render.ParseColor(column_separator.GoString())
+		if err != nil {
+			return nil, fmt.Errorf("column_separator is not a valid hex string: %s", column_separator.String())
+		}
+		w.ColumnSeparator = c
+	}
+
+	w.starlarkRowSeparator = row_separator
+	if row_separator.Len() > 0 {
+		c, err := render.ParseColor(row_separator.GoString())
+		if err != nil {
+			return nil, fmt.Errorf("row_separator is not a valid hex string: %s", row_separator.String())
+		}
+		w.RowSeparator = c
+	}
+
+	w.starlarkRowColors = row_colors
+	if row_colors != nil {
+		if val, err := ColorSeriesFromStarlark(row_colors); err == nil {
+			w.RowColors = val
+		} else {
+			return nil, err
+		}
+	}
+
+	w.frame_count = starlark.NewBuiltin("frame_count", tableFrameCount)
+
+	if err := w.Init(); err != nil {
+		return nil, err
+	}
 
 	return w, nil
 }
 
-func (w *Row) AsRenderWidget() render.Widget {
-	return &w.Row
+func (w *Table) AsRenderWidget() render.Widget {
+	return &w.Table
 }
 
-func (w *Row) AttrNames() []string {
+func (w *Table) AttrNames() []string {
 	return []string{
-		"children", "main_align", "cross_align", "expanded",
+		"rows", "columns", "column_gap", "row_gap", "column_separator", "row_separator", "row_colors",
 	}
 }
 
-func (w *Row) Attr(name string) (starlark.Value, error) {
-	switch name {
+func (w *Table) Attr(name string) (starlark.Value, error) {
+	switch name {
+
+	case "rows":
+
+		return w.starlarkRows, nil
+
+	case "columns":
+
+		return w.starlarkColumns, nil
 
-	case "children":
+	case "column_gap":
 
-		return w.starlarkChildren, nil
+		return starlark.MakeInt(int(w.ColumnGap)), nil
 
-	case "main_align":
+	case "row_gap":
 
-		return starlark.String(w.MainAlign), nil
+		return starlark.MakeInt(int(w.RowGap)), nil
 
-	case "cross_align":
+	case "column_separator":
 
-		return starlark.String(w.CrossAlign), nil
+		return w.starlarkColumnSeparator, nil
 
-	case "expanded":
+	case "row_separator":
 
-		return starlark.Bool(w.Expanded), nil
+		return w.starlarkRowSeparator, nil
+
+	case "row_colors":
+
+		return w.starlarkRowColors, nil
 
 	case "frame_count":
 		return w.frame_count.BindReceiver(w), nil
@@ -1552,39 +3567,35 @@ func (w *Row) Attr(name string) (starlark.Value, error) {
 	}
 }
 
-func (w *Row) String() string       { return "Row(...)" }
-func (w *Row) Type() string         { return "Row" }
-func (w *Row) Freeze()              {}
-func (w *Row) Truth() starlark.Bool { return true }
+func (w *Table) String() string       { return "Table(...)" }
+func (w *Table) Type() string         { return "Table" }
+func (w *Table) Freeze()              {}
+func (w *Table) Truth() starlark.Bool { return true }
 
-func (w *Row) Hash() (uint32, error) {
+func (w *Table) Hash() (uint32, error) {
 	sum, err := hashstructure.Hash(w, hashstructure.FormatV2, nil)
 	return uint32(sum), err
 }
 
-func rowFrameCount(
+func tableFrameCount(
 	thread *starlark.Thread,
 	b *starlark.Builtin,
 	args starlark.Tuple,
 	kwargs []starlark.Tuple) (starlark.Value, error) {
 
-	w := b.Receiver().(*Row)
+	w := b.Receiver().(*Table)
 	count := w.FrameCount()
 
 	return starlark.MakeInt(count), nil
 }
 
-type Sequence struct {
-	Widget
-
-	render.Sequence
-
-	starlarkChildren *starlark.List
+type TableCell struct {
+	render.TableCell
 
-	frame_count *starlark.Builtin
+	starlarkChild starlark.Value
 }
 
-func newSequence(
+func newTableCell(
 	thread *starlark.Thread,
 	_ *starlark.Builtin,
 	args starlark.Tuple,
@@ -1592,103 +3603,89 @@ func newSequence(
 ) (starlark.Value, error) {
 
 	var (
-		children *starlark.List
+		child          starlark.Value
+		align          starlark.String
+		vertical_align starlark.String
 	)
 
 	if err := starlark.UnpackArgs(
-		"Sequence",
+		"TableCell",
 		args, kwargs,
-		"children", &children,
+		"child", &child,
+		"align?", &align,
+		"vertical_align?", &vertical_align,
 	); err != nil {
-		return nil, fmt.Errorf("unpacking arguments for Sequence: %s", err)
+		return nil, fmt.Errorf("unpacking arguments for TableCell: %s", err)
 	}
 
-	w := &Sequence{}
-
-	var childrenVal starlark.Value
-	childrenIter := children.Iterate()
-	defer childrenIter.Done()
-	for i := 0; childrenIter.Next(&childrenVal); {
-		if _, isNone := childrenVal.(starlark.NoneType); isNone {
-			continue
-		}
+	w := &TableCell{}
 
-		childrenChild, ok := childrenVal.(Widget)
+	if child != nil {
+		childWidget, ok := child.(Widget)
 		if !ok {
 			return nil, fmt.Errorf(
-				"expected children to be a list of Widget but found: %s (at index %d)",
-				childrenVal.Type(),
-				i,
+				"invalid type for child: %s (expected Widget)",
+				child.Type(),
 			)
 		}
-
-		w.Children = append(w.Children, childrenChild.AsRenderWidget())
+		w.Child = childWidget.AsRenderWidget()
+		w.starlarkChild = child
 	}
-	w.starlarkChildren = children
 
-	w.frame_count = starlark.NewBuiltin("frame_count", sequenceFrameCount)
+	w.Align = align.GoString()
 
-	return w, nil
-}
+	w.VerticalAlign = vertical_align.GoString()
 
-func (w *Sequence) AsRenderWidget() render.Widget {
-	return &w.Sequence
+	if err := w.Init(); err != nil {
+		return nil, err
+	}
+
+	return w, nil
 }
 
-func (w *Sequence) AttrNames() []string {
+func (w *TableCell) AttrNames() []string {
 	return []string{
-		"children",
+		"child", "align", "vertical_align",
 	}
 }
 
-func (w *Sequence) Attr(name string) (starlark.Value, error) {
+func (w *TableCell) Attr(name string) (starlark.Value, error) {
 	switch name {
 
-	case "children":
+	case "child":
 
-		return w.starlarkChildren, nil
+		return w.starlarkChild, nil
 
-	case "frame_count":
-		return w.frame_count.BindReceiver(w), nil
+	case "align":
+
+		return starlark.String(w.Align), nil
+
+	case "vertical_align":
+
+		return starlark.String(w.VerticalAlign), nil
 
 	default:
 		return nil, nil
 	}
 }
 
-func (w *Sequence) String() string       { return "Sequence(...)" }
-func (w *Sequence) Type() string         { return "Sequence" }
-func (w *Sequence) Freeze()              {}
-func (w *Sequence) Truth() starlark.Bool { return true }
+func (w *TableCell) String() string       { return "TableCell(...)" }
+func (w *TableCell) Type() string         { return "TableCell" }
+func (w *TableCell) Freeze()              {}
+func (w *TableCell) Truth() starlark.Bool { return true }
 
-func (w *Sequence) Hash() (uint32, error) {
+func (w *TableCell) Hash() (uint32, error) {
 	sum, err := hashstructure.Hash(w, hashstructure.FormatV2, nil)
 	return uint32(sum), err
 }
 
-func sequenceFrameCount(
-	thread *starlark.Thread,
-	b *starlark.Builtin,
-	args starlark.Tuple,
-	kwargs []starlark.Tuple) (starlark.Value, error) {
-
-	w := b.Receiver().(*Sequence)
-	count := w.FrameCount()
-
-	return starlark.MakeInt(count), nil
-}
-
-type Stack struct {
-	Widget
-
-	render.Stack
-
-	starlarkChildren *starlark.List
+type TableColumn struct {
+	render.TableColumn
 
-	frame_count *starlark.Builtin
+	starlarkWidth starlark.Value
 }
 
-func newStack(
+func newTableColumn(
 	thread *starlark.Thread,
 	_ *starlark.Builtin,
 	args starlark.Tuple,
@@ -1696,92 +3693,82 @@ func newStack(
 ) (starlark.Value, error) {
 
 	var (
-		children *starlark.List
+		width    starlark.Value
+		align    starlark.String
+		overflow starlark.String
 	)
 
 	if err := starlark.UnpackArgs(
-		"Stack",
+		"TableColumn",
 		args, kwargs,
-		"children", &children,
+		"width?", &width,
+		"align?", &align,
+		"overflow?", &overflow,
 	); err != nil {
-		return nil, fmt.Errorf("unpacking arguments for Stack: %s", err)
+		return nil, fmt.Errorf("unpacking arguments for TableColumn: %s", err)
 	}
 
-	w := &Stack{}
+	w := &TableColumn{}
 
-	var childrenVal starlark.Value
-	childrenIter := children.Iterate()
-	defer childrenIter.Done()
-	for i := 0; childrenIter.Next(&childrenVal); {
-		if _, isNone := childrenVal.(starlark.NoneType); isNone {
-			continue
-		}
+	w.starlarkWidth = width
+	switch widthVal := width.(type) {
+	case nil:
+		w.starlarkWidth = starlark.None
+	case starlark.Int:
+		w.Width = render.ColumnWidth(widthVal.String())
+	case starlark.String:
+		w.Width = render.ColumnWidth(widthVal.GoString())
+	default:
+		return nil, fmt.Errorf("width must be int or str")
+	}
 
-		childrenChild, ok := childrenVal.(Widget)
-		if !ok {
-			return nil, fmt.Errorf(
-				"expected children to be a list of Widget but found: %s (at index %d)",
-				childrenVal.Type(),
-				i,
-			)
-		}
+	w.Align = align.GoString()
 
-		w.Children = append(w.Children, childrenChild.AsRenderWidget())
-	}
-	w.starlarkChildren = children
+	w.Overflow = overflow.GoString()
 
-	w.frame_count = starlark.NewBuiltin("frame_count", stackFrameCount)
+	if err := w.Init(); err != nil {
+		return nil, err
+	}
 
 	return w, nil
 }
 
-func (w *Stack) AsRenderWidget() render.Widget {
-	return &w.Stack
-}
-
-func (w *Stack) AttrNames() []string {
+func (w *TableColumn) AttrNames() []string {
 	return []string{
-		"children",
+		"width", "align", "overflow",
 	}
 }
 
-func (w *Stack) Attr(name string) (starlark.Value, error) {
+func (w *TableColumn) Attr(name string) (starlark.Value, error) {
 	switch name {
 
-	case "children":
+	case "width":
 
-		return w.starlarkChildren, nil
+		return w.starlarkWidth, nil
 
-	case "frame_count":
-		return w.frame_count.BindReceiver(w), nil
+	case "align":
+
+		return starlark.String(w.Align), nil
+
+	case "overflow":
+
+		return starlark.String(w.Overflow), nil
 
 	default:
 		return nil, nil
 	}
 }
 
-func (w *Stack) String() string       { return "Stack(...)" }
-func (w *Stack) Type() string         { return "Stack" }
-func (w *Stack) Freeze()              {}
-func (w *Stack) Truth() starlark.Bool { return true }
+func (w *TableColumn) String() string       { return "TableColumn(...)" }
+func (w *TableColumn) Type() string         { return "TableColumn" }
+func (w *TableColumn) Freeze()              {}
+func (w *TableColumn) Truth() starlark.Bool { return true }
 
-func (w *Stack) Hash() (uint32, error) {
+func (w *TableColumn) Hash() (uint32, error) {
 	sum, err := hashstructure.Hash(w, hashstructure.FormatV2, nil)
 	return uint32(sum), err
 }
 
-func stackFrameCount(
-	thread *starlark.Thread,
-	b *starlark.Builtin,
-	args starlark.Tuple,
-	kwargs []starlark.Tuple) (starlark.Value, error) {
-
-	w := b.Receiver().(*Stack)
-	count := w.FrameCount()
-
-	return starlark.MakeInt(count), nil
-}
-
 type Text struct {
 	Widget
 
@@ -1789,6 +3776,12 @@ type Text struct {
 
 	starlarkColor starlark.String
 
+	starlarkOutlineColor starlark.String
+
+	starlarkShadowColor starlark.String
+
+	starlarkShadowOffset starlark.Value
+
 	size *starlark.Builtin
 
 	frame_count *starlark.Builtin
@@ -1802,11 +3795,16 @@ func newText(
 ) (starlark.Value, error) {
 
 	var (
-		content starlark.String
-		font    starlark.String
-		height  starlark.Int
-		offset  starlark.Int
-		color   starlark.String
+		content         starlark.String
+		font            starlark.String
+		height          starlark.Int
+		offset          starlark.Int
+		color           starlark.String
+		letter_spacing  starlark.Int
+		tabular_numbers starlark.Bool
+		outline_color   starlark.String
+		shadow_color    starlark.String
+		shadow_offset   starlark.Value
 	)
 
 	if err := starlark.UnpackArgs(
@@ -1817,6 +3815,11 @@ func newText(
 		"height?", &height,
 		"offset?", &offset,
 		"color?", &color,
+		"letter_spacing?", &letter_spacing,
+		"tabular_numbers?", &tabular_numbers,
+		"outline_color?", &outline_color,
+		"shadow_color?", &shadow_color,
+		"shadow_offset?", &shadow_offset,
 	); err != nil {
 		return nil, fmt.Errorf("unpacking arguments for Text: %s", err)
 	}
@@ -1840,6 +3843,57 @@ func newText(
 		w.Color = c
 	}
 
+	w.LetterSpacing = int(letter_spacing.BigInt().Int64())
+
+	w.TabularNumbers = bool(tabular_numbers)
+
+	w.starlarkOutlineColor = outline_color
+	if outline_color.Len() > 0 {
+		c, err := render.ParseColor(outline_color.GoString())
+		if err != nil {
+			return nil, fmt.Errorf("outline_color is not a valid hex string: %s", outline_color.String())
+		}
+		w.OutlineColor = c
+	}
+
+	w.starlarkShadowColor = shadow_color
+	if shadow_color.Len() > 0 {
+		c, err := render.ParseColor(shadow_color.GoString())
+		if err != nil {
+			return nil, fmt.Errorf("shadow_color is not a valid hex string: %s", shadow_color.String())
+		}
+		w.ShadowColor = c
+	}
+
+	w.starlarkShadowOffset = shadow_offset
+	switch shadow_offsetVal := shadow_offset.(type) {
+	case nil:
+		w.starlarkShadowOffset = starlark.None
+	case starlark.Int:
+		shadow_offsetInt := int(shadow_offsetVal.BigInt().Int64())
+		w.ShadowOffset.X = shadow_offsetInt
+		w.ShadowOffset.Y = shadow_offsetInt
+	case starlark.Tuple:
+		if len(shadow_offsetVal) != 2 {
+			return nil, fmt.Errorf(
+				"shadow_offset tuple must hold 2 elements (x, y), found %d",
+				len(shadow_offsetVal),
+			)
+		}
+		shadow_offsetX, ok := shadow_offsetVal[0].(starlark.Int)
+		if !ok {
+			return nil, fmt.Errorf("shadow_offset element 0 is not int")
+		}
+		shadow_offsetY, ok := shadow_offsetVal[1].(starlark.Int)
+		if !ok {
+			return nil, fmt.Errorf("shadow_offset element 1 is not int")
+		}
+		w.ShadowOffset.X = int(shadow_offsetX.BigInt().Int64())
+		w.ShadowOffset.Y = int(shadow_offsetY.BigInt().Int64())
+	default:
+		return nil, fmt.Errorf("shadow_offset must be int or 2-tuple of int")
+	}
+
 	w.size = starlark.NewBuiltin("size", textSize)
 
 	w.frame_count = starlark.NewBuiltin("frame_count", textFrameCount)
@@ -1857,7 +3911,7 @@ func (w *Text) AsRenderWidget() render.Widget {
 
 func (w *Text) AttrNames() []string {
 	return []string{
-		"content", "font", "height", "offset", "color",
+		"content", "font", "height", "offset", "color", "letter_spacing", "tabular_numbers", "outline_color", "shadow_color", "shadow_offset",
 	}
 }
 
@@ -1884,6 +3938,26 @@ func (w *Text) Attr(name string) (starlark.Value, error) {
 
 		return w.starlarkColor, nil
 
+	case "letter_spacing":
+
+		return starlark.MakeInt(int(w.LetterSpacing)), nil
+
+	case "tabular_numbers":
+
+		return starlark.Bool(w.TabularNumbers), nil
+
+	case "outline_color":
+
+		return w.starlarkOutlineColor, nil
+
+	case "shadow_color":
+
+		return w.starlarkShadowColor, nil
+
+	case "shadow_offset":
+
+		return w.starlarkShadowOffset, nil
+
 	case "size":
 		return w.size.BindReceiver(w), nil
 
@@ -1939,6 +4013,12 @@ type WrappedText struct {
 
 	starlarkColor starlark.String
 
+	starlarkOutlineColor starlark.String
+
+	starlarkShadowColor starlark.String
+
+	starlarkShadowOffset starlark.Value
+
 	frame_count *starlark.Builtin
 }
 
@@ -1957,6 +4037,15 @@ func newWrappedText(
 		linespacing starlark.Int
 		color       starlark.String
 		align       starlark.String
+		max_lines   starlark.Int
+		overflow    starlark.String
+		hyphenate   starlark.Bool
+
+		letter_spacing  starlark.Int
+		tabular_numbers starlark.Bool
+		outline_color   starlark.String
+		shadow_color    starlark.String
+		shadow_offset   starlark.Value
 	)
 
 	if err := starlark.UnpackArgs(
@@ -1969,6 +4058,14 @@ func newWrappedText(
 		"linespacing?", &linespacing,
 		"color?", &color,
 		"align?", &align,
+		"max_lines?", &max_lines,
+		"overflow?", &overflow,
+		"hyphenate?", &hyphenate,
+		"letter_spacing?", &letter_spacing,
+		"tabular_numbers?", &tabular_numbers,
+		"outline_color?", &outline_color,
+		"shadow_color?", &shadow_color,
+		"shadow_offset?", &shadow_offset,
 	); err != nil {
 		return nil, fmt.Errorf("unpacking arguments for WrappedText: %s", err)
 	}
@@ -1996,6 +4093,63 @@ func newWrappedText(
 
 	w.Align = align.GoString()
 
+	w.MaxLines = int(max_lines.BigInt().Int64())
+
+	w.Overflow = overflow.GoString()
+
+	w.Hyphenate = bool(hyphenate)
+
+	w.LetterSpacing = int(letter_spacing.BigInt().Int64())
+
+	w.TabularNumbers = bool(tabular_numbers)
+
+	w.starlarkOutlineColor = outline_color
+	if outline_color.Len() > 0 {
+		c, err := render.ParseColor(outline_color.GoString())
+		if err != nil {
+			return nil, fmt.Errorf("outline_color is not a valid hex string: %s", outline_color.String())
+		}
+		w.OutlineColor = c
+	}
+
+	w.starlarkShadowColor = shadow_color
+	if shadow_color.Len() > 0 {
+		c, err := render.ParseColor(shadow_color.GoString())
+		if err != nil {
+			return nil, fmt.Errorf("shadow_color is not a valid hex string: %s", shadow_color.String())
+		}
+		w.ShadowColor = c
+	}
+
+	w.starlarkShadowOffset = shadow_offset
+	switch shadow_offsetVal := shadow_offset.(type) {
+	case nil:
+		w.starlarkShadowOffset = starlark.None
+	case starlark.Int:
+		shadow_offsetInt := int(shadow_offsetVal.BigInt().Int64())
+		w.ShadowOffset.X = shadow_offsetInt
+		w.ShadowOffset.Y = shadow_offsetInt
+	case starlark.Tuple:
+		if len(shadow_offsetVal) != 2 {
+			return nil, fmt.Errorf(
+				"shadow_offset tuple must hold 2 elements (x, y), found %d",
+				len(shadow_offsetVal),
+			)
+		}
+		shadow_offsetX, ok := shadow_offsetVal[0].(starlark.Int)
+		if !ok {
+			return nil, fmt.Errorf("shadow_offset element 0 is not int")
+		}
+		shadow_offsetY, ok := shadow_offsetVal[1].(starlark.Int)
+		if !ok {
+			return nil, fmt.Errorf("shadow_offset element 1 is not int")
+		}
+		w.ShadowOffset.X = int(shadow_offsetX.BigInt().Int64())
+		w.ShadowOffset.Y = int(shadow_offsetY.BigInt().Int64())
+	default:
+		return nil, fmt.Errorf("shadow_offset must be int or 2-tuple of int")
+	}
+
 	w.frame_count = starlark.NewBuiltin("frame_count", wrappedtextFrameCount)
 
 	if err := w.Init(); err != nil {
@@ -2011,7 +4165,7 @@ func (w *WrappedText) AsRenderWidget() render.Widget {
 
 func (w *WrappedText) AttrNames() []string {
 	return []string{
-		"content", "font", "height", "width", "linespacing", "color", "align",
+		"content", "font", "height", "width", "linespacing", "color", "align", "max_lines", "overflow", "hyphenate", "truncated", "letter_spacing", "tabular_numbers", "outline_color", "shadow_color", "shadow_offset",
 	}
 }
 
@@ -2046,6 +4200,42 @@ func (w *WrappedText) Attr(name string) (starlark.Value, error) {
 
 		return starlark.String(w.Align), nil
 
+	case "max_lines":
+
+		return starlark.MakeInt(int(w.MaxLines)), nil
+
+	case "overflow":
+
+		return starlark.String(w.Overflow), nil
+
+	case "hyphenate":
+
+		return starlark.Bool(w.Hyphenate), nil
+
+	case "truncated":
+
+		return starlark.Bool(w.Truncated), nil
+
+	case "letter_spacing":
+
+		return starlark.MakeInt(int(w.LetterSpacing)), nil
+
+	case "tabular_numbers":
+
+		return starlark.Bool(w.TabularNumbers), nil
+
+	case "outline_color":
+
+		return w.starlarkOutlineColor, nil
+
+	case "shadow_color":
+
+		return w.starlarkShadowColor, nil
+
+	case "shadow_offset":
+
+		return w.starlarkShadowOffset, nil
+
 	case "frame_count":
 		return w.frame_count.BindReceiver(w), nil
 
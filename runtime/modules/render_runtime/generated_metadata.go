@@ -0,0 +1,1331 @@
+package render_runtime
+
+// Code generated by runtime/gen. DO NOT EDIT.
+
+// Metadata describes the types of this module and their attributes.
+var Metadata = []TypeMetadata{
+	{
+		Name:          "Animation",
+		Documentation: "Animations turns a list of children into an animation, where each\nchild is a frame.\n\nFIXME: Behaviour when children themselves are animated is a bit\nweird. Think and fix.",
+		Attributes: []AttrMetadata{
+			{
+				Name:          "children",
+				Type:          "[Widget]",
+				Documentation: "Children to use as frames in the animation",
+				Required:      false,
+				ReadOnly:      false,
+			},
+		},
+	},
+	{
+		Name:          "BarChart",
+		Documentation: "BarChart draws one or more series of values as bars.\n\n`data` is either a list of numbers, for a single series, or a list\nof series. Bars for the same position in each series are drawn next\nto each other, or on top of each other if `stacked` is set. Bars\ngrow up from zero, or down for negative values, and a value of\n`None` leaves a gap. With `horizontal` set, bars grow right from\nzero instead, and the first bar is at the top.\n\nWith a single series, `colors` are used for each bar in turn.\nOtherwise, they are used for each series. Negative values are drawn\nin `color_inverted`, if set.\n\nThe value axis spans zero and every value, unless limited by\n`value_lim`.",
+		Attributes: []AttrMetadata{
+			{
+				Name:          "data",
+				Type:          "[float] / [[float]]",
+				Documentation: "A list of numbers, or a list of lists of numbers",
+				Required:      true,
+				ReadOnly:      false,
+			},
+			{
+				Name:          "width",
+				Type:          "int",
+				Documentation: "Limits BarChart width",
+				Required:      true,
+				ReadOnly:      false,
+			},
+			{
+				Name:          "height",
+				Type:          "int",
+				Documentation: "Limits BarChart height",
+				Required:      true,
+				ReadOnly:      false,
+			},
+			{
+				Name:          "colors",
+				Type:          "[color]",
+				Documentation: "Colors of bars or series, default is '#fff'",
+				Required:      false,
+				ReadOnly:      false,
+			},
+			{
+				Name:          "color_inverted",
+				Type:          "color",
+				Documentation: "Color for values below 0",
+				Required:      false,
+				ReadOnly:      false,
+			},
+			{
+				Name:          "horizontal",
+				Type:          "bool",
+				Documentation: "Draw bars from left to right instead of bottom to top",
+				Required:      false,
+				ReadOnly:      false,
+			},
+			{
+				Name:          "stacked",
+				Type:          "bool",
+				Documentation: "Stack series on top of each other instead of side by side",
+				Required:      false,
+				ReadOnly:      false,
+			},
+			{
+				Name:          "gap",
+				Type:          "int",
+				Documentation: "Space between bars or groups of bars",
+				Required:      false,
+				ReadOnly:      false,
+			},
+			{
+				Name:          "value_lim",
+				Type:          "(float, float)",
+				Documentation: "Limit value axis to a range",
+				Required:      false,
+				ReadOnly:      false,
+			},
+		},
+	},
+	{
+		Name:          "Box",
+		Documentation: "A Box is a rectangular widget that can hold a child widget.\n\nBoxes are transparent unless `color` is provided. They expand to\nfill all available space, unless `width` and/or `height` is\nprovided. Boxes can have a `child`, which will be centered in the\nbox, and the child can be padded (via `padding`).",
+		Attributes: []AttrMetadata{
+			{
+				Name:          "child",
+				Type:          "Widget",
+				Documentation: "Child to center inside box",
+				Required:      false,
+				ReadOnly:      false,
+			},
+			{
+				Name:          "width",
+				Type:          "int",
+				Documentation: "Limits Box width",
+				Required:      false,
+				ReadOnly:      false,
+			},
+			{
+				Name:          "height",
+				Type:          "int",
+				Documentation: "Limits Box height",
+				Required:      false,
+				ReadOnly:      false,
+			},
+			{
+				Name:          "padding",
+				Type:          "int",
+				Documentation: "Padding around the child widget",
+				Required:      false,
+				ReadOnly:      false,
+			},
+			{
+				Name:          "color",
+				Type:          "color",
+				Documentation: "Background color",
+				Required:      false,
+				ReadOnly:      false,
+			},
+		},
+	},
+	{
+		Name:          "Circle",
+		Documentation: "Circle draws a circle with the given `diameter` and `color`. If a\n`child` widget is provided, it is drawn in the center of the\ncircle.",
+		Attributes: []AttrMetadata{
+			{
+				Name:          "color",
+				Type:          "color",
+				Documentation: "Fill color",
+				Required:      true,
+				ReadOnly:      false,
+			},
+			{
+				Name:          "diameter",
+				Type:          "int",
+				Documentation: "Diameter of the circle",
+				Required:      true,
+				ReadOnly:      false,
+			},
+			{
+				Name:          "child",
+				Type:          "Widget",
+				Documentation: "Widget to place in the center of the circle",
+				Required:      false,
+				ReadOnly:      false,
+			},
+		},
+	},
+	{
+		Name:          "Column",
+		Documentation: "Column lays out and draws its children vertically (in a column).\n\nBy default, a Column is as small as possible, while still holding\nall its children. However, if `expanded` is set, the Column will\nfill all available space vertically. The width of a Column is\nalways that of its widest child.\n\nAlignment along the vertical main axis is controlled by passing\none of the following `main_align` values:\n- `\"start\"`: place children at the beginning of the column\n- `\"end\"`: place children at the end of the column\n- `\"center\"`: place children in the middle of the column\n- `\"space_between\"`: place equal space between children\n- `\"space_evenly\"`: equal space between children and before/after first/last child\n- `\"space_around\"`: equal space between children, and half of that before/after first/last child\n\nAlignment along the horizontal cross axis is controlled by passing\none of the following `cross_align` values:\n- `\"start\"`: place children at the left\n- `\"end\"`: place children at the right\n- `\"center\"`: place children in the center\n\n`gap` leaves space between children. Children wrapped in `Flex`\nshare the space that's left over by the others, and children that\ndon't fit are cut off, unless `shrink` is set, which makes them\nsmaller to fit in the Column.",
+		Attributes: []AttrMetadata{
+			{
+				Name:          "children",
+				Type:          "[Widget]",
+				Documentation: "Child widgets to lay out",
+				Required:      true,
+				ReadOnly:      false,
+			},
+			{
+				Name:          "main_align",
+				Type:          "str",
+				Documentation: "Alignment along vertical main axis",
+				Required:      false,
+				ReadOnly:      false,
+			},
+			{
+				Name:          "cross_align",
+				Type:          "str",
+				Documentation: "Alignment along horizontal cross axis",
+				Required:      false,
+				ReadOnly:      false,
+			},
+			{
+				Name:          "expanded",
+				Type:          "bool",
+				Documentation: "Column should expand to fill all available vertical space",
+				Required:      false,
+				ReadOnly:      false,
+			},
+			{
+				Name:          "gap",
+				Type:          "int",
+				Documentation: "Space between children",
+				Required:      false,
+				ReadOnly:      false,
+			},
+			{
+				Name:          "shrink",
+				Type:          "bool",
+				Documentation: "Shrink children that don't fit, instead of cutting them off",
+				Required:      false,
+				ReadOnly:      false,
+			},
+		},
+	},
+	{
+		Name:          "Flex",
+		Documentation: "Flex lets a child of a Row or Column share the space that the other\nchildren leave over.\n\nFlex children start at `min_size` along the main axis of their Row\nor Column, which is 0 by default, and grow to fill the space that's\nleft, shared by their `weight`. A child with a weight of 2 gets\ntwice as much as one with a weight of 1, up to its `max_size`, if\nset. Their child is laid out in the size they get, and cut off if\nit doesn't fit.\n\nOutside of a Row or Column, Flex draws its child as is.",
+		Attributes: []AttrMetadata{
+			{
+				Name:          "child",
+				Type:          "Widget",
+				Documentation: "Widget to lay out",
+				Required:      true,
+				ReadOnly:      false,
+			},
+			{
+				Name:          "weight",
+				Type:          "float / int",
+				Documentation: "Share of the space left over, default is 1",
+				Required:      false,
+				ReadOnly:      false,
+			},
+			{
+				Name:          "min_size",
+				Type:          "int",
+				Documentation: "Smallest size along the main axis",
+				Required:      false,
+				ReadOnly:      false,
+			},
+			{
+				Name:          "max_size",
+				Type:          "int",
+				Documentation: "Largest size along the main axis, or 0 for no limit",
+				Required:      false,
+				ReadOnly:      false,
+			},
+		},
+	},
+	{
+		Name:          "Gauge",
+		Documentation: "Gauge draws an arc that's filled clockwise in proportion to a value.\n\nThe arc is `sweep` degrees of a ring, 270 by default, with a gap at\nthe bottom. It's `thickness` pixels wide, which is a quarter of the\ndiameter unless set. The range, `thresholds` and `colors` work the\nsame way as for ProgressBar.",
+		Attributes: []AttrMetadata{
+			{
+				Name:          "value",
+				Type:          "float / int",
+				Documentation: "Value to show",
+				Required:      true,
+				ReadOnly:      false,
+			},
+			{
+				Name:          "diameter",
+				Type:          "int",
+				Documentation: "Diameter of the gauge",
+				Required:      true,
+				ReadOnly:      false,
+			},
+			{
+				Name:          "min",
+				Type:          "float / int",
+				Documentation: "Value of an empty gauge, default is 0",
+				Required:      false,
+				ReadOnly:      false,
+			},
+			{
+				Name:          "max",
+				Type:          "float / int",
+				Documentation: "Value of a full gauge, default is 100",
+				Required:      false,
+				ReadOnly:      false,
+			},
+			{
+				Name:          "thresholds",
+				Type:          "[float]",
+				Documentation: "Values where bands of color start",
+				Required:      false,
+				ReadOnly:      false,
+			},
+			{
+				Name:          "colors",
+				Type:          "[color]",
+				Documentation: "Color of each band, default is '#fff'",
+				Required:      false,
+				ReadOnly:      false,
+			},
+			{
+				Name:          "background",
+				Type:          "color",
+				Documentation: "Color of the unfilled part, default is a dimmed band color",
+				Required:      false,
+				ReadOnly:      false,
+			},
+			{
+				Name:          "thickness",
+				Type:          "int",
+				Documentation: "Width of the arc",
+				Required:      false,
+				ReadOnly:      false,
+			},
+			{
+				Name:          "sweep",
+				Type:          "float / int",
+				Documentation: "Angle of the arc in degrees, default is 270",
+				Required:      false,
+				ReadOnly:      false,
+			},
+		},
+	},
+	{
+		Name:          "Image",
+		Documentation: "Image renders the binary image data passed via `src`. Supported\nformats include PNG, JPEG, GIF, and SVG.\n\nIf `width` or `height` are set, the image will be scaled\naccordingly, with nearest neighbor interpolation. Otherwise the\nimage's original dimensions are used.\n\nIf the image data encodes an animated GIF, the Image instance will\nalso be animated. Frame delay (in milliseconds) can be read from\nthe `delay` attribute.",
+		Attributes: []AttrMetadata{
+			{
+				Name:          "src",
+				Type:          "str",
+				Documentation: "Binary image data or SVG text",
+				Required:      true,
+				ReadOnly:      false,
+			},
+			{
+				Name:          "width",
+				Type:          "int",
+				Documentation: "Scale image to this width",
+				Required:      false,
+				ReadOnly:      false,
+			},
+			{
+				Name:          "height",
+				Type:          "int",
+				Documentation: "Scale image to this height",
+				Required:      false,
+				ReadOnly:      false,
+			},
+			{
+				Name:          "delay",
+				Type:          "int",
+				Documentation: "(Read-only) Frame delay in ms, for animated GIFs",
+				Required:      false,
+				ReadOnly:      true,
+			},
+		},
+	},
+	{
+		Name:          "Marquee",
+		Documentation: "Marquee scrolls its child horizontally or vertically.\n\nThe `scroll_direction` will be 'horizontal' and will scroll from right\nto left if left empty, if specified as 'vertical' the Marquee will\nscroll from bottom to top.\n\nIn horizontal mode the height of the Marquee will be that of its child,\nbut its `width` must be specified explicitly. In vertical mode the width\nwill be that of its child but the `height` must be specified explicitly.\n\nIf the child's width fits fully, it will not scroll.\n\nHebrew, Arabic and other right-to-left text scrolls the other way, from\nleft to right, in horizontal mode. Its `offset_start`, `offset_end` and\n`align` are then measured from the right.\n\nThe `offset_start` and `offset_end` parameters control the position\nof the child in the beginning and the end of the animation.\n\nAlignment for a child that fits fully along the horizontal/vertical axis is controlled by passing\none of the following `align` values:\n- `\"start\"`: place child at the left/top\n- `\"end\"`: place child at the right/bottom\n- `\"center\"`: place child at the center",
+		Attributes: []AttrMetadata{
+			{
+				Name:          "child",
+				Type:          "Widget",
+				Documentation: "Widget to potentially scroll",
+				Required:      true,
+				ReadOnly:      false,
+			},
+			{
+				Name:          "width",
+				Type:          "int",
+				Documentation: "Width of the Marquee, required for horizontal",
+				Required:      false,
+				ReadOnly:      false,
+			},
+			{
+				Name:          "height",
+				Type:          "int",
+				Documentation: "Height of the Marquee, required for vertical",
+				Required:      false,
+				ReadOnly:      false,
+			},
+			{
+				Name:          "offset_start",
+				Type:          "int",
+				Documentation: "Position of child at beginning of animation",
+				Required:      false,
+				ReadOnly:      false,
+			},
+			{
+				Name:          "offset_end",
+				Type:          "int",
+				Documentation: "Position of child at end of animation",
+				Required:      false,
+				ReadOnly:      false,
+			},
+			{
+				Name:          "scroll_direction",
+				Type:          "str",
+				Documentation: "Direction to scroll, 'vertical' or 'horizontal', default is horizontal",
+				Required:      false,
+				ReadOnly:      false,
+			},
+			{
+				Name:          "align",
+				Type:          "str",
+				Documentation: "Alignment when contents fit on screen, 'start', 'center' or 'end', default is start",
+				Required:      false,
+				ReadOnly:      false,
+			},
+			{
+				Name:          "delay",
+				Type:          "int",
+				Documentation: "Delay the scroll of the animation by a certain number of frames, default is 0",
+				Required:      false,
+				ReadOnly:      false,
+			},
+		},
+	},
+	{
+		Name:          "Padding",
+		Documentation: "Padding places padding around its child.\n\nIf the `pad` attribute is a single integer, that amount of padding\nwill be placed on all sides of the child. If it's a 4-tuple `(left,\ntop, right, bottom)`, then padding will be placed on the sides\naccordingly.",
+		Attributes: []AttrMetadata{
+			{
+				Name:          "child",
+				Type:          "Widget",
+				Documentation: "The Widget to place padding around",
+				Required:      true,
+				ReadOnly:      false,
+			},
+			{
+				Name:          "pad",
+				Type:          "int / (int, int, int, int)",
+				Documentation: "Padding around the child",
+				Required:      false,
+				ReadOnly:      false,
+			},
+			{
+				Name:          "expanded",
+				Type:          "bool",
+				Documentation: "This is a confusing parameter",
+				Required:      false,
+				ReadOnly:      false,
+			},
+			{
+				Name:          "color",
+				Type:          "color",
+				Documentation: "Background color",
+				Required:      false,
+				ReadOnly:      false,
+			},
+		},
+	},
+	{
+		Name:          "PieChart",
+		Documentation: "PieChart draws a circular pie chart of size `diameter`. It takes two\narguments for the data: parallel lists `colors` and `weights` representing\nthe shading and relative sizes of each data entry.",
+		Attributes: []AttrMetadata{
+			{
+				Name:          "colors",
+				Type:          "[color]",
+				Documentation: "List of color hex codes",
+				Required:      true,
+				ReadOnly:      false,
+			},
+			{
+				Name:          "weights",
+				Type:          "[float]",
+				Documentation: "List of numbers corresponding to the relative size of each color",
+				Required:      true,
+				ReadOnly:      false,
+			},
+			{
+				Name:          "diameter",
+				Type:          "int",
+				Documentation: "Diameter of the circle",
+				Required:      true,
+				ReadOnly:      false,
+			},
+		},
+	},
+	{
+		Name:          "Plot",
+		Documentation: "Plot is a widget that draws one or more data series.\n\nThe first series is `data`, drawn in `color`. More can be added with\n`series`, a list of `PlotSeries`, which are drawn on top of it. All\nseries share the same axes, which span all of their data unless\nlimited by `x_lim` and `y_lim`. With `independent_axes`, each series\nis scaled to fit on its own instead, using its own limits, which\nsuits comparing series of different units, like a stock and an\nindex.\n\n`reference_lines` draws horizontal lines across the plot, like a\nprevious close, and `bands` shades ranges of Y-values. Both are\ndrawn behind the series, and use the axes of `data`.\n\n`x_ticks` and `y_ticks` draw that many tick marks, spread evenly\nalong the bottom and left edges. With `x_labels` or `y_labels`, the\nvalues at the ticks are written below or to the left of the plot,\nin `label_font`. If there are fewer than two ticks, the ends of the\naxis are labeled. The space for labels is taken from `width` and\n`height`, so the plot itself gets smaller.",
+		Attributes: []AttrMetadata{
+			{
+				Name:          "data",
+				Type:          "[(float, float)]",
+				Documentation: "A list of 2-tuples of numbers",
+				Required:      true,
+				ReadOnly:      false,
+			},
+			{
+				Name:          "width",
+				Type:          "int",
+				Documentation: "Limits Plot width",
+				Required:      true,
+				ReadOnly:      false,
+			},
+			{
+				Name:          "height",
+				Type:          "int",
+				Documentation: "Limits Plot height",
+				Required:      true,
+				ReadOnly:      false,
+			},
+			{
+				Name:          "color",
+				Type:          "color",
+				Documentation: "Line color, default is '#fff'",
+				Required:      false,
+				ReadOnly:      false,
+			},
+			{
+				Name:          "color_inverted",
+				Type:          "color",
+				Documentation: "Line color for Y-values below 0",
+				Required:      false,
+				ReadOnly:      false,
+			},
+			{
+				Name:          "x_lim",
+				Type:          "(float, float)",
+				Documentation: "Limit X-axis to a range",
+				Required:      false,
+				ReadOnly:      false,
+			},
+			{
+				Name:          "y_lim",
+				Type:          "(float, float)",
+				Documentation: "Limit Y-axis to a range",
+				Required:      false,
+				ReadOnly:      false,
+			},
+			{
+				Name:          "fill",
+				Type:          "bool",
+				Documentation: "Paint surface between line and X-axis",
+				Required:      false,
+				ReadOnly:      false,
+			},
+			{
+				Name:          "chart_type",
+				Type:          "str",
+				Documentation: "Specifies the type of chart to render, \"scatter\" or \"line\", default is \"line\"",
+				Required:      false,
+				ReadOnly:      false,
+			},
+			{
+				Name:          "fill_color",
+				Type:          "color",
+				Documentation: "Fill color for Y-values above 0",
+				Required:      false,
+				ReadOnly:      false,
+			},
+			{
+				Name:          "fill_color_inverted",
+				Type:          "color",
+				Documentation: "Fill color for Y-values below 0",
+				Required:      false,
+				ReadOnly:      false,
+			},
+			{
+				Name:          "series",
+				Type:          "[PlotSeries]",
+				Documentation: "More data series to draw",
+				Required:      false,
+				ReadOnly:      false,
+			},
+			{
+				Name:          "independent_axes",
+				Type:          "bool",
+				Documentation: "Scale each series to fit on its own",
+				Required:      false,
+				ReadOnly:      false,
+			},
+			{
+				Name:          "reference_lines",
+				Type:          "[PlotLine]",
+				Documentation: "Horizontal lines across the plot",
+				Required:      false,
+				ReadOnly:      false,
+			},
+			{
+				Name:          "bands",
+				Type:          "[PlotBand]",
+				Documentation: "Shaded ranges of Y-values",
+				Required:      false,
+				ReadOnly:      false,
+			},
+			{
+				Name:          "x_ticks",
+				Type:          "int",
+				Documentation: "Number of tick marks along the X-axis",
+				Required:      false,
+				ReadOnly:      false,
+			},
+			{
+				Name:          "y_ticks",
+				Type:          "int",
+				Documentation: "Number of tick marks along the Y-axis",
+				Required:      false,
+				ReadOnly:      false,
+			},
+			{
+				Name:          "x_labels",
+				Type:          "bool",
+				Documentation: "Label the X-axis",
+				Required:      false,
+				ReadOnly:      false,
+			},
+			{
+				Name:          "y_labels",
+				Type:          "bool",
+				Documentation: "Label the Y-axis",
+				Required:      false,
+				ReadOnly:      false,
+			},
+			{
+				Name:          "label_font",
+				Type:          "str",
+				Documentation: "Font for axis labels, default is 'CG-pixel-3x5-mono'",
+				Required:      false,
+				ReadOnly:      false,
+			},
+			{
+				Name:          "axis_color",
+				Type:          "color",
+				Documentation: "Color of ticks and labels, default is '#888'",
+				Required:      false,
+				ReadOnly:      false,
+			},
+		},
+	},
+	{
+		Name:          "PlotBand",
+		Documentation: "PlotBand shades a range of Y-values across a Plot, like a target\nrange.",
+		Attributes: []AttrMetadata{
+			{
+				Name:          "low",
+				Type:          "float / int",
+				Documentation: "Lowest Y-value of the band",
+				Required:      true,
+				ReadOnly:      false,
+			},
+			{
+				Name:          "high",
+				Type:          "float / int",
+				Documentation: "Highest Y-value of the band",
+				Required:      true,
+				ReadOnly:      false,
+			},
+			{
+				Name:          "color",
+				Type:          "color",
+				Documentation: "Band color, default is '#222'",
+				Required:      false,
+				ReadOnly:      false,
+			},
+		},
+	},
+	{
+		Name:          "PlotLine",
+		Documentation: "PlotLine is a horizontal reference line across a Plot, like a\nprevious close.",
+		Attributes: []AttrMetadata{
+			{
+				Name:          "y",
+				Type:          "float / int",
+				Documentation: "Y-value of the line",
+				Required:      true,
+				ReadOnly:      false,
+			},
+			{
+				Name:          "color",
+				Type:          "color",
+				Documentation: "Line color, default is '#555'",
+				Required:      false,
+				ReadOnly:      false,
+			},
+			{
+				Name:          "dashed",
+				Type:          "bool",
+				Documentation: "Draw every other pixel",
+				Required:      false,
+				ReadOnly:      false,
+			},
+		},
+	},
+	{
+		Name:          "PlotSeries",
+		Documentation: "PlotSeries is a data series drawn by a Plot, in addition to its\n`data`. Its limits are only used if the Plot has independent axes.",
+		Attributes: []AttrMetadata{
+			{
+				Name:          "data",
+				Type:          "[(float, float)]",
+				Documentation: "A list of 2-tuples of numbers",
+				Required:      true,
+				ReadOnly:      false,
+			},
+			{
+				Name:          "color",
+				Type:          "color",
+				Documentation: "Line color, default is '#fff'",
+				Required:      false,
+				ReadOnly:      false,
+			},
+			{
+				Name:          "color_inverted",
+				Type:          "color",
+				Documentation: "Line color for Y-values below 0",
+				Required:      false,
+				ReadOnly:      false,
+			},
+			{
+				Name:          "x_lim",
+				Type:          "(float, float)",
+				Documentation: "Limit X-axis to a range",
+				Required:      false,
+				ReadOnly:      false,
+			},
+			{
+				Name:          "y_lim",
+				Type:          "(float, float)",
+				Documentation: "Limit Y-axis to a range",
+				Required:      false,
+				ReadOnly:      false,
+			},
+			{
+				Name:          "fill",
+				Type:          "bool",
+				Documentation: "Paint surface between line and X-axis",
+				Required:      false,
+				ReadOnly:      false,
+			},
+			{
+				Name:          "fill_color",
+				Type:          "color",
+				Documentation: "Fill color for Y-values above 0",
+				Required:      false,
+				ReadOnly:      false,
+			},
+			{
+				Name:          "fill_color_inverted",
+				Type:          "color",
+				Documentation: "Fill color for Y-values below 0",
+				Required:      false,
+				ReadOnly:      false,
+			},
+			{
+				Name:          "chart_type",
+				Type:          "str",
+				Documentation: "Specifies the type of chart to render, \"scatter\" or \"line\", default is \"line\"",
+				Required:      false,
+				ReadOnly:      false,
+			},
+		},
+	},
+	{
+		Name:          "ProgressBar",
+		Documentation: "ProgressBar draws a bar that's filled from the left in proportion to\na value, or from the bottom if `vertical` is set.\n\nThe range is from `min` to `max`, which is 100 unless set. The\nrange can be split into bands by `thresholds`, in ascending order.\nValues below the first threshold get the first of `colors`, values\nbetween the first and second threshold get the second, and so on.\nThe part that's filled up to the value is drawn in the color of the\nvalue's band, and the rest in the dimmed color of each band, or in\n`background` if set.",
+		Attributes: []AttrMetadata{
+			{
+				Name:          "value",
+				Type:          "float / int",
+				Documentation: "Value to show",
+				Required:      true,
+				ReadOnly:      false,
+			},
+			{
+				Name:          "width",
+				Type:          "int",
+				Documentation: "Width of the bar",
+				Required:      true,
+				ReadOnly:      false,
+			},
+			{
+				Name:          "height",
+				Type:          "int",
+				Documentation: "Height of the bar",
+				Required:      true,
+				ReadOnly:      false,
+			},
+			{
+				Name:          "min",
+				Type:          "float / int",
+				Documentation: "Value of an empty bar, default is 0",
+				Required:      false,
+				ReadOnly:      false,
+			},
+			{
+				Name:          "max",
+				Type:          "float / int",
+				Documentation: "Value of a full bar, default is 100",
+				Required:      false,
+				ReadOnly:      false,
+			},
+			{
+				Name:          "thresholds",
+				Type:          "[float]",
+				Documentation: "Values where bands of color start",
+				Required:      false,
+				ReadOnly:      false,
+			},
+			{
+				Name:          "colors",
+				Type:          "[color]",
+				Documentation: "Color of each band, default is '#fff'",
+				Required:      false,
+				ReadOnly:      false,
+			},
+			{
+				Name:          "background",
+				Type:          "color",
+				Documentation: "Color of the unfilled part, default is a dimmed band color",
+				Required:      false,
+				ReadOnly:      false,
+			},
+			{
+				Name:          "vertical",
+				Type:          "bool",
+				Documentation: "Fill from the bottom instead of the left",
+				Required:      false,
+				ReadOnly:      false,
+			},
+		},
+	},
+	{
+		Name:          "RichText",
+		Documentation: "RichText draws text made of spans in different styles, wrapping it\nacross lines like WrappedText.\n\nEach span can have its own font, color, background and offset from\nthe baseline. Spans are given as a list of `Span` and strings, or\nwith `markup`, where tags style the text between them:\n- `[b]bold[/b]`\n- `[color=#f00]red[/color]`\n- `[bg=#00f]on blue[/bg]`\n- `[font=tom-thumb]small[/font]`\n- `[offset=2]raised[/offset]`\n\nTags can be nested, `[/]` closes the innermost one, and `[[` is a\nliteral `[`.\n\nThe optional `width` and `height` parameters limit the drawing\narea. If not set, RichText will use as much vertical and horizontal\nspace as possible to fit the text. Lines are aligned with `align`,\nas in WrappedText.",
+		Attributes: []AttrMetadata{
+			{
+				Name:          "spans",
+				Type:          "[Span / str]",
+				Documentation: "Spans of text to draw, as `Span` or strings",
+				Required:      false,
+				ReadOnly:      false,
+			},
+			{
+				Name:          "markup",
+				Type:          "str",
+				Documentation: "Text with markup tags, instead of spans",
+				Required:      false,
+				ReadOnly:      false,
+			},
+			{
+				Name:          "font",
+				Type:          "str",
+				Documentation: "Default font face of spans",
+				Required:      false,
+				ReadOnly:      false,
+			},
+			{
+				Name:          "color",
+				Type:          "color",
+				Documentation: "Default font color of spans",
+				Required:      false,
+				ReadOnly:      false,
+			},
+			{
+				Name:          "width",
+				Type:          "int",
+				Documentation: "Limits width of the area on which text may be drawn",
+				Required:      false,
+				ReadOnly:      false,
+			},
+			{
+				Name:          "height",
+				Type:          "int",
+				Documentation: "Limits height of the area on which text may be drawn",
+				Required:      false,
+				ReadOnly:      false,
+			},
+			{
+				Name:          "linespacing",
+				Type:          "int",
+				Documentation: "Controls spacing between lines",
+				Required:      false,
+				ReadOnly:      false,
+			},
+			{
+				Name:          "align",
+				Type:          "str",
+				Documentation: "Text Alignment",
+				Required:      false,
+				ReadOnly:      false,
+			},
+		},
+	},
+	{
+		Name:          "Root",
+		Documentation: "Every Widget tree has a Root.\n\nThe child widget, and all its descendants, will be drawn on a 64x32\ncanvas. Root places its child in the upper left corner of the\ncanvas.\n\nIf the tree contains animated widgets, the resulting animation will\nrun with _delay_ milliseconds per frame.\n\nIf the tree holds time sensitive information which must never be\ndisplayed past a certain point in time, pass _MaxAge_ to specify\nan expiration time in seconds. Display devices use this to avoid\ndisplaying stale data in the event of e.g. connectivity issues.",
+		Attributes: []AttrMetadata{
+			{
+				Name:          "child",
+				Type:          "Widget",
+				Documentation: "Widget to render",
+				Required:      true,
+				ReadOnly:      false,
+			},
+			{
+				Name:          "delay",
+				Type:          "int",
+				Documentation: "Frame delay in milliseconds",
+				Required:      false,
+				ReadOnly:      false,
+			},
+			{
+				Name:          "max_age",
+				Type:          "int",
+				Documentation: "Expiration time in seconds",
+				Required:      false,
+				ReadOnly:      false,
+			},
+			{
+				Name:          "show_full_animation",
+				Type:          "bool",
+				Documentation: "Request animation is shown in full, regardless of app cycle speed",
+				Required:      false,
+				ReadOnly:      false,
+			},
+		},
+	},
+	{
+		Name:          "Row",
+		Documentation: "Row lays out and draws its children horizontally (in a row).\n\nBy default, a Row is as small as possible, while still holding all\nits children. However, if `expanded` is set, the Row will fill all\navailable space horizontally. The height of a Row is always that of\nits tallest child.\n\nAlignment along the horizontal main axis is controlled by passing\none of the following `main_align` values:\n- `\"start\"`: place children at the beginning of the row\n- `\"end\"`: place children at the end of the row\n- `\"center\"`: place children in the middle of the row\n- `\"space_between\"`: place equal space between children\n- `\"space_evenly\"`: equal space between children and before/after first/last child\n- `\"space_around\"`: equal space between children, and half of that before/after first/last child\n\nAlignment along the vertical cross axis is controlled by passing\none of the following `cross_align` values:\n- `\"start\"`: place children at the top\n- `\"end\"`: place children at the bottom\n- `\"center\"`: place children at the center\n\n`gap` leaves space between children. Children wrapped in `Flex`\nshare the space that's left over by the others, and children that\ndon't fit are cut off, unless `shrink` is set, which makes them\nsmaller to fit in the Row.",
+		Attributes: []AttrMetadata{
+			{
+				Name:          "children",
+				Type:          "[Widget]",
+				Documentation: "Child widgets to lay out",
+				Required:      true,
+				ReadOnly:      false,
+			},
+			{
+				Name:          "main_align",
+				Type:          "str",
+				Documentation: "Alignment along horizontal main axis",
+				Required:      false,
+				ReadOnly:      false,
+			},
+			{
+				Name:          "cross_align",
+				Type:          "str",
+				Documentation: "Alignment along vertical cross axis",
+				Required:      false,
+				ReadOnly:      false,
+			},
+			{
+				Name:          "expanded",
+				Type:          "bool",
+				Documentation: "Row should expand to fill all available horizontal space",
+				Required:      false,
+				ReadOnly:      false,
+			},
+			{
+				Name:          "gap",
+				Type:          "int",
+				Documentation: "Space between children",
+				Required:      false,
+				ReadOnly:      false,
+			},
+			{
+				Name:          "shrink",
+				Type:          "bool",
+				Documentation: "Shrink children that don't fit, instead of cutting them off",
+				Required:      false,
+				ReadOnly:      false,
+			},
+		},
+	},
+	{
+		Name:          "Sequence",
+		Documentation: "Sequence renders a list of child widgets in sequence.\n\nEach child widget is rendered for the duration of its\nframe count, then the next child wiget in the list will\nbe rendered and so on.\n\nIt comes in quite useful when chaining animations.\nIf you want to know more about that, go check\nout the [animation](animation.md) documentation.",
+		Attributes: []AttrMetadata{
+			{
+				Name:          "children",
+				Type:          "[Widget]",
+				Documentation: "List of child widgets",
+				Required:      true,
+				ReadOnly:      false,
+			},
+		},
+	},
+	{
+		Name:          "Span",
+		Documentation: "Span is a run of text in a RichText, with its own style. Unset\nattributes are inherited from the RichText.",
+		Attributes: []AttrMetadata{
+			{
+				Name:          "content",
+				Type:          "str",
+				Documentation: "The text string to draw",
+				Required:      true,
+				ReadOnly:      false,
+			},
+			{
+				Name:          "font",
+				Type:          "str",
+				Documentation: "Desired font face",
+				Required:      false,
+				ReadOnly:      false,
+			},
+			{
+				Name:          "color",
+				Type:          "color",
+				Documentation: "Desired font color",
+				Required:      false,
+				ReadOnly:      false,
+			},
+			{
+				Name:          "background",
+				Type:          "color",
+				Documentation: "Background color behind the text",
+				Required:      false,
+				ReadOnly:      false,
+			},
+			{
+				Name:          "offset",
+				Type:          "int",
+				Documentation: "Shifts the text up from the baseline",
+				Required:      false,
+				ReadOnly:      false,
+			},
+			{
+				Name:          "bold",
+				Type:          "bool",
+				Documentation: "Draw the text in bold, by drawing every glyph twice",
+				Required:      false,
+				ReadOnly:      false,
+			},
+		},
+	},
+	{
+		Name:          "Stack",
+		Documentation: "Stack draws its children on top of each other.\n\nJust like a stack of pancakes, except with Widgets instead of\npancakes. The Stack will be given a width and height sufficient to\nfit all its children.",
+		Attributes: []AttrMetadata{
+			{
+				Name:          "children",
+				Type:          "[Widget]",
+				Documentation: "Widgets to stack",
+				Required:      true,
+				ReadOnly:      false,
+			},
+		},
+	},
+	{
+		Name:          "Table",
+		Documentation: "Table lays out widgets in rows and columns.\n\nEach row is a list of widgets, or of `TableCell` for cells with their\nown alignment, and `None` leaves a cell empty. Columns are sized and\naligned by `columns`, a list of `TableColumn`. Columns without one\nfit their widest cell and are aligned left.\n\n`column_gap` and `row_gap` leave space between columns and rows. With\n`column_separator` or `row_separator`, a line is drawn in the middle\nof that space, which is then at least one pixel wide. Rows can have\nbackground colors with `row_colors`, which are used in turn, so two\ncolors make alternating rows.\n\nThe table is as wide as its columns, or as wide as it can be if any\nof them are fractions. Rows that don't fit in the height available\nare cut off.",
+		Attributes: []AttrMetadata{
+			{
+				Name:          "rows",
+				Type:          "[[Widget / TableCell]]",
+				Documentation: "Rows of cells, as lists of Widget or TableCell",
+				Required:      true,
+				ReadOnly:      false,
+			},
+			{
+				Name:          "columns",
+				Type:          "[TableColumn]",
+				Documentation: "Width, alignment and overflow of each column",
+				Required:      false,
+				ReadOnly:      false,
+			},
+			{
+				Name:          "column_gap",
+				Type:          "int",
+				Documentation: "Pixels between columns",
+				Required:      false,
+				ReadOnly:      false,
+			},
+			{
+				Name:          "row_gap",
+				Type:          "int",
+				Documentation: "Pixels between rows",
+				Required:      false,
+				ReadOnly:      false,
+			},
+			{
+				Name:          "column_separator",
+				Type:          "color",
+				Documentation: "Color of lines between columns",
+				Required:      false,
+				ReadOnly:      false,
+			},
+			{
+				Name:          "row_separator",
+				Type:          "color",
+				Documentation: "Color of lines between rows",
+				Required:      false,
+				ReadOnly:      false,
+			},
+			{
+				Name:          "row_colors",
+				Type:          "[color]",
+				Documentation: "Background colors of rows, used in turn",
+				Required:      false,
+				ReadOnly:      false,
+			},
+		},
+	},
+	{
+		Name:          "TableCell",
+		Documentation: "TableCell is a cell of a Table with its own alignment. Widgets in the\nrows of a Table are cells aligned like their column.",
+		Attributes: []AttrMetadata{
+			{
+				Name:          "child",
+				Type:          "Widget",
+				Documentation: "Widget to draw in the cell",
+				Required:      true,
+				ReadOnly:      false,
+			},
+			{
+				Name:          "align",
+				Type:          "str",
+				Documentation: "Horizontal alignment, 'left', 'center' or 'right', default is that of the column",
+				Required:      false,
+				ReadOnly:      false,
+			},
+			{
+				Name:          "vertical_align",
+				Type:          "str",
+				Documentation: "Vertical alignment, 'top', 'center' or 'bottom', default is top",
+				Required:      false,
+				ReadOnly:      false,
+			},
+		},
+	},
+	{
+		Name:          "TableColumn",
+		Documentation: "TableColumn sets how wide a column of a Table is, and how its cells\nare laid out.\n\nThe `width` of a column is one of:\n- `\"auto\"`: as wide as its widest cell, which is the default\n- a number of pixels, like `20`\n- a fraction of the width left over by the other columns, like\n  `\"1fr\"` or `\"2fr\"`\n\nCells that are wider than their column are cut off with the `\"clip\"`\noverflow, which is the default. With `\"ellipsis\"`, text is shortened\nto fit and ends in an ellipsis, and with `\"scroll\"`, cells scroll\nthrough the column like a Marquee.",
+		Attributes: []AttrMetadata{
+			{
+				Name:          "width",
+				Type:          "int / str",
+				Documentation: "Width of the column, 'auto', a number of pixels or a fraction like '1fr'",
+				Required:      false,
+				ReadOnly:      false,
+			},
+			{
+				Name:          "align",
+				Type:          "str",
+				Documentation: "Alignment of cells in the column, 'left', 'center' or 'right', default is left",
+				Required:      false,
+				ReadOnly:      false,
+			},
+			{
+				Name:          "overflow",
+				Type:          "str",
+				Documentation: "What to do with cells wider than the column, 'clip', 'ellipsis' or 'scroll', default is clip",
+				Required:      false,
+				ReadOnly:      false,
+			},
+		},
+	},
+	{
+		Name:          "Text",
+		Documentation: "Text draws a string of text on a single line.\n\nBy default, the text will use the \"tb-8\" font, but other fonts can\nbe chosen via the `font` attribute. The `height` and `offset`\nparameters allow fine tuning of the vertical layout of the\nstring. Take a look at the [font documentation](fonts.md) for more\ninformation.\n\nHebrew, Arabic and other right-to-left text is laid out with the\nUnicode bidirectional algorithm, and Arabic letters are joined if\nthe font has their contextual forms.\n\nThe spacing between letters can be tightened or loosened with\n`letter_spacing`, and `tabular_numbers` gives all digits the same\nwidth, so that clocks and counters don't jitter as they change. Text\ncan have a one pixel outline, and a shadow offset by `shadow_offset`,\nwhich is one pixel down and to the right by default. The outline and\nshadow add to the size of the text.",
+		Attributes: []AttrMetadata{
+			{
+				Name:          "content",
+				Type:          "str",
+				Documentation: "The text string to draw",
+				Required:      true,
+				ReadOnly:      false,
+			},
+			{
+				Name:          "font",
+				Type:          "str",
+				Documentation: "Desired font face",
+				Required:      false,
+				ReadOnly:      false,
+			},
+			{
+				Name:          "height",
+				Type:          "int",
+				Documentation: "Limits height of the area on which text is drawn",
+				Required:      false,
+				ReadOnly:      false,
+			},
+			{
+				Name:          "offset",
+				Type:          "int",
+				Documentation: "Shifts position of text vertically.",
+				Required:      false,
+				ReadOnly:      false,
+			},
+			{
+				Name:          "color",
+				Type:          "color",
+				Documentation: "Desired font color",
+				Required:      false,
+				ReadOnly:      false,
+			},
+			{
+				Name:          "letter_spacing",
+				Type:          "int",
+				Documentation: "Pixels added between letters, or removed if negative",
+				Required:      false,
+				ReadOnly:      false,
+			},
+			{
+				Name:          "tabular_numbers",
+				Type:          "bool",
+				Documentation: "Give all digits the same width",
+				Required:      false,
+				ReadOnly:      false,
+			},
+			{
+				Name:          "outline_color",
+				Type:          "color",
+				Documentation: "Color of an outline around the text",
+				Required:      false,
+				ReadOnly:      false,
+			},
+			{
+				Name:          "shadow_color",
+				Type:          "color",
+				Documentation: "Color of a shadow behind the text",
+				Required:      false,
+				ReadOnly:      false,
+			},
+			{
+				Name:          "shadow_offset",
+				Type:          "int / (int, int)",
+				Documentation: "Offset of the shadow, as x and y or a single number for both",
+				Required:      false,
+				ReadOnly:      false,
+			},
+		},
+	},
+	{
+		Name:          "WrappedText",
+		Documentation: "WrappedText draws multi-line text.\n\nThe optional `width` and `height` parameters limit the drawing\narea. If not set, WrappedText will use as much vertical and\nhorizontal space as possible to fit the text.\n\nAlignment of the text is controlled by passing one of the following `align` values:\n- `\"left\"`: align text to the left\n- `\"center\"`: align text in the center\n- `\"right\"`: align text to the right\n- `\"justify\"`: stretch the space between words so that lines fill\nthe width, except for the last line of a paragraph\n\nIf `align` isn't set, each paragraph is aligned to the side it starts\non, which is the right for Hebrew, Arabic and other right-to-left\ntext. Lines of right-to-left text are broken and laid out with the\nUnicode bidirectional algorithm.\n\nThe number of lines can be limited with `max_lines`. What happens to\ntext that doesn't fit is controlled by passing one of the following\n`overflow` values:\n- `\"clip\"`: cut the text off at the edges, which is the default\n- `\"ellipsis\"`: leave out lines that don't fit entirely, and end the\ntext with an ellipsis where it's cut\n- `\"fade\"`: leave out lines that don't fit entirely, and fade the\ntext out where it's cut\n\nWords are broken across lines at soft hyphens (`\"\\u00ad\"`). With\n`hyphenate`, English words are also broken where a dictionary says\nthey can be. The read-only `truncated` attribute tells if some of the\ntext didn't fit, for the `width` and `height` of the WrappedText, or\nthe size of the display if they're not set.\n\nLetter spacing, tabular numbers, outline and shadow work as they do\nfor Text.",
+		Attributes: []AttrMetadata{
+			{
+				Name:          "content",
+				Type:          "str",
+				Documentation: "The text string to draw",
+				Required:      true,
+				ReadOnly:      false,
+			},
+			{
+				Name:          "font",
+				Type:          "str",
+				Documentation: "Desired font face",
+				Required:      false,
+				ReadOnly:      false,
+			},
+			{
+				Name:          "height",
+				Type:          "int",
+				Documentation: "Limits height of the area on which text may be drawn",
+				Required:      false,
+				ReadOnly:      false,
+			},
+			{
+				Name:          "width",
+				Type:          "int",
+				Documentation: "Limits width of the area on which text may be drawn",
+				Required:      false,
+				ReadOnly:      false,
+			},
+			{
+				Name:          "linespacing",
+				Type:          "int",
+				Documentation: "Controls spacing between lines",
+				Required:      false,
+				ReadOnly:      false,
+			},
+			{
+				Name:          "color",
+				Type:          "color",
+				Documentation: "Desired font color",
+				Required:      false,
+				ReadOnly:      false,
+			},
+			{
+				Name:          "align",
+				Type:          "str",
+				Documentation: "Text Alignment",
+				Required:      false,
+				ReadOnly:      false,
+			},
+			{
+				Name:          "max_lines",
+				Type:          "int",
+				Documentation: "Limits the number of lines drawn",
+				Required:      false,
+				ReadOnly:      false,
+			},
+			{
+				Name:          "overflow",
+				Type:          "str",
+				Documentation: "What to do with text that doesn't fit, 'clip', 'ellipsis' or 'fade', default is clip",
+				Required:      false,
+				ReadOnly:      false,
+			},
+			{
+				Name:          "hyphenate",
+				Type:          "bool",
+				Documentation: "Break English words with hyphens to fit more text on each line",
+				Required:      false,
+				ReadOnly:      false,
+			},
+			{
+				Name:          "truncated",
+				Type:          "bool",
+				Documentation: "(Read-only) Whether some of the text didn't fit",
+				Required:      false,
+				ReadOnly:      true,
+			},
+			{
+				Name:          "letter_spacing",
+				Type:          "int",
+				Documentation: "Pixels added between letters, or removed if negative",
+				Required:      false,
+				ReadOnly:      false,
+			},
+			{
+				Name:          "tabular_numbers",
+				Type:          "bool",
+				Documentation: "Give all digits the same width",
+				Required:      false,
+				ReadOnly:      false,
+			},
+			{
+				Name:          "outline_color",
+				Type:          "color",
+				Documentation: "Color of an outline around the text",
+				Required:      false,
+				ReadOnly:      false,
+			},
+			{
+				Name:          "shadow_color",
+				Type:          "color",
+				Documentation: "Color of a shadow behind the text",
+				Required:      false,
+				ReadOnly:      false,
+			},
+			{
+				Name:          "shadow_offset",
+				Type:          "int / (int, int)",
+				Documentation: "Offset of the shadow, as x and y or a single number for both",
+				Required:      false,
+				ReadOnly:      false,
+			},
+		},
+	},
+}
@@ -0,0 +1,79 @@
+package render_runtime
+
+import (
+	"fmt"
+
+	"go.starlark.net/starlark"
+	"go.starlark.net/starlarkstruct"
+
+	"tidbyt.dev/pixlet/globals"
+	"tidbyt.dev/pixlet/render"
+)
+
+func measureText(
+	thread *starlark.Thread,
+	b *starlark.Builtin,
+	args starlark.Tuple,
+	kwargs []starlark.Tuple) (starlark.Value, error) {
+
+	var (
+		content starlark.String
+		font    starlark.String
+	)
+
+	if err := starlark.UnpackArgs(
+		"measure_text",
+		args, kwargs,
+		"content", &content,
+		"font?", &font,
+	); err != nil {
+		return nil, fmt.Errorf("unpacking arguments for measure_text: %s", err)
+	}
+
+	metrics, err := render.MeasureText(content.GoString(), font.GoString())
+	if err != nil {
+		return nil, fmt.Errorf("measuring text: %w", err)
+	}
+
+	return starlarkstruct.FromStringDict(starlarkstruct.Default, starlark.StringDict{
+		"width":   starlark.MakeInt(metrics.Width),
+		"height":  starlark.MakeInt(metrics.Height),
+		"ascent":  starlark.MakeInt(metrics.Ascent),
+		"descent": starlark.MakeInt(metrics.Descent),
+	}), nil
+}
+
+func widgetSize(
+	thread *starlark.Thread,
+	b *starlark.Builtin,
+	args starlark.Tuple,
+	kwargs []starlark.Tuple) (starlark.Value, error) {
+
+	var (
+		widget    starlark.Value
+		maxWidth  = globals.Width
+		maxHeight = globals.Height
+	)
+
+	if err := starlark.UnpackArgs(
+		"size",
+		args, kwargs,
+		"widget", &widget,
+		"max_width?", &maxWidth,
+		"max_height?", &maxHeight,
+	); err != nil {
+		return nil, fmt.Errorf("unpacking arguments for size: %s", err)
+	}
+
+	w, ok := widget.(Widget)
+	if !ok {
+		return nil, fmt.Errorf("expected widget to be a Widget but found: %s", widget.Type())
+	}
+
+	width, height := render.WidgetSize(w.AsRenderWidget(), maxWidth, maxHeight)
+
+	return starlark.Tuple([]starlark.Value{
+		starlark.MakeInt(width),
+		starlark.MakeInt(height),
+	}), nil
+}
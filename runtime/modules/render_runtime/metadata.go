@@ -0,0 +1,32 @@
+package render_runtime
+
+// TypeMetadata describes a type exposed to Starlark, such as a widget.
+// It's generated from the same definitions as the Starlark bindings, and
+// is used by tooling like the language server.
+type TypeMetadata struct {
+	Name          string
+	Documentation string
+	Attributes    []AttrMetadata
+}
+
+// AttrMetadata describes a single attribute of a type.
+type AttrMetadata struct {
+	Name          string
+	Type          string
+	Documentation string
+
+	// Required attributes must be passed to the constructor. ReadOnly
+	// attributes can't be passed to it at all.
+	Required bool
+	ReadOnly bool
+}
+
+// Attribute returns the metadata of the named attribute, if it exists.
+func (t TypeMetadata) Attribute(name string) (AttrMetadata, bool) {
+	for _, attr := range t.Attributes {
+		if attr.Name == name {
+			return attr, true
+		}
+	}
+	return AttrMetadata{}, false
+}
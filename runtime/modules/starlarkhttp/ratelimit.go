@@ -0,0 +1,121 @@
+package starlarkhttp
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// RateLimiter limits the rate of requests to every host with a token
+// bucket per host. Buckets refill at rate tokens per second, up to burst
+// tokens, and every request takes a token. Hosts without a limit of their
+// own each get a bucket with the default limit.
+type RateLimiter struct {
+	defaultLimit limit
+	limits       map[string]limit
+	buckets      map[string]*bucket
+	mutex        sync.Mutex
+}
+
+type limit struct {
+	rate  float64
+	burst int
+}
+
+type bucket struct {
+	limit
+	tokens float64
+	last   time.Time
+}
+
+// NewRateLimiter creates a rate limiter that allows rate requests per
+// second to every host, with bursts of up to burst requests. A rate of
+// zero or less doesn't limit hosts, unless they're given a limit with
+// SetHostLimit.
+func NewRateLimiter(rate float64, burst int) *RateLimiter {
+	return &RateLimiter{
+		defaultLimit: limit{rate: rate, burst: burst},
+		limits:       map[string]limit{},
+		buckets:      map[string]*bucket{},
+	}
+}
+
+// SetHostLimit sets the limit for a single host, as in the host name of a
+// URL, replacing the default limit for it.
+func (l *RateLimiter) SetHostLimit(host string, rate float64, burst int) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	l.limits[host] = limit{rate: rate, burst: burst}
+	delete(l.buckets, host)
+}
+
+// Wait blocks until a request to host is allowed, or ctx is done.
+func (l *RateLimiter) Wait(ctx context.Context, host string) error {
+	delay, ok := l.reserve(host)
+	if !ok || delay <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		l.cancel(host)
+		return ctx.Err()
+	}
+}
+
+// reserve takes a token from the bucket of host, and returns how long to
+// wait before the token is actually available. It returns false if host
+// isn't limited.
+func (l *RateLimiter) reserve(host string) (time.Duration, bool) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	b, ok := l.buckets[host]
+	if !ok {
+		lim, ok := l.limits[host]
+		if !ok {
+			lim = l.defaultLimit
+		}
+		if lim.rate <= 0 {
+			return 0, false
+		}
+		if lim.burst < 1 {
+			lim.burst = 1
+		}
+
+		b = &bucket{limit: lim, tokens: float64(lim.burst), last: time.Now()}
+		l.buckets[host] = b
+	}
+
+	now := time.Now()
+	b.tokens += now.Sub(b.last).Seconds() * b.rate
+	if b.tokens > float64(b.burst) {
+		b.tokens = float64(b.burst)
+	}
+	b.last = now
+
+	// the token may be taken before it's there, in which case the
+	// caller waits for the bucket to catch up
+	b.tokens--
+	if b.tokens >= 0 {
+		return 0, true
+	}
+
+	return time.Duration(-b.tokens / b.rate * float64(time.Second)), true
+}
+
+// cancel returns a token that was reserved but not used.
+func (l *RateLimiter) cancel(host string) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	if b, ok := l.buckets[host]; ok {
+		b.tokens++
+	}
+}
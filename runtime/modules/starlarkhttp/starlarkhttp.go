@@ -27,6 +27,7 @@ package starlarkhttp
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -35,10 +36,14 @@ import (
 	"net/url"
 	"strconv"
 	"strings"
+	"sync"
+	"time"
 
 	util "github.com/qri-io/starlib/util"
 	"go.starlark.net/starlark"
 	"go.starlark.net/starlarkstruct"
+
+	"tidbyt.dev/pixlet/starlarkutil"
 )
 
 // AsString unquotes a starlark string value
@@ -57,8 +62,57 @@ var (
 	// StarlarkHTTPGuard is a global RequestGuard used in LoadModule. override with a custom
 	// implementation before calling LoadModule
 	StarlarkHTTPGuard RequestGuard
+	// StarlarkHTTPRateLimiter limits the rate of requests to every host, if set.
+	// override before calling LoadModule
+	StarlarkHTTPRateLimiter *RateLimiter
+	// MaxConcurrentRequestsPerHost limits how many requests made by get_many
+	// and batch are in flight to a single host at a time
+	MaxConcurrentRequestsPerHost = 4
+	// MaxConcurrentRequests limits how many requests made by get_many and
+	// batch are in flight at a time, across all applets
+	MaxConcurrentRequests = 32
+	// MaxTimeout is the longest timeout a request can ask for. longer
+	// timeouts are cut down to it
+	MaxTimeout = 5 * time.Second
+	// MaxRetries is the largest number of retries a request can ask for
+	MaxRetries = 5
+	// MaxRetryDelay is the longest a request waits before retrying. if a
+	// server asks for a longer wait with Retry-After, its response is
+	// returned instead
+	MaxRetryDelay = 10 * time.Second
+	// DefaultBackoff is how long a request waits before its first retry,
+	// unless the server says otherwise. the wait doubles for every retry
+	DefaultBackoff = 500 * time.Millisecond
 )
 
+// retryableStatusCodes are the responses that are worth retrying.
+var retryableStatusCodes = map[int]bool{
+	http.StatusTooManyRequests:     true,
+	http.StatusInternalServerError: true,
+	http.StatusBadGateway:          true,
+	http.StatusServiceUnavailable:  true,
+	http.StatusGatewayTimeout:      true,
+}
+
+// TransportOptions are attached to the context of requests by the module,
+// for the transport of the http client to act on.
+type TransportOptions struct {
+	// Timeout replaces the default timeout of the transport, if set.
+	Timeout time.Duration
+
+	// Retry is set on requests that retry an earlier attempt, which must
+	// not be answered from a cache.
+	Retry bool
+}
+
+type transportOptionsKey struct{}
+
+// TransportOptionsFrom returns the transport options attached to ctx.
+func TransportOptionsFrom(ctx context.Context) TransportOptions {
+	opts, _ := ctx.Value(transportOptionsKey{}).(TransportOptions)
+	return opts
+}
+
 // Encodings for form data.
 //
 // See: https://developer.mozilla.org/en-US/docs/Web/HTTP/Methods/POST
@@ -69,7 +123,7 @@ const (
 
 // LoadModule creates an http Module
 func LoadModule() (starlark.StringDict, error) {
-	var m = &Module{cli: StarlarkHTTPClient}
+	var m = &Module{cli: StarlarkHTTPClient, rl: StarlarkHTTPRateLimiter}
 	if StarlarkHTTPGuard != nil {
 		m.rg = StarlarkHTTPGuard
 	}
@@ -90,6 +144,7 @@ type RequestGuard interface {
 type Module struct {
 	cli *http.Client
 	rg  RequestGuard
+	rl  *RateLimiter
 }
 
 // Struct returns this module's methods as a starlark Struct
@@ -106,68 +161,443 @@ func (m *Module) StringDict() starlark.StringDict {
 		"delete":  starlark.NewBuiltin("delete", m.reqMethod("delete")),
 		"patch":   starlark.NewBuiltin("patch", m.reqMethod("patch")),
 		"options": starlark.NewBuiltin("options", m.reqMethod("options")),
+
+		"get_many": starlark.NewBuiltin("get_many", m.getMany),
+		"batch":    starlark.NewBuiltin("batch", m.batch),
 	}
 }
 
+// supportedMethods are the methods that can be used in a batch.
+var supportedMethods = map[string]bool{
+	"get":     true,
+	"put":     true,
+	"post":    true,
+	"delete":  true,
+	"patch":   true,
+	"options": true,
+}
+
 // reqMethod is a factory function for generating starlark builtin functions for different http request methods
 func (m *Module) reqMethod(method string) func(thread *starlark.Thread, _ *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
 	return func(thread *starlark.Thread, _ *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
-		var (
-			urlv         starlark.String
-			params       = &starlark.Dict{}
-			headers      = &starlark.Dict{}
-			formBody     = &starlark.Dict{}
-			formEncoding starlark.String
-			auth         starlark.Tuple
-			body         starlark.String
-			jsonBody     starlark.Value
-			ttl          starlark.Int
-		)
-
-		if err := starlark.UnpackArgs(method, args, kwargs, "url", &urlv, "params?", &params, "headers", &headers, "body", &body, "form_body", &formBody, "form_encoding", &formEncoding, "json_body", &jsonBody, "auth", &auth, "ttl_seconds?", &ttl); err != nil {
+		ra, err := unpackRequestArgs(method, args, kwargs)
+		if err != nil {
+			return nil, err
+		}
+
+		req, err := m.newRequest(thread, method, ra)
+		if err != nil {
 			return nil, err
 		}
 
-		rawurl, err := AsString(urlv)
+		res, err := m.do(req)
 		if err != nil {
 			return nil, err
 		}
-		if err = setQueryParams(&rawurl, params); err != nil {
+
+		r := &Response{*res}
+		return r.Struct(), nil
+	}
+}
+
+// requestArgs are the arguments that describe a request, as passed to
+// the request methods.
+type requestArgs struct {
+	url          starlark.String
+	params       *starlark.Dict
+	headers      *starlark.Dict
+	formBody     *starlark.Dict
+	formEncoding starlark.String
+	auth         starlark.Tuple
+	body         starlark.String
+	jsonBody     starlark.Value
+	ttl          starlark.Int
+	timeout      starlark.Value
+	retries      int
+	backoff      starlark.Value
+}
+
+// request is a request along with the options for sending it.
+type request struct {
+	*http.Request
+	timeout time.Duration
+	retries int
+	backoff time.Duration
+}
+
+func unpackRequestArgs(fnname string, args starlark.Tuple, kwargs []starlark.Tuple) (*requestArgs, error) {
+	ra := &requestArgs{
+		params:   &starlark.Dict{},
+		headers:  &starlark.Dict{},
+		formBody: &starlark.Dict{},
+	}
+
+	if err := starlark.UnpackArgs(fnname, args, kwargs, "url", &ra.url, "params?", &ra.params, "headers", &ra.headers, "body", &ra.body, "form_body", &ra.formBody, "form_encoding", &ra.formEncoding, "json_body", &ra.jsonBody, "auth", &ra.auth, "ttl_seconds?", &ra.ttl, "timeout?", &ra.timeout, "retries?", &ra.retries, "backoff?", &ra.backoff); err != nil {
+		return nil, err
+	}
+
+	return ra, nil
+}
+
+// newRequest builds a request, bound to the context of the thread. The
+// request guard, if any, is consulted before the request is returned.
+func (m *Module) newRequest(thread *starlark.Thread, method string, ra *requestArgs) (*request, error) {
+	timeout, err := seconds("timeout", ra.timeout, 0)
+	if err != nil {
+		return nil, err
+	}
+	timeout = min(timeout, MaxTimeout)
+	backoff, err := seconds("backoff", ra.backoff, DefaultBackoff)
+	if err != nil {
+		return nil, err
+	}
+	if ra.retries < 0 || ra.retries > MaxRetries {
+		return nil, fmt.Errorf("retries must be between 0 and %d, got %d", MaxRetries, ra.retries)
+	}
+
+	rawurl, err := AsString(ra.url)
+	if err != nil {
+		return nil, err
+	}
+	if err = setQueryParams(&rawurl, ra.params); err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(starlarkutil.ThreadContext(thread), strings.ToUpper(method), rawurl, nil)
+	if err != nil {
+		return nil, err
+	}
+	if m.rg != nil {
+		req, err = m.rg.Allowed(thread, req)
+		if err != nil {
 			return nil, err
 		}
+	}
+
+	if err = setHeaders(req, ra.headers); err != nil {
+		return nil, err
+	}
+	if err = setStandardHeaders(req, thread, ra.ttl); err != nil {
+		return nil, err
+	}
+	if err = setAuth(req, ra.auth); err != nil {
+		return nil, err
+	}
+	if err = SetBody(req, ra.body, ra.formBody, ra.formEncoding, ra.jsonBody); err != nil {
+		return nil, err
+	}
 
-		req, err := http.NewRequest(strings.ToUpper(method), rawurl, nil)
+	if ra.retries > 0 && req.Body != nil {
+		// retries send the body again
+		data, err := io.ReadAll(req.Body)
 		if err != nil {
 			return nil, err
 		}
-		if m.rg != nil {
-			req, err = m.rg.Allowed(thread, req)
-			if err != nil {
+		req.Body = io.NopCloser(bytes.NewReader(data))
+		req.GetBody = func() (io.ReadCloser, error) {
+			return io.NopCloser(bytes.NewReader(data)), nil
+		}
+	}
+
+	return &request{Request: req, timeout: timeout, retries: ra.retries, backoff: backoff}, nil
+}
+
+// seconds converts a number of seconds passed as an argument to a
+// duration, returning def if the argument wasn't passed.
+func seconds(name string, v starlark.Value, def time.Duration) (time.Duration, error) {
+	if v == nil || v == starlark.None {
+		return def, nil
+	}
+
+	f, ok := starlark.AsFloat(v)
+	if !ok {
+		return 0, fmt.Errorf("%s must be a number of seconds, got %s", name, v.Type())
+	}
+	if f < 0 || (name == "timeout" && f == 0) {
+		return 0, fmt.Errorf("%s must be positive, got %s", name, v)
+	}
+
+	return time.Duration(f * float64(time.Second)), nil
+}
+
+// do sends a request, retrying it if it fails and the request allows it.
+// Retries wait for the backoff of the request, doubled for every retry,
+// or for as long as the server asks with Retry-After.
+func (m *Module) do(r *request) (*http.Response, error) {
+	ctx := r.Context()
+
+	cli := m.cli
+	opts := TransportOptions{Timeout: r.timeout}
+	if r.timeout > 0 {
+		c := *m.cli
+		c.Timeout = r.timeout
+		cli = &c
+	}
+
+	req := r.Request
+	for attempt := 0; ; attempt++ {
+		if m.rl != nil {
+			if err := m.rl.Wait(ctx, req.URL.Hostname()); err != nil {
 				return nil, err
 			}
 		}
 
-		if err = setHeaders(req, headers); err != nil {
-			return nil, err
+		res, err := cli.Do(req.WithContext(context.WithValue(ctx, transportOptionsKey{}, opts)))
+		if attempt >= r.retries || ctx.Err() != nil {
+			return res, err
 		}
-		if err = setStandardHeaders(req, thread, ttl); err != nil {
-			return nil, err
+		if err == nil && !retryableStatusCodes[res.StatusCode] {
+			return res, nil
 		}
-		if err = setAuth(req, auth); err != nil {
-			return nil, err
+
+		delay := r.backoff << attempt
+		if err == nil {
+			if after, ok := retryAfter(res.Header.Get("Retry-After"), time.Now()); ok {
+				delay = after
+			}
 		}
-		if err = SetBody(req, body, formBody, formEncoding, jsonBody); err != nil {
-			return nil, err
+		if delay > MaxRetryDelay {
+			return res, err
+		}
+		if res != nil {
+			io.Copy(io.Discard, res.Body)
+			res.Body.Close()
 		}
 
-		res, err := m.cli.Do(req)
+		timer := time.NewTimer(delay)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		}
+
+		req = req.Clone(ctx)
+		if req.GetBody != nil {
+			if req.Body, err = req.GetBody(); err != nil {
+				return nil, err
+			}
+		}
+		opts.Retry = true
+	}
+}
+
+// retryAfter parses the value of a Retry-After header, which is either a
+// number of seconds or a date.
+func retryAfter(value string, now time.Time) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+
+	if secs, err := strconv.Atoi(value); err == nil {
+		if secs < 0 {
+			return 0, false
+		}
+		return time.Duration(secs) * time.Second, true
+	}
+
+	if t, err := http.ParseTime(value); err == nil {
+		if t.Before(now) {
+			return 0, true
+		}
+		return t.Sub(now), true
+	}
+
+	return 0, false
+}
+
+// getMany issues GET requests for a list of URLs concurrently, passing the
+// same parameters with each of them.
+func (m *Module) getMany(thread *starlark.Thread, _ *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	var (
+		urls    *starlark.List
+		params  = &starlark.Dict{}
+		headers = &starlark.Dict{}
+		auth    starlark.Tuple
+		ttl     starlark.Int
+		timeout starlark.Value
+		retries int
+		backoff starlark.Value
+	)
+
+	if err := starlark.UnpackArgs("get_many", args, kwargs, "urls", &urls, "params?", &params, "headers?", &headers, "auth?", &auth, "ttl_seconds?", &ttl, "timeout?", &timeout, "retries?", &retries, "backoff?", &backoff); err != nil {
+		return nil, err
+	}
+
+	reqs := make([]*request, 0, urls.Len())
+	for i := 0; i < urls.Len(); i++ {
+		u, ok := urls.Index(i).(starlark.String)
+		if !ok {
+			return nil, fmt.Errorf("get_many: expected urls[%d] to be a string. got: '%s'", i, urls.Index(i).Type())
+		}
+
+		req, err := m.newRequest(thread, "get", &requestArgs{
+			url:      u,
+			params:   params,
+			headers:  headers,
+			formBody: &starlark.Dict{},
+			auth:     auth,
+			ttl:      ttl,
+			timeout:  timeout,
+			retries:  retries,
+			backoff:  backoff,
+		})
 		if err != nil {
-			return nil, err
+			return nil, fmt.Errorf("get_many: urls[%d]: %w", i, err)
+		}
+		reqs = append(reqs, req)
+	}
+
+	return m.doAll("get_many", reqs)
+}
+
+// batch issues a list of requests concurrently. Each request is a dict
+// with a "method", which defaults to "get", and the same keys as the
+// arguments of the request methods.
+func (m *Module) batch(thread *starlark.Thread, _ *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	var requests *starlark.List
+
+	if err := starlark.UnpackArgs("batch", args, kwargs, "requests", &requests); err != nil {
+		return nil, err
+	}
+
+	reqs := make([]*request, 0, requests.Len())
+	for i := 0; i < requests.Len(); i++ {
+		d, ok := requests.Index(i).(*starlark.Dict)
+		if !ok {
+			return nil, fmt.Errorf("batch: expected requests[%d] to be a dict. got: '%s'", i, requests.Index(i).Type())
 		}
 
+		// the dict is unpacked as if its items were passed as keyword
+		// arguments to a request method
+		method := "get"
+		var itemKwargs []starlark.Tuple
+		for _, item := range d.Items() {
+			key, ok := starlark.AsString(item[0])
+			if !ok {
+				return nil, fmt.Errorf("batch: expected keys of requests[%d] to be strings. got: '%s'", i, item[0].Type())
+			}
+
+			if key == "method" {
+				v, ok := starlark.AsString(item[1])
+				if !ok || !supportedMethods[strings.ToLower(v)] {
+					return nil, fmt.Errorf("batch: unsupported method in requests[%d]: %s", i, item[1])
+				}
+				method = strings.ToLower(v)
+				continue
+			}
+
+			itemKwargs = append(itemKwargs, starlark.Tuple{starlark.String(key), item[1]})
+		}
+
+		ra, err := unpackRequestArgs(method, nil, itemKwargs)
+		if err != nil {
+			return nil, fmt.Errorf("batch: requests[%d]: %w", i, err)
+		}
+
+		req, err := m.newRequest(thread, method, ra)
+		if err != nil {
+			return nil, fmt.Errorf("batch: requests[%d]: %w", i, err)
+		}
+		reqs = append(reqs, req)
+	}
+
+	return m.doAll("batch", reqs)
+}
+
+// requestSlots counts the requests made by get_many and batch that are
+// in flight, so that there are never more than MaxConcurrentRequests.
+var requestSlots = newSlots()
+
+type slots struct {
+	mutex sync.Mutex
+	cond  *sync.Cond
+	used  int
+}
+
+func newSlots() *slots {
+	s := &slots{}
+	s.cond = sync.NewCond(&s.mutex)
+	return s
+}
+
+func (s *slots) acquire() {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	for s.used >= max(MaxConcurrentRequests, 1) {
+		s.cond.Wait()
+	}
+	s.used++
+}
+
+func (s *slots) release() {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.used--
+	s.cond.Broadcast()
+}
+
+// doAll sends requests concurrently, with at most
+// MaxConcurrentRequestsPerHost in flight to any single host and
+// MaxConcurrentRequests in flight overall, and returns the responses in
+// the order of the requests. If any request fails, the whole batch fails.
+func (m *Module) doAll(fnname string, reqs []*request) (starlark.Value, error) {
+	responses := make([]*http.Response, len(reqs))
+	errs := make([]error, len(reqs))
+
+	limit := MaxConcurrentRequestsPerHost
+	if limit < 1 {
+		limit = 1
+	}
+
+	hosts := map[string]chan struct{}{}
+	var wg sync.WaitGroup
+	for i, req := range reqs {
+		sem, ok := hosts[req.URL.Host]
+		if !ok {
+			sem = make(chan struct{}, limit)
+			hosts[req.URL.Host] = sem
+		}
+
+		wg.Add(1)
+		go func(i int, req *request, sem chan struct{}) {
+			defer wg.Done()
+
+			sem <- struct{}{}
+			defer func() { <-sem }()
+			requestSlots.acquire()
+			defer requestSlots.release()
+
+			responses[i], errs[i] = m.do(req)
+		}(i, req, sem)
+	}
+	wg.Wait()
+
+	var firstErr error
+	for i, err := range errs {
+		if err != nil && firstErr == nil {
+			firstErr = fmt.Errorf("%s: %s %s: %w", fnname, reqs[i].Method, reqs[i].URL, err)
+		}
+	}
+
+	if firstErr != nil {
+		for _, res := range responses {
+			if res != nil {
+				res.Body.Close()
+			}
+		}
+		return nil, firstErr
+	}
+
+	results := make([]starlark.Value, len(responses))
+	for i, res := range responses {
 		r := &Response{*res}
-		return r.Struct(), nil
+		results[i] = r.Struct()
 	}
+
+	return starlark.NewList(results), nil
 }
 
 func setQueryParams(rawurl *string, params *starlark.Dict) error {
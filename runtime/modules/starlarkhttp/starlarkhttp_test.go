@@ -23,11 +23,16 @@
 package starlarkhttp_test
 
 import (
+	"context"
+	"fmt"
 	"io"
 	"net/http"
 	"net/http/httptest"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/qri-io/starlib/testdata"
 	"go.starlark.net/starlark"
@@ -140,3 +145,311 @@ func TestSetBody(t *testing.T) {
 		}
 	}
 }
+
+type hostGuard struct {
+	denied string
+}
+
+func (g hostGuard) Allowed(_ *starlark.Thread, req *http.Request) (*http.Request, error) {
+	if strings.HasSuffix(req.URL.Path, g.denied) {
+		return nil, fmt.Errorf("%s is denied", req.URL.Path)
+	}
+	req.Header.Set("X-Guarded", "yes")
+	return req, nil
+}
+
+func TestGetManyAndBatch(t *testing.T) {
+	var (
+		inFlight, maxInFlight int32
+		mutex                 sync.Mutex
+	)
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+
+		mutex.Lock()
+		if n > maxInFlight {
+			maxInFlight = n
+		}
+		mutex.Unlock()
+
+		// respond in reverse order, to check that results aren't
+		// returned in the order of completion
+		if r.URL.Path == "/0" {
+			time.Sleep(50 * time.Millisecond)
+		}
+		time.Sleep(10 * time.Millisecond)
+
+		body, _ := io.ReadAll(r.Body)
+		fmt.Fprintf(w, "%s %s %s %s %s", r.Method, r.URL.Path, r.URL.RawQuery, r.Header.Get("X-Guarded"), body)
+	}))
+	defer ts.Close()
+
+	starlarkhttp.StarlarkHTTPGuard = hostGuard{denied: "/denied"}
+	starlarkhttp.MaxConcurrentRequestsPerHost = 2
+	defer func() {
+		starlarkhttp.StarlarkHTTPGuard = nil
+		starlarkhttp.MaxConcurrentRequestsPerHost = 4
+	}()
+
+	src := `
+load("assert.star", "assert")
+load("http.star", "http")
+
+urls = ["%s/%d" % (test_server_url, i) for i in range(8)]
+responses = http.get_many(urls, params = {"a": "b"})
+assert.eq([r.status_code for r in responses], [200] * 8)
+assert.eq([r.body() for r in responses], ["GET /%d a=b yes " % i for i in range(8)])
+
+batched = http.batch([
+    {"url": test_server_url + "/x", "method": "post", "body": "hello"},
+    {"url": test_server_url + "/y"},
+    {"url": test_server_url + "/z", "method": "PUT", "json_body": {"a": 1}},
+])
+assert.eq([r.body() for r in batched], [
+    "POST /x  yes hello",
+    "GET /y  yes ",
+    'PUT /z  yes {"a":1}',
+])
+
+assert.eq(http.get_many([]), [])
+assert.fails(lambda: http.get_many([test_server_url + "/denied"]), "urls\\[0\\]: /denied is denied")
+assert.fails(lambda: http.batch([{"url": test_server_url, "method": "head"}]), "unsupported method in requests\\[0\\]")
+assert.fails(lambda: http.batch([{"uri": test_server_url}]), "unexpected keyword argument")
+assert.fails(lambda: http.get_many(["http://127.0.0.1:0/"]), "get_many: GET http://127.0.0.1:0/")
+`
+
+	thread := &starlark.Thread{Name: "unittests/abc123", Load: testdata.NewLoader(starlarkhttp.LoadModule, starlarkhttp.ModuleName)}
+	starlarktest.SetReporter(thread, t)
+
+	_, err := starlark.ExecFile(thread, "get_many.star", src, starlark.StringDict{"test_server_url": starlark.String(ts.URL)})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if maxInFlight > 2 {
+		t.Errorf("expected at most 2 requests in flight. got: %d", maxInFlight)
+	}
+	if maxInFlight < 2 {
+		t.Errorf("expected requests to be made concurrently. got: %d in flight", maxInFlight)
+	}
+}
+
+func TestMaxConcurrentRequests(t *testing.T) {
+	var (
+		inFlight, maxInFlight int32
+		mutex                 sync.Mutex
+	)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+
+		mutex.Lock()
+		if n > maxInFlight {
+			maxInFlight = n
+		}
+		mutex.Unlock()
+
+		time.Sleep(20 * time.Millisecond)
+	})
+
+	// the servers listen on different ports, so they count as
+	// different hosts
+	one := httptest.NewServer(handler)
+	defer one.Close()
+	two := httptest.NewServer(handler)
+	defer two.Close()
+
+	starlarkhttp.MaxConcurrentRequests = 3
+	defer func() {
+		starlarkhttp.MaxConcurrentRequests = 32
+	}()
+
+	src := `
+load("assert.star", "assert")
+load("http.star", "http")
+
+urls = ["%s/%d" % (url, i) for i in range(4) for url in (one_url, two_url)]
+assert.eq([r.status_code for r in http.get_many(urls)], [200] * 8)
+`
+
+	thread := &starlark.Thread{Name: "unittests/abc123", Load: testdata.NewLoader(starlarkhttp.LoadModule, starlarkhttp.ModuleName)}
+	starlarktest.SetReporter(thread, t)
+
+	_, err := starlark.ExecFile(thread, "max_concurrent.star", src, starlark.StringDict{
+		"one_url": starlark.String(one.URL),
+		"two_url": starlark.String(two.URL),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if maxInFlight > 3 {
+		t.Errorf("expected at most 3 requests in flight. got: %d", maxInFlight)
+	}
+}
+
+func TestRetries(t *testing.T) {
+	var calls int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&calls, 1)
+		body, _ := io.ReadAll(r.Body)
+
+		switch r.URL.Path {
+		case "/flaky":
+			// fails twice, then succeeds
+			if n%3 != 0 {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+		case "/throttled":
+			w.Header().Set("Retry-After", "3600")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		case "/missing":
+			w.WriteHeader(http.StatusNotFound)
+			return
+		case "/slow":
+			time.Sleep(200 * time.Millisecond)
+		}
+
+		fmt.Fprintf(w, "%d %s", n, body)
+	}))
+	defer ts.Close()
+
+	src := `
+load("assert.star", "assert")
+load("http.star", "http")
+
+res = http.post(test_server_url + "/flaky", body = "again", retries = 2, backoff = 0.01)
+assert.eq(res.status_code, 200)
+assert.eq(res.body(), "3 again")
+
+# too few retries
+assert.eq(http.get(test_server_url + "/flaky", retries = 1, backoff = 0.01).status_code, 503)
+
+# waiting for Retry-After would take too long
+assert.eq(http.get(test_server_url + "/throttled", retries = 3).status_code, 429)
+
+# client errors aren't retried
+assert.eq(http.get(test_server_url + "/missing", retries = 3).status_code, 404)
+
+assert.fails(lambda: http.get(test_server_url + "/slow", timeout = 0.05), "Client.Timeout exceeded")
+
+# timeouts can't be longer than the maximum
+assert.fails(lambda: http.get(test_server_url + "/slow", timeout = 3600), "Client.Timeout exceeded")
+assert.fails(lambda: http.get(test_server_url, timeout = 0), "timeout must be positive")
+assert.fails(lambda: http.get(test_server_url, retries = 100), "retries must be between 0 and 5")
+assert.fails(lambda: http.get(test_server_url, backoff = "1s"), "backoff must be a number of seconds")
+`
+
+	thread := &starlark.Thread{Name: "unittests/abc123", Load: testdata.NewLoader(starlarkhttp.LoadModule, starlarkhttp.ModuleName)}
+	starlarktest.SetReporter(thread, t)
+
+	starlarkhttp.MaxTimeout = 100 * time.Millisecond
+	defer func() {
+		starlarkhttp.MaxTimeout = 5 * time.Second
+	}()
+
+	_, err := starlark.ExecFile(thread, "retries.star", src, starlark.StringDict{"test_server_url": starlark.String(ts.URL)})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// 3 + 2 flaky, 1 throttled, 1 missing, 2 slow
+	if got := atomic.LoadInt32(&calls); got != 9 {
+		t.Errorf("expected 9 requests. got: %d", got)
+	}
+}
+
+func TestRetryAfterDate(t *testing.T) {
+	var calls int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			// a date in the past means right away
+			w.Header().Set("Retry-After", "Mon, 01 Jun 2000 00:00:00 GMT")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.Write([]byte("ok"))
+	}))
+	defer ts.Close()
+
+	thread := &starlark.Thread{Name: "unittests/abc123", Load: testdata.NewLoader(starlarkhttp.LoadModule, starlarkhttp.ModuleName)}
+	starlarktest.SetReporter(thread, t)
+
+	start := time.Now()
+	_, err := starlark.ExecFile(thread, "retry_after.star", `
+load("assert.star", "assert")
+load("http.star", "http")
+assert.eq(http.get(test_server_url, retries = 1, backoff = 5).body(), "ok")
+`, starlark.StringDict{"test_server_url": starlark.String(ts.URL)})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if elapsed := time.Since(start); elapsed > 2*time.Second {
+		t.Errorf("expected Retry-After to replace the backoff. took: %s", elapsed)
+	}
+}
+
+func TestRateLimiter(t *testing.T) {
+	var calls int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+	}))
+	defer ts.Close()
+
+	// 20 requests per second with no burst to spare, so 5 requests
+	// take at least 200ms
+	starlarkhttp.StarlarkHTTPRateLimiter = starlarkhttp.NewRateLimiter(20, 1)
+	defer func() { starlarkhttp.StarlarkHTTPRateLimiter = nil }()
+
+	thread := &starlark.Thread{Name: "unittests/abc123", Load: testdata.NewLoader(starlarkhttp.LoadModule, starlarkhttp.ModuleName)}
+	starlarktest.SetReporter(thread, t)
+
+	start := time.Now()
+	_, err := starlark.ExecFile(thread, "rate_limit.star", `
+load("http.star", "http")
+http.get_many([test_server_url] * 6)
+`, starlark.StringDict{"test_server_url": starlark.String(ts.URL)})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if elapsed := time.Since(start); elapsed < 200*time.Millisecond {
+		t.Errorf("expected requests to be rate limited. took: %s", elapsed)
+	}
+	if got := atomic.LoadInt32(&calls); got != 6 {
+		t.Errorf("expected 6 requests. got: %d", got)
+	}
+}
+
+func TestRateLimiterHostLimits(t *testing.T) {
+	l := starlarkhttp.NewRateLimiter(0, 0)
+	l.SetHostLimit("api.example.com", 1, 2)
+
+	ctx := context.Background()
+
+	// hosts without a limit aren't limited
+	for i := 0; i < 10; i++ {
+		if err := l.Wait(ctx, "example.com"); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// the burst is allowed right away, and then there's a wait
+	for i := 0; i < 2; i++ {
+		if err := l.Wait(ctx, "api.example.com"); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+	defer cancel()
+	if err := l.Wait(ctx, "api.example.com"); err != context.DeadlineExceeded {
+		t.Errorf("expected to wait past the deadline. got: %v", err)
+	}
+}
@@ -0,0 +1,545 @@
+package stats
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"sync"
+
+	"go.starlark.net/starlark"
+	"go.starlark.net/starlarkstruct"
+)
+
+const (
+	ModuleName = "stats"
+)
+
+var (
+	once   sync.Once
+	module starlark.StringDict
+)
+
+func LoadModule() (starlark.StringDict, error) {
+	once.Do(func() {
+		module = starlark.StringDict{
+			ModuleName: &starlarkstruct.Module{
+				Name: ModuleName,
+				Members: starlark.StringDict{
+					"min":               starlark.NewBuiltin("min", aggregate("min", minimum)),
+					"max":               starlark.NewBuiltin("max", aggregate("max", maximum)),
+					"mean":              starlark.NewBuiltin("mean", aggregate("mean", mean)),
+					"median":            starlark.NewBuiltin("median", aggregate("median", median)),
+					"percentile":        starlark.NewBuiltin("percentile", percentileBuiltin),
+					"stddev":            starlark.NewBuiltin("stddev", stddevBuiltin),
+					"sma":               starlark.NewBuiltin("sma", sma),
+					"ema":               starlark.NewBuiltin("ema", ema),
+					"linear_regression": starlark.NewBuiltin("linear_regression", linearRegression),
+					"downsample":        starlark.NewBuiltin("downsample", downsample),
+					"normalize":         starlark.NewBuiltin("normalize", normalize),
+				},
+			},
+		}
+	})
+
+	return module, nil
+}
+
+// series is a sequence of numbers, or of (x, y) points as accepted by
+// render.Plot. Functions operate on the y values of points.
+type series struct {
+	points bool
+	xs     []float64
+	ys     []float64
+
+	// xValues are the original x values of points, so that they can be
+	// returned as they were passed
+	xValues []starlark.Value
+}
+
+func toSeries(fnname string, v starlark.Value) (*series, error) {
+	iterable, ok := v.(starlark.Indexable)
+	if !ok {
+		return nil, fmt.Errorf("%s: expected a list of numbers or (x, y) tuples, got %s", fnname, v.Type())
+	}
+
+	s := &series{}
+	for i := 0; i < iterable.Len(); i++ {
+		item := iterable.Index(i)
+
+		if point, ok := item.(starlark.Tuple); ok {
+			if i > 0 && !s.points {
+				return nil, fmt.Errorf("%s: can't mix numbers and (x, y) tuples", fnname)
+			}
+			if len(point) != 2 {
+				return nil, fmt.Errorf("%s: expected (x, y) tuple at index %d, got %d values", fnname, i, len(point))
+			}
+
+			x, ok := starlark.AsFloat(point[0])
+			if !ok {
+				return nil, fmt.Errorf("%s: x at index %d is not a number: %s", fnname, i, point[0].Type())
+			}
+			y, ok := starlark.AsFloat(point[1])
+			if !ok {
+				return nil, fmt.Errorf("%s: y at index %d is not a number: %s", fnname, i, point[1].Type())
+			}
+
+			s.points = true
+			s.xs = append(s.xs, x)
+			s.ys = append(s.ys, y)
+			s.xValues = append(s.xValues, point[0])
+			continue
+		}
+
+		if s.points {
+			return nil, fmt.Errorf("%s: can't mix numbers and (x, y) tuples", fnname)
+		}
+
+		y, ok := starlark.AsFloat(item)
+		if !ok {
+			return nil, fmt.Errorf("%s: value at index %d is not a number: %s", fnname, i, item.Type())
+		}
+		s.xs = append(s.xs, float64(i))
+		s.ys = append(s.ys, y)
+	}
+
+	return s, nil
+}
+
+// value returns the series with the y values replaced by ys, keeping the
+// points from offset on.
+func (s *series) value(ys []float64, offset int) starlark.Value {
+	values := make([]starlark.Value, len(ys))
+	for i, y := range ys {
+		if s.points {
+			values[i] = starlark.Tuple{s.xValues[offset+i], starlark.Float(y)}
+		} else {
+			values[i] = starlark.Float(y)
+		}
+	}
+	return starlark.NewList(values)
+}
+
+// aggregate is a factory function for builtins that reduce a series to a
+// single number. Empty series reduce to None.
+func aggregate(name string, fn func([]float64) float64) func(*starlark.Thread, *starlark.Builtin, starlark.Tuple, []starlark.Tuple) (starlark.Value, error) {
+	return func(thread *starlark.Thread, _ *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+		var values starlark.Value
+
+		if err := starlark.UnpackArgs(
+			name,
+			args, kwargs,
+			"series", &values,
+		); err != nil {
+			return nil, fmt.Errorf("unpacking arguments for %s: %s", name, err)
+		}
+
+		s, err := toSeries(name, values)
+		if err != nil {
+			return nil, err
+		}
+		if len(s.ys) == 0 {
+			return starlark.None, nil
+		}
+
+		return starlark.Float(fn(s.ys)), nil
+	}
+}
+
+func minimum(ys []float64) float64 {
+	m := ys[0]
+	for _, y := range ys[1:] {
+		m = math.Min(m, y)
+	}
+	return m
+}
+
+func maximum(ys []float64) float64 {
+	m := ys[0]
+	for _, y := range ys[1:] {
+		m = math.Max(m, y)
+	}
+	return m
+}
+
+func mean(ys []float64) float64 {
+	sum := 0.0
+	for _, y := range ys {
+		sum += y
+	}
+	return sum / float64(len(ys))
+}
+
+func median(ys []float64) float64 {
+	return percentile(ys, 50)
+}
+
+// percentile interpolates linearly between the closest ranks, like
+// numpy's default method.
+func percentile(ys []float64, p float64) float64 {
+	sorted := append([]float64{}, ys...)
+	sort.Float64s(sorted)
+
+	rank := p / 100 * float64(len(sorted)-1)
+	lower := int(math.Floor(rank))
+	upper := int(math.Ceil(rank))
+
+	return sorted[lower] + (sorted[upper]-sorted[lower])*(rank-float64(lower))
+}
+
+func stddev(ys []float64, sample bool) float64 {
+	m := mean(ys)
+
+	sum := 0.0
+	for _, y := range ys {
+		sum += (y - m) * (y - m)
+	}
+
+	n := float64(len(ys))
+	if sample {
+		n--
+	}
+
+	return math.Sqrt(sum / n)
+}
+
+func percentileBuiltin(thread *starlark.Thread, _ *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	var (
+		values starlark.Value
+		p      starlark.Value
+	)
+
+	if err := starlark.UnpackArgs(
+		"percentile",
+		args, kwargs,
+		"series", &values,
+		"p", &p,
+	); err != nil {
+		return nil, fmt.Errorf("unpacking arguments for percentile: %s", err)
+	}
+
+	pf, ok := starlark.AsFloat(p)
+	if !ok || pf < 0 || pf > 100 {
+		return nil, fmt.Errorf("percentile: p must be a number between 0 and 100, got %s", p)
+	}
+
+	s, err := toSeries("percentile", values)
+	if err != nil {
+		return nil, err
+	}
+	if len(s.ys) == 0 {
+		return starlark.None, nil
+	}
+
+	return starlark.Float(percentile(s.ys, pf)), nil
+}
+
+func stddevBuiltin(thread *starlark.Thread, _ *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	var (
+		values starlark.Value
+		sample bool
+	)
+
+	if err := starlark.UnpackArgs(
+		"stddev",
+		args, kwargs,
+		"series", &values,
+		"sample?", &sample,
+	); err != nil {
+		return nil, fmt.Errorf("unpacking arguments for stddev: %s", err)
+	}
+
+	s, err := toSeries("stddev", values)
+	if err != nil {
+		return nil, err
+	}
+	if len(s.ys) == 0 || (sample && len(s.ys) < 2) {
+		return starlark.None, nil
+	}
+
+	return starlark.Float(stddev(s.ys, sample)), nil
+}
+
+func sma(thread *starlark.Thread, _ *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	var (
+		values starlark.Value
+		window int
+	)
+
+	if err := starlark.UnpackArgs(
+		"sma",
+		args, kwargs,
+		"series", &values,
+		"window", &window,
+	); err != nil {
+		return nil, fmt.Errorf("unpacking arguments for sma: %s", err)
+	}
+
+	if window < 1 {
+		return nil, fmt.Errorf("sma: window must be at least 1, got %d", window)
+	}
+
+	s, err := toSeries("sma", values)
+	if err != nil {
+		return nil, err
+	}
+	if len(s.ys) < window {
+		return starlark.NewList(nil), nil
+	}
+
+	// the first average is of the first full window, and belongs to the
+	// last point in it
+	averages := make([]float64, 0, len(s.ys)-window+1)
+	sum := 0.0
+	for i, y := range s.ys {
+		sum += y
+		if i >= window {
+			sum -= s.ys[i-window]
+		}
+		if i >= window-1 {
+			averages = append(averages, sum/float64(window))
+		}
+	}
+
+	return s.value(averages, window-1), nil
+}
+
+func ema(thread *starlark.Thread, _ *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	var (
+		values starlark.Value
+		span   int
+		alpha  starlark.Value = starlark.None
+	)
+
+	if err := starlark.UnpackArgs(
+		"ema",
+		args, kwargs,
+		"series", &values,
+		"span?", &span,
+		"alpha?", &alpha,
+	); err != nil {
+		return nil, fmt.Errorf("unpacking arguments for ema: %s", err)
+	}
+
+	var a float64
+	switch {
+	case alpha != starlark.None && span != 0:
+		return nil, fmt.Errorf("ema: only one of span and alpha can be given")
+	case alpha != starlark.None:
+		var ok bool
+		a, ok = starlark.AsFloat(alpha)
+		if !ok || a <= 0 || a > 1 {
+			return nil, fmt.Errorf("ema: alpha must be a number above 0 and at most 1, got %s", alpha)
+		}
+	case span >= 1:
+		a = 2 / (float64(span) + 1)
+	default:
+		return nil, fmt.Errorf("ema: span must be at least 1, or alpha given")
+	}
+
+	s, err := toSeries("ema", values)
+	if err != nil {
+		return nil, err
+	}
+
+	// the average starts out as the first value
+	averages := make([]float64, len(s.ys))
+	for i, y := range s.ys {
+		if i == 0 {
+			averages[i] = y
+			continue
+		}
+		averages[i] = a*y + (1-a)*averages[i-1]
+	}
+
+	return s.value(averages, 0), nil
+}
+
+func linearRegression(thread *starlark.Thread, _ *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	var values starlark.Value
+
+	if err := starlark.UnpackArgs(
+		"linear_regression",
+		args, kwargs,
+		"series", &values,
+	); err != nil {
+		return nil, fmt.Errorf("unpacking arguments for linear_regression: %s", err)
+	}
+
+	s, err := toSeries("linear_regression", values)
+	if err != nil {
+		return nil, err
+	}
+	if len(s.ys) < 2 {
+		return starlark.None, nil
+	}
+
+	mx, my := mean(s.xs), mean(s.ys)
+	var sxx, sxy, syy float64
+	for i := range s.xs {
+		dx, dy := s.xs[i]-mx, s.ys[i]-my
+		sxx += dx * dx
+		sxy += dx * dy
+		syy += dy * dy
+	}
+	if sxx == 0 {
+		// all points share an x, so there's no line through them
+		return starlark.None, nil
+	}
+
+	slope := sxy / sxx
+	intercept := my - slope*mx
+
+	r2 := 1.0
+	if syy != 0 {
+		r2 = sxy * sxy / (sxx * syy)
+	}
+
+	return starlarkstruct.FromStringDict(starlarkstruct.Default, starlark.StringDict{
+		"slope":     starlark.Float(slope),
+		"intercept": starlark.Float(intercept),
+		"r2":        starlark.Float(r2),
+	}), nil
+}
+
+func downsample(thread *starlark.Thread, _ *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	var (
+		values    starlark.Value
+		threshold int
+	)
+
+	if err := starlark.UnpackArgs(
+		"downsample",
+		args, kwargs,
+		"series", &values,
+		"threshold", &threshold,
+	); err != nil {
+		return nil, fmt.Errorf("unpacking arguments for downsample: %s", err)
+	}
+
+	if threshold < 3 {
+		return nil, fmt.Errorf("downsample: threshold must be at least 3, got %d", threshold)
+	}
+
+	s, err := toSeries("downsample", values)
+	if err != nil {
+		return nil, err
+	}
+
+	indices := lttb(s.xs, s.ys, threshold)
+
+	result := make([]starlark.Value, len(indices))
+	for i, j := range indices {
+		if s.points {
+			result[i] = starlark.Tuple{s.xValues[j], starlark.Float(s.ys[j])}
+		} else {
+			result[i] = starlark.Float(s.ys[j])
+		}
+	}
+
+	return starlark.NewList(result), nil
+}
+
+// lttb picks the indices of threshold points that preserve the shape of a
+// series, with the Largest-Triangle-Three-Buckets algorithm by Sveinn
+// Steinarsson. The first and last points are always kept.
+func lttb(xs, ys []float64, threshold int) []int {
+	n := len(xs)
+	if threshold >= n {
+		indices := make([]int, n)
+		for i := range indices {
+			indices[i] = i
+		}
+		return indices
+	}
+
+	indices := make([]int, 0, threshold)
+	indices = append(indices, 0)
+
+	// the points between the first and last are split into buckets, and
+	// the point of every bucket that forms the largest triangle with the
+	// point picked before it and the average of the next bucket is picked
+	every := float64(n-2) / float64(threshold-2)
+	a := 0
+	for i := 0; i < threshold-2; i++ {
+		nextStart := int(math.Floor(float64(i+1)*every)) + 1
+		nextEnd := int(math.Floor(float64(i+2)*every)) + 1
+		if nextEnd > n {
+			nextEnd = n
+		}
+
+		var avgX, avgY float64
+		for j := nextStart; j < nextEnd; j++ {
+			avgX += xs[j]
+			avgY += ys[j]
+		}
+		count := float64(nextEnd - nextStart)
+		avgX /= count
+		avgY /= count
+
+		start := int(math.Floor(float64(i)*every)) + 1
+		end := nextStart
+
+		maxArea := -1.0
+		picked := start
+		for j := start; j < end; j++ {
+			area := math.Abs((xs[a]-avgX)*(ys[j]-ys[a]) - (xs[a]-xs[j])*(avgY-ys[a]))
+			if area > maxArea {
+				maxArea = area
+				picked = j
+			}
+		}
+
+		indices = append(indices, picked)
+		a = picked
+	}
+
+	return append(indices, n-1)
+}
+
+func normalize(thread *starlark.Thread, _ *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	var (
+		values starlark.Value
+		low    starlark.Value = starlark.Float(0)
+		high   starlark.Value = starlark.Float(1)
+	)
+
+	if err := starlark.UnpackArgs(
+		"normalize",
+		args, kwargs,
+		"series", &values,
+		"min?", &low,
+		"max?", &high,
+	); err != nil {
+		return nil, fmt.Errorf("unpacking arguments for normalize: %s", err)
+	}
+
+	lo, ok := starlark.AsFloat(low)
+	if !ok {
+		return nil, fmt.Errorf("normalize: min must be a number, got %s", low.Type())
+	}
+	hi, ok := starlark.AsFloat(high)
+	if !ok {
+		return nil, fmt.Errorf("normalize: max must be a number, got %s", high.Type())
+	}
+
+	s, err := toSeries("normalize", values)
+	if err != nil {
+		return nil, err
+	}
+	if len(s.ys) == 0 {
+		return starlark.NewList(nil), nil
+	}
+
+	ymin, ymax := minimum(s.ys), maximum(s.ys)
+	scaled := make([]float64, len(s.ys))
+	for i, y := range s.ys {
+		if ymax == ymin {
+			// a flat series has nowhere to go
+			scaled[i] = lo
+			continue
+		}
+		scaled[i] = lo + (y-ymin)/(ymax-ymin)*(hi-lo)
+	}
+
+	return s.value(scaled, 0), nil
+}
@@ -0,0 +1,125 @@
+package stats_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"tidbyt.dev/pixlet/runtime"
+)
+
+var statsSource = `
+load("stats.star", "stats")
+
+def assert(success, message=None):
+    if not success:
+        fail(message or "assertion failed")
+
+def close(a, b):
+    return abs(a - b) < 1e-9
+
+def close_list(a, b):
+    if len(a) != len(b):
+        return False
+    for x, y in zip(a, b):
+        if type(x) == "tuple":
+            if x[0] != y[0] or not close(x[1], y[1]):
+                return False
+        elif not close(x, y):
+            return False
+    return True
+
+def test_aggregates():
+    values = [3, 1, 4, 1, 5, 9, 2, 6]
+    points = [(i * 10, v) for i, v in enumerate(values)]
+
+    for series in [values, points]:
+        assert(stats.min(series) == 1)
+        assert(stats.max(series) == 9)
+        assert(stats.mean(series) == 3.875)
+        assert(stats.median(series) == 3.5)
+        assert(stats.percentile(series, 0) == 1)
+        assert(stats.percentile(series, 100) == 9)
+        assert(close(stats.percentile(series, 25), 1.75))
+        assert(close(stats.stddev(series), 2.5708704751503917))
+        assert(close(stats.stddev(series, sample = True), 2.748376143938949))
+
+    assert(stats.median([5, 1, 3]) == 3)
+    assert(stats.mean([]) == None)
+    assert(stats.stddev([1], sample = True) == None)
+
+def test_moving_averages():
+    assert(close_list(stats.sma([1, 2, 3, 4, 5], 2), [1.5, 2.5, 3.5, 4.5]))
+    assert(close_list(stats.sma([(0, 1), (1, 2), (2, 3)], 3), [(2, 2.0)]))
+    assert(stats.sma([1, 2], 3) == [])
+
+    assert(close_list(stats.ema([1, 2, 3], alpha = 0.5), [1, 1.5, 2.25]))
+    assert(close_list(stats.ema([(5, 1), (6, 2), (7, 3)], span = 3), [(5, 1), (6, 1.5), (7, 2.25)]))
+
+def test_linear_regression():
+    fit = stats.linear_regression([(0, 1), (1, 3), (2, 5), (3, 7)])
+    assert(close(fit.slope, 2) and close(fit.intercept, 1) and close(fit.r2, 1))
+
+    fit = stats.linear_regression([2, 4, 5, 4, 5])
+    assert(close(fit.slope, 0.6) and close(fit.intercept, 2.8))
+    assert(close(fit.r2, 0.6))
+
+    assert(stats.linear_regression([(1, 1), (1, 2)]) == None)
+
+def test_downsample():
+    # a spike survives downsampling
+    series = [(x, 100 if x == 37 else 0) for x in range(100)]
+    small = stats.downsample(series, 10)
+    assert(len(small) == 10)
+    assert(small[0] == (0, 0) and small[-1] == (99, 0))
+    assert((37, 100) in small)
+
+    values = [float(v) for v in range(10)]
+    assert(stats.downsample(values, 20) == values)
+    assert(len(stats.downsample(values, 4)) == 4)
+
+def test_normalize():
+    assert(close_list(stats.normalize([10, 20, 15]), [0, 1, 0.5]))
+    assert(close_list(stats.normalize([(1, 10), (2, 20)], min = -1, max = 1), [(1, -1), (2, 1)]))
+    assert(stats.normalize([3, 3]) == [0.0, 0.0])
+
+test_aggregates()
+test_moving_averages()
+test_linear_regression()
+test_downsample()
+test_normalize()
+
+def main():
+    return []
+`
+
+func TestStats(t *testing.T) {
+	app, err := runtime.NewApplet("stats_test.star", []byte(statsSource))
+	require.NoError(t, err)
+
+	screens, err := app.Run(context.Background())
+	require.NoError(t, err)
+	assert.NotNil(t, screens)
+}
+
+func TestStatsErrors(t *testing.T) {
+	for _, expr := range []string{
+		`stats.mean("abc")`,
+		`stats.mean([1, (2, 3)])`,
+		`stats.mean([(1, 2), 3])`,
+		`stats.mean([(1, 2, 3)])`,
+		`stats.percentile([1], 101)`,
+		`stats.sma([1], 0)`,
+		`stats.ema([1])`,
+		`stats.ema([1], span = 2, alpha = 0.5)`,
+		`stats.downsample([1, 2, 3], 2)`,
+	} {
+		src := "load(\"stats.star\", \"stats\")\nx = " + expr + "\ndef main():\n    return []\n"
+		app, err := runtime.NewApplet("stats_error.star", []byte(src))
+		if err == nil {
+			_, err = app.Run(context.Background())
+		}
+		assert.Error(t, err, expr)
+	}
+}
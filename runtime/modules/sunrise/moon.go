@@ -0,0 +1,323 @@
+package sunrise
+
+import (
+	"math"
+	"time"
+
+	gosunrise "github.com/nathan-osman/go-sunrise"
+)
+
+// The position of the moon is calculated with the main terms of the
+// series in chapter 47 of Jean Meeus' Astronomical Algorithms, which is
+// good to a few hundredths of a degree, and so to within a minute or so
+// for moonrise and moonset.
+
+const (
+	degree = math.Pi / 180
+
+	// j2000 is the Julian day of the J2000.0 epoch.
+	j2000 = 2451545.0
+
+	// auKilometers is the length of an astronomical unit in kilometers.
+	auKilometers = 149597870.7
+)
+
+// moonTerm is a periodic term of the moon's longitude and distance, or of
+// its latitude, as multiples of the fundamental arguments D, M, M' and F.
+type moonTerm struct {
+	d, m, mp, f float64
+	a, b        float64
+}
+
+// moonLongitudeDistanceTerms are the main terms of Meeus' table 47.A, for
+// longitude in millionths of a degree and distance in meters.
+var moonLongitudeDistanceTerms = []moonTerm{
+	{0, 0, 1, 0, 6288774, -20905355},
+	{2, 0, -1, 0, 1274027, -3699111},
+	{2, 0, 0, 0, 658314, -2955968},
+	{0, 0, 2, 0, 213618, -569925},
+	{0, 1, 0, 0, -185116, 48888},
+	{0, 0, 0, 2, -114332, -3149},
+	{2, 0, -2, 0, 58793, 246158},
+	{2, -1, -1, 0, 57066, -152138},
+	{2, 0, 1, 0, 53322, -170733},
+	{2, -1, 0, 0, 45758, -204586},
+	{0, 1, -1, 0, -40923, -129620},
+	{1, 0, 0, 0, -34720, 108743},
+	{0, 1, 1, 0, -30383, 104755},
+	{2, 0, 0, -2, 15327, 10321},
+	{0, 0, 1, 2, -12528, 0},
+	{0, 0, 1, -2, 10980, 79661},
+	{4, 0, -1, 0, 10675, -34782},
+	{0, 0, 3, 0, 10034, -23210},
+	{4, 0, -2, 0, 8548, -21636},
+	{2, 1, -1, 0, -7888, 24208},
+	{2, 1, 0, 0, -6766, 30824},
+	{1, 0, -1, 0, -5163, -8379},
+	{1, 1, 0, 0, 4987, -16675},
+	{2, -1, 1, 0, 4036, -12831},
+	{2, 0, 2, 0, 3994, -10445},
+	{4, 0, 0, 0, 3861, -11650},
+	{2, 0, -3, 0, 3665, 14403},
+	{0, 1, -2, 0, -2689, -7003},
+	{2, 0, -1, 2, -2602, 0},
+	{2, -1, -2, 0, 2390, 10056},
+	{1, 0, 1, 0, -2348, 6322},
+	{2, -2, 0, 0, 2236, -9884},
+	{0, 1, 2, 0, -2120, 5751},
+	{0, 2, 0, 0, -2069, 0},
+}
+
+// moonLatitudeTerms are the main terms of Meeus' table 47.B, for latitude
+// in millionths of a degree.
+var moonLatitudeTerms = []moonTerm{
+	{0, 0, 0, 1, 5128122, 0},
+	{0, 0, 1, 1, 280602, 0},
+	{0, 0, 1, -1, 277693, 0},
+	{2, 0, 0, -1, 173237, 0},
+	{2, 0, -1, 1, 55413, 0},
+	{2, 0, -1, -1, 46271, 0},
+	{2, 0, 0, 1, 32573, 0},
+	{0, 0, 2, 1, 17198, 0},
+	{2, 0, 1, -1, 9266, 0},
+	{0, 0, 2, -1, 8822, 0},
+	{2, -1, 0, -1, 8216, 0},
+	{2, 0, -2, -1, 4324, 0},
+	{2, 0, 1, 1, 4200, 0},
+	{2, 1, 0, -1, -3359, 0},
+	{2, -1, -1, 1, 2463, 0},
+	{2, -1, 0, 1, 2211, 0},
+	{2, -1, -1, -1, 2065, 0},
+	{0, 1, -1, -1, -1870, 0},
+	{4, 0, -1, -1, 1828, 0},
+	{0, 1, 0, 1, -1794, 0},
+	{0, 0, 0, 3, -1749, 0},
+	{0, 1, -1, 1, -1565, 0},
+	{1, 0, 0, 1, -1491, 0},
+	{0, 1, 1, 1, -1475, 0},
+	{0, 1, 1, -1, -1410, 0},
+	{0, 1, 0, -1, -1344, 0},
+	{1, 0, 0, -1, -1335, 0},
+	{0, 0, 3, 1, 1107, 0},
+	{4, 0, 0, -1, 1021, 0},
+	{4, 0, -1, 1, 833, 0},
+}
+
+// julianEphemerisDay converts a time to a Julian day in dynamical time,
+// which the positions of the sun and moon are calculated in.
+func julianEphemerisDay(t time.Time) float64 {
+	return gosunrise.TimeToJulianDay(t) + deltaT(t)/86400
+}
+
+// deltaT approximates the difference between dynamical time and universal
+// time in seconds, with the polynomials of Espenak and Meeus.
+func deltaT(t time.Time) float64 {
+	y := float64(t.Year()) + (float64(t.YearDay())-0.5)/365.25
+
+	if y >= 2005 && y < 2050 {
+		u := y - 2000
+		return 62.92 + 0.32217*u + 0.005589*u*u
+	}
+
+	u := (y - 1820) / 100
+	return -20 + 32*u*u
+}
+
+// normalize reduces an angle in degrees to [0, 360).
+func normalize(deg float64) float64 {
+	deg = math.Mod(deg, 360)
+	if deg < 0 {
+		deg += 360
+	}
+	return deg
+}
+
+// moonPosition returns the geocentric ecliptic longitude and latitude of
+// the moon in degrees, and its distance in kilometers, at a Julian
+// ephemeris day.
+func moonPosition(jde float64) (lambda, beta, distance float64) {
+	t := (jde - j2000) / 36525
+
+	lp := normalize(218.3164477 + 481267.88123421*t)
+	d := normalize(297.8501921 + 445267.1114034*t)
+	m := normalize(357.5291092 + 35999.0502909*t)
+	mp := normalize(134.9633964 + 477198.8675055*t)
+	f := normalize(93.2720950 + 483202.0175233*t)
+
+	a1 := normalize(119.75 + 131.849*t)
+	a2 := normalize(53.09 + 479264.290*t)
+	a3 := normalize(313.45 + 481266.484*t)
+
+	// the eccentricity of the earth's orbit decreases, which affects the
+	// terms that depend on the sun's anomaly
+	e := 1 - 0.002516*t - 0.0000074*t*t
+	eccentricity := func(term moonTerm) float64 {
+		switch math.Abs(term.m) {
+		case 1:
+			return e
+		case 2:
+			return e * e
+		}
+		return 1
+	}
+
+	var sl, sr, sb float64
+	for _, term := range moonLongitudeDistanceTerms {
+		arg := (term.d*d + term.m*m + term.mp*mp + term.f*f) * degree
+		sl += term.a * eccentricity(term) * math.Sin(arg)
+		sr += term.b * eccentricity(term) * math.Cos(arg)
+	}
+	for _, term := range moonLatitudeTerms {
+		arg := (term.d*d + term.m*m + term.mp*mp + term.f*f) * degree
+		sb += term.a * eccentricity(term) * math.Sin(arg)
+	}
+
+	// additive terms for the action of Venus, Jupiter and the flattening
+	// of the earth
+	sl += 3958*math.Sin(a1*degree) + 1962*math.Sin((lp-f)*degree) + 318*math.Sin(a2*degree)
+	sb += -2235*math.Sin(lp*degree) + 382*math.Sin(a3*degree) +
+		175*math.Sin((a1-f)*degree) + 175*math.Sin((a1+f)*degree) +
+		127*math.Sin((lp-mp)*degree) - 115*math.Sin((lp+mp)*degree)
+
+	lambda = normalize(lp + sl/1e6)
+	beta = sb / 1e6
+	distance = 385000.56 + sr/1000
+
+	return lambda, beta, distance
+}
+
+// sunPosition returns the apparent geocentric ecliptic longitude of the
+// sun in degrees, and its distance in kilometers, at a Julian ephemeris
+// day. See chapter 25 of Astronomical Algorithms.
+func sunPosition(jde float64) (lambda, distance float64) {
+	t := (jde - j2000) / 36525
+
+	l0 := normalize(280.46646 + 36000.76983*t + 0.0003032*t*t)
+	m := normalize(357.52911 + 35999.05029*t - 0.0001537*t*t)
+	e := 0.016708634 - 0.000042037*t - 0.0000001267*t*t
+
+	c := (1.914602-0.004817*t-0.000014*t*t)*math.Sin(m*degree) +
+		(0.019993-0.000101*t)*math.Sin(2*m*degree) +
+		0.000289*math.Sin(3*m*degree)
+
+	v := m + c
+	r := 1.000001018 * (1 - e*e) / (1 + e*math.Cos(v*degree))
+
+	omega := 125.04 - 1934.136*t
+	lambda = normalize(l0 + c - 0.00569 - 0.00478*math.Sin(omega*degree))
+
+	return lambda, r * auKilometers
+}
+
+// moonPhase returns how far the moon is through its cycle of phases, as
+// a fraction where 0 is new moon, 0.25 first quarter, 0.5 full moon and
+// 0.75 last quarter.
+func moonPhase(when time.Time) float64 {
+	jde := julianEphemerisDay(when)
+	moon, _, _ := moonPosition(jde)
+	sun, _ := sunPosition(jde)
+
+	return normalize(moon-sun) / 360
+}
+
+// moonIllumination returns the illuminated fraction of the moon's disk.
+// See chapter 48 of Astronomical Algorithms.
+func moonIllumination(when time.Time) float64 {
+	jde := julianEphemerisDay(when)
+	lambda, beta, delta := moonPosition(jde)
+	sun, r := sunPosition(jde)
+
+	// the geocentric elongation of the moon from the sun, and the phase
+	// angle as seen from the moon
+	psi := math.Acos(math.Cos(beta*degree) * math.Cos((lambda-sun)*degree))
+	i := math.Atan2(r*math.Sin(psi), delta-r*math.Cos(psi))
+
+	return (1 + math.Cos(i)) / 2
+}
+
+// moonAltitude returns how far the moon is above the altitude at which it
+// rises and sets, in degrees, at a location and point in time.
+func moonAltitude(lat, lng float64, when time.Time) float64 {
+	jde := julianEphemerisDay(when)
+	t := (jde - j2000) / 36525
+
+	lambda, beta, delta := moonPosition(jde)
+
+	epsilon := (23.4392911 - 0.0130042*t) * degree
+	alpha := math.Atan2(
+		math.Sin(lambda*degree)*math.Cos(epsilon)-math.Tan(beta*degree)*math.Sin(epsilon),
+		math.Cos(lambda*degree),
+	)
+	dec := math.Asin(
+		math.Sin(beta*degree)*math.Cos(epsilon) +
+			math.Cos(beta*degree)*math.Sin(epsilon)*math.Sin(lambda*degree),
+	)
+
+	// sidereal time at Greenwich, in universal time
+	jd := gosunrise.TimeToJulianDay(when)
+	tu := (jd - j2000) / 36525
+	gmst := 280.46061837 + 360.98564736629*(jd-j2000) + 0.000387933*tu*tu - tu*tu*tu/38710000
+
+	h := (gmst+lng)*degree - alpha
+	altitude := math.Asin(
+		math.Sin(lat*degree)*math.Sin(dec)+
+			math.Cos(lat*degree)*math.Cos(dec)*math.Cos(h),
+	) / degree
+
+	// the moon rises when its upper limb crosses the horizon, which is
+	// affected by parallax, refraction and the moon's semidiameter
+	parallax := math.Asin(6378.14/delta) / degree
+	h0 := 0.7275*parallax - 34.0/60
+
+	return altitude - h0
+}
+
+// moonRiseSet returns when the moon rises and sets during the day of
+// date, in the location of date. Either is the zero time if the moon
+// doesn't rise or set that day.
+func moonRiseSet(lat, lng float64, date time.Time) (rise, set time.Time) {
+	start := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, date.Location())
+	end := start.AddDate(0, 0, 1)
+
+	// the moon's altitude changes by at most a few degrees in ten minutes,
+	// so it can't cross the horizon twice within a step
+	const step = 10 * time.Minute
+
+	prevTime := start
+	prev := moonAltitude(lat, lng, prevTime)
+	for prevTime.Before(end) {
+		nextTime := prevTime.Add(step)
+		if nextTime.After(end) {
+			nextTime = end
+		}
+		next := moonAltitude(lat, lng, nextTime)
+
+		if prev < 0 && next >= 0 && rise.IsZero() {
+			rise = moonCrossing(lat, lng, prevTime, nextTime)
+		}
+		if prev >= 0 && next < 0 && set.IsZero() {
+			set = moonCrossing(lat, lng, prevTime, nextTime)
+		}
+
+		prevTime, prev = nextTime, next
+	}
+
+	return rise, set
+}
+
+// moonCrossing narrows down when the moon crosses the horizon between a
+// and b, to the second.
+func moonCrossing(lat, lng float64, a, b time.Time) time.Time {
+	above := moonAltitude(lat, lng, a) >= 0
+
+	for b.Sub(a) > time.Second {
+		mid := a.Add(b.Sub(a) / 2)
+		if (moonAltitude(lat, lng, mid) >= 0) == above {
+			a = mid
+		} else {
+			b = mid
+		}
+	}
+
+	return b.Truncate(time.Second).UTC()
+}
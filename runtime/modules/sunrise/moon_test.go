@@ -0,0 +1,81 @@
+package sunrise
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMoonPosition(t *testing.T) {
+	// example 47.a of Astronomical Algorithms, for 1992 April 12 at 0h TD
+	lambda, beta, distance := moonPosition(2448724.5)
+	assert.InDelta(t, 133.162655, lambda, 0.01)
+	assert.InDelta(t, -3.229126, beta, 0.01)
+	assert.InDelta(t, 368409.7, distance, 50)
+}
+
+func TestMoonIllumination(t *testing.T) {
+	// example 48.a of Astronomical Algorithms, for 1992 April 12 at 0h TD
+	when := time.Date(1992, 4, 12, 0, 0, 0, 0, time.UTC).Add(-time.Duration(deltaT(time.Date(1992, 4, 12, 0, 0, 0, 0, time.UTC)) * float64(time.Second)))
+	assert.InDelta(t, 0.6786, moonIllumination(when), 0.001)
+}
+
+func TestMoonPhase(t *testing.T) {
+	// times of lunar phases as published by the US Naval Observatory
+	for _, tc := range []struct {
+		when  time.Time
+		phase float64
+	}{
+		{time.Date(2024, 1, 11, 11, 57, 0, 0, time.UTC), 0},
+		{time.Date(2024, 1, 18, 3, 52, 0, 0, time.UTC), 0.25},
+		{time.Date(2024, 1, 25, 17, 54, 0, 0, time.UTC), 0.5},
+		{time.Date(2024, 4, 8, 18, 21, 0, 0, time.UTC), 0},
+		{time.Date(2023, 8, 31, 1, 36, 0, 0, time.UTC), 0.5},
+		{time.Date(2024, 9, 18, 2, 34, 0, 0, time.UTC), 0.5},
+	} {
+		phase := moonPhase(tc.when)
+
+		// the moon moves about half a degree an hour relative to the
+		// sun, so this is within a few minutes of the published time
+		diff := math.Abs(phase - tc.phase)
+		diff = math.Min(diff, 1-diff)
+		assert.Less(t, diff, 0.0002, tc.when)
+
+		illumination := moonIllumination(tc.when)
+		switch tc.phase {
+		case 0:
+			assert.Less(t, illumination, 0.005, tc.when)
+		case 0.25:
+			assert.InDelta(t, 0.5, illumination, 0.01, tc.when)
+		case 0.5:
+			assert.Greater(t, illumination, 0.995, tc.when)
+		}
+	}
+}
+
+func TestMoonRiseSet(t *testing.T) {
+	ny, err := time.LoadLocation("America/New_York")
+	assert.NoError(t, err)
+
+	// on the day of the full moon, the moon rises around sunset and sets
+	// around sunrise
+	rise, set := moonRiseSet(40.7128, -74.0060, time.Date(2024, 1, 25, 12, 0, 0, 0, ny))
+	assert.Equal(t, time.Date(2024, 1, 25, 21, 55, 0, 0, time.UTC), rise.Truncate(5*time.Minute))
+	assert.Equal(t, time.Date(2024, 1, 25, 12, 30, 0, 0, time.UTC), set.Truncate(5*time.Minute))
+
+	// and the moon is on the horizon at those times
+	assert.InDelta(t, 0, moonAltitude(40.7128, -74.0060, rise), 0.01)
+	assert.InDelta(t, 0, moonAltitude(40.7128, -74.0060, set), 0.01)
+
+	// on the day of the new moon, it rises and sets with the sun
+	rise, set = moonRiseSet(40.7128, -74.0060, time.Date(2024, 4, 8, 12, 0, 0, 0, ny))
+	assert.Equal(t, time.Date(2024, 4, 8, 10, 20, 0, 0, time.UTC), rise.Truncate(5*time.Minute))
+	assert.Equal(t, time.Date(2024, 4, 8, 23, 40, 0, 0, time.UTC), set.Truncate(5*time.Minute))
+
+	// near the poles, the moon can stay up or down all day
+	rise, set = moonRiseSet(89, 0, time.Date(2024, 1, 25, 0, 0, 0, 0, time.UTC))
+	assert.True(t, rise.IsZero())
+	assert.True(t, set.IsZero())
+}
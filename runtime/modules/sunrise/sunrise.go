@@ -15,6 +15,19 @@ const (
 	ModuleName = "sunrise"
 )
 
+// Elevations of the sun in degrees that mark the start and end of
+// twilight, golden hour and blue hour.
+const (
+	CivilTwilightElevation        = -6.0
+	NauticalTwilightElevation     = -12.0
+	AstronomicalTwilightElevation = -18.0
+
+	GoldenHourLow  = -4.0
+	GoldenHourHigh = 6.0
+	BlueHourLow    = -6.0
+	BlueHourHigh   = -4.0
+)
+
 var (
 	once   sync.Once
 	module starlark.StringDict
@@ -31,6 +44,19 @@ func LoadModule() (starlark.StringDict, error) {
 					"sunset":         starlark.NewBuiltin("sunset", sunset),
 					"elevation":      starlark.NewBuiltin("elevation", elevation),
 					"elevation_time": starlark.NewBuiltin("elevation_time", elevation_time),
+
+					"civil_twilight":        starlark.NewBuiltin("civil_twilight", twilight("civil_twilight", CivilTwilightElevation)),
+					"nautical_twilight":     starlark.NewBuiltin("nautical_twilight", twilight("nautical_twilight", NauticalTwilightElevation)),
+					"astronomical_twilight": starlark.NewBuiltin("astronomical_twilight", twilight("astronomical_twilight", AstronomicalTwilightElevation)),
+					"solar_noon":            starlark.NewBuiltin("solar_noon", solarNoon),
+					"day_length":            starlark.NewBuiltin("day_length", dayLength),
+					"golden_hour":           starlark.NewBuiltin("golden_hour", hour("golden_hour", GoldenHourLow, GoldenHourHigh)),
+					"blue_hour":             starlark.NewBuiltin("blue_hour", hour("blue_hour", BlueHourLow, BlueHourHigh)),
+
+					"moon_phase":        starlark.NewBuiltin("moon_phase", moonPhaseBuiltin),
+					"moon_illumination": starlark.NewBuiltin("moon_illumination", moonIlluminationBuiltin),
+					"moonrise":          starlark.NewBuiltin("moonrise", moonrise),
+					"moonset":           starlark.NewBuiltin("moonset", moonset),
 				},
 			},
 		}
@@ -153,3 +179,215 @@ func elevation_time(thread *starlark.Thread, _ *starlark.Builtin, args starlark.
 
 	return starlark.Tuple([]starlark.Value{starMorning, starEvening}), nil
 }
+
+// twilight is a factory function for builtins that return when twilight
+// starts in the morning and ends in the evening, which is when the sun is
+// at the given elevation.
+func twilight(name string, elev float64) func(*starlark.Thread, *starlark.Builtin, starlark.Tuple, []starlark.Tuple) (starlark.Value, error) {
+	return func(thread *starlark.Thread, _ *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+		var (
+			starLat  starlark.Float
+			starLng  starlark.Float
+			starDate startime.Time
+		)
+
+		if err := starlark.UnpackArgs(
+			name,
+			args, kwargs,
+			"lat", &starLat,
+			"lng", &starLng,
+			"date", &starDate,
+		); err != nil {
+			return nil, fmt.Errorf("unpacking arguments for %s: %s", name, err)
+		}
+
+		date := time.Time(starDate)
+		dawn, dusk := gosunrise.TimeOfElevation(float64(starLat), float64(starLng), elev, date.Year(), date.Month(), date.Day())
+		if dawn == empty || dusk == empty {
+			return starlark.None, nil
+		}
+
+		return starlark.Tuple{startime.Time(dawn), startime.Time(dusk)}, nil
+	}
+}
+
+// hour is a factory function for builtins that return when the sun passes
+// between two elevations, in the morning and in the evening.
+func hour(name string, low, high float64) func(*starlark.Thread, *starlark.Builtin, starlark.Tuple, []starlark.Tuple) (starlark.Value, error) {
+	return func(thread *starlark.Thread, _ *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+		var (
+			starLat  starlark.Float
+			starLng  starlark.Float
+			starDate startime.Time
+		)
+
+		if err := starlark.UnpackArgs(
+			name,
+			args, kwargs,
+			"lat", &starLat,
+			"lng", &starLng,
+			"date", &starDate,
+		); err != nil {
+			return nil, fmt.Errorf("unpacking arguments for %s: %s", name, err)
+		}
+
+		lat := float64(starLat)
+		lng := float64(starLng)
+		date := time.Time(starDate)
+
+		lowMorning, lowEvening := gosunrise.TimeOfElevation(lat, lng, low, date.Year(), date.Month(), date.Day())
+		highMorning, highEvening := gosunrise.TimeOfElevation(lat, lng, high, date.Year(), date.Month(), date.Day())
+		if lowMorning == empty || highMorning == empty {
+			return starlark.None, nil
+		}
+
+		return starlark.Tuple{
+			starlark.Tuple{startime.Time(lowMorning), startime.Time(highMorning)},
+			starlark.Tuple{startime.Time(highEvening), startime.Time(lowEvening)},
+		}, nil
+	}
+}
+
+// transit returns when the sun is highest in the sky on a given day, as
+// a Julian day.
+func transit(lng float64, date time.Time) float64 {
+	d := gosunrise.MeanSolarNoon(lng, date.Year(), date.Month(), date.Day())
+	solarAnomaly := gosunrise.SolarMeanAnomaly(d)
+	equationOfCenter := gosunrise.EquationOfCenter(solarAnomaly)
+	eclipticLongitude := gosunrise.EclipticLongitude(solarAnomaly, equationOfCenter, d)
+	return gosunrise.SolarTransit(d, solarAnomaly, eclipticLongitude)
+}
+
+func solarNoon(thread *starlark.Thread, _ *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	var (
+		starLat  starlark.Float
+		starLng  starlark.Float
+		starDate startime.Time
+	)
+
+	if err := starlark.UnpackArgs(
+		"solar_noon",
+		args, kwargs,
+		"lat", &starLat,
+		"lng", &starLng,
+		"date", &starDate,
+	); err != nil {
+		return nil, fmt.Errorf("unpacking arguments for solar_noon: %s", err)
+	}
+
+	noon := gosunrise.JulianDayToTime(transit(float64(starLng), time.Time(starDate)))
+	return startime.Time(noon), nil
+}
+
+func dayLength(thread *starlark.Thread, _ *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	var (
+		starLat  starlark.Float
+		starLng  starlark.Float
+		starDate startime.Time
+	)
+
+	if err := starlark.UnpackArgs(
+		"day_length",
+		args, kwargs,
+		"lat", &starLat,
+		"lng", &starLng,
+		"date", &starDate,
+	); err != nil {
+		return nil, fmt.Errorf("unpacking arguments for day_length: %s", err)
+	}
+
+	lat := float64(starLat)
+	lng := float64(starLng)
+	date := time.Time(starDate)
+
+	rise, set := gosunrise.SunriseSunset(lat, lng, date.Year(), date.Month(), date.Day())
+	if rise != empty && set != empty {
+		return startime.Duration(set.Sub(rise)), nil
+	}
+
+	// the sun doesn't rise or set, so it's either up or down all day
+	noon := gosunrise.JulianDayToTime(transit(lng, date))
+	if gosunrise.Elevation(lat, lng, noon) > 0 {
+		return startime.Duration(24 * time.Hour), nil
+	}
+
+	return startime.Duration(0), nil
+}
+
+func moonPhaseBuiltin(thread *starlark.Thread, _ *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	var starTime startime.Time
+
+	if err := starlark.UnpackArgs(
+		"moon_phase",
+		args, kwargs,
+		"time", &starTime,
+	); err != nil {
+		return nil, fmt.Errorf("unpacking arguments for moon_phase: %s", err)
+	}
+
+	return starlark.Float(moonPhase(time.Time(starTime))), nil
+}
+
+func moonIlluminationBuiltin(thread *starlark.Thread, _ *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	var starTime startime.Time
+
+	if err := starlark.UnpackArgs(
+		"moon_illumination",
+		args, kwargs,
+		"time", &starTime,
+	); err != nil {
+		return nil, fmt.Errorf("unpacking arguments for moon_illumination: %s", err)
+	}
+
+	return starlark.Float(moonIllumination(time.Time(starTime))), nil
+}
+
+func moonrise(thread *starlark.Thread, _ *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	var (
+		starLat  starlark.Float
+		starLng  starlark.Float
+		starDate startime.Time
+	)
+
+	if err := starlark.UnpackArgs(
+		"moonrise",
+		args, kwargs,
+		"lat", &starLat,
+		"lng", &starLng,
+		"date", &starDate,
+	); err != nil {
+		return nil, fmt.Errorf("unpacking arguments for moonrise: %s", err)
+	}
+
+	rise, _ := moonRiseSet(float64(starLat), float64(starLng), time.Time(starDate))
+	if rise.IsZero() {
+		return starlark.None, nil
+	}
+
+	return startime.Time(rise), nil
+}
+
+func moonset(thread *starlark.Thread, _ *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	var (
+		starLat  starlark.Float
+		starLng  starlark.Float
+		starDate startime.Time
+	)
+
+	if err := starlark.UnpackArgs(
+		"moonset",
+		args, kwargs,
+		"lat", &starLat,
+		"lng", &starLng,
+		"date", &starDate,
+	); err != nil {
+		return nil, fmt.Errorf("unpacking arguments for moonset: %s", err)
+	}
+
+	_, set := moonRiseSet(float64(starLat), float64(starLng), time.Time(starDate))
+	if set.IsZero() {
+		return starlark.None, nil
+	}
+
+	return startime.Time(set), nil
+}
@@ -59,3 +59,122 @@ func TestSunrise(t *testing.T) {
 	assert.NoError(t, err)
 	assert.NotNil(t, screens)
 }
+
+var astronomySource = `
+load("time.star", "time")
+load("sunrise.star", "sunrise")
+
+def assert(success, message=None):
+    if not success:
+        fail(message or "assertion failed")
+
+def abs(x):
+    if x > 0:
+        return x
+    return -x
+
+def near(t, expected, seconds = 90):
+    assert(abs(t.unix - time.parse_time(expected).unix) < seconds, "%s is not near %s" % (t, expected))
+
+def check():
+    lat = 40.6781784
+    lng = -73.9441579
+    date = time.parse_time("2022-01-15T22:40:24Z")
+
+    # Twilight starts and ends when the sun is at a given elevation.
+    for twilight, elev in [
+        (sunrise.civil_twilight, -6.0),
+        (sunrise.nautical_twilight, -12.0),
+        (sunrise.astronomical_twilight, -18.0),
+    ]:
+        dawn, dusk = twilight(lat, lng, date)
+        assert(abs(sunrise.elevation(lat, lng, dawn) - elev) < 0.05)
+        assert(abs(sunrise.elevation(lat, lng, dusk) - elev) < 0.05)
+        assert(dawn < sunrise.sunrise(lat, lng, date))
+        assert(dusk > sunrise.sunset(lat, lng, date))
+
+    # Reference times from the equations of NOAA's Solar Calculator,
+    # https://gml.noaa.gov/grad/solcalc/calcdetails.html, which are good
+    # to about a minute.
+    dawn, dusk = sunrise.civil_twilight(lat, lng, date)
+    near(dawn, "2022-01-15T11:47:28Z")
+    near(dusk, "2022-01-15T22:23:20Z")
+    dawn, dusk = sunrise.nautical_twilight(lat, lng, date)
+    near(dawn, "2022-01-15T11:13:45Z")
+    near(dusk, "2022-01-15T22:57:04Z")
+    dawn, dusk = sunrise.astronomical_twilight(lat, lng, date)
+    near(dawn, "2022-01-15T10:40:58Z")
+    near(dusk, "2022-01-15T23:29:51Z")
+
+    near(sunrise.solar_noon(lat, lng, date), "2022-01-15T17:05:15Z")
+    length = sunrise.day_length(lat, lng, date)
+    assert(length > time.parse_duration("9h34m10s") and length < time.parse_duration("9h37m10s"))
+
+    # London has no astronomical night around the summer solstice.
+    assert(sunrise.astronomical_twilight(51.5, -0.13, time.parse_time("2024-06-21T12:00:00Z")) == None)
+
+    # Golden hour is when the sun is between -4 and 6 degrees, and blue hour
+    # when it's between -6 and -4 degrees.
+    morning, evening = sunrise.golden_hour(lat, lng, date)
+    assert(abs(sunrise.elevation(lat, lng, morning[0]) + 4) < 0.05)
+    assert(abs(sunrise.elevation(lat, lng, morning[1]) - 6) < 0.05)
+    assert(abs(sunrise.elevation(lat, lng, evening[0]) - 6) < 0.05)
+    assert(abs(sunrise.elevation(lat, lng, evening[1]) + 4) < 0.05)
+    near(morning[0], "2022-01-15T11:58:59Z")
+    near(morning[1], "2022-01-15T12:59:36Z")
+    near(evening[0], "2022-01-15T21:11:12Z")
+    near(evening[1], "2022-01-15T22:11:50Z")
+
+    morning, evening = sunrise.blue_hour(lat, lng, date)
+    assert(morning[0] < morning[1] and morning[1] == sunrise.golden_hour(lat, lng, date)[0][0])
+    assert(evening[0] < evening[1] and evening[0] == sunrise.golden_hour(lat, lng, date)[1][1])
+    near(morning[0], "2022-01-15T11:47:28Z")
+    near(evening[1], "2022-01-15T22:23:20Z")
+
+    # The sun never gets 6 degrees high at the north pole in winter.
+    assert(sunrise.golden_hour(89.0, 0.0, date) == None)
+
+    # Solar noon on the prime meridian is offset by the equation of time,
+    # which peaks at about -14m15s in February and +16m25s in November.
+    near(sunrise.solar_noon(0.0, 0.0, time.parse_time("2024-02-11T00:00:00Z")), "2024-02-11T12:14:13Z", 60)
+    near(sunrise.solar_noon(0.0, 0.0, time.parse_time("2024-11-03T00:00:00Z")), "2024-11-03T11:43:30Z", 60)
+
+    # Days at the equator are a few minutes longer than 12 hours, because of
+    # refraction and the size of the sun.
+    length = sunrise.day_length(0.0, 0.0, time.parse_time("2024-03-20T00:00:00Z"))
+    assert(length > time.parse_duration("12h5m31s") and length < time.parse_duration("12h7m31s"))
+
+    # Polar day and night.
+    midsummer = time.parse_time("2024-06-21T00:00:00Z")
+    assert(sunrise.day_length(78.2, 15.6, midsummer) == time.parse_duration("24h"))
+    assert(sunrise.day_length(-78.2, 15.6, midsummer) == time.parse_duration("0s"))
+
+    # The moon is full at 17:54 UTC on 2024-01-25, according to the phases
+    # published by the US Naval Observatory at
+    # https://aa.usno.navy.mil/data/MoonPhases. Being opposite the sun, a
+    # full moon rises around sunset and sets around sunrise.
+    full = time.parse_time("2024-01-25T17:54:00Z")
+    assert(abs(sunrise.moon_phase(full) - 0.5) < 0.001)
+    assert(sunrise.moon_illumination(full) > 0.99)
+
+    ny = time.time(year = 2024, month = 1, day = 25, location = "America/New_York")
+    rise = sunrise.moonrise(40.7128, -74.0060, ny)
+    assert(abs(rise.unix - sunrise.sunset(40.7128, -74.0060, ny).unix) < 3600)
+    assert(abs(sunrise.moonset(40.7128, -74.0060, ny).unix - sunrise.sunrise(40.7128, -74.0060, ny).unix) < 3600)
+    assert(sunrise.moonset(40.7128, -74.0060, ny) < rise)
+    assert(sunrise.moonrise(89.0, 0.0, ny) == None)
+
+check()
+
+def main():
+    return []
+`
+
+func TestAstronomy(t *testing.T) {
+	app, err := runtime.NewApplet("astronomy.star", []byte(astronomySource))
+	assert.NoError(t, err)
+
+	screens, err := app.Run(context.Background())
+	assert.NoError(t, err)
+	assert.NotNil(t, screens)
+}
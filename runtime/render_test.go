@@ -8,6 +8,7 @@ import (
 	"image"
 	"image/color"
 	"image/png"
+	"math"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -215,6 +216,116 @@ def main():
 	assert.Equal(t, text.Height, rendered.Bounds().Dy())
 }
 
+func TestRichText(t *testing.T) {
+	const (
+		filename = "test_rich_text.star"
+		src      = `
+load("render.star", "render")
+t = render.RichText(
+	spans = [
+		render.Span("72", color = "#fa0", bold = True),
+		"°F",
+	],
+	width = 30,
+)
+def main():
+    return render.Root(child=t)
+`
+	)
+
+	app, err := NewApplet(filename, []byte(src))
+	require.NoError(t, err)
+
+	txt := app.Globals[filename]["t"]
+	assert.IsType(t, &render_runtime.RichText{}, txt)
+
+	text := txt.(*render_runtime.RichText).AsRenderWidget().(*render.RichText)
+	require.Len(t, text.Spans, 2)
+	assert.Equal(t, "72", text.Spans[0].Content)
+	assert.True(t, text.Spans[0].Bold)
+	assert.Equal(t, "°F", text.Spans[1].Content)
+	assert.Nil(t, text.Spans[1].Color)
+
+	_, err = NewApplet(filename, []byte(`
+load("render.star", "render")
+t = render.RichText(spans = [render.Text("hi")])
+def main():
+    return render.Root(child=t)
+`))
+	assert.ErrorContains(t, err, "expected spans to be a list of Span or str but found: Text (at index 0)")
+}
+
+func TestWrappedTextTruncated(t *testing.T) {
+	const (
+		filename = "test_wrapped_text.star"
+		src      = `
+load("assert.star", "assert")
+load("render.star", "render")
+short = render.WrappedText("Breaking news", width = 64, max_lines = 1)
+long = render.WrappedText("Breaking news from everywhere", width = 64, max_lines = 1, overflow = "ellipsis")
+assert.eq(short.truncated, False)
+assert.eq(long.truncated, True)
+assert.eq(long.max_lines, 1)
+assert.eq(long.overflow, "ellipsis")
+def main():
+    return render.Root(child=long)
+`
+	)
+
+	app, err := NewApplet(filename, []byte(src))
+	require.NoError(t, err)
+
+	roots, err := app.Run(context.Background())
+	require.NoError(t, err)
+	require.Len(t, roots, 1)
+
+	_, err = NewApplet(filename, []byte(`
+load("render.star", "render")
+t = render.WrappedText("hi", overflow = "scroll")
+def main():
+    return render.Root(child=t)
+`))
+	assert.ErrorContains(t, err, "invalid overflow 'scroll'")
+}
+
+func TestTextStyle(t *testing.T) {
+	const (
+		filename = "test_text_style.star"
+		src      = `
+load("assert.star", "assert")
+load("render.star", "render")
+plain = render.Text("12:05")
+styled = render.Text("12:05", letter_spacing = 1, tabular_numbers = True, shadow_color = "#400", shadow_offset = 2)
+tuple = render.WrappedText("12:05", outline_color = "#a00", shadow_color = "#400", shadow_offset = (-1, 2))
+assert.eq(plain.shadow_offset, None)
+assert.eq(styled.shadow_offset, 2)
+assert.eq(tuple.shadow_offset, (-1, 2))
+assert.eq(styled.letter_spacing, 1)
+assert.eq(styled.tabular_numbers, True)
+assert.eq(styled.size()[0], plain.size()[0] + 5 + 2)
+def main():
+    return render.Root(child=render.Column(children=[styled, tuple]))
+`
+	)
+
+	app, err := NewApplet(filename, []byte(src))
+	require.NoError(t, err)
+
+	roots, err := app.Run(context.Background())
+	require.NoError(t, err)
+	require.Len(t, roots, 1)
+
+	for _, offset := range []string{`"down"`, `(1, 2, 3)`, `(1, "2")`} {
+		_, err = NewApplet(filename, []byte(fmt.Sprintf(`
+load("render.star", "render")
+t = render.Text("hi", shadow_color = "#400", shadow_offset = %s)
+def main():
+    return render.Root(child=t)
+`, offset)))
+		assert.ErrorContains(t, err, "shadow_offset")
+	}
+}
+
 func TestImage(t *testing.T) {
 	// create a new PNG with a single blue pixel
 	bounds := image.Rect(0, 0, 64, 32)
@@ -247,3 +358,316 @@ def main():
 	assert.Equal(t, bounds, actualIm.Bounds())
 	assert.Equal(t, blue, actualIm.At(12, 12))
 }
+
+func TestMeasure(t *testing.T) {
+	const (
+		filename = "test_measure.star"
+		src      = `
+load("assert.star", "assert")
+load("render.star", "render")
+
+m = render.measure_text("Hello", "tom-thumb")
+assert.eq(m.width, render.Text("Hello", font = "tom-thumb").size()[0])
+assert.eq(m.height, m.ascent + m.descent)
+assert.true(render.measure_text("Hello").width > m.width)
+
+row = render.Row(children = [render.Box(width = 10, height = 4), render.Box(width = 20, height = 6)])
+assert.eq(render.size(row), (30, 6))
+assert.eq(render.size(render.Box()), (64, 32))
+assert.eq(render.size(render.Box(), max_width = 20, max_height = 10), (20, 10))
+
+def main():
+    return render.Root(child = row)
+`
+	)
+
+	app, err := NewApplet(filename, []byte(src))
+	require.NoError(t, err)
+
+	roots, err := app.Run(context.Background())
+	require.NoError(t, err)
+	require.Len(t, roots, 1)
+
+	_, err = NewApplet(filename, []byte(`
+load("render.star", "render")
+s = render.size(render.Root(child = render.Box()))
+def main():
+    return render.Root(child = render.Box())
+`))
+	assert.ErrorContains(t, err, "expected widget to be a Widget but found: Root")
+
+	_, err = NewApplet(filename, []byte(`
+load("render.star", "render")
+m = render.measure_text("hi", "comic-sans")
+def main():
+    return render.Root(child = render.Box())
+`))
+	assert.ErrorContains(t, err, "measuring text")
+}
+
+func TestTable(t *testing.T) {
+	const (
+		filename = "test_table.star"
+		src      = `
+load("render.star", "render")
+t = render.Table(
+	columns = [
+		render.TableColumn(width = "1fr", overflow = "ellipsis"),
+		render.TableColumn(width = 14, align = "right"),
+	],
+	rows = [
+		[render.Text("Arsenal"), render.Text("72")],
+		(render.Text("Chelsea"), None),
+		[None, render.TableCell(render.Text("-"), align = "center")],
+	],
+	row_colors = ["#000", "#222"],
+)
+def main():
+    return render.Root(child=t)
+`
+	)
+
+	app, err := NewApplet(filename, []byte(src))
+	require.NoError(t, err)
+
+	tbl := app.Globals[filename]["t"]
+	require.IsType(t, &render_runtime.Table{}, tbl)
+
+	table := tbl.(*render_runtime.Table).AsRenderWidget().(*render.Table)
+	require.Len(t, table.Columns, 2)
+	assert.Equal(t, render.ColumnWidth("1fr"), table.Columns[0].Width)
+	assert.Equal(t, render.ColumnWidth("14"), table.Columns[1].Width)
+	assert.Equal(t, "right", table.Columns[1].Align)
+
+	require.Len(t, table.Rows, 3)
+	assert.IsType(t, &render.Text{}, table.Rows[0][0].Child)
+	assert.Nil(t, table.Rows[1][1].Child)
+	assert.Nil(t, table.Rows[2][0].Child)
+	assert.Equal(t, "center", table.Rows[2][1].Align)
+	assert.Len(t, table.RowColors, 2)
+
+	assert.Equal(t, image.Rect(0, 0, 64, 24), render.PaintWidget(table, image.Rect(0, 0, 64, 32), 0).Bounds())
+
+	for _, tc := range []struct {
+		src string
+		err string
+	}{
+		{`render.Table(rows = [render.Text("hi")])`, "expected rows to be a list of lists but found: Text (at index 0)"},
+		{`render.Table(rows = [["hi"]])`, "expected rows to hold Widget, TableCell or None but found: string (at row 0, column 0)"},
+		{`render.Table(rows = [], columns = [render.Text("hi")])`, "expected columns to be a list of TableColumn but found: Text (at index 0)"},
+		{`render.TableColumn(width = "wide")`, "invalid column width 'wide'"},
+		{`render.TableColumn(width = 1.5)`, "width must be int or str"},
+		{`render.TableCell(render.Text("hi"), vertical_align = "middle")`, "invalid vertical_align 'middle'"},
+	} {
+		_, err = NewApplet(filename, []byte(fmt.Sprintf(`
+load("render.star", "render")
+t = %s
+def main():
+    return render.Root(child = render.Box())
+`, tc.src)))
+		assert.ErrorContains(t, err, tc.err)
+	}
+}
+
+func TestFlex(t *testing.T) {
+	const (
+		filename = "test_flex.star"
+		src      = `
+load("assert.star", "assert")
+load("render.star", "render")
+label = render.Flex(render.Text("Label"))
+r = render.Row(
+	gap = 2,
+	shrink = True,
+	children = [label, render.Flex(render.Text("42"), weight = 2, min_size = 10, max_size = 20)],
+)
+assert.eq(label.weight, None)
+assert.eq(r.children[1].weight, 2)
+assert.eq(r.children[1].min_size, 10)
+assert.eq(r.gap, 2)
+assert.eq(r.shrink, True)
+assert.eq(render.size(r), (64, 8))
+def main():
+    return render.Root(child = r)
+`
+	)
+
+	app, err := NewApplet(filename, []byte(src))
+	require.NoError(t, err)
+
+	row := app.Globals[filename]["r"].(*render_runtime.Row).AsRenderWidget().(*render.Row)
+	assert.Equal(t, 2, row.Gap)
+	assert.True(t, row.Shrink)
+	require.IsType(t, &render.Flex{}, row.Children[1])
+	assert.Equal(t, 2.0, row.Children[1].(*render.Flex).Weight)
+
+	_, err = NewApplet(filename, []byte(`
+load("render.star", "render")
+f = render.Flex(render.Text("hi"), min_size = 10, max_size = 5)
+def main():
+    return render.Root(child = f)
+`))
+	assert.ErrorContains(t, err, "max_size 5 is less than min_size 10")
+}
+
+func TestBarChart(t *testing.T) {
+	const (
+		filename = "test_bar_chart.star"
+		src      = `
+load("assert.star", "assert")
+load("render.star", "render")
+single = render.BarChart(data = [1, None, 3], width = 10, height = 8)
+grouped = render.BarChart(
+	data = [[1, 2], [3, -4.5]],
+	width = 10,
+	height = 8,
+	colors = ["#f00", "#0f0"],
+	stacked = True,
+	value_lim = (-5, None),
+)
+assert.eq(single.data, [1, None, 3])
+assert.eq(grouped.stacked, True)
+assert.eq(render.size(grouped), (10, 8))
+def main():
+    return render.Root(child = grouped)
+`
+	)
+
+	app, err := NewApplet(filename, []byte(src))
+	require.NoError(t, err)
+
+	single := app.Globals[filename]["single"].(*render_runtime.BarChart).AsRenderWidget().(*render.BarChart)
+	require.Len(t, single.Data, 1)
+	assert.Equal(t, 1.0, single.Data[0][0])
+	assert.True(t, math.IsNaN(single.Data[0][1]))
+
+	grouped := app.Globals[filename]["grouped"].(*render_runtime.BarChart).AsRenderWidget().(*render.BarChart)
+	assert.Equal(t, [][]float64{{1, 2}, {3, -4.5}}, grouped.Data)
+	assert.Equal(t, -5.0, grouped.ValueLim[0])
+	assert.True(t, math.IsNaN(grouped.ValueLim[1]))
+
+	_, err = NewApplet(filename, []byte(`
+load("render.star", "render")
+c = render.BarChart(data = [[1, 2], 3], width = 10, height = 8)
+def main():
+    return render.Root(child = c)
+`))
+	assert.ErrorContains(t, err, "expected a list")
+}
+
+func TestGauge(t *testing.T) {
+	const (
+		filename = "test_gauge.star"
+		src      = `
+load("assert.star", "assert")
+load("render.star", "render")
+bar = render.ProgressBar(
+	value = 42,
+	width = 20,
+	height = 4,
+	thresholds = [50, 80],
+	colors = ["#0f0", "#ff0", "#f00"],
+)
+gauge = render.Gauge(value = 0.5, diameter = 16, max = 1, sweep = 180)
+half = render.ProgressBar(value = 75, min = 50, width = 10, height = 1)
+assert.eq(bar.value, 42)
+assert.eq(bar.thresholds, [50, 80])
+assert.eq(bar.min, None)
+assert.eq(gauge.max, 1)
+assert.eq(render.size(bar), (20, 4))
+assert.eq(render.size(gauge), (16, 16))
+def main():
+    return render.Root(child = render.Row(children = [bar, gauge]))
+`
+	)
+
+	app, err := NewApplet(filename, []byte(src))
+	require.NoError(t, err)
+
+	bar := app.Globals[filename]["bar"].(*render_runtime.ProgressBar).AsRenderWidget().(*render.ProgressBar)
+	assert.Equal(t, 42.0, bar.Value)
+	assert.Equal(t, []float64{50, 80}, bar.Thresholds)
+	assert.Len(t, bar.Colors, 3)
+
+	assert.True(t, math.IsNaN(bar.Max))
+
+	// max defaults to 100, even when only min is given
+	half := app.Globals[filename]["half"].(*render_runtime.ProgressBar).AsRenderWidget().(*render.ProgressBar)
+	assert.Equal(t, 50.0, half.Min)
+	assert.True(t, math.IsNaN(half.Max))
+	im := render.PaintWidget(half, image.Rect(0, 0, 10, 1), 0)
+	assert.Equal(t, color.RGBA{0xff, 0xff, 0xff, 0xff}, im.At(4, 0))
+	assert.NotEqual(t, color.RGBA{0xff, 0xff, 0xff, 0xff}, im.At(5, 0))
+
+	gauge := app.Globals[filename]["gauge"].(*render_runtime.Gauge).AsRenderWidget().(*render.Gauge)
+	assert.Equal(t, 1.0, gauge.Max)
+	assert.Equal(t, 180.0, gauge.Sweep)
+
+	_, err = NewApplet(filename, []byte(`
+load("render.star", "render")
+g = render.Gauge(value = 1, diameter = 10, thresholds = [5, 2])
+def main():
+    return render.Root(child = g)
+`))
+	assert.ErrorContains(t, err, "ascending order")
+}
+
+func TestPlotSeries(t *testing.T) {
+	const (
+		filename = "test_plot_series.star"
+		src      = `
+load("assert.star", "assert")
+load("render.star", "render")
+p = render.Plot(
+	data = [(0, 1), (1, 2)],
+	series = [render.PlotSeries(data = [(0, 100), (1, 50)], color = "#f00", y_lim = (0, None))],
+	independent_axes = True,
+	width = 32,
+	height = 16,
+	reference_lines = [render.PlotLine(y = 1.5, dashed = True)],
+	bands = [render.PlotBand(low = 1, high = 2, color = "#030")],
+	x_ticks = 2,
+	y_labels = True,
+)
+assert.eq(p.independent_axes, True)
+assert.eq(p.x_ticks, 2)
+assert.eq(len(p.series), 1)
+assert.eq(p.reference_lines[0].y, 1.5)
+assert.eq(render.size(p), (32, 16))
+def main():
+    return render.Root(child = p)
+`
+	)
+
+	app, err := NewApplet(filename, []byte(src))
+	require.NoError(t, err)
+
+	p := app.Globals[filename]["p"].(*render_runtime.Plot).AsRenderWidget().(*render.Plot)
+	require.Len(t, p.Series, 1)
+	assert.Equal(t, [][2]float64{{0, 100}, {1, 50}}, p.Series[0].Data)
+	assert.Equal(t, 0.0, p.Series[0].YLim[0])
+	assert.True(t, math.IsNaN(p.Series[0].YLim[1]))
+	assert.Equal(t, []render.PlotLine{{Y: 1.5, Dashed: true}}, p.ReferenceLines)
+	require.Len(t, p.Bands, 1)
+	assert.Equal(t, 2.0, p.Bands[0].High)
+
+	roots, err := app.Run(context.Background())
+	require.NoError(t, err)
+	require.Len(t, roots, 1)
+
+	_, err = NewApplet(filename, []byte(`
+load("render.star", "render")
+p = render.Plot(data = [], width = 10, height = 10, series = [render.PlotLine(y = 1)])
+def main():
+    return render.Root(child = p)
+`))
+	assert.ErrorContains(t, err, "expected series to be a list of PlotSeries")
+
+	_, err = NewApplet(filename, []byte(`
+load("render.star", "render")
+p = render.Plot(data = [], width = 10, height = 10, y_labels = True, label_font = "nope")
+def main():
+    return render.Root(child = p)
+`))
+	assert.Error(t, err)
+}
@@ -0,0 +1,431 @@
+package runtime
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+
+	"go.starlark.net/starlark"
+	"go.starlark.net/starlarkstruct"
+)
+
+// DefaultStoreQuota is the default number of bytes, counting both keys and
+// values, that a single installation of an app can keep in a store.
+const DefaultStoreQuota = 64 * 1024
+
+// ErrStoreQuotaExceeded is returned by stores when a write would take an
+// installation over its quota.
+var ErrStoreQuotaExceeded = errors.New("store quota exceeded")
+
+// Store keeps state for apps between runs. Unlike a cache, values don't
+// expire. Every key belongs to a scope, which identifies the app and the
+// installation of it, and scopes are isolated from each other.
+type Store interface {
+	Get(thread *starlark.Thread, scope, key string) ([]byte, bool, error)
+	Set(thread *starlark.Thread, scope, key string, value []byte) error
+	Delete(thread *starlark.Thread, scope, key string) error
+
+	// Increment atomically adds delta to the decimal integer stored at
+	// key, treating a missing key as zero, and returns the new value.
+	Increment(thread *starlark.Thread, scope, key string, delta int64) (int64, error)
+}
+
+// InMemoryStore is a Store that keeps state in memory, for as long as the
+// process lives.
+type InMemoryStore struct {
+	quota  int
+	scopes map[string]map[string][]byte
+	mutex  sync.Mutex
+}
+
+// NewInMemoryStore creates an in-memory store that allows quota bytes
+// per scope.
+func NewInMemoryStore(quota int) *InMemoryStore {
+	return &InMemoryStore{
+		quota:  quota,
+		scopes: map[string]map[string][]byte{},
+	}
+}
+
+func (s *InMemoryStore) Get(_ *starlark.Thread, scope, key string) ([]byte, bool, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	val, found := s.scopes[scope][key]
+	return val, found, nil
+}
+
+func (s *InMemoryStore) Set(_ *starlark.Thread, scope, key string, value []byte) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	records := s.scopes[scope]
+	if records == nil {
+		records = map[string][]byte{}
+		s.scopes[scope] = records
+	}
+
+	return setRecord(records, key, value, s.quota)
+}
+
+func (s *InMemoryStore) Delete(_ *starlark.Thread, scope, key string) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	delete(s.scopes[scope], key)
+	return nil
+}
+
+func (s *InMemoryStore) Increment(_ *starlark.Thread, scope, key string, delta int64) (int64, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	records := s.scopes[scope]
+	if records == nil {
+		records = map[string][]byte{}
+		s.scopes[scope] = records
+	}
+
+	return incrementRecord(records, key, delta, s.quota)
+}
+
+// FileStore is a Store that keeps every scope in a JSON file in a
+// directory, so that state survives restarts. It's safe for use by a
+// single process at a time.
+type FileStore struct {
+	dir   string
+	quota int
+	mutex sync.Mutex
+}
+
+// NewFileStore creates a store that keeps state in dir, allowing quota
+// bytes per scope. The directory is created if it doesn't exist.
+func NewFileStore(dir string, quota int) (*FileStore, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, fmt.Errorf("creating store directory: %w", err)
+	}
+
+	return &FileStore{dir: dir, quota: quota}, nil
+}
+
+func (s *FileStore) Get(_ *starlark.Thread, scope, key string) ([]byte, bool, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	records, err := s.read(scope)
+	if err != nil {
+		return nil, false, err
+	}
+
+	val, found := records[key]
+	return val, found, nil
+}
+
+func (s *FileStore) Set(_ *starlark.Thread, scope, key string, value []byte) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	records, err := s.read(scope)
+	if err != nil {
+		return err
+	}
+
+	if err := setRecord(records, key, value, s.quota); err != nil {
+		return err
+	}
+
+	return s.write(scope, records)
+}
+
+func (s *FileStore) Delete(_ *starlark.Thread, scope, key string) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	records, err := s.read(scope)
+	if err != nil {
+		return err
+	}
+
+	if _, found := records[key]; !found {
+		return nil
+	}
+	delete(records, key)
+
+	return s.write(scope, records)
+}
+
+func (s *FileStore) Increment(_ *starlark.Thread, scope, key string, delta int64) (int64, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	records, err := s.read(scope)
+	if err != nil {
+		return 0, err
+	}
+
+	n, err := incrementRecord(records, key, delta, s.quota)
+	if err != nil {
+		return 0, err
+	}
+
+	return n, s.write(scope, records)
+}
+
+// path returns the file that holds a scope. Scopes are hashed, since they
+// can contain characters that aren't valid in file names.
+func (s *FileStore) path(scope string) string {
+	sum := sha256.Sum256([]byte(scope))
+	return filepath.Join(s.dir, hex.EncodeToString(sum[:])+".json")
+}
+
+func (s *FileStore) read(scope string) (map[string][]byte, error) {
+	records := map[string][]byte{}
+
+	data, err := os.ReadFile(s.path(scope))
+	if errors.Is(err, os.ErrNotExist) {
+		return records, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading store: %w", err)
+	}
+
+	if err := json.Unmarshal(data, &records); err != nil {
+		return nil, fmt.Errorf("decoding store: %w", err)
+	}
+
+	return records, nil
+}
+
+func (s *FileStore) write(scope string, records map[string][]byte) error {
+	data, err := json.Marshal(records)
+	if err != nil {
+		return fmt.Errorf("encoding store: %w", err)
+	}
+
+	// write to a temporary file first, so that a crash can't leave a
+	// partially written store behind
+	tmp, err := os.CreateTemp(s.dir, "store-*.tmp")
+	if err != nil {
+		return fmt.Errorf("writing store: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return fmt.Errorf("writing store: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("writing store: %w", err)
+	}
+
+	if err := os.Rename(tmp.Name(), s.path(scope)); err != nil {
+		return fmt.Errorf("writing store: %w", err)
+	}
+
+	return nil
+}
+
+// setRecord sets key to value in records, unless that would take the
+// records over quota.
+func setRecord(records map[string][]byte, key string, value []byte, quota int) error {
+	size := len(key) + len(value)
+	for k, v := range records {
+		if k != key {
+			size += len(k) + len(v)
+		}
+	}
+
+	if size > quota {
+		return fmt.Errorf("%w: %d bytes used of %d", ErrStoreQuotaExceeded, size, quota)
+	}
+
+	records[key] = value
+	return nil
+}
+
+func incrementRecord(records map[string][]byte, key string, delta int64, quota int) (int64, error) {
+	var n int64
+	if val, found := records[key]; found {
+		var err error
+		n, err = strconv.ParseInt(string(val), 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("value of %s is not an integer: %q", key, val)
+		}
+	}
+
+	if (delta > 0 && n > math.MaxInt64-delta) || (delta < 0 && n < math.MinInt64-delta) {
+		return 0, fmt.Errorf("incrementing %s by %d overflows", key, delta)
+	}
+
+	n += delta
+	if err := setRecord(records, key, []byte(strconv.FormatInt(n, 10)), quota); err != nil {
+		return 0, err
+	}
+
+	return n, nil
+}
+
+var (
+	storeOnce   sync.Once
+	storeModule starlark.StringDict
+	store       Store
+)
+
+func InitStore(s Store) {
+	store = s
+}
+
+func LoadStoreModule() (starlark.StringDict, error) {
+	storeOnce.Do(func() {
+		storeModule = starlark.StringDict{
+			"store": &starlarkstruct.Module{
+				Name: "store",
+				Members: starlark.StringDict{
+					"get":       starlark.NewBuiltin("get", storeGet),
+					"set":       starlark.NewBuiltin("set", storeSet),
+					"delete":    starlark.NewBuiltin("delete", storeDelete),
+					"increment": starlark.NewBuiltin("increment", storeIncrement),
+				},
+			},
+		}
+	})
+
+	return storeModule, nil
+}
+
+// storeScope identifies the app and installation that a thread runs.
+func storeScope(thread *starlark.Thread) string {
+	// the ID is escaped, so that it can't contain the separator
+	return fmt.Sprintf("pixlet:%s:%s", thread.Name, url.QueryEscape(InstallationID(thread)))
+}
+
+func storeGet(thread *starlark.Thread, _ *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	var (
+		key starlark.String
+		def starlark.Value = starlark.None
+	)
+
+	if err := starlark.UnpackArgs(
+		"get",
+		args, kwargs,
+		"key", &key,
+		"default?", &def,
+	); err != nil {
+		return nil, fmt.Errorf("unpacking arguments for store.get: %w", err)
+	}
+
+	if store == nil {
+		// no store configured
+		return def, nil
+	}
+
+	val, found, err := store.Get(thread, storeScope(thread), key.GoString())
+	if err != nil {
+		return nil, fmt.Errorf("getting %s from store: %w", key.GoString(), err)
+	}
+
+	if !found {
+		return def, nil
+	}
+
+	return starlark.String(val), nil
+}
+
+func storeSet(thread *starlark.Thread, _ *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	var (
+		key starlark.String
+		val starlark.String
+	)
+
+	if err := starlark.UnpackArgs(
+		"set",
+		args, kwargs,
+		"key", &key,
+		"value", &val,
+	); err != nil {
+		return nil, fmt.Errorf("unpacking arguments for store.set: %w", err)
+	}
+
+	if key.GoString() == "" {
+		return nil, fmt.Errorf("store.set: key cannot be empty")
+	}
+
+	if store == nil {
+		// no store configured
+		return starlark.None, nil
+	}
+
+	if err := store.Set(thread, storeScope(thread), key.GoString(), []byte(val.GoString())); err != nil {
+		return nil, fmt.Errorf("setting %s in store: %w", key.GoString(), err)
+	}
+
+	return starlark.None, nil
+}
+
+func storeDelete(thread *starlark.Thread, _ *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	var key starlark.String
+
+	if err := starlark.UnpackArgs(
+		"delete",
+		args, kwargs,
+		"key", &key,
+	); err != nil {
+		return nil, fmt.Errorf("unpacking arguments for store.delete: %w", err)
+	}
+
+	if store == nil {
+		// no store configured
+		return starlark.None, nil
+	}
+
+	if err := store.Delete(thread, storeScope(thread), key.GoString()); err != nil {
+		return nil, fmt.Errorf("deleting %s from store: %w", key.GoString(), err)
+	}
+
+	return starlark.None, nil
+}
+
+func storeIncrement(thread *starlark.Thread, _ *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	var (
+		key   starlark.String
+		delta = starlark.MakeInt(1)
+	)
+
+	if err := starlark.UnpackArgs(
+		"increment",
+		args, kwargs,
+		"key", &key,
+		"delta?", &delta,
+	); err != nil {
+		return nil, fmt.Errorf("unpacking arguments for store.increment: %w", err)
+	}
+
+	if key.GoString() == "" {
+		return nil, fmt.Errorf("store.increment: key cannot be empty")
+	}
+
+	delta64, ok := delta.Int64()
+	if !ok {
+		return nil, fmt.Errorf("delta must be valid integer (not %s)", delta.String())
+	}
+
+	if store == nil {
+		// no store configured, so every counter starts over
+		return starlark.MakeInt64(delta64), nil
+	}
+
+	n, err := store.Increment(thread, storeScope(thread), key.GoString(), delta64)
+	if err != nil {
+		return nil, fmt.Errorf("incrementing %s in store: %w", key.GoString(), err)
+	}
+
+	return starlark.MakeInt64(n), nil
+}
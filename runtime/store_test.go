@@ -0,0 +1,191 @@
+package runtime
+
+import (
+	"context"
+	"math"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.starlark.net/starlark"
+)
+
+func TestStoreGetSetDelete(t *testing.T) {
+	src := `
+load("render.star", "render")
+load("store.star", "store")
+
+def main():
+    if store.get("name") != None:
+        fail("got something I hadn't set")
+    if store.get("name", "nobody") != "nobody":
+        fail("didn't get the default")
+
+    store.set("name", "pixlet")
+    if store.get("name") != "pixlet":
+        fail("didn't get what I set")
+
+    store.delete("name")
+    if store.get("name") != None:
+        fail("got something I deleted")
+
+    # deleting a missing key is fine
+    store.delete("name")
+
+    return render.Root(child=render.Box())
+`
+	InitStore(NewInMemoryStore(DefaultStoreQuota))
+	app, err := NewApplet("test.star", []byte(src))
+	require.NoError(t, err)
+	_, err = app.Run(context.Background())
+	assert.NoError(t, err)
+}
+
+func TestStoreIncrement(t *testing.T) {
+	src := `
+load("render.star", "render")
+load("store.star", "store")
+
+def main():
+    runs = store.increment("runs")
+    store.increment("score", delta = 10)
+    store.increment("score", -3)
+    return [render.Root(child=render.Box()) for _ in range(runs)]
+`
+	s := NewInMemoryStore(DefaultStoreQuota)
+	InitStore(s)
+
+	app, err := NewApplet("test.star", []byte(src), WithInstallationID("one"))
+	require.NoError(t, err)
+
+	for i := 1; i <= 3; i++ {
+		roots, err := app.Run(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, i, len(roots))
+	}
+
+	val, found, err := s.Get(nil, "pixlet:test.star:one", "score")
+	require.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, "21", string(val))
+
+	// state is scoped to the installation
+	app, err = NewApplet("test.star", []byte(src), WithInstallationID("two"))
+	require.NoError(t, err)
+	roots, err := app.Run(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 1, len(roots))
+
+	// and to the app
+	app, err = NewApplet("test2.star", []byte(src), WithInstallationID("one"))
+	require.NoError(t, err)
+	roots, err = app.Run(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 1, len(roots))
+
+	// values that aren't integers can't be incremented
+	require.NoError(t, s.Set(nil, "pixlet:test.star:one", "runs", []byte("many")))
+	app, err = NewApplet("test.star", []byte(src), WithInstallationID("one"))
+	require.NoError(t, err)
+	_, err = app.Run(context.Background())
+	assert.ErrorContains(t, err, `value of runs is not an integer: "many"`)
+
+	// and increments that would overflow fail
+	records := map[string][]byte{"n": []byte(strconv.FormatInt(math.MaxInt64-1, 10))}
+	n, err := incrementRecord(records, "n", 1, DefaultStoreQuota)
+	require.NoError(t, err)
+	assert.Equal(t, int64(math.MaxInt64), n)
+	_, err = incrementRecord(records, "n", 1, DefaultStoreQuota)
+	assert.ErrorContains(t, err, "incrementing n by 1 overflows")
+	records["n"] = []byte(strconv.FormatInt(math.MinInt64, 10))
+	_, err = incrementRecord(records, "n", -1, DefaultStoreQuota)
+	assert.ErrorContains(t, err, "overflows")
+}
+
+func TestStoreScope(t *testing.T) {
+	thread := &starlark.Thread{Name: "a"}
+	thread.SetLocal(threadInstallationIDKey, "b:c")
+	other := &starlark.Thread{Name: "a:b"}
+	other.SetLocal(threadInstallationIDKey, "c")
+
+	// IDs are escaped, so they can't be confused with app names
+	assert.Equal(t, "pixlet:a:b%3Ac", storeScope(thread))
+	assert.NotEqual(t, storeScope(thread), storeScope(other))
+}
+
+func TestStoreQuota(t *testing.T) {
+	src := `
+load("render.star", "render")
+load("store.star", "store")
+
+def main():
+    store.set("a", "0123456789")
+    store.set("a", "9876543210")
+    store.set("b", "0123456789")
+    return render.Root(child=render.Box())
+`
+	InitStore(NewInMemoryStore(20))
+	app, err := NewApplet("test.star", []byte(src))
+	require.NoError(t, err)
+	_, err = app.Run(context.Background())
+	assert.ErrorContains(t, err, "setting b in store: store quota exceeded: 22 bytes used of 20")
+}
+
+func TestStoreNoInit(t *testing.T) {
+	src := `
+load("render.star", "render")
+load("store.star", "store")
+
+def main():
+    store.set("key", "value")
+    if store.get("key") != None:
+        fail("without store init we should only get None")
+    if store.increment("counter") != 1 or store.increment("counter") != 1:
+        fail("without store init counters should start over")
+    return render.Root(child=render.Box())
+`
+	InitStore(nil)
+	app, err := NewApplet("test.star", []byte(src))
+	require.NoError(t, err)
+	_, err = app.Run(context.Background())
+	assert.NoError(t, err)
+}
+
+func TestFileStore(t *testing.T) {
+	dir := t.TempDir()
+
+	s, err := NewFileStore(dir, 100)
+	require.NoError(t, err)
+
+	require.NoError(t, s.Set(nil, "scope", "greeting", []byte("hello")))
+	n, err := s.Increment(nil, "scope", "counter", 5)
+	require.NoError(t, err)
+	assert.Equal(t, int64(5), n)
+
+	// state survives a new store on the same directory
+	s, err = NewFileStore(dir, 100)
+	require.NoError(t, err)
+
+	val, found, err := s.Get(nil, "scope", "greeting")
+	require.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, "hello", string(val))
+
+	n, err = s.Increment(nil, "scope", "counter", 1)
+	require.NoError(t, err)
+	assert.Equal(t, int64(6), n)
+
+	// scopes are isolated
+	_, found, err = s.Get(nil, "other scope", "greeting")
+	require.NoError(t, err)
+	assert.False(t, found)
+
+	require.NoError(t, s.Delete(nil, "scope", "greeting"))
+	_, found, err = s.Get(nil, "scope", "greeting")
+	require.NoError(t, err)
+	assert.False(t, found)
+
+	err = s.Set(nil, "scope", "big", make([]byte, 100))
+	assert.ErrorIs(t, err, ErrStoreQuotaExceeded)
+}
@@ -0,0 +1,241 @@
+package dap
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"tidbyt.dev/pixlet/runtime"
+)
+
+var debugSource = `
+load("render.star", "render")
+
+GREETING = "hello"
+
+def greet(name):
+    msg = GREETING + " " + name
+    return msg
+
+def main(config):
+    who = config.get("who", "world")
+    msg = greet(who)
+    words = [msg, who]
+    return render.Root(child = render.Text(msg))
+`
+
+func waitStop(t *testing.T, stops chan string) string {
+	select {
+	case reason := <-stops:
+		return reason
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for thread to stop")
+		return ""
+	}
+}
+
+func findVar(vars []Variable, name string) *Variable {
+	for i := range vars {
+		if vars[i].Name == name {
+			return &vars[i]
+		}
+	}
+	return nil
+}
+
+func TestDebuggerBreakpointsAndStepping(t *testing.T) {
+	stops := make(chan string, 1)
+	d := New(false)
+	d.OnStop = func(reason string) { stops <- reason }
+	d.SetBreakpoints("debug.star/debug.star", []int{11})
+
+	app, err := runtime.NewApplet("debug.star", []byte(debugSource), runtime.WithThreadInitializer(d.Attach))
+	require.NoError(t, err)
+
+	done := make(chan error)
+	go func() {
+		_, err := app.RunWithConfig(context.Background(), map[string]string{"who": "pixlet"})
+		done <- err
+	}()
+
+	// stopped at the breakpoint in main()
+	assert.Equal(t, ReasonBreakpoint, waitStop(t, stops))
+	stack := d.Stack()
+	require.NotEmpty(t, stack)
+	assert.Equal(t, "main", stack[0].Name)
+	assert.Equal(t, 11, stack[0].Line)
+	assert.Equal(t, "debug.star/debug.star", stack[0].File)
+
+	// step over the assignment to `who`
+	d.StepOver()
+	assert.Equal(t, ReasonStep, waitStop(t, stops))
+	assert.Equal(t, 12, d.Stack()[0].Line)
+
+	locals, globals, err := d.Scopes(stack[0].ID)
+	require.NoError(t, err)
+	vars, err := d.Variables(locals)
+	require.NoError(t, err)
+	who := findVar(vars, "who")
+	require.NotNil(t, who)
+	assert.Equal(t, `"pixlet"`, who.Value)
+	assert.Nil(t, findVar(vars, "msg"), "msg is not assigned yet")
+
+	vars, err = d.Variables(globals)
+	require.NoError(t, err)
+	greeting := findVar(vars, "GREETING")
+	require.NotNil(t, greeting)
+	assert.Equal(t, `"hello"`, greeting.Value)
+
+	// step into greet()
+	d.StepIn()
+	assert.Equal(t, ReasonStep, waitStop(t, stops))
+	stack = d.Stack()
+	assert.Equal(t, "greet", stack[0].Name)
+	assert.Equal(t, 7, stack[0].Line)
+	assert.Equal(t, "main", stack[1].Name)
+
+	v, err := d.Evaluate(stack[0].ID, `GREETING + " " + name`)
+	require.NoError(t, err)
+	assert.Equal(t, `"hello pixlet"`, v.Value)
+
+	// step out of greet() and back into main()
+	d.StepOut()
+	assert.Equal(t, ReasonStep, waitStop(t, stops))
+	stack = d.Stack()
+	assert.Equal(t, "main", stack[0].Name)
+
+	d.StepOver()
+	assert.Equal(t, ReasonStep, waitStop(t, stops))
+	d.StepOver()
+	assert.Equal(t, ReasonStep, waitStop(t, stops))
+	assert.Equal(t, 14, d.Stack()[0].Line)
+
+	// lists can be expanded
+	locals, _, err = d.Scopes(d.Stack()[0].ID)
+	require.NoError(t, err)
+	vars, err = d.Variables(locals)
+	require.NoError(t, err)
+	words := findVar(vars, "words")
+	require.NotNil(t, words)
+	require.NotZero(t, words.Reference)
+	items, err := d.Variables(words.Reference)
+	require.NoError(t, err)
+	require.Len(t, items, 2)
+	assert.Equal(t, `"hello pixlet"`, items[0].Value)
+
+	d.Continue()
+	require.NoError(t, <-done)
+}
+
+func TestDebuggerDetach(t *testing.T) {
+	stops := make(chan string, 1)
+	d := New(true)
+	d.OnStop = func(reason string) { stops <- reason }
+
+	done := make(chan error)
+	go func() {
+		_, err := runtime.NewApplet("debug.star", []byte(debugSource), runtime.WithThreadInitializer(d.Attach))
+		done <- err
+	}()
+
+	assert.Equal(t, ReasonEntry, waitStop(t, stops))
+	d.Detach()
+	require.NoError(t, <-done)
+}
+
+type client struct {
+	t   *testing.T
+	w   io.Writer
+	r   *bufio.Reader
+	seq int
+}
+
+func (c *client) send(command string, args interface{}) {
+	c.seq++
+	raw, err := json.Marshal(args)
+	require.NoError(c.t, err)
+	require.NoError(c.t, writeMessage(c.w, &request{
+		message:   message{Seq: c.seq, Type: "request"},
+		Command:   command,
+		Arguments: raw,
+	}))
+}
+
+// next returns the next message that is either a response to command or
+// the named event, skipping everything else.
+func (c *client) next(kind, name string) map[string]interface{} {
+	for {
+		data, err := readMessage(c.r)
+		require.NoError(c.t, err)
+
+		var msg map[string]interface{}
+		require.NoError(c.t, json.Unmarshal(data, &msg))
+
+		if msg["type"] == kind && (msg["command"] == name || msg["event"] == name) {
+			return msg
+		}
+	}
+}
+
+func TestSession(t *testing.T) {
+	dir := t.TempDir()
+	program := filepath.Join(dir, "hello.star")
+	require.NoError(t, os.WriteFile(program, []byte(debugSource), 0644))
+
+	reqR, reqW := io.Pipe()
+	resR, resW := io.Pipe()
+
+	s := NewSession(reqR, resW)
+	go s.Serve()
+
+	c := &client{t: t, w: reqW, r: bufio.NewReader(resR)}
+
+	c.send("initialize", map[string]interface{}{"adapterID": "pixlet"})
+	res := c.next("response", "initialize")
+	assert.Equal(t, true, res["success"])
+	c.next("event", "initialized")
+
+	c.send("launch", launchArguments{Program: program, Config: map[string]string{"who": "dap"}})
+	assert.Equal(t, true, c.next("response", "launch")["success"])
+
+	c.send("setBreakpoints", setBreakpointsArguments{
+		Source:      source{Path: program},
+		Breakpoints: []sourceBreakpoint{{Line: 8}},
+	})
+	assert.Equal(t, true, c.next("response", "setBreakpoints")["success"])
+
+	c.send("configurationDone", nil)
+	stopped := c.next("event", "stopped")
+	assert.Equal(t, ReasonBreakpoint, stopped["body"].(map[string]interface{})["reason"])
+
+	c.send("stackTrace", stackTraceArguments{ThreadID: threadID})
+	res = c.next("response", "stackTrace")
+	frames := res["body"].(map[string]interface{})["stackFrames"].([]interface{})
+	top := frames[0].(map[string]interface{})
+	assert.Equal(t, "greet", top["name"])
+	assert.Equal(t, float64(8), top["line"])
+	assert.Equal(t, program, top["source"].(map[string]interface{})["path"])
+
+	c.send("evaluate", map[string]interface{}{"expression": "msg", "frameId": top["id"]})
+	res = c.next("response", "evaluate")
+	assert.Equal(t, `"hello dap"`, res["body"].(map[string]interface{})["result"])
+
+	c.send("continue", map[string]interface{}{"threadId": threadID})
+	c.next("response", "continue")
+
+	output := c.next("event", "output")
+	assert.Contains(t, output["body"].(map[string]interface{})["output"], "returned 1 root(s)")
+	exited := c.next("event", "exited")
+	assert.Equal(t, float64(0), exited["body"].(map[string]interface{})["exitCode"])
+
+	c.send("disconnect", nil)
+	assert.Equal(t, true, c.next("response", "disconnect")["success"])
+}
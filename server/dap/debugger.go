@@ -0,0 +1,418 @@
+// Package dap implements a Debug Adapter Protocol server for stepping
+// through Pixlet apps.
+package dap
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	"go.starlark.net/starlark"
+	"go.starlark.net/syntax"
+)
+
+type stepMode int
+
+const (
+	modeContinue stepMode = iota
+	modeStepIn
+	modeStepOver
+	modeStepOut
+)
+
+// Stop reasons reported by the debugger. They match the reasons used by
+// the DAP "stopped" event.
+const (
+	ReasonBreakpoint = "breakpoint"
+	ReasonStep       = "step"
+	ReasonPause      = "pause"
+	ReasonEntry      = "entry"
+)
+
+// Frame describes a single Starlark frame of a stopped thread.
+type Frame struct {
+	ID     int
+	Name   string
+	File   string
+	Line   int
+	Column int
+}
+
+// Variable is a named value as presented to the debugger client. If
+// Reference is non-zero, the value has children that can be retrieved
+// with Debugger.Variables.
+type Variable struct {
+	Name      string
+	Value     string
+	Type      string
+	Reference int
+}
+
+// Debugger pauses Starlark threads at breakpoints and steps through
+// them. Threads are instrumented by passing Debugger.Attach as a thread
+// initializer to the applet.
+//
+// Only a single thread executes at any time when running an applet, so
+// the debugger tracks one stopped thread at a time.
+type Debugger struct {
+	// OnStop is called when a thread stops, with one of the Reason*
+	// constants. The thread remains stopped until it is resumed.
+	OnStop func(reason string)
+
+	mu          sync.Mutex
+	breakpoints map[string]map[int]bool
+	mode        stepMode
+	stepDepth   int
+	pause       bool
+	entry       bool
+	detached    bool
+
+	stopped *starlark.Thread
+	resume  chan struct{}
+
+	// handles are the scopes (starlark.StringDict) and values
+	// (starlark.Value) that have been handed out as variable references
+	// since the thread stopped. Reference n refers to handles[n-1].
+	handles []interface{}
+}
+
+// New creates a debugger with no breakpoints. If stopOnEntry is true,
+// the first line executed by any attached thread stops it.
+func New(stopOnEntry bool) *Debugger {
+	d := &Debugger{
+		breakpoints: map[string]map[int]bool{},
+		entry:       stopOnEntry,
+	}
+	if stopOnEntry {
+		d.mode = modeStepIn
+	}
+	return d
+}
+
+// SetBreakpoints replaces all breakpoints in file with the given lines.
+// The file is the name of the file as seen by Starlark.
+func (d *Debugger) SetBreakpoints(file string, lines []int) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	bps := make(map[int]bool, len(lines))
+	for _, l := range lines {
+		bps[l] = true
+	}
+	d.breakpoints[file] = bps
+}
+
+// Attach instruments a thread so that it can be stopped by the debugger.
+// It has the signature of a runtime.ThreadInitializer.
+func (d *Debugger) Attach(thread *starlark.Thread) *starlark.Thread {
+	// the last line observed at every depth of the call stack, used to
+	// detect when execution moves to a new line
+	var lines []syntax.Position
+
+	// with a limit of one step, OnMaxSteps is called before every
+	// instruction the interpreter executes
+	thread.SetMaxExecutionSteps(1)
+	thread.OnMaxSteps = func(t *starlark.Thread) {
+		depth := t.CallStackDepth()
+		pos := t.DebugFrame(0).Position()
+
+		returned := depth < len(lines)
+		if returned {
+			lines = lines[:depth]
+		}
+
+		newLine := false
+		if depth > len(lines) {
+			for len(lines) < depth {
+				lines = append(lines, syntax.Position{})
+			}
+			newLine = true
+		}
+		if prev := lines[depth-1]; prev.Line != pos.Line || prev.Filename() != pos.Filename() {
+			newLine = true
+		}
+		lines[depth-1] = pos
+
+		if newLine || returned {
+			d.check(t, depth, pos, newLine)
+		}
+	}
+
+	return thread
+}
+
+func (d *Debugger) check(thread *starlark.Thread, depth int, pos syntax.Position, newLine bool) {
+	d.mu.Lock()
+
+	if d.detached {
+		d.mu.Unlock()
+		return
+	}
+
+	reason := ""
+	switch {
+	case d.pause:
+		reason = ReasonPause
+	case d.mode == modeStepIn && d.entry:
+		reason = ReasonEntry
+	case d.mode == modeStepIn:
+		reason = ReasonStep
+	case d.mode == modeStepOver && depth <= d.stepDepth:
+		reason = ReasonStep
+	case d.mode == modeStepOut && depth < d.stepDepth:
+		reason = ReasonStep
+	case newLine && d.breakpoints[pos.Filename()][int(pos.Line)]:
+		reason = ReasonBreakpoint
+	}
+
+	if reason == "" {
+		d.mu.Unlock()
+		return
+	}
+
+	resume := make(chan struct{})
+	d.stopped = thread
+	d.resume = resume
+	d.pause = false
+	d.entry = false
+	d.handles = nil
+	d.mu.Unlock()
+
+	if d.OnStop != nil {
+		d.OnStop(reason)
+	}
+
+	<-resume
+}
+
+// Continue resumes the stopped thread until the next breakpoint.
+func (d *Debugger) Continue() { d.resumeWith(modeContinue) }
+
+// StepIn resumes the stopped thread until the next line, entering
+// function calls.
+func (d *Debugger) StepIn() { d.resumeWith(modeStepIn) }
+
+// StepOver resumes the stopped thread until the next line in the
+// current function or its callers.
+func (d *Debugger) StepOver() { d.resumeWith(modeStepOver) }
+
+// StepOut resumes the stopped thread until the current function returns.
+func (d *Debugger) StepOut() { d.resumeWith(modeStepOut) }
+
+// Pause stops the running thread at the next line it executes.
+func (d *Debugger) Pause() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.pause = true
+}
+
+// Detach removes all breakpoints and lets any stopped thread run to
+// completion.
+func (d *Debugger) Detach() {
+	d.mu.Lock()
+	d.detached = true
+	d.mu.Unlock()
+
+	d.resumeWith(modeContinue)
+}
+
+func (d *Debugger) resumeWith(mode stepMode) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.stopped == nil {
+		return
+	}
+
+	d.mode = mode
+	d.stepDepth = d.stopped.CallStackDepth()
+	d.stopped = nil
+	d.handles = nil
+	close(d.resume)
+}
+
+// Stack returns the Starlark frames of the stopped thread, innermost
+// first. It returns nil if no thread is stopped.
+func (d *Debugger) Stack() []Frame {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.stopped == nil {
+		return nil
+	}
+
+	var frames []Frame
+	for i := 0; i < d.stopped.CallStackDepth(); i++ {
+		fr := d.stopped.DebugFrame(i)
+		if _, ok := fr.Callable().(*starlark.Function); !ok {
+			// skip built-ins, they have no source to show
+			continue
+		}
+
+		pos := fr.Position()
+		frames = append(frames, Frame{
+			ID:     i,
+			Name:   fr.Callable().Name(),
+			File:   pos.Filename(),
+			Line:   int(pos.Line),
+			Column: int(pos.Col),
+		})
+	}
+
+	return frames
+}
+
+// Scopes returns references to the local and global variables of the
+// frame at the given depth of the stopped thread.
+func (d *Debugger) Scopes(frameID int) (locals, globals int, err error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	fr, err := d.frame(frameID)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	localVars := starlark.StringDict{}
+	for i := 0; i < fr.NumLocals(); i++ {
+		binding, val := fr.Local(i)
+		if val == nil {
+			// not yet assigned
+			continue
+		}
+		localVars[binding.Name] = val
+	}
+
+	globalVars := starlark.StringDict{}
+	for name, val := range fr.Callable().(*starlark.Function).Globals() {
+		if val != nil {
+			globalVars[name] = val
+		}
+	}
+
+	return d.addHandle(localVars), d.addHandle(globalVars), nil
+}
+
+// Variables returns the children of a scope or value reference.
+func (d *Debugger) Variables(ref int) ([]Variable, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.stopped == nil {
+		return nil, fmt.Errorf("not stopped")
+	}
+
+	if ref <= 0 || ref > len(d.handles) {
+		return nil, fmt.Errorf("invalid variable reference: %d", ref)
+	}
+
+	var vars []Variable
+	switch v := d.handles[ref-1].(type) {
+	case starlark.StringDict:
+		for _, name := range v.Keys() {
+			vars = append(vars, d.variable(name, v[name]))
+		}
+
+	case starlark.Indexable:
+		for i := 0; i < v.Len(); i++ {
+			vars = append(vars, d.variable(fmt.Sprintf("[%d]", i), v.Index(i)))
+		}
+
+	case starlark.IterableMapping:
+		for _, item := range v.Items() {
+			vars = append(vars, d.variable(item[0].String(), item[1]))
+		}
+
+	case starlark.HasAttrs:
+		names := v.AttrNames()
+		sort.Strings(names)
+		for _, name := range names {
+			attr, err := v.Attr(name)
+			if err != nil || attr == nil {
+				continue
+			}
+			vars = append(vars, d.variable(name, attr))
+		}
+	}
+
+	return vars, nil
+}
+
+// Evaluate evaluates a Starlark expression in the scope of the frame at
+// the given depth of the stopped thread.
+func (d *Debugger) Evaluate(frameID int, expr string) (Variable, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	fr, err := d.frame(frameID)
+	if err != nil {
+		return Variable{}, err
+	}
+
+	env := starlark.StringDict{}
+	for name, val := range fr.Callable().(*starlark.Function).Globals() {
+		env[name] = val
+	}
+	for i := 0; i < fr.NumLocals(); i++ {
+		binding, val := fr.Local(i)
+		if val != nil {
+			env[binding.Name] = val
+		}
+	}
+
+	thread := &starlark.Thread{Name: "evaluate", Load: d.stopped.Load}
+	val, err := starlark.EvalOptions(&syntax.FileOptions{}, thread, "<evaluate>", expr, env)
+	if err != nil {
+		return Variable{}, err
+	}
+
+	return d.variable(expr, val), nil
+}
+
+func (d *Debugger) frame(frameID int) (starlark.DebugFrame, error) {
+	if d.stopped == nil {
+		return nil, fmt.Errorf("not stopped")
+	}
+
+	if frameID < 0 || frameID >= d.stopped.CallStackDepth() {
+		return nil, fmt.Errorf("invalid frame: %d", frameID)
+	}
+
+	fr := d.stopped.DebugFrame(frameID)
+	if _, ok := fr.Callable().(*starlark.Function); !ok {
+		return nil, fmt.Errorf("frame %d is not a Starlark function", frameID)
+	}
+
+	return fr, nil
+}
+
+func (d *Debugger) addHandle(h interface{}) int {
+	d.handles = append(d.handles, h)
+	return len(d.handles)
+}
+
+func (d *Debugger) variable(name string, val starlark.Value) Variable {
+	v := Variable{
+		Name:  name,
+		Value: val.String(),
+		Type:  val.Type(),
+	}
+
+	hasChildren := false
+	switch c := val.(type) {
+	case starlark.String:
+		// strings are indexable, but not worth expanding
+	case starlark.Indexable:
+		hasChildren = c.Len() > 0
+	case starlark.IterableMapping:
+		hasChildren = len(c.Items()) > 0
+	case starlark.HasAttrs:
+		hasChildren = len(c.AttrNames()) > 0
+	}
+
+	if hasChildren {
+		v.Reference = d.addHandle(val)
+	}
+
+	return v
+}
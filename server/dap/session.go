@@ -0,0 +1,504 @@
+package dap
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"go.starlark.net/starlark"
+
+	"tidbyt.dev/pixlet/runtime"
+	"tidbyt.dev/pixlet/tools"
+)
+
+// threadID is the only thread reported to clients. Apps run on a single
+// Starlark thread at a time.
+const threadID = 1
+
+// Session serves a single debugging session over a reader and a writer,
+// such as a network connection or the standard streams.
+type Session struct {
+	// Program is the path to the app to debug. Config, Handler and
+	// Parameter configure how it's run: if Handler is set, the schema
+	// handler of that name is called with Parameter, otherwise main()
+	// is called with Config. All of these can be overridden by the
+	// client's launch request.
+	Program   string
+	Config    map[string]string
+	Handler   string
+	Parameter string
+
+	r   *bufio.Reader
+	w   io.Writer
+	wmu sync.Mutex
+	seq int
+
+	mu          sync.Mutex
+	dbg         *Debugger
+	root        string
+	id          string
+	isDir       bool
+	breakpoints map[string][]int
+	launched    bool
+	configured  bool
+	started     bool
+	cancel      context.CancelFunc
+	finished    chan struct{}
+}
+
+// NewSession creates a session reading requests from r and writing
+// responses and events to w.
+func NewSession(r io.Reader, w io.Writer) *Session {
+	return &Session{
+		r:           bufio.NewReader(r),
+		w:           w,
+		breakpoints: map[string][]int{},
+		finished:    make(chan struct{}),
+	}
+}
+
+// Serve handles requests until the client disconnects or the input is
+// closed.
+func (s *Session) Serve() error {
+	defer s.shutdown()
+
+	for {
+		data, err := readMessage(s.r)
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("reading request: %w", err)
+		}
+
+		var req request
+		if err := json.Unmarshal(data, &req); err != nil {
+			return fmt.Errorf("decoding request: %w", err)
+		}
+
+		if req.Type != "request" {
+			continue
+		}
+
+		body, err := s.handle(&req)
+		if err != nil {
+			s.respond(&req, false, err.Error(), nil)
+		} else {
+			s.respond(&req, true, "", body)
+		}
+
+		switch req.Command {
+		case "initialize":
+			// the initialized event must follow the response
+			s.sendEvent("initialized", nil)
+		case "disconnect":
+			return nil
+		}
+	}
+}
+
+func (s *Session) handle(req *request) (interface{}, error) {
+	switch req.Command {
+	case "initialize":
+		return capabilities{
+			SupportsConfigurationDoneRequest: true,
+			SupportsEvaluateForHovers:        true,
+			SupportTerminateDebuggee:         true,
+		}, nil
+
+	case "launch":
+		var args launchArguments
+		if err := unmarshalArgs(req, &args); err != nil {
+			return nil, err
+		}
+		return nil, s.launch(args)
+
+	case "setBreakpoints":
+		var args setBreakpointsArguments
+		if err := unmarshalArgs(req, &args); err != nil {
+			return nil, err
+		}
+		return s.setBreakpoints(args), nil
+
+	case "configurationDone":
+		s.mu.Lock()
+		s.configured = true
+		s.mu.Unlock()
+		s.maybeStart()
+		return nil, nil
+
+	case "threads":
+		return map[string]interface{}{
+			"threads": []thread{{ID: threadID, Name: "main"}},
+		}, nil
+
+	case "stackTrace":
+		return s.stackTrace(), nil
+
+	case "scopes":
+		var args scopesArguments
+		if err := unmarshalArgs(req, &args); err != nil {
+			return nil, err
+		}
+		locals, globals, err := s.debugger().Scopes(args.FrameID - 1)
+		if err != nil {
+			return nil, err
+		}
+		return map[string]interface{}{
+			"scopes": []scope{
+				{Name: "Locals", VariablesReference: locals},
+				{Name: "Globals", VariablesReference: globals},
+			},
+		}, nil
+
+	case "variables":
+		var args variablesArguments
+		if err := unmarshalArgs(req, &args); err != nil {
+			return nil, err
+		}
+		vars, err := s.debugger().Variables(args.VariablesReference)
+		if err != nil {
+			return nil, err
+		}
+		result := make([]variable, 0, len(vars))
+		for _, v := range vars {
+			result = append(result, toVariable(v))
+		}
+		return map[string]interface{}{"variables": result}, nil
+
+	case "evaluate":
+		var args evaluateArguments
+		if err := unmarshalArgs(req, &args); err != nil {
+			return nil, err
+		}
+		return s.evaluate(args)
+
+	case "continue":
+		s.debugger().Continue()
+		return map[string]interface{}{"allThreadsContinued": true}, nil
+
+	case "next":
+		s.debugger().StepOver()
+		return nil, nil
+
+	case "stepIn":
+		s.debugger().StepIn()
+		return nil, nil
+
+	case "stepOut":
+		s.debugger().StepOut()
+		return nil, nil
+
+	case "pause":
+		s.debugger().Pause()
+		return nil, nil
+
+	case "disconnect", "terminate":
+		s.shutdown()
+		return nil, nil
+
+	default:
+		return nil, fmt.Errorf("unsupported command: %s", req.Command)
+	}
+}
+
+func (s *Session) launch(args launchArguments) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if args.Program != "" {
+		s.Program = args.Program
+	}
+	if args.Config != nil {
+		s.Config = args.Config
+	}
+	if args.Handler != "" {
+		s.Handler = args.Handler
+		s.Parameter = args.Parameter
+	}
+
+	if s.Program == "" {
+		return fmt.Errorf("no program to debug")
+	}
+
+	abs, err := filepath.Abs(s.Program)
+	if err != nil {
+		return fmt.Errorf("resolving %s: %w", s.Program, err)
+	}
+
+	info, err := os.Stat(abs)
+	if err != nil {
+		return fmt.Errorf("failed to stat %s: %w", s.Program, err)
+	}
+
+	s.id = filepath.Base(abs)
+	s.isDir = info.IsDir()
+	if s.isDir {
+		s.root = abs
+	} else {
+		s.root = filepath.Dir(abs)
+	}
+
+	s.dbg = New(args.StopOnEntry)
+	s.dbg.OnStop = func(reason string) {
+		s.sendEvent("stopped", stoppedEventBody{
+			Reason:            reason,
+			ThreadID:          threadID,
+			AllThreadsStopped: true,
+		})
+	}
+	for file, lines := range s.breakpoints {
+		s.dbg.SetBreakpoints(s.starlarkPath(file), lines)
+	}
+
+	s.launched = true
+	go s.maybeStart()
+
+	return nil
+}
+
+func (s *Session) setBreakpoints(args setBreakpointsArguments) interface{} {
+	lines := args.Lines
+	if len(args.Breakpoints) > 0 {
+		lines = make([]int, 0, len(args.Breakpoints))
+		for _, bp := range args.Breakpoints {
+			lines = append(lines, bp.Line)
+		}
+	}
+
+	s.mu.Lock()
+	s.breakpoints[args.Source.Path] = lines
+	if s.dbg != nil {
+		s.dbg.SetBreakpoints(s.starlarkPath(args.Source.Path), lines)
+	}
+	s.mu.Unlock()
+
+	bps := make([]breakpoint, 0, len(lines))
+	for _, l := range lines {
+		bps = append(bps, breakpoint{
+			Verified: true,
+			Line:     l,
+			Source:   &args.Source,
+		})
+	}
+
+	return map[string]interface{}{"breakpoints": bps}
+}
+
+func (s *Session) stackTrace() interface{} {
+	frames := s.debugger().Stack()
+
+	result := make([]stackFrame, 0, len(frames))
+	for _, fr := range frames {
+		p := s.localPath(fr.File)
+		result = append(result, stackFrame{
+			ID:     fr.ID + 1,
+			Name:   fr.Name,
+			Source: &source{Name: filepath.Base(p), Path: p},
+			Line:   fr.Line,
+			Column: fr.Column,
+		})
+	}
+
+	return map[string]interface{}{
+		"stackFrames": result,
+		"totalFrames": len(result),
+	}
+}
+
+func (s *Session) evaluate(args evaluateArguments) (interface{}, error) {
+	frameID := 0
+	if args.FrameID != nil {
+		frameID = *args.FrameID - 1
+	} else if frames := s.debugger().Stack(); len(frames) > 0 {
+		frameID = frames[0].ID
+	}
+
+	v, err := s.debugger().Evaluate(frameID, args.Expression)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]interface{}{
+		"result":             v.Value,
+		"type":               v.Type,
+		"variablesReference": v.Reference,
+	}, nil
+}
+
+// maybeStart runs the app once it has been launched and the client is
+// done configuring breakpoints.
+func (s *Session) maybeStart() {
+	s.mu.Lock()
+	if !s.launched || !s.configured || s.started {
+		s.mu.Unlock()
+		return
+	}
+	s.started = true
+
+	ctx, cancel := context.WithCancel(context.Background())
+	s.cancel = cancel
+	s.mu.Unlock()
+
+	go func() {
+		defer close(s.finished)
+
+		exitCode := 0
+		if err := s.run(ctx); err != nil {
+			s.output("stderr", err.Error()+"\n")
+			exitCode = 1
+		}
+
+		s.sendEvent("exited", exitedEventBody{ExitCode: exitCode})
+		s.sendEvent("terminated", nil)
+	}()
+}
+
+func (s *Session) run(ctx context.Context) error {
+	var fsys fs.FS
+	if s.isDir {
+		fsys = os.DirFS(s.root)
+	} else {
+		fsys = tools.NewSingleFileFS(filepath.Join(s.root, s.id))
+	}
+
+	applet, err := runtime.NewAppletFromFS(
+		s.id,
+		fsys,
+		runtime.WithThreadInitializer(s.dbg.Attach),
+		runtime.WithPrintFunc(func(thread *starlark.Thread, msg string) {
+			s.output("stdout", msg+"\n")
+		}),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to load applet: %w", err)
+	}
+	defer applet.Close()
+
+	if s.Handler != "" {
+		result, err := applet.CallSchemaHandler(ctx, s.Handler, s.Parameter)
+		if err != nil {
+			return err
+		}
+		s.output("console", fmt.Sprintf("%s() returned: %s\n", s.Handler, result))
+		return nil
+	}
+
+	roots, err := applet.RunWithConfig(ctx, s.Config)
+	if err != nil {
+		return fmt.Errorf("error running script: %w", err)
+	}
+	s.output("console", fmt.Sprintf("main() returned %d root(s)\n", len(roots)))
+
+	return nil
+}
+
+func (s *Session) shutdown() {
+	s.mu.Lock()
+	dbg, cancel, started := s.dbg, s.cancel, s.started
+	s.mu.Unlock()
+
+	if dbg != nil {
+		dbg.Detach()
+	}
+	if cancel != nil {
+		cancel()
+	}
+	if started {
+		<-s.finished
+	}
+}
+
+func (s *Session) debugger() *Debugger {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.dbg == nil {
+		// not launched yet, nothing is ever stopped
+		return New(false)
+	}
+	return s.dbg
+}
+
+// starlarkPath converts a path on disk to the file name used by Starlark,
+// which is the path relative to the app prefixed with the app ID.
+func (s *Session) starlarkPath(p string) string {
+	rel, err := filepath.Rel(s.root, p)
+	if err != nil {
+		return p
+	}
+	return path.Join(s.id, filepath.ToSlash(rel))
+}
+
+// localPath is the inverse of starlarkPath.
+func (s *Session) localPath(name string) string {
+	rel := strings.TrimPrefix(name, s.id+"/")
+	return filepath.Join(s.root, filepath.FromSlash(rel))
+}
+
+func (s *Session) output(category, msg string) {
+	s.sendEvent("output", outputEventBody{Category: category, Output: msg})
+}
+
+func (s *Session) respond(req *request, success bool, msg string, body interface{}) {
+	s.send(&response{
+		message:    message{Type: "response"},
+		RequestSeq: req.Seq,
+		Success:    success,
+		Command:    req.Command,
+		Message:    msg,
+		Body:       body,
+	})
+}
+
+func (s *Session) sendEvent(name string, body interface{}) {
+	s.send(&event{
+		message: message{Type: "event"},
+		Event:   name,
+		Body:    body,
+	})
+}
+
+func (s *Session) send(msg interface{}) {
+	s.wmu.Lock()
+	defer s.wmu.Unlock()
+
+	s.seq++
+	switch m := msg.(type) {
+	case *response:
+		m.Seq = s.seq
+	case *event:
+		m.Seq = s.seq
+	}
+
+	// there's nobody to report a failed write to, and the next read
+	// will fail too
+	writeMessage(s.w, msg)
+}
+
+func unmarshalArgs(req *request, v interface{}) error {
+	if len(req.Arguments) == 0 {
+		return nil
+	}
+	if err := json.Unmarshal(req.Arguments, v); err != nil {
+		return fmt.Errorf("decoding arguments for %s: %w", req.Command, err)
+	}
+	return nil
+}
+
+func toVariable(v Variable) variable {
+	return variable{
+		Name:               v.Name,
+		Value:              v.Value,
+		Type:               v.Type,
+		VariablesReference: v.Reference,
+	}
+}
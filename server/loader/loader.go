@@ -71,6 +71,7 @@ func NewLoader(
 	cache := runtime.NewInMemoryCache()
 	runtime.InitHTTP(cache)
 	runtime.InitCache(cache)
+	runtime.InitStore(runtime.NewInMemoryStore(runtime.DefaultStoreQuota))
 
 	if !l.watch {
 		app, err := loadScript("app-id", l.fs)
@@ -173,6 +174,7 @@ func (l *Loader) loadApplet(config map[string]string) (string, error) {
 		if err != nil {
 			return "", err
 		} else {
+			l.applet.Close()
 			l.applet = *app
 		}
 	}
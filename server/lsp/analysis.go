@@ -0,0 +1,412 @@
+package lsp
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode/utf16"
+	"unicode/utf8"
+
+	"go.starlark.net/starlark"
+
+	"tidbyt.dev/pixlet/runtime"
+	"tidbyt.dev/pixlet/runtime/modules/animation_runtime"
+	"tidbyt.dev/pixlet/runtime/modules/render_runtime"
+)
+
+// typeMetadata holds the metadata of the types constructed by members of
+// built-in modules, keyed by module name.
+var typeMetadata = map[string][]render_runtime.TypeMetadata{
+	"render.star":    render_runtime.Metadata,
+	"animation.star": animation_runtime.Metadata,
+}
+
+var (
+	loadRe       = regexp.MustCompile(`load\(\s*"([^"]+)"((?:\s*,\s*(?:\w+\s*=\s*)?"[^"]*")*)`)
+	loadSymbolRe = regexp.MustCompile(`(?:(\w+)\s*=\s*)?"([^"]*)"`)
+	calleeRe     = regexp.MustCompile(`([A-Za-z_]\w*(?:\.[A-Za-z_]\w*)*)\s*$`)
+	kwargRe      = regexp.MustCompile(`^\s*([A-Za-z_]\w*)\s*=($|[^=])`)
+)
+
+// binding is a global name bound by a load statement to a symbol of a
+// module.
+type binding struct {
+	module string
+	symbol string
+}
+
+// loadBindings finds the names bound by load statements. It works on
+// plain text so that it keeps working while the document is being edited
+// and doesn't parse.
+func loadBindings(text string) map[string]binding {
+	bindings := map[string]binding{}
+
+	for _, m := range loadRe.FindAllStringSubmatch(text, -1) {
+		for _, sym := range loadSymbolRe.FindAllStringSubmatch(m[2], -1) {
+			name := sym[1]
+			if name == "" {
+				name = sym[2]
+			}
+			bindings[name] = binding{module: m[1], symbol: sym[2]}
+		}
+	}
+
+	return bindings
+}
+
+// value returns the value the binding refers to, if it's a symbol of a
+// built-in module.
+func (b binding) value() (starlark.Value, bool) {
+	mod, err := runtime.LoadBuiltinModule(b.module)
+	if err != nil {
+		return nil, false
+	}
+
+	v, ok := mod[b.symbol]
+	return v, ok
+}
+
+// members returns the sorted attribute names of the bound value.
+func (b binding) members() []string {
+	v, ok := b.value()
+	if !ok {
+		return nil
+	}
+
+	attrs, ok := v.(starlark.HasAttrs)
+	if !ok {
+		return nil
+	}
+
+	names := attrs.AttrNames()
+	sort.Strings(names)
+	return names
+}
+
+// member returns the named attribute of the bound value.
+func (b binding) member(name string) (starlark.Value, bool) {
+	v, ok := b.value()
+	if !ok {
+		return nil, false
+	}
+
+	attrs, ok := v.(starlark.HasAttrs)
+	if !ok {
+		return nil, false
+	}
+
+	m, err := attrs.Attr(name)
+	if err != nil || m == nil {
+		return nil, false
+	}
+	return m, true
+}
+
+// typeOf returns the metadata of the type constructed by the named
+// member of the bound value.
+func (b binding) typeOf(name string) (render_runtime.TypeMetadata, bool) {
+	for _, t := range typeMetadata[b.module] {
+		if t.Name == name {
+			return t, true
+		}
+	}
+	return render_runtime.TypeMetadata{}, false
+}
+
+// resolveType resolves an expression like "render.Text" to the type it
+// constructs.
+func resolveType(bindings map[string]binding, expr string) (render_runtime.TypeMetadata, bool) {
+	name, member, ok := strings.Cut(expr, ".")
+	if !ok {
+		return render_runtime.TypeMetadata{}, false
+	}
+
+	b, ok := bindings[name]
+	if !ok {
+		return render_runtime.TypeMetadata{}, false
+	}
+
+	return b.typeOf(member)
+}
+
+// signature formats the constructor of a type, as called from Starlark.
+func signature(prefix string, t render_runtime.TypeMetadata) string {
+	var params []string
+	for _, attr := range t.Attributes {
+		if !attr.ReadOnly {
+			params = append(params, attr.Name)
+		}
+	}
+	return fmt.Sprintf("%s.%s(%s)", prefix, t.Name, strings.Join(params, ", "))
+}
+
+// typeDoc formats the documentation of a type as Markdown, in the same
+// layout as the generated widget docs.
+func typeDoc(prefix string, t render_runtime.TypeMetadata) string {
+	var sb strings.Builder
+
+	fmt.Fprintf(&sb, "```python\n%s\n```\n\n", signature(prefix, t))
+	if t.Documentation != "" {
+		fmt.Fprintf(&sb, "%s\n\n", t.Documentation)
+	}
+
+	sb.WriteString("| Name | Type | Description | Required |\n")
+	sb.WriteString("| --- | --- | --- | --- |\n")
+	for _, attr := range t.Attributes {
+		required := "N"
+		if attr.Required {
+			required = "**Y**"
+		}
+		fmt.Fprintf(&sb, "| `%s` | `%s` | %s | %s |\n", attr.Name, attr.Type, attr.Documentation, required)
+	}
+
+	return sb.String()
+}
+
+// attrDoc formats the documentation of a single attribute as Markdown.
+func attrDoc(attr render_runtime.AttrMetadata) string {
+	var sb strings.Builder
+
+	fmt.Fprintf(&sb, "```python\n%s: %s\n```\n\n", attr.Name, attr.Type)
+	if attr.Documentation != "" {
+		sb.WriteString(attr.Documentation)
+	}
+	if attr.Required {
+		sb.WriteString("\n\nRequired.")
+	}
+
+	return sb.String()
+}
+
+// scanState describes the syntactic context at an offset in a document.
+type scanState struct {
+	// brackets holds the offsets of the brackets that are open, innermost
+	// last
+	brackets []int
+
+	inString  bool
+	inComment bool
+}
+
+// scan scans text up to offset, skipping over strings and comments.
+func scan(text string, offset int) scanState {
+	var st scanState
+
+	for i := 0; i < offset; i++ {
+		switch c := text[i]; c {
+		case '#':
+			end := strings.IndexByte(text[i:], '\n')
+			if end < 0 || i+end >= offset {
+				st.inComment = true
+				return st
+			}
+			i += end
+
+		case '"', '\'':
+			quote := string(c)
+			if strings.HasPrefix(text[i:], strings.Repeat(quote, 3)) {
+				quote = strings.Repeat(quote, 3)
+			}
+
+			end := stringEnd(text, i+len(quote), quote)
+			if end < 0 || end > offset {
+				st.inString = true
+				return st
+			}
+			i = end - 1
+
+		case '(', '[', '{':
+			st.brackets = append(st.brackets, i)
+
+		case ')', ']', '}':
+			if n := len(st.brackets); n > 0 {
+				st.brackets = st.brackets[:n-1]
+			}
+		}
+	}
+
+	return st
+}
+
+// stringEnd returns the offset just past the closing quote of a string
+// whose contents start at start, or -1 if it isn't closed.
+func stringEnd(text string, start int, quote string) int {
+	for i := start; i < len(text); i++ {
+		switch {
+		case text[i] == '\\':
+			i++
+		case strings.HasPrefix(text[i:], quote):
+			return i + len(quote)
+		case text[i] == '\n' && len(quote) == 1:
+			return -1
+		}
+	}
+	return -1
+}
+
+// callAt finds the innermost call whose argument list contains offset. It
+// returns the callee expression, such as "render.Text", and the offset at
+// which the arguments start.
+func callAt(text string, offset int) (callee string, argsStart int, ok bool) {
+	st := scan(text, offset)
+	if st.inString || st.inComment || len(st.brackets) == 0 {
+		return "", 0, false
+	}
+
+	open := st.brackets[len(st.brackets)-1]
+	if text[open] != '(' {
+		return "", 0, false
+	}
+
+	m := calleeRe.FindStringSubmatch(text[:open])
+	if m == nil {
+		return "", 0, false
+	}
+
+	return m[1], open + 1, true
+}
+
+// splitArgs splits an argument list on the commas that aren't nested in
+// brackets or strings.
+func splitArgs(args string) []string {
+	var parts []string
+
+	depth, start := 0, 0
+	for i := 0; i < len(args); i++ {
+		switch c := args[i]; c {
+		case '"', '\'':
+			quote := string(c)
+			if strings.HasPrefix(args[i:], strings.Repeat(quote, 3)) {
+				quote = strings.Repeat(quote, 3)
+			}
+			end := stringEnd(args, i+len(quote), quote)
+			if end < 0 {
+				i = len(args)
+			} else {
+				i = end - 1
+			}
+
+		case '(', '[', '{':
+			depth++
+
+		case ')', ']', '}':
+			depth--
+
+		case ',':
+			if depth == 0 {
+				parts = append(parts, args[start:i])
+				start = i + 1
+			}
+		}
+	}
+
+	return append(parts, args[start:])
+}
+
+// kwargName returns the name of the keyword argument an argument assigns,
+// or "" if it's a positional argument.
+func kwargName(arg string) string {
+	if m := kwargRe.FindStringSubmatch(arg); m != nil {
+		return m[1]
+	}
+	return ""
+}
+
+func isIdentByte(c byte) bool {
+	return c == '_' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9'
+}
+
+// wordAt returns the dotted identifier around offset, such as
+// "render.Text", and its start and end offsets.
+func wordAt(text string, offset int) (word string, start, end int) {
+	start, end = offset, offset
+	for start > 0 && (isIdentByte(text[start-1]) || text[start-1] == '.') {
+		start--
+	}
+	for end < len(text) && isIdentByte(text[end]) {
+		end++
+	}
+	return text[start:end], start, end
+}
+
+// offsetOf converts an LSP position, which counts UTF-16 code units, to a
+// byte offset in text.
+func offsetOf(text string, pos position) int {
+	offset := 0
+	for line := 0; line < pos.Line; line++ {
+		i := strings.IndexByte(text[offset:], '\n')
+		if i < 0 {
+			return len(text)
+		}
+		offset += i + 1
+	}
+
+	for units := 0; units < pos.Character && offset < len(text); {
+		r, size := utf8.DecodeRuneInString(text[offset:])
+		if r == '\n' {
+			break
+		}
+		units += len(utf16.Encode([]rune{r}))
+		offset += size
+	}
+
+	return offset
+}
+
+// positionOf converts a byte offset in text to an LSP position.
+func positionOf(text string, offset int) position {
+	if offset > len(text) {
+		offset = len(text)
+	}
+
+	lineStart := strings.LastIndexByte(text[:offset], '\n') + 1
+	line := strings.Count(text[:lineStart], "\n")
+
+	units := 0
+	for _, r := range text[lineStart:offset] {
+		units += len(utf16.Encode([]rune{r}))
+	}
+
+	return position{Line: line, Character: units}
+}
+
+// closest returns the candidate with the smallest edit distance to name,
+// if it's close enough to be a likely typo.
+func closest(name string, candidates []string) string {
+	best, bestDist := "", len(name)/2+1
+	for _, c := range candidates {
+		if d := editDistance(name, c); d < bestDist {
+			best, bestDist = c, d
+		}
+	}
+	return best
+}
+
+// editDistance computes the Damerau-Levenshtein distance between a and
+// b, counting transpositions of adjacent characters as one edit.
+func editDistance(a, b string) int {
+	d := make([][]int, len(a)+1)
+	for i := range d {
+		d[i] = make([]int, len(b)+1)
+		d[i][0] = i
+	}
+	for j := range d[0] {
+		d[0][j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			d[i][j] = min(d[i-1][j]+1, d[i][j-1]+1, d[i-1][j-1]+cost)
+			if i > 1 && j > 1 && a[i-1] == b[j-2] && a[i-2] == b[j-1] {
+				d[i][j] = min(d[i][j], d[i-2][j-2]+1)
+			}
+		}
+	}
+
+	return d[len(a)][len(b)]
+}
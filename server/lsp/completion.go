@@ -0,0 +1,153 @@
+package lsp
+
+import (
+	"regexp"
+	"strings"
+
+	"go.starlark.net/starlark"
+
+	"tidbyt.dev/pixlet/runtime"
+)
+
+var (
+	loadModulePrefixRe = regexp.MustCompile(`load\(\s*"([^"]*)$`)
+	loadSymbolPrefixRe = regexp.MustCompile(`load\(\s*"([^"]+)"\s*,.*"(\w*)$`)
+	memberPrefixRe     = regexp.MustCompile(`(?:^|[^\w.])([A-Za-z_]\w*)\.(\w*)$`)
+	kwargPrefixRe      = regexp.MustCompile(`^\s*(\w*)$`)
+)
+
+// complete returns the completions at offset in text.
+func complete(text string, offset int) []completionItem {
+	lineStart := strings.LastIndexByte(text[:offset], '\n') + 1
+	line := text[lineStart:offset]
+
+	// module names in load statements
+	if m := loadModulePrefixRe.FindStringSubmatch(line); m != nil {
+		return completeModules(text, offset-len(m[1]), offset)
+	}
+
+	// symbols of modules in load statements
+	if m := loadSymbolPrefixRe.FindStringSubmatch(line); m != nil {
+		return completeSymbols(text, m[1], offset-len(m[2]), offset)
+	}
+
+	st := scan(text, offset)
+	if st.inString || st.inComment {
+		return nil
+	}
+
+	bindings := loadBindings(text)
+
+	// members of loaded modules, including widget constructors
+	if m := memberPrefixRe.FindStringSubmatch(line); m != nil {
+		if b, ok := bindings[m[1]]; ok {
+			return completeMembers(m[1], b)
+		}
+		return nil
+	}
+
+	// keyword arguments of widget constructors
+	if callee, argsStart, ok := callAt(text, offset); ok {
+		args := splitArgs(text[argsStart:offset])
+		if !kwargPrefixRe.MatchString(args[len(args)-1]) {
+			// not at the start of an argument
+			return nil
+		}
+
+		if t, ok := resolveType(bindings, callee); ok {
+			used := map[string]bool{}
+			positional := 0
+			for _, arg := range args[:len(args)-1] {
+				if name := kwargName(arg); name != "" {
+					used[name] = true
+				} else if !strings.HasPrefix(strings.TrimSpace(arg), "*") {
+					positional++
+				}
+			}
+
+			var items []completionItem
+			for _, attr := range t.Attributes {
+				if attr.ReadOnly {
+					continue
+				}
+				if positional > 0 {
+					// passed by position
+					positional--
+					continue
+				}
+				if used[attr.Name] {
+					continue
+				}
+				items = append(items, completionItem{
+					Label:         attr.Name,
+					Kind:          completionKindProperty,
+					Detail:        attr.Type,
+					Documentation: &markupContent{Kind: markupKindMarkdown, Value: attrDoc(attr)},
+					InsertText:    attr.Name + " = ",
+				})
+			}
+			return items
+		}
+	}
+
+	return nil
+}
+
+func completeModules(text string, start, end int) []completionItem {
+	rng := textRange{Start: positionOf(text, start), End: positionOf(text, end)}
+
+	var items []completionItem
+	for _, name := range runtime.ModuleNames() {
+		items = append(items, completionItem{
+			Label:    name,
+			Kind:     completionKindModule,
+			TextEdit: &textEdit{Range: rng, NewText: name},
+		})
+	}
+	return items
+}
+
+func completeSymbols(text, module string, start, end int) []completionItem {
+	mod, err := runtime.LoadBuiltinModule(module)
+	if err != nil {
+		return nil
+	}
+
+	rng := textRange{Start: positionOf(text, start), End: positionOf(text, end)}
+
+	var items []completionItem
+	for _, name := range mod.Keys() {
+		items = append(items, completionItem{
+			Label:    name,
+			Kind:     completionKindModule,
+			Detail:   mod[name].Type(),
+			TextEdit: &textEdit{Range: rng, NewText: name},
+		})
+	}
+	return items
+}
+
+func completeMembers(prefix string, b binding) []completionItem {
+	var items []completionItem
+	for _, name := range b.members() {
+		if t, ok := b.typeOf(name); ok {
+			items = append(items, completionItem{
+				Label:         name,
+				Kind:          completionKindClass,
+				Detail:        signature(prefix, t),
+				Documentation: &markupContent{Kind: markupKindMarkdown, Value: typeDoc(prefix, t)},
+			})
+			continue
+		}
+
+		item := completionItem{Label: name, Kind: completionKindProperty}
+		if v, ok := b.member(name); ok {
+			item.Detail = v.Type()
+			if _, ok := v.(starlark.Callable); ok {
+				item.Kind = completionKindFunction
+			}
+		}
+		items = append(items, item)
+	}
+	return items
+}
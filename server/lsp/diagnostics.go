@@ -0,0 +1,179 @@
+package lsp
+
+import (
+	"fmt"
+	"net/url"
+	"path"
+
+	"github.com/bazelbuild/buildtools/build"
+	"github.com/bazelbuild/buildtools/warn"
+
+	"tidbyt.dev/pixlet/runtime/modules/render_runtime"
+)
+
+const diagnosticSource = "pixlet"
+
+// diagnose parses and lints a document. It reports syntax errors, the
+// enabled buildifier warnings, and calls to widget constructors with
+// unknown or missing arguments.
+func diagnose(uri, text string, warnings []string) []diagnostic {
+	filename := uri
+	if u, err := url.Parse(uri); err == nil {
+		filename = path.Base(u.Path)
+	}
+
+	f, err := build.Parse(filename, []byte(text))
+	if err != nil {
+		pos := position{}
+		if pe, ok := err.(build.ParseError); ok {
+			pos = positionOf(text, pe.Pos.Byte)
+		}
+		return []diagnostic{{
+			Range:    textRange{Start: pos, End: pos},
+			Severity: severityError,
+			Source:   diagnosticSource,
+			Message:  err.Error(),
+		}}
+	}
+
+	diags := []diagnostic{}
+
+	for _, finding := range warn.FileWarnings(f, warnings, nil, warn.ModeWarn, nil) {
+		diags = append(diags, diagnostic{
+			Range: textRange{
+				Start: positionOf(text, finding.Start.Byte),
+				End:   positionOf(text, finding.End.Byte),
+			},
+			Severity: severityWarning,
+			Code:     finding.Category,
+			Source:   diagnosticSource,
+			Message:  finding.Message,
+		})
+	}
+
+	bindings := loadBindings(text)
+	build.Walk(f, func(x build.Expr, _ []build.Expr) {
+		call, ok := x.(*build.CallExpr)
+		if !ok {
+			return
+		}
+
+		dot, ok := call.X.(*build.DotExpr)
+		if !ok {
+			return
+		}
+
+		ident, ok := dot.X.(*build.Ident)
+		if !ok {
+			return
+		}
+
+		b, ok := bindings[ident.Name]
+		if !ok {
+			return
+		}
+
+		if _, ok := typeMetadata[b.module]; !ok {
+			// no metadata to check against
+			return
+		}
+
+		t, ok := b.typeOf(dot.Name)
+		if !ok {
+			if _, isMember := b.member(dot.Name); !isMember {
+				diags = append(diags, errorAt(text, dot.NamePos, dot.Name, fmt.Sprintf(
+					"%s has no member %s%s", ident.Name, dot.Name,
+					suggestion(dot.Name, b.members()),
+				)))
+			}
+			return
+		}
+
+		diags = append(diags, checkArgs(text, ident.Name, t, call)...)
+	})
+
+	return diags
+}
+
+// checkArgs checks the arguments of a call to the constructor of t.
+func checkArgs(text, prefix string, t render_runtime.TypeMetadata, call *build.CallExpr) []diagnostic {
+	var params, valid []string
+	for _, attr := range t.Attributes {
+		if !attr.ReadOnly {
+			params = append(params, attr.Name)
+			valid = append(valid, attr.Name)
+		}
+	}
+
+	var diags []diagnostic
+	passed := map[string]bool{}
+	positional := 0
+	unpacked := false
+
+	for _, arg := range call.List {
+		switch a := arg.(type) {
+		case *build.AssignExpr:
+			lhs, ok := a.LHS.(*build.Ident)
+			if !ok || a.Op != "=" {
+				continue
+			}
+
+			passed[lhs.Name] = true
+			if attr, ok := t.Attribute(lhs.Name); !ok || attr.ReadOnly {
+				diags = append(diags, errorAt(text, lhs.NamePos, lhs.Name, fmt.Sprintf(
+					"%s.%s has no argument %s%s", prefix, t.Name, lhs.Name,
+					suggestion(lhs.Name, valid),
+				)))
+			}
+
+		case *build.UnaryExpr:
+			if a.Op == "*" || a.Op == "**" {
+				unpacked = true
+				continue
+			}
+			positional++
+
+		default:
+			positional++
+		}
+	}
+
+	if unpacked {
+		// can't tell which arguments are passed
+		return diags
+	}
+
+	for i := 0; i < positional && i < len(params); i++ {
+		passed[params[i]] = true
+	}
+
+	for _, attr := range t.Attributes {
+		if attr.Required && !passed[attr.Name] {
+			start, _ := call.X.Span()
+			diags = append(diags, errorAt(text, start, prefix+"."+t.Name, fmt.Sprintf(
+				"%s.%s is missing required argument %s", prefix, t.Name, attr.Name,
+			)))
+		}
+	}
+
+	return diags
+}
+
+func errorAt(text string, pos build.Position, name, msg string) diagnostic {
+	return diagnostic{
+		Range: textRange{
+			Start: positionOf(text, pos.Byte),
+			End:   positionOf(text, pos.Byte+len(name)),
+		},
+		Severity: severityError,
+		Source:   diagnosticSource,
+		Message:  msg,
+	}
+}
+
+func suggestion(name string, candidates []string) string {
+	if c := closest(name, candidates); c != "" {
+		return fmt.Sprintf(" (did you mean %s?)", c)
+	}
+	return ""
+}
@@ -0,0 +1,61 @@
+package lsp
+
+import (
+	"fmt"
+	"strings"
+)
+
+// hoverAt returns the documentation for the identifier at offset in text,
+// or nil if there's nothing to show.
+func hoverAt(text string, offset int) *hover {
+	if st := scan(text, offset); st.inString || st.inComment {
+		return nil
+	}
+
+	word, start, end := wordAt(text, offset)
+	if word == "" {
+		return nil
+	}
+
+	bindings := loadBindings(text)
+	rng := &textRange{Start: positionOf(text, start), End: positionOf(text, end)}
+
+	// a keyword argument of a widget constructor
+	rest := strings.TrimLeft(text[end:], " \t")
+	if !strings.Contains(word, ".") && strings.HasPrefix(rest, "=") && !strings.HasPrefix(rest, "==") {
+		if callee, _, ok := callAt(text, start); ok {
+			if t, ok := resolveType(bindings, callee); ok {
+				if attr, ok := t.Attribute(word); ok {
+					return markdownHover(attrDoc(attr), rng)
+				}
+			}
+		}
+	}
+
+	name, member, dotted := strings.Cut(word, ".")
+	b, ok := bindings[name]
+	if !ok {
+		return nil
+	}
+
+	if !dotted {
+		return markdownHover(fmt.Sprintf("```python\n%s\n```\n\nLoaded from `%s`.", name, b.module), rng)
+	}
+
+	if t, ok := b.typeOf(member); ok {
+		return markdownHover(typeDoc(name, t), rng)
+	}
+
+	if v, ok := b.member(member); ok {
+		return markdownHover(fmt.Sprintf("```python\n%s: %s\n```", word, v.Type()), rng)
+	}
+
+	return nil
+}
+
+func markdownHover(value string, rng *textRange) *hover {
+	return &hover{
+		Contents: markupContent{Kind: markupKindMarkdown, Value: value},
+		Range:    rng,
+	}
+}
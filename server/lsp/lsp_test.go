@@ -0,0 +1,250 @@
+package lsp
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var lspSource = `load("render.star", "render")
+load("http.star", "http")
+
+def main(config):
+    return render.Root(
+        child = render.Row(
+            cross_aling = "center",
+            children = [render.Text("hi", color = "#fff")],
+        ),
+    )
+`
+
+// cursor returns text with the "|" marker removed, and the offset of the
+// marker.
+func cursor(text string) (string, int) {
+	i := strings.Index(text, "|")
+	return text[:i] + text[i+1:], i
+}
+
+func labels(items []completionItem) []string {
+	var l []string
+	for _, item := range items {
+		l = append(l, item.Label)
+	}
+	return l
+}
+
+func TestCompleteModules(t *testing.T) {
+	text, offset := cursor(`load("ren|`)
+	items := complete(text, offset)
+
+	assert.Contains(t, labels(items), "render.star")
+	assert.Contains(t, labels(items), "encoding/json.star")
+
+	for _, item := range items {
+		require.NotNil(t, item.TextEdit)
+		assert.Equal(t, position{Line: 0, Character: 6}, item.TextEdit.Range.Start)
+		assert.Equal(t, position{Line: 0, Character: 9}, item.TextEdit.Range.End)
+	}
+}
+
+func TestCompleteLoadSymbols(t *testing.T) {
+	text, offset := cursor(`load("humanize.star", "|`)
+	assert.Equal(t, []string{"humanize"}, labels(complete(text, offset)))
+}
+
+func TestCompleteMembers(t *testing.T) {
+	text, offset := cursor(`load("render.star", "render")
+load("http.star", "http")
+
+def main():
+    render.|`)
+	items := complete(text, offset)
+	assert.Contains(t, labels(items), "Text")
+	assert.Contains(t, labels(items), "WrappedText")
+	assert.Contains(t, labels(items), "fonts")
+
+	for _, item := range items {
+		if item.Label == "Text" {
+			assert.Equal(t, completionKindClass, item.Kind)
+			assert.Contains(t, item.Detail, "render.Text(content, font")
+			assert.Contains(t, item.Documentation.Value, "| `content` | `str` |")
+		}
+	}
+
+	text, offset = cursor(`load("http.star", "http")
+x = http.g|`)
+	items = complete(text, offset)
+	assert.Contains(t, labels(items), "get")
+	assert.Equal(t, completionKindFunction, items[0].Kind)
+
+	// not a loaded module
+	text, offset = cursor(`x = foo.|`)
+	assert.Empty(t, complete(text, offset))
+}
+
+func TestCompleteKwargs(t *testing.T) {
+	text, offset := cursor(`load("render.star", "render")
+x = render.Text("hi", color = "#fff", |)`)
+	items := complete(text, offset)
+	assert.Contains(t, labels(items), "font")
+	assert.NotContains(t, labels(items), "color", "already passed")
+	assert.NotContains(t, labels(items), "content", "already passed by position")
+	assert.Equal(t, "font = ", items[0].InsertText)
+
+	// in a nested call
+	text, offset = cursor(`load("render.star", "render")
+x = render.Box(child = render.Row(cross|))`)
+	assert.Contains(t, labels(complete(text, offset)), "cross_align")
+
+	// not while typing a value
+	text, offset = cursor(`load("render.star", "render")
+x = render.Text(color = |)`)
+	assert.Empty(t, complete(text, offset))
+
+	// not in strings
+	text, offset = cursor(`load("render.star", "render")
+x = render.Text("|")`)
+	assert.Empty(t, complete(text, offset))
+}
+
+func TestHover(t *testing.T) {
+	offset := strings.Index(lspSource, "Row") + 1
+	h := hoverAt(lspSource, offset)
+	require.NotNil(t, h)
+	assert.Contains(t, h.Contents.Value, "render.Row(children")
+	assert.Contains(t, h.Contents.Value, "| `main_align` |")
+
+	offset = strings.Index(lspSource, "color")
+	h = hoverAt(lspSource, offset)
+	require.NotNil(t, h)
+	assert.Contains(t, h.Contents.Value, "color: color")
+
+	offset = strings.Index(lspSource, "http.star") + len(`http.star", "`)
+	h = hoverAt(lspSource, offset)
+	assert.Nil(t, h, "strings have no hover")
+
+	offset = strings.Index(lspSource, "def main") + 1
+	assert.Nil(t, hoverAt(lspSource, offset))
+}
+
+func TestDiagnostics(t *testing.T) {
+	diags := diagnose("file:///app/hello.star", lspSource, []string{"load"})
+
+	var messages []string
+	for _, d := range diags {
+		messages = append(messages, d.Message)
+	}
+
+	require.Len(t, diags, 2, messages)
+
+	// unused load from buildifier
+	assert.Equal(t, "load", diags[0].Code)
+	assert.Equal(t, severityWarning, diags[0].Severity)
+	assert.Equal(t, 1, diags[0].Range.Start.Line)
+
+	// misspelled kwarg
+	assert.Equal(t, "render.Row has no argument cross_aling (did you mean cross_align?)", diags[1].Message)
+	assert.Equal(t, severityError, diags[1].Severity)
+	assert.Equal(t, position{Line: 6, Character: 12}, diags[1].Range.Start)
+	assert.Equal(t, position{Line: 6, Character: 23}, diags[1].Range.End)
+}
+
+func TestDiagnosticsMissingArguments(t *testing.T) {
+	diags := diagnose("hello.star", `load("render.star", "render")
+a = render.Text()
+b = render.Txt("hi")
+c = render.Text(**kwargs)
+d = render.Text(content = "hi")
+`, nil)
+
+	require.Len(t, diags, 2)
+	assert.Equal(t, "render.Text is missing required argument content", diags[0].Message)
+	assert.Equal(t, "render has no member Txt (did you mean Text?)", diags[1].Message)
+}
+
+func TestDiagnosticsSyntaxError(t *testing.T) {
+	diags := diagnose("hello.star", "def main(:\n", nil)
+	require.Len(t, diags, 1)
+	assert.Equal(t, severityError, diags[0].Severity)
+	assert.Equal(t, 0, diags[0].Range.Start.Line)
+}
+
+func TestPositions(t *testing.T) {
+	text := "ab\n😀x = 1\n"
+
+	assert.Equal(t, 0, offsetOf(text, position{0, 0}))
+	assert.Equal(t, 3, offsetOf(text, position{1, 0}))
+	assert.Equal(t, 7, offsetOf(text, position{1, 2}), "emoji is two UTF-16 code units")
+	assert.Equal(t, 12, offsetOf(text, position{1, 100}), "clamped to end of line")
+
+	assert.Equal(t, position{1, 2}, positionOf(text, 7))
+	assert.Equal(t, position{1, 3}, positionOf(text, 8))
+}
+
+func TestServer(t *testing.T) {
+	reqR, reqW := io.Pipe()
+	resR, resW := io.Pipe()
+
+	s := NewServer(reqR, resW)
+	done := make(chan error)
+	go func() { done <- s.Serve() }()
+
+	r := bufio.NewReader(resR)
+	send := func(id int, method string, params interface{}) {
+		msg := map[string]interface{}{"jsonrpc": "2.0", "method": method, "params": params}
+		if id > 0 {
+			msg["id"] = id
+		}
+		require.NoError(t, writeMessage(reqW, msg))
+	}
+	recv := func() map[string]interface{} {
+		data, err := readMessage(r)
+		require.NoError(t, err)
+		var msg map[string]interface{}
+		require.NoError(t, json.Unmarshal(data, &msg))
+		return msg
+	}
+
+	send(1, "initialize", map[string]interface{}{})
+	res := recv()
+	assert.Equal(t, float64(1), res["id"])
+	caps := res["result"].(map[string]interface{})["capabilities"].(map[string]interface{})
+	assert.Equal(t, true, caps["hoverProvider"])
+
+	send(0, "initialized", map[string]interface{}{})
+
+	uri := "file:///app/hello.star"
+	send(0, "textDocument/didOpen", map[string]interface{}{
+		"textDocument": map[string]interface{}{"uri": uri, "languageId": "starlark", "version": 1, "text": lspSource},
+	})
+	msg := recv()
+	assert.Equal(t, "textDocument/publishDiagnostics", msg["method"])
+	diags := msg["params"].(map[string]interface{})["diagnostics"].([]interface{})
+	assert.Len(t, diags, 1)
+
+	send(2, "textDocument/completion", map[string]interface{}{
+		"textDocument": map[string]interface{}{"uri": uri},
+		"position":     map[string]interface{}{"line": 4, "character": 18},
+	})
+	res = recv()
+	assert.Equal(t, float64(2), res["id"])
+	items := res["result"].(map[string]interface{})["items"].([]interface{})
+	assert.NotEmpty(t, items)
+
+	send(3, "textDocument/definition", map[string]interface{}{})
+	res = recv()
+	assert.Equal(t, float64(codeMethodNotFound), res["error"].(map[string]interface{})["code"])
+
+	send(4, "shutdown", nil)
+	res = recv()
+	assert.Equal(t, float64(4), res["id"])
+	assert.Nil(t, res["result"])
+
+	send(0, "exit", nil)
+	require.NoError(t, <-done)
+}
@@ -0,0 +1,193 @@
+// Package lsp implements a Language Server Protocol server for editing
+// Pixlet apps, with completion, hover docs and diagnostics.
+package lsp
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sync"
+)
+
+// Server serves a single client over a reader and a writer, usually the
+// standard streams of the process.
+type Server struct {
+	// Warnings are the buildifier warnings to report as diagnostics.
+	Warnings []string
+
+	r   *bufio.Reader
+	w   io.Writer
+	wmu sync.Mutex
+
+	docs map[string]string
+}
+
+// NewServer creates a server reading messages from r and writing
+// messages to w.
+func NewServer(r io.Reader, w io.Writer) *Server {
+	return &Server{
+		r:    bufio.NewReader(r),
+		w:    w,
+		docs: map[string]string{},
+	}
+}
+
+// Serve handles messages until the client sends the exit notification
+// or the input is closed.
+func (s *Server) Serve() error {
+	for {
+		data, err := readMessage(s.r)
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("reading message: %w", err)
+		}
+
+		var msg message
+		if err := json.Unmarshal(data, &msg); err != nil {
+			s.send(&response{
+				JSONRPC: "2.0",
+				Error:   &responseError{Code: codeParseError, Message: err.Error()},
+			})
+			continue
+		}
+
+		if msg.Method == "exit" {
+			return nil
+		}
+
+		result, err := s.handle(&msg)
+		if msg.ID == nil {
+			// notifications have no response, even if they fail
+			continue
+		}
+
+		res := &response{JSONRPC: "2.0", ID: msg.ID, Result: result}
+		if err != nil {
+			res.Result = nil
+			res.Error = toResponseError(err)
+		}
+		s.send(res)
+	}
+}
+
+// methodError is an error with a specific JSON-RPC error code.
+type methodError struct {
+	code int
+	msg  string
+}
+
+func (e *methodError) Error() string { return e.msg }
+
+func toResponseError(err error) *responseError {
+	if me, ok := err.(*methodError); ok {
+		return &responseError{Code: me.code, Message: me.msg}
+	}
+	return &responseError{Code: codeInvalidParams, Message: err.Error()}
+}
+
+func (s *Server) handle(msg *message) (interface{}, error) {
+	switch msg.Method {
+	case "initialize":
+		return map[string]interface{}{
+			"capabilities": map[string]interface{}{
+				"textDocumentSync": syncFull,
+				"completionProvider": map[string]interface{}{
+					"triggerCharacters": []string{".", "\"", "(", ","},
+				},
+				"hoverProvider": true,
+			},
+			"serverInfo": map[string]string{"name": "pixlet"},
+		}, nil
+
+	case "initialized", "$/cancelRequest", "$/setTrace":
+		return nil, nil
+
+	case "shutdown":
+		return nil, nil
+
+	case "textDocument/didOpen":
+		var params didOpenParams
+		if err := json.Unmarshal(msg.Params, &params); err != nil {
+			return nil, err
+		}
+		s.update(params.TextDocument.URI, params.TextDocument.Text)
+		return nil, nil
+
+	case "textDocument/didChange":
+		var params didChangeParams
+		if err := json.Unmarshal(msg.Params, &params); err != nil {
+			return nil, err
+		}
+		// with full document sync, the last change holds the whole
+		// document
+		if n := len(params.ContentChanges); n > 0 {
+			s.update(params.TextDocument.URI, params.ContentChanges[n-1].Text)
+		}
+		return nil, nil
+
+	case "textDocument/didClose":
+		var params didCloseParams
+		if err := json.Unmarshal(msg.Params, &params); err != nil {
+			return nil, err
+		}
+		delete(s.docs, params.TextDocument.URI)
+		s.publishDiagnostics(params.TextDocument.URI, []diagnostic{})
+		return nil, nil
+
+	case "textDocument/completion":
+		var params textDocumentPositionParams
+		if err := json.Unmarshal(msg.Params, &params); err != nil {
+			return nil, err
+		}
+		text := s.docs[params.TextDocument.URI]
+		return completionList{
+			Items: complete(text, offsetOf(text, params.Position)),
+		}, nil
+
+	case "textDocument/hover":
+		var params textDocumentPositionParams
+		if err := json.Unmarshal(msg.Params, &params); err != nil {
+			return nil, err
+		}
+		text := s.docs[params.TextDocument.URI]
+		if h := hoverAt(text, offsetOf(text, params.Position)); h != nil {
+			return h, nil
+		}
+		// a null result means there's nothing to show
+		return nil, nil
+
+	default:
+		return nil, &methodError{
+			code: codeMethodNotFound,
+			msg:  fmt.Sprintf("unsupported method: %s", msg.Method),
+		}
+	}
+}
+
+func (s *Server) update(uri, text string) {
+	s.docs[uri] = text
+	s.publishDiagnostics(uri, diagnose(uri, text, s.Warnings))
+}
+
+func (s *Server) publishDiagnostics(uri string, diags []diagnostic) {
+	s.send(&notification{
+		JSONRPC: "2.0",
+		Method:  "textDocument/publishDiagnostics",
+		Params: publishDiagnosticsParams{
+			URI:         uri,
+			Diagnostics: diags,
+		},
+	})
+}
+
+func (s *Server) send(msg interface{}) {
+	s.wmu.Lock()
+	defer s.wmu.Unlock()
+
+	// there's nobody to report a failed write to, and the next read
+	// will fail too
+	writeMessage(s.w, msg)
+}